@@ -3,10 +3,12 @@ package agent
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
 	"reflect"
 	"sync"
 	"time"
 
+	"github.com/docker/swarmkit/agent/discovery"
 	"github.com/docker/swarmkit/agent/exec"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/log"
@@ -31,8 +33,9 @@ type Agent struct {
 
 	keys []*api.EncryptionKey
 
-	sessionq chan sessionOperation
-	worker   Worker
+	sessionq  chan sessionOperation
+	worker    Worker
+	discovery *discovery.Records
 
 	started   chan struct{}
 	startOnce sync.Once // start only once
@@ -53,17 +56,18 @@ func New(config *Config) (*Agent, error) {
 	}
 
 	a := &Agent{
-		config:   config,
-		sessionq: make(chan sessionOperation),
-		started:  make(chan struct{}),
-		leaving:  make(chan struct{}),
-		left:     make(chan struct{}),
-		stopped:  make(chan struct{}),
-		closed:   make(chan struct{}),
-		ready:    make(chan struct{}),
+		config:    config,
+		sessionq:  make(chan sessionOperation),
+		started:   make(chan struct{}),
+		leaving:   make(chan struct{}),
+		left:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+		closed:    make(chan struct{}),
+		ready:     make(chan struct{}),
+		discovery: discovery.NewRecords(),
 	}
 
-	a.worker = newWorker(config.DB, config.Executor, a)
+	a.worker = newWorker(config.DB, config.Executor, a, a.discovery)
 	return a, nil
 }
 
@@ -208,6 +212,16 @@ func (a *Agent) run(ctx context.Context) {
 	}
 	defer a.worker.Close()
 
+	if a.config.DiscoverySocket != "" {
+		l, err := discovery.Listen(a.config.DiscoverySocket)
+		if err != nil {
+			log.G(ctx).WithError(err).Error("failed to listen on discovery socket")
+		} else {
+			defer l.Close()
+			go http.Serve(l, a.discovery)
+		}
+	}
+
 	// setup a reliable reporter to call back to us.
 	reporter := newStatusReporter(ctx, a)
 	defer reporter.Close()
@@ -376,6 +390,14 @@ func (a *Agent) handleSessionMessage(ctx context.Context, message *api.SessionMe
 		}
 	}
 
+	if message.RoutingTable != nil {
+		if receiver, ok := a.config.Executor.(exec.RoutingTableReceiver); ok {
+			if err := receiver.SetRoutingTable(message.RoutingTable); err != nil {
+				log.G(ctx).WithError(err).Error("setting routing table failed")
+			}
+		}
+	}
+
 	if message.NetworkBootstrapKeys == nil {
 		return nil
 	}
@@ -29,6 +29,10 @@ type Config struct {
 
 	// Credentials is credentials for grpc connection to manager.
 	Credentials credentials.TransportCredentials
+
+	// DiscoverySocket is the path of the local socket the service discovery
+	// records are served on. If empty, the records are not served.
+	DiscoverySocket string
 }
 
 func (c *Config) validate() error {
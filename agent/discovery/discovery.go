@@ -0,0 +1,144 @@
+// Package discovery keeps the service discovery records distributed to the
+// agent by the manager, and serves them over a local socket so that
+// processes which don't use the engine's embedded DNS can resolve services.
+package discovery
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/xnet"
+	"github.com/pkg/errors"
+)
+
+// Records holds the discovery records of the services, indexed by service
+// ID. It implements http.Handler, serving the records as JSON:
+//
+//	GET /services         lists the records of all services
+//	GET /services/<name>  returns the record of a service, by name or ID
+type Records struct {
+	mu      sync.RWMutex
+	records map[string]*api.DiscoveryRecord
+}
+
+// NewRecords returns an empty set of discovery records.
+func NewRecords() *Records {
+	return &Records{
+		records: make(map[string]*api.DiscoveryRecord),
+	}
+}
+
+// Add adds or replaces the records of the services.
+func (r *Records) Add(records ...*api.DiscoveryRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, record := range records {
+		r.records[record.ServiceID] = record.Copy()
+	}
+}
+
+// Remove removes the records of the services with the given IDs.
+func (r *Records) Remove(serviceIDs []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range serviceIDs {
+		delete(r.records, id)
+	}
+}
+
+// Reset removes all the records.
+func (r *Records) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = make(map[string]*api.DiscoveryRecord)
+}
+
+// Lookup returns the record of the service with the given name or ID, or nil
+// if there is no such service.
+func (r *Records) Lookup(service string) *api.DiscoveryRecord {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if record, ok := r.records[service]; ok {
+		return record
+	}
+	for _, record := range r.records {
+		if record.ServiceName == service {
+			return record
+		}
+	}
+	return nil
+}
+
+// List returns the records of all services, sorted by service name.
+func (r *Records) List() []*api.DiscoveryRecord {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	records := make([]*api.DiscoveryRecord, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, record)
+	}
+	sort.Sort(recordsByName(records))
+	return records
+}
+
+type recordsByName []*api.DiscoveryRecord
+
+func (r recordsByName) Len() int { return len(r) }
+
+func (r recordsByName) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+
+func (r recordsByName) Less(i, j int) bool {
+	if r[i].ServiceName != r[j].ServiceName {
+		return r[i].ServiceName < r[j].ServiceName
+	}
+	return r[i].ServiceID < r[j].ServiceID
+}
+
+// ServeHTTP serves the records as JSON.
+func (r *Records) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var v interface{}
+	switch {
+	case req.URL.Path == "/services":
+		v = r.List()
+	case strings.HasPrefix(req.URL.Path, "/services/"):
+		record := r.Lookup(strings.TrimPrefix(req.URL.Path, "/services/"))
+		if record == nil {
+			http.NotFound(w, req)
+			return
+		}
+		v = record
+	default:
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// Listen opens the local socket the records are served on, replacing a
+// stale socket file left at the same path.
+func Listen(socket string) (net.Listener, error) {
+	if runtime.GOOS != "windows" {
+		if err := os.MkdirAll(filepath.Dir(socket), 0700); err != nil {
+			return nil, errors.Wrap(err, "failed to create socket directory")
+		}
+		os.Remove(socket)
+	}
+	return xnet.ListenLocal(socket)
+}
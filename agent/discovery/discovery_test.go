@@ -0,0 +1,71 @@
+package discovery
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecords(t *testing.T) {
+	records := NewRecords()
+	web := &api.DiscoveryRecord{
+		ServiceID:   "id1",
+		ServiceName: "web",
+		Networks: []*api.DiscoveryRecord_Network{{
+			NetworkID:     "net1",
+			VirtualIP:     "10.0.0.2",
+			TaskAddresses: []string{"10.0.0.3", "10.0.0.4"},
+		}},
+	}
+	db := &api.DiscoveryRecord{ServiceID: "id2", ServiceName: "db"}
+	records.Add(web, db)
+
+	assert.Equal(t, web, records.Lookup("web"))
+	assert.Equal(t, web, records.Lookup("id1"))
+	assert.Nil(t, records.Lookup("cache"))
+	assert.Equal(t, []*api.DiscoveryRecord{db, web}, records.List())
+
+	records.Remove([]string{"id2"})
+	assert.Nil(t, records.Lookup("db"))
+	assert.Len(t, records.List(), 1)
+
+	records.Reset()
+	assert.Empty(t, records.List())
+}
+
+func TestRecordsServeHTTP(t *testing.T) {
+	records := NewRecords()
+	web := &api.DiscoveryRecord{
+		ServiceID:   "id1",
+		ServiceName: "web",
+		Networks:    []*api.DiscoveryRecord_Network{{NetworkID: "net1", VirtualIP: "10.0.0.2"}},
+	}
+	records.Add(web)
+
+	srv := httptest.NewServer(records)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/services")
+	require.NoError(t, err)
+	var list []*api.DiscoveryRecord
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&list))
+	resp.Body.Close()
+	assert.Equal(t, []*api.DiscoveryRecord{web}, list)
+
+	resp, err = http.Get(srv.URL + "/services/web")
+	require.NoError(t, err)
+	var record api.DiscoveryRecord
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&record))
+	resp.Body.Close()
+	assert.Equal(t, web, &record)
+
+	resp, err = http.Get(srv.URL + "/services/db")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -459,6 +459,7 @@ func (c *containerConfig) networkingConfig() *network.NetworkingConfig {
 				IPv4Address: ipv4,
 				IPv6Address: ipv6,
 			},
+			MacAddress: na.MacAddress,
 		}
 
 		epConfig[na.Network.Spec.Annotations.Name] = epSettings
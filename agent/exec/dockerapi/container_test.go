@@ -6,6 +6,7 @@ import (
 	"time"
 
 	enginecontainer "github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
 	"github.com/docker/swarmkit/api"
 	gogotypes "github.com/gogo/protobuf/types"
 )
@@ -148,3 +149,85 @@ func TestStopSignal(t *testing.T) {
 		t.Fatalf("expected %s, got %s", expected, actual)
 	}
 }
+
+func TestPortBindingsSCTP(t *testing.T) {
+	c := containerConfig{
+		task: &api.Task{
+			Spec: api.TaskSpec{Runtime: &api.TaskSpec_Container{
+				Container: &api.ContainerSpec{},
+			}},
+			Endpoint: &api.Endpoint{
+				Ports: []*api.PortConfig{
+					{
+						Protocol:      api.ProtocolSCTP,
+						TargetPort:    3868,
+						PublishedPort: 33868,
+						PublishMode:   api.PublishModeHost,
+					},
+				},
+			},
+		},
+	}
+
+	expectedBindings := nat.PortMap{
+		"3868/sctp": []nat.PortBinding{{HostPort: "33868"}},
+	}
+	if actual := c.portBindings(); !reflect.DeepEqual(actual, expectedBindings) {
+		t.Fatalf("expected %v, got %v", expectedBindings, actual)
+	}
+
+	expectedExposed := map[nat.Port]struct{}{"3868/sctp": {}}
+	if actual := c.exposedPorts(); !reflect.DeepEqual(actual, expectedExposed) {
+		t.Fatalf("expected %v, got %v", expectedExposed, actual)
+	}
+
+	ports, err := parsePortMap(expectedBindings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedPorts := []*api.PortConfig{
+		{
+			Protocol:      api.ProtocolSCTP,
+			TargetPort:    3868,
+			PublishedPort: 33868,
+			PublishMode:   api.PublishModeHost,
+		},
+	}
+	if !reflect.DeepEqual(ports, expectedPorts) {
+		t.Fatalf("expected %v, got %v", expectedPorts, ports)
+	}
+}
+
+func TestRouterContainerConfig(t *testing.T) {
+	task := &api.Task{
+		ID: "router1",
+		Spec: api.TaskSpec{Runtime: &api.TaskSpec_Router{
+			Router: &api.RouterSpec{ListenPort: 8080, Image: "router:latest"},
+		}},
+		Networks: []*api.NetworkAttachment{
+			{Network: &api.Network{ID: "net1", Spec: api.NetworkSpec{Annotations: api.Annotations{Name: "net1"}}}},
+		},
+	}
+
+	c, err := newContainerConfig(routerContainerTask(task, "/var/lib/swarmkit/router"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.Spec.GetRouter() == nil {
+		t.Fatal("router task was modified")
+	}
+
+	if c.image() != "router:latest" {
+		t.Fatalf("unexpected image: %s", c.image())
+	}
+	if binds := c.hostConfig().Binds; !reflect.DeepEqual(binds, []string{"/var/lib/swarmkit/router:/run/swarm-router:ro"}) {
+		t.Fatalf("unexpected binds: %v", binds)
+	}
+	expectedPorts := nat.PortMap{"8080/tcp": []nat.PortBinding{{HostPort: "8080"}}}
+	if ports := c.portBindings(); !reflect.DeepEqual(ports, expectedPorts) {
+		t.Fatalf("unexpected port bindings: %v", ports)
+	}
+	if networks := c.networks(); !reflect.DeepEqual(networks, []string{"net1"}) {
+		t.Fatalf("unexpected networks: %v", networks)
+	}
+}
@@ -3,26 +3,39 @@ package dockerapi
 import (
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/docker/docker/api/types/filters"
 	engineapi "github.com/docker/docker/client"
 	"github.com/docker/swarmkit/agent/exec"
+	"github.com/docker/swarmkit/agent/exec/router"
 	"github.com/docker/swarmkit/agent/secrets"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/template"
 	"golang.org/x/net/context"
 )
 
 type executor struct {
 	client  engineapi.APIClient
 	secrets exec.SecretsManager
+
+	// routerDir holds the routing table of the router containers. Router
+	// tasks aren't supported if it is empty.
+	routerDir string
+	routerMu  sync.Mutex // serializes routing table writes
+
+	mu   sync.Mutex // protects node
+	node *api.NodeDescription
 }
 
-// NewExecutor returns an executor from the docker client.
-func NewExecutor(client engineapi.APIClient) exec.Executor {
+// NewExecutor returns an executor from the docker client. The routing table
+// of router tasks is kept in routerDir.
+func NewExecutor(client engineapi.APIClient, routerDir string) exec.Executor {
 	return &executor{
-		client:  client,
-		secrets: secrets.NewManager(),
+		client:    client,
+		secrets:   secrets.NewManager(),
+		routerDir: routerDir,
 	}
 }
 
@@ -108,6 +121,11 @@ func (e *executor) Describe(ctx context.Context) (*api.NodeDescription, error) {
 		},
 	}
 
+	// Save the node information in the executor field
+	e.mu.Lock()
+	e.node = description
+	e.mu.Unlock()
+
 	return description, nil
 }
 
@@ -115,9 +133,22 @@ func (e *executor) Configure(ctx context.Context, node *api.Node) error {
 	return nil
 }
 
-// Controller returns a docker container controller.
+// Controller returns a docker container controller. Router tasks run as
+// router containers.
 func (e *executor) Controller(t *api.Task) (exec.Controller, error) {
-	ctlr, err := newController(e.client, t, secrets.Restrict(e.secrets, t))
+	if t.Spec.GetRouter() != nil {
+		if e.routerDir == "" {
+			return nil, exec.ErrRuntimeUnsupported
+		}
+		t = routerContainerTask(t, e.routerDir)
+	}
+
+	e.mu.Lock()
+	node := e.node
+	e.mu.Unlock()
+
+	restricted := secrets.Restrict(e.secrets, t)
+	ctlr, err := newController(e.client, t, template.NewTemplatedSecretGetter(restricted, t, node))
 	if err != nil {
 		return nil, err
 	}
@@ -129,6 +160,17 @@ func (e *executor) SetNetworkBootstrapKeys([]*api.EncryptionKey) error {
 	return nil
 }
 
+// SetRoutingTable updates the routing table of the router containers.
+func (e *executor) SetRoutingTable(table *api.RoutingTable) error {
+	if e.routerDir == "" {
+		return nil
+	}
+
+	e.routerMu.Lock()
+	defer e.routerMu.Unlock()
+	return router.WriteTable(e.routerDir, table)
+}
+
 func (e *executor) Secrets() exec.SecretsManager {
 	return e.secrets
 }
@@ -0,0 +1,53 @@
+package dockerapi
+
+import (
+	"path"
+	"strconv"
+
+	"github.com/docker/swarmkit/agent/exec/router"
+	"github.com/docker/swarmkit/api"
+)
+
+// routerTableDir is where the directory holding the routing table is mounted
+// in router containers.
+const routerTableDir = "/run/swarm-router"
+
+// routerContainerTask returns a copy of the router task t which runs the
+// router as a container. The container is attached to the task networks, so
+// that it can reach the tasks it routes to, publishes the listen port on the
+// host and reads the routing table from tableDir.
+func routerContainerTask(t *api.Task, tableDir string) *api.Task {
+	spec := t.Spec.GetRouter()
+	port := strconv.FormatUint(uint64(spec.ListenPort), 10)
+
+	t = t.Copy()
+	t.Spec.Runtime = &api.TaskSpec_Container{
+		Container: &api.ContainerSpec{
+			Image: spec.Image,
+			Args: []string{
+				"--listen-addr", ":" + port,
+				"--routing-table", path.Join(routerTableDir, router.TableFile),
+			},
+			Mounts: []api.Mount{
+				{
+					Type:     api.MountTypeBind,
+					Source:   tableDir,
+					Target:   routerTableDir,
+					ReadOnly: true,
+				},
+			},
+		},
+	}
+
+	if t.Endpoint == nil {
+		t.Endpoint = &api.Endpoint{}
+	}
+	t.Endpoint.Ports = append(t.Endpoint.Ports, &api.PortConfig{
+		Protocol:      api.ProtocolTCP,
+		TargetPort:    spec.ListenPort,
+		PublishedPort: spec.ListenPort,
+		PublishMode:   api.PublishModeHost,
+	})
+
+	return t
+}
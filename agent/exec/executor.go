@@ -22,6 +22,13 @@ type Executor interface {
 	SetNetworkBootstrapKeys([]*api.EncryptionKey) error
 }
 
+// RoutingTableReceiver is implemented by executors that run built-in router
+// tasks, which proxy requests according to the routing table distributed by
+// the manager.
+type RoutingTableReceiver interface {
+	SetRoutingTable(*api.RoutingTable) error
+}
+
 // SecretsProvider is implemented by objects that can store secrets, typically
 // an executor.
 type SecretsProvider interface {
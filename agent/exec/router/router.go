@@ -0,0 +1,105 @@
+// Package router implements the built-in HTTP router, which proxies requests
+// to the tasks of services according to the routing table distributed by the
+// manager. The agent writes the routing table to a file shared with the
+// router container, which runs swarm-router to serve it.
+package router
+
+import (
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/docker/swarmkit/api"
+)
+
+// Router is an http.Handler which proxies requests to the backends of the
+// most specific routing table entry matching them, in round-robin order.
+type Router struct {
+	mu      sync.RWMutex
+	entries []*entry
+}
+
+type entry struct {
+	*api.RoutingTable_Entry
+
+	// next is the index of the next backend to proxy to, accessed
+	// atomically.
+	next uint32
+}
+
+// New returns a router with an empty routing table.
+func New() *Router {
+	return &Router{}
+}
+
+// Update replaces the routing table of the router.
+func (r *Router) Update(table *api.RoutingTable) {
+	entries := make([]*entry, 0, len(table.Entries))
+	for _, e := range table.Entries {
+		entries = append(entries, &entry{RoutingTable_Entry: e.Copy()})
+	}
+
+	r.mu.Lock()
+	r.entries = entries
+	r.mu.Unlock()
+}
+
+// match returns the first entry matching host and path. Entries are sorted by
+// the manager so that the first match is the most specific one.
+func (r *Router) match(host, path string) *entry {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, e := range r.entries {
+		if !matchPathPrefix(path, e.PathPrefix) {
+			continue
+		}
+		if len(e.Hostnames) == 0 {
+			return e
+		}
+		for _, hostname := range e.Hostnames {
+			if strings.EqualFold(hostname, host) {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// matchPathPrefix returns whether path starts with prefix on a path segment
+// boundary, so that "/api" matches "/api" and "/api/v1", but not "/apis".
+func matchPathPrefix(path, prefix string) bool {
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	return len(path) == len(prefix) || strings.HasSuffix(prefix, "/") || path[len(prefix)] == '/'
+}
+
+// ServeHTTP proxies the request to a backend of the matching entry.
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	e := r.match(req.Host, req.URL.Path)
+	if e == nil {
+		http.NotFound(w, req)
+		return
+	}
+	if len(e.Backends) == 0 {
+		http.Error(w, "no backend available", http.StatusServiceUnavailable)
+		return
+	}
+
+	backend := e.Backends[int(atomic.AddUint32(&e.next, 1)-1)%len(e.Backends)]
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = "http"
+			req.URL.Host = backend
+		},
+	}
+	proxy.ServeHTTP(w, req)
+}
@@ -0,0 +1,113 @@
+package router
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func backend(name string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Write([]byte(name + " " + req.URL.Path))
+	}))
+}
+
+func get(t *testing.T, url, host string) (int, string) {
+	req, err := http.NewRequest("GET", url, nil)
+	require.NoError(t, err)
+	req.Host = host
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestRouter(t *testing.T) {
+	api1, api2, web := backend("api1"), backend("api2"), backend("web")
+	defer api1.Close()
+	defer api2.Close()
+	defer web.Close()
+
+	r := New()
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	// Nothing is routed before the routing table is received.
+	code, _ := get(t, srv.URL+"/", "example.com")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	r.Update(&api.RoutingTable{
+		Entries: []*api.RoutingTable_Entry{
+			{
+				ServiceID:  "api",
+				Hostnames:  []string{"example.com"},
+				PathPrefix: "/api",
+				Backends: []string{
+					strings.TrimPrefix(api1.URL, "http://"),
+					strings.TrimPrefix(api2.URL, "http://"),
+				},
+			},
+			{
+				ServiceID:  "down",
+				PathPrefix: "/down",
+			},
+			{
+				ServiceID:  "web",
+				Hostnames:  []string{"example.com"},
+				PathPrefix: "/",
+				Backends:   []string{strings.TrimPrefix(web.URL, "http://")},
+			},
+		},
+	})
+
+	// The most specific entry wins, and its backends are used in turn.
+	_, body := get(t, srv.URL+"/api/v1", "example.com")
+	assert.Equal(t, "api1 /api/v1", body)
+	_, body = get(t, srv.URL+"/api/v1", "example.com:8080")
+	assert.Equal(t, "api2 /api/v1", body)
+	_, body = get(t, srv.URL+"/index.html", "EXAMPLE.com")
+	assert.Equal(t, "web /index.html", body)
+
+	// Path prefixes match on path segment boundaries.
+	_, body = get(t, srv.URL+"/api", "example.com")
+	assert.Equal(t, "api1 /api", body)
+	_, body = get(t, srv.URL+"/apis", "example.com")
+	assert.Equal(t, "web /apis", body)
+
+	code, _ = get(t, srv.URL+"/", "other.com")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = get(t, srv.URL+"/down", "other.com")
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestLoadTable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "router")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	r := New()
+	assert.Error(t, r.Load(filepath.Join(dir, TableFile)))
+
+	table := &api.RoutingTable{
+		Entries: []*api.RoutingTable_Entry{
+			{ServiceID: "web", PathPrefix: "/", Backends: []string{"10.0.0.2:80"}, NetworkID: "net1"},
+		},
+	}
+	require.NoError(t, WriteTable(dir, table))
+	require.NoError(t, r.Load(filepath.Join(dir, TableFile)))
+
+	e := r.match("example.com", "/")
+	require.NotNil(t, e)
+	assert.Equal(t, table.Entries[0], e.RoutingTable_Entry)
+}
@@ -0,0 +1,45 @@
+package router
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ioutils"
+	"github.com/pkg/errors"
+)
+
+// TableFile is the name of the routing table file in the directory shared
+// between the agent and the router containers.
+const TableFile = "routing-table"
+
+// WriteTable atomically replaces the routing table stored in dir. The
+// directory, rather than the file, is mounted into the router containers so
+// that they see the replaced file.
+func WriteTable(dir string, table *api.RoutingTable) error {
+	data, err := table.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(filepath.Join(dir, TableFile), data, 0644)
+}
+
+// Load replaces the routing table of the router with the one stored in
+// path.
+func (r *Router) Load(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var table api.RoutingTable
+	if err := table.Unmarshal(data); err != nil {
+		return errors.Wrapf(err, "invalid routing table %s", path)
+	}
+	r.Update(&table)
+	return nil
+}
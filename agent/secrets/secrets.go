@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/swarmkit/agent/exec"
 	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/identity"
 )
 
 // secrets is a map that keeps all the currently available secrets to the agent
@@ -61,6 +62,7 @@ func (s *secrets) Reset() {
 type taskRestrictedSecretsProvider struct {
 	secrets   exec.SecretGetter
 	secretIDs map[string]struct{} // allow list of secret ids
+	taskID    string
 }
 
 func (sp *taskRestrictedSecretsProvider) Get(secretID string) *api.Secret {
@@ -68,6 +70,14 @@ func (sp *taskRestrictedSecretsProvider) Get(secretID string) *api.Secret {
 		return nil
 	}
 
+	// Secrets provided by drivers are sent for each task, under an ID
+	// combining the secret and task IDs.
+	if secret := sp.secrets.Get(identity.CombineTwoIDs(secretID, sp.taskID)); secret != nil {
+		secret = secret.Copy()
+		secret.ID = secretID
+		return secret
+	}
+
 	return sp.secrets.Get(secretID)
 }
 
@@ -83,5 +93,5 @@ func Restrict(secrets exec.SecretGetter, t *api.Task) exec.SecretGetter {
 		}
 	}
 
-	return &taskRestrictedSecretsProvider{secrets: secrets, secretIDs: sids}
+	return &taskRestrictedSecretsProvider{secrets: secrets, secretIDs: sids, taskID: t.ID}
 }
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"errors"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -14,7 +15,15 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
-const dispatcherRPCTimeout = 5 * time.Second
+const (
+	dispatcherRPCTimeout = 5 * time.Second
+
+	// initialStatusBackoff and maxStatusBackoff bound the delay before
+	// retrying task status updates the dispatcher rejected because it is
+	// overloaded.
+	initialStatusBackoff = 100 * time.Millisecond
+	maxStatusBackoff     = 8 * time.Second
+)
 
 var (
 	errSessionDisconnect = errors.New("agent: session disconnect") // instructed to disconnect
@@ -330,9 +339,37 @@ func (s *session) watch(ctx context.Context) error {
 }
 
 // sendTaskStatus uses the current session to send the status of a single task.
-func (s *session) sendTaskStatus(ctx context.Context, taskID string, status *api.TaskStatus) error {
+// updateTaskStatus sends the task status updates of req to the dispatcher.
+// If the dispatcher rejects them because it is overloaded, they are sent
+// again after a randomized, exponentially increasing delay.
+func (s *session) updateTaskStatus(ctx context.Context, req *api.UpdateTaskStatusRequest) error {
 	client := api.NewDispatcherClient(s.conn.ClientConn)
-	if _, err := client.UpdateTaskStatus(ctx, &api.UpdateTaskStatusRequest{
+	backoff := initialStatusBackoff
+	for {
+		_, err := client.UpdateTaskStatus(ctx, req)
+		if grpc.Code(err) != codes.ResourceExhausted {
+			return err
+		}
+
+		delay := backoff/2 + time.Duration(rand.Int63n(int64(backoff)))
+		log.G(ctx).WithError(err).Debugf("task status updates rejected, retrying in %v", delay)
+		select {
+		case <-time.After(delay):
+		case <-s.closed:
+			return ErrClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		backoff *= 2
+		if backoff > maxStatusBackoff {
+			backoff = maxStatusBackoff
+		}
+	}
+}
+
+func (s *session) sendTaskStatus(ctx context.Context, taskID string, status *api.TaskStatus) error {
+	if err := s.updateTaskStatus(ctx, &api.UpdateTaskStatusRequest{
 		SessionID: s.sessionID,
 		Updates: []*api.UpdateTaskStatusRequest_TaskStatusUpdate{
 			{
@@ -372,14 +409,13 @@ func (s *session) sendTaskStatuses(ctx context.Context, updates ...*api.UpdateTa
 		return updates, ctx.Err()
 	}
 
-	client := api.NewDispatcherClient(s.conn.ClientConn)
 	n := batchSize
 
 	if len(updates) < n {
 		n = len(updates)
 	}
 
-	if _, err := client.UpdateTaskStatus(ctx, &api.UpdateTaskStatusRequest{
+	if err := s.updateTaskStatus(ctx, &api.UpdateTaskStatusRequest{
 		SessionID: s.sessionID,
 		Updates:   updates[:n],
 	}); err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/boltdb/bolt"
+	"github.com/docker/swarmkit/agent/discovery"
 	"github.com/docker/swarmkit/agent/exec"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/log"
@@ -57,6 +58,7 @@ type worker struct {
 	listeners         map[*statusReporterKey]struct{}
 	taskevents        *watch.Queue
 	publisherProvider exec.LogPublisherProvider
+	discovery         *discovery.Records
 
 	taskManagers map[string]*taskManager
 	mu           sync.RWMutex
@@ -65,11 +67,12 @@ type worker struct {
 	closers sync.WaitGroup // keeps track of active closers
 }
 
-func newWorker(db *bolt.DB, executor exec.Executor, publisherProvider exec.LogPublisherProvider) *worker {
+func newWorker(db *bolt.DB, executor exec.Executor, publisherProvider exec.LogPublisherProvider, records *discovery.Records) *worker {
 	return &worker{
 		db:                db,
 		executor:          executor,
 		publisherProvider: publisherProvider,
+		discovery:         records,
 		taskevents:        watch.NewQueue(),
 		listeners:         make(map[*statusReporterKey]struct{}),
 		taskManagers:      make(map[string]*taskManager),
@@ -142,6 +145,8 @@ func (w *worker) Assign(ctx context.Context, assignments []*api.AssignmentChange
 		return err
 	}
 
+	reconcileDiscoveryRecords(ctx, w, assignments, true)
+
 	return reconcileTaskState(ctx, w, assignments, true)
 }
 
@@ -167,6 +172,8 @@ func (w *worker) Update(ctx context.Context, assignments []*api.AssignmentChange
 		return err
 	}
 
+	reconcileDiscoveryRecords(ctx, w, assignments, false)
+
 	return reconcileTaskState(ctx, w, assignments, false)
 }
 
@@ -344,6 +351,36 @@ func reconcileSecrets(ctx context.Context, w *worker, assignments []*api.Assignm
 	return nil
 }
 
+func reconcileDiscoveryRecords(ctx context.Context, w *worker, assignments []*api.AssignmentChange, fullSnapshot bool) {
+	var (
+		updatedRecords []*api.DiscoveryRecord
+		removedRecords []string
+	)
+	for _, a := range assignments {
+		if r := a.Assignment.GetDiscoveryRecord(); r != nil {
+			switch a.Action {
+			case api.AssignmentChange_AssignmentActionUpdate:
+				updatedRecords = append(updatedRecords, r)
+			case api.AssignmentChange_AssignmentActionRemove:
+				removedRecords = append(removedRecords, r.ServiceID)
+			}
+		}
+	}
+
+	log.G(ctx).WithFields(logrus.Fields{
+		"len(updatedRecords)": len(updatedRecords),
+		"len(removedRecords)": len(removedRecords),
+	}).Debug("(*worker).reconcileDiscoveryRecords")
+
+	// If this was a complete set of records, we're going to clear the records and add all of them
+	if fullSnapshot {
+		w.discovery.Reset()
+	} else {
+		w.discovery.Remove(removedRecords)
+	}
+	w.discovery.Add(updatedRecords...)
+}
+
 func (w *worker) Listen(ctx context.Context, reporter StatusReporter) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
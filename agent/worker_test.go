@@ -5,6 +5,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/boltdb/bolt"
+	"github.com/docker/swarmkit/agent/discovery"
 	"github.com/docker/swarmkit/agent/exec"
 	"github.com/docker/swarmkit/agent/secrets"
 	"github.com/docker/swarmkit/api"
@@ -35,7 +36,7 @@ func TestWorkerAssign(t *testing.T) {
 
 	ctx := context.Background()
 	executor := &mockExecutor{t: t, secrets: secrets.NewManager()}
-	worker := newWorker(db, executor, &testPublisherProvider{})
+	worker := newWorker(db, executor, &testPublisherProvider{}, discovery.NewRecords())
 	reporter := statusReporterFunc(func(ctx context.Context, taskID string, status *api.TaskStatus) error {
 		log.G(ctx).WithFields(logrus.Fields{"task.id": taskID, "status": status}).Info("status update received")
 		return nil
@@ -167,7 +168,7 @@ func TestWorkerWait(t *testing.T) {
 
 	ctx := context.Background()
 	executor := &mockExecutor{t: t, secrets: secrets.NewManager()}
-	worker := newWorker(db, executor, &testPublisherProvider{})
+	worker := newWorker(db, executor, &testPublisherProvider{}, discovery.NewRecords())
 	reporter := statusReporterFunc(func(ctx context.Context, taskID string, status *api.TaskStatus) error {
 		log.G(ctx).WithFields(logrus.Fields{"task.id": taskID, "status": status}).Info("status update received")
 		return nil
@@ -263,7 +264,7 @@ func TestWorkerUpdate(t *testing.T) {
 
 	ctx := context.Background()
 	executor := &mockExecutor{t: t, secrets: secrets.NewManager()}
-	worker := newWorker(db, executor, &testPublisherProvider{})
+	worker := newWorker(db, executor, &testPublisherProvider{}, discovery.NewRecords())
 	reporter := statusReporterFunc(func(ctx context.Context, taskID string, status *api.TaskStatus) error {
 		log.G(ctx).WithFields(logrus.Fields{"task.id": taskID, "status": status}).Info("status update received")
 		return nil
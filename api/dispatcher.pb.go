@@ -58,7 +58,7 @@ func (x AssignmentChange_AssignmentAction) String() string {
 	return proto.EnumName(AssignmentChange_AssignmentAction_name, int32(x))
 }
 func (AssignmentChange_AssignmentAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorDispatcher, []int{10, 0}
+	return fileDescriptorDispatcher, []int{11, 0}
 }
 
 // AssignmentType specifies whether this assignment message carries
@@ -83,7 +83,7 @@ func (x AssignmentsMessage_Type) String() string {
 	return proto.EnumName(AssignmentsMessage_Type_name, int32(x))
 }
 func (AssignmentsMessage_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorDispatcher, []int{11, 0}
+	return fileDescriptorDispatcher, []int{12, 0}
 }
 
 // SessionRequest starts a session.
@@ -154,6 +154,8 @@ type SessionMessage struct {
 	// Symmetric encryption key distributed by the lead manager. Used by agents
 	// for securing network bootstrapping and communication.
 	NetworkBootstrapKeys []*EncryptionKey `protobuf:"bytes,4,rep,name=network_bootstrap_keys,json=networkBootstrapKeys" json:"network_bootstrap_keys,omitempty"`
+	// RoutingTable is the routing table of the built-in HTTP router.
+	RoutingTable *RoutingTable `protobuf:"bytes,5,opt,name=routing_table,json=routingTable" json:"routing_table,omitempty"`
 }
 
 func (m *SessionMessage) Reset()                    { *m = SessionMessage{} }
@@ -244,6 +246,7 @@ type Assignment struct {
 	// Types that are valid to be assigned to Item:
 	//	*Assignment_Task
 	//	*Assignment_Secret
+	//	*Assignment_DiscoveryRecord
 	Item isAssignment_Item `protobuf_oneof:"item"`
 }
 
@@ -263,9 +266,13 @@ type Assignment_Task struct {
 type Assignment_Secret struct {
 	Secret *Secret `protobuf:"bytes,2,opt,name=secret,oneof"`
 }
+type Assignment_DiscoveryRecord struct {
+	DiscoveryRecord *DiscoveryRecord `protobuf:"bytes,3,opt,name=discovery_record,json=discoveryRecord,oneof"`
+}
 
 func (*Assignment_Task) isAssignment_Item()   {}
 func (*Assignment_Secret) isAssignment_Item() {}
+func (*Assignment_DiscoveryRecord) isAssignment_Item() {}
 
 func (m *Assignment) GetItem() isAssignment_Item {
 	if m != nil {
@@ -288,11 +295,19 @@ func (m *Assignment) GetSecret() *Secret {
 	return nil
 }
 
+func (m *Assignment) GetDiscoveryRecord() *DiscoveryRecord {
+	if x, ok := m.GetItem().(*Assignment_DiscoveryRecord); ok {
+		return x.DiscoveryRecord
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Assignment) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Assignment_OneofMarshaler, _Assignment_OneofUnmarshaler, _Assignment_OneofSizer, []interface{}{
 		(*Assignment_Task)(nil),
 		(*Assignment_Secret)(nil),
+		(*Assignment_DiscoveryRecord)(nil),
 	}
 }
 
@@ -310,6 +325,11 @@ func _Assignment_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Secret); err != nil {
 			return err
 		}
+	case *Assignment_DiscoveryRecord:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DiscoveryRecord); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Assignment.Item has unexpected type %T", x)
@@ -336,6 +356,14 @@ func _Assignment_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buf
 		err := b.DecodeMessage(msg)
 		m.Item = &Assignment_Secret{msg}
 		return true, err
+	case 3: // item.discovery_record
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(DiscoveryRecord)
+		err := b.DecodeMessage(msg)
+		m.Item = &Assignment_DiscoveryRecord{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -355,6 +383,11 @@ func _Assignment_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(2<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Assignment_DiscoveryRecord:
+		s := proto.Size(x.DiscoveryRecord)
+		n += proto.SizeVarint(3<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -362,6 +395,38 @@ func _Assignment_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// DiscoveryRecord describes how to reach a service on each of its networks,
+// so that agents can resolve services without the engine's embedded DNS.
+// Every agent receives the records of all services.
+type DiscoveryRecord struct {
+	ServiceID   string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// Networks are sorted by network ID.
+	Networks []*DiscoveryRecord_Network `protobuf:"bytes,3,rep,name=networks" json:"networks,omitempty"`
+	// Ports are the ports exposed by the service.
+	Ports []*PortConfig `protobuf:"bytes,4,rep,name=ports" json:"ports,omitempty"`
+}
+
+func (m *DiscoveryRecord) Reset()                    { *m = DiscoveryRecord{} }
+func (*DiscoveryRecord) ProtoMessage()               {}
+func (*DiscoveryRecord) Descriptor() ([]byte, []int) { return fileDescriptorDispatcher, []int{10} }
+
+type DiscoveryRecord_Network struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// VirtualIP is the virtual IP of the service on the network, if the
+	// service uses VIP resolution mode.
+	VirtualIP string `protobuf:"bytes,2,opt,name=virtual_ip,json=virtualIp,proto3" json:"virtual_ip,omitempty"`
+	// TaskAddresses are the addresses of the running tasks of the
+	// service on the network.
+	TaskAddresses []string `protobuf:"bytes,3,rep,name=task_addresses,json=taskAddresses" json:"task_addresses,omitempty"`
+}
+
+func (m *DiscoveryRecord_Network) Reset()      { *m = DiscoveryRecord_Network{} }
+func (*DiscoveryRecord_Network) ProtoMessage() {}
+func (*DiscoveryRecord_Network) Descriptor() ([]byte, []int) {
+	return fileDescriptorDispatcher, []int{10, 0}
+}
+
 type AssignmentChange struct {
 	Assignment *Assignment                       `protobuf:"bytes,1,opt,name=assignment" json:"assignment,omitempty"`
 	Action     AssignmentChange_AssignmentAction `protobuf:"varint,2,opt,name=action,proto3,enum=docker.swarmkit.v1.AssignmentChange_AssignmentAction" json:"action,omitempty"`
@@ -369,7 +434,7 @@ type AssignmentChange struct {
 
 func (m *AssignmentChange) Reset()                    { *m = AssignmentChange{} }
 func (*AssignmentChange) ProtoMessage()               {}
-func (*AssignmentChange) Descriptor() ([]byte, []int) { return fileDescriptorDispatcher, []int{10} }
+func (*AssignmentChange) Descriptor() ([]byte, []int) { return fileDescriptorDispatcher, []int{11} }
 
 type AssignmentsMessage struct {
 	Type AssignmentsMessage_Type `protobuf:"varint,1,opt,name=type,proto3,enum=docker.swarmkit.v1.AssignmentsMessage_Type" json:"type,omitempty"`
@@ -389,7 +454,7 @@ type AssignmentsMessage struct {
 
 func (m *AssignmentsMessage) Reset()                    { *m = AssignmentsMessage{} }
 func (*AssignmentsMessage) ProtoMessage()               {}
-func (*AssignmentsMessage) Descriptor() ([]byte, []int) { return fileDescriptorDispatcher, []int{11} }
+func (*AssignmentsMessage) Descriptor() ([]byte, []int) { return fileDescriptorDispatcher, []int{12} }
 
 func init() {
 	proto.RegisterType((*SessionRequest)(nil), "docker.swarmkit.v1.SessionRequest")
@@ -403,6 +468,8 @@ func init() {
 	proto.RegisterType((*TasksMessage)(nil), "docker.swarmkit.v1.TasksMessage")
 	proto.RegisterType((*AssignmentsRequest)(nil), "docker.swarmkit.v1.AssignmentsRequest")
 	proto.RegisterType((*Assignment)(nil), "docker.swarmkit.v1.Assignment")
+	proto.RegisterType((*DiscoveryRecord)(nil), "docker.swarmkit.v1.DiscoveryRecord")
+	proto.RegisterType((*DiscoveryRecord_Network)(nil), "docker.swarmkit.v1.DiscoveryRecord.Network")
 	proto.RegisterType((*AssignmentChange)(nil), "docker.swarmkit.v1.AssignmentChange")
 	proto.RegisterType((*AssignmentsMessage)(nil), "docker.swarmkit.v1.AssignmentsMessage")
 	proto.RegisterEnum("docker.swarmkit.v1.AssignmentChange_AssignmentAction", AssignmentChange_AssignmentAction_name, AssignmentChange_AssignmentAction_value)
@@ -513,6 +580,10 @@ func (m *SessionMessage) CopyFrom(src interface{}) {
 		}
 	}
 
+	if o.RoutingTable != nil {
+		m.RoutingTable = &RoutingTable{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.RoutingTable, o.RoutingTable)
+	}
 }
 
 func (m *HeartbeatRequest) Copy() *HeartbeatRequest {
@@ -678,11 +749,68 @@ func (m *Assignment) CopyFrom(src interface{}) {
 			}
 			github_com_docker_swarmkit_api_deepcopy.Copy(v.Secret, o.GetSecret())
 			m.Item = &v
+		case *Assignment_DiscoveryRecord:
+			v := Assignment_DiscoveryRecord{
+				DiscoveryRecord: &DiscoveryRecord{},
+		}
+			github_com_docker_swarmkit_api_deepcopy.Copy(v.DiscoveryRecord, o.GetDiscoveryRecord())
+			m.Item = &v
+		}
+	}
+
+}
+
+func (m *DiscoveryRecord) Copy() *DiscoveryRecord {
+	if m == nil {
+		return nil
+	}
+	o := &DiscoveryRecord{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *DiscoveryRecord) CopyFrom(src interface{}) {
+
+	o := src.(*DiscoveryRecord)
+	*m = *o
+	if o.Networks != nil {
+		m.Networks = make([]*DiscoveryRecord_Network, len(o.Networks))
+		for i := range m.Networks {
+			m.Networks[i] = &DiscoveryRecord_Network{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.Networks[i], o.Networks[i])
+		}
+	}
+
+	if o.Ports != nil {
+		m.Ports = make([]*PortConfig, len(o.Ports))
+		for i := range m.Ports {
+			m.Ports[i] = &PortConfig{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.Ports[i], o.Ports[i])
 		}
 	}
 
 }
 
+func (m *DiscoveryRecord_Network) Copy() *DiscoveryRecord_Network {
+	if m == nil {
+		return nil
+	}
+	o := &DiscoveryRecord_Network{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *DiscoveryRecord_Network) CopyFrom(src interface{}) {
+
+	o := src.(*DiscoveryRecord_Network)
+	*m = *o
+	if o.TaskAddresses != nil {
+		m.TaskAddresses = make([]string, len(o.TaskAddresses))
+		copy(m.TaskAddresses, o.TaskAddresses)
+	}
+
+}
+
 func (m *AssignmentChange) Copy() *AssignmentChange {
 	if m == nil {
 		return nil
@@ -1148,6 +1276,16 @@ func (m *SessionMessage) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.RoutingTable != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(m.RoutingTable.Size()))
+		n3, err := m.RoutingTable.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -1193,11 +1331,11 @@ func (m *HeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintDispatcher(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)))
-	n3, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i:])
+	n4, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
 	return i, nil
 }
 
@@ -1262,11 +1400,11 @@ func (m *UpdateTaskStatusRequest_TaskStatusUpdate) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Status.Size()))
-		n4, err := m.Status.MarshalTo(dAtA[i:])
+		n5, err := m.Status.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	return i, nil
 }
@@ -1383,11 +1521,11 @@ func (m *Assignment) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Item != nil {
-		nn5, err := m.Item.MarshalTo(dAtA[i:])
+		nn6, err := m.Item.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn5
+		i += nn6
 	}
 	return i, nil
 }
@@ -1398,11 +1536,11 @@ func (m *Assignment_Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Task.Size()))
-		n6, err := m.Task.MarshalTo(dAtA[i:])
+		n7, err := m.Task.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	return i, nil
 }
@@ -1412,14 +1550,127 @@ func (m *Assignment_Secret) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Secret.Size()))
-		n7, err := m.Secret.MarshalTo(dAtA[i:])
+		n8, err := m.Secret.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
+	}
+	return i, nil
+}
+func (m *Assignment_DiscoveryRecord) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DiscoveryRecord != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(m.DiscoveryRecord.Size()))
+		n9, err := m.DiscoveryRecord.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+func (m *DiscoveryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiscoveryRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.ServiceID)))
+		i += copy(dAtA[i:], m.ServiceID)
+	}
+	if len(m.ServiceName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.ServiceName)))
+		i += copy(dAtA[i:], m.ServiceName)
+	}
+	if len(m.Networks) > 0 {
+		for _, msg := range m.Networks {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintDispatcher(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, msg := range m.Ports {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintDispatcher(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DiscoveryRecord_Network) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiscoveryRecord_Network) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.NetworkID)))
+		i += copy(dAtA[i:], m.NetworkID)
+	}
+	if len(m.VirtualIP) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDispatcher(dAtA, i, uint64(len(m.VirtualIP)))
+		i += copy(dAtA[i:], m.VirtualIP)
+	}
+	if len(m.TaskAddresses) > 0 {
+		for _, s := range m.TaskAddresses {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
+
 func (m *AssignmentChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1439,11 +1690,11 @@ func (m *AssignmentChange) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintDispatcher(dAtA, i, uint64(m.Assignment.Size()))
-		n8, err := m.Assignment.MarshalTo(dAtA[i:])
+		n10, err := m.Assignment.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	if m.Action != 0 {
 		dAtA[i] = 0x10
@@ -1860,6 +2111,10 @@ func (m *SessionMessage) Size() (n int) {
 			n += 1 + l + sovDispatcher(uint64(l))
 		}
 	}
+	if m.RoutingTable != nil {
+		l = m.RoutingTable.Size()
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
 	return n
 }
 
@@ -1976,6 +2231,61 @@ func (m *Assignment_Secret) Size() (n int) {
 	}
 	return n
 }
+func (m *Assignment_DiscoveryRecord) Size() (n int) {
+	var l int
+	_ = l
+	if m.DiscoveryRecord != nil {
+		l = m.DiscoveryRecord.Size()
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	return n
+}
+func (m *DiscoveryRecord) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ServiceID)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 1 + l + sovDispatcher(uint64(l))
+		}
+	}
+	if len(m.Ports) > 0 {
+		for _, e := range m.Ports {
+			l = e.Size()
+			n += 1 + l + sovDispatcher(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DiscoveryRecord_Network) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	l = len(m.VirtualIP)
+	if l > 0 {
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
+	if len(m.TaskAddresses) > 0 {
+		for _, s := range m.TaskAddresses {
+			l = len(s)
+			n += 1 + l + sovDispatcher(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *AssignmentChange) Size() (n int) {
 	var l int
 	_ = l
@@ -2045,6 +2355,7 @@ func (this *SessionMessage) String() string {
 		`Node:` + strings.Replace(fmt.Sprintf("%v", this.Node), "Node", "Node", 1) + `,`,
 		`Managers:` + strings.Replace(fmt.Sprintf("%v", this.Managers), "WeightedPeer", "WeightedPeer", 1) + `,`,
 		`NetworkBootstrapKeys:` + strings.Replace(fmt.Sprintf("%v", this.NetworkBootstrapKeys), "EncryptionKey", "EncryptionKey", 1) + `,`,
+		`RoutingTable:` + strings.Replace(fmt.Sprintf("%v", this.RoutingTable), "RoutingTable", "RoutingTable", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2160,6 +2471,41 @@ func (this *Assignment_Secret) String() string {
 	}, "")
 	return s
 }
+func (this *Assignment_DiscoveryRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Assignment_DiscoveryRecord{`,
+		`DiscoveryRecord:` + strings.Replace(fmt.Sprintf("%v", this.DiscoveryRecord), "DiscoveryRecord", "DiscoveryRecord", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveryRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiscoveryRecord{`,
+		`ServiceID:` + fmt.Sprintf("%v", this.ServiceID) + `,`,
+		`ServiceName:` + fmt.Sprintf("%v", this.ServiceName) + `,`,
+		`Networks:` + strings.Replace(fmt.Sprintf("%v", this.Networks), "DiscoveryRecord_Network", "DiscoveryRecord_Network", 1) + `,`,
+		`Ports:` + strings.Replace(fmt.Sprintf("%v", this.Ports), "PortConfig", "PortConfig", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveryRecord_Network) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiscoveryRecord_Network{`,
+		`NetworkID:` + fmt.Sprintf("%v", this.NetworkID) + `,`,
+		`VirtualIP:` + fmt.Sprintf("%v", this.VirtualIP) + `,`,
+		`TaskAddresses:` + fmt.Sprintf("%v", this.TaskAddresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AssignmentChange) String() string {
 	if this == nil {
 		return "nil"
@@ -2457,6 +2803,39 @@ func (m *SessionMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingTable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoutingTable == nil {
+				m.RoutingTable = &RoutingTable{}
+			}
+			if err := m.RoutingTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -3241,6 +3620,345 @@ func (m *Assignment) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &Assignment_Secret{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DiscoveryRecord{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &Assignment_DiscoveryRecord{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispatcher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispatcher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Networks = append(m.Networks, &DiscoveryRecord_Network{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ports = append(m.Ports, &PortConfig{})
+			if err := m.Ports[len(m.Ports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispatcher(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveryRecord_Network) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispatcher
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Network: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Network: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualIP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskAddresses = append(m.TaskAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
@@ -3630,65 +4348,77 @@ var (
 func init() { proto.RegisterFile("dispatcher.proto", fileDescriptorDispatcher) }
 
 var fileDescriptorDispatcher = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xe3, 0x54,
-	0x14, 0x8e, 0xd3, 0xd4, 0x6d, 0x4f, 0xda, 0x12, 0x2e, 0xa3, 0xc1, 0x58, 0x9a, 0x34, 0xb8, 0x4c,
-	0x54, 0x69, 0x8a, 0x3b, 0x84, 0x9f, 0x0d, 0x55, 0xa1, 0x69, 0x22, 0x35, 0x9a, 0x49, 0xa7, 0xba,
-	0xcd, 0xcc, 0x2c, 0x23, 0x27, 0x3e, 0xb8, 0x26, 0x8d, 0xaf, 0xf1, 0xbd, 0x99, 0x21, 0x48, 0x48,
-	0x48, 0x30, 0x12, 0x62, 0x85, 0x58, 0x75, 0xc3, 0x2b, 0xf0, 0x1c, 0x15, 0x2b, 0x96, 0xac, 0x0a,
-	0x93, 0x07, 0xe0, 0x01, 0x58, 0x21, 0xdb, 0xd7, 0x49, 0xc8, 0x24, 0x9d, 0xb4, 0xab, 0xc4, 0xe7,
-	0x7c, 0xdf, 0x39, 0x9f, 0xbf, 0x7b, 0x7c, 0x2e, 0xe4, 0x6c, 0x97, 0xfb, 0x96, 0x68, 0x9f, 0x62,
-	0x60, 0xfa, 0x01, 0x13, 0x8c, 0x10, 0x9b, 0xb5, 0x3b, 0x18, 0x98, 0xfc, 0xb9, 0x15, 0x74, 0x3b,
-	0xae, 0x30, 0x9f, 0x7d, 0xa0, 0x67, 0x45, 0xdf, 0x47, 0x1e, 0x03, 0xf4, 0x35, 0xd6, 0xfa, 0x12,
-	0xdb, 0x22, 0x79, 0xbc, 0xe5, 0x30, 0x87, 0x45, 0x7f, 0x77, 0xc2, 0x7f, 0x32, 0xfa, 0x96, 0x7f,
-	0xd6, 0x73, 0x5c, 0x6f, 0x27, 0xfe, 0x91, 0xc1, 0xbc, 0xc3, 0x98, 0x73, 0x86, 0x3b, 0xd1, 0x53,
-	0xab, 0xf7, 0xc5, 0x8e, 0xdd, 0x0b, 0x2c, 0xe1, 0x32, 0x99, 0x37, 0x5e, 0x28, 0xb0, 0x7e, 0x82,
-	0x9c, 0xbb, 0xcc, 0xa3, 0xf8, 0x55, 0x0f, 0xb9, 0x20, 0x55, 0xc8, 0xda, 0xc8, 0xdb, 0x81, 0xeb,
-	0x87, 0x38, 0x4d, 0x29, 0x28, 0x5b, 0xd9, 0xd2, 0xa6, 0xf9, 0xaa, 0x46, 0xf3, 0x88, 0xd9, 0x58,
-	0x19, 0x41, 0xe9, 0x38, 0x8f, 0x6c, 0x03, 0xf0, 0xb8, 0x70, 0xd3, 0xb5, 0xb5, 0x74, 0x41, 0xd9,
-	0x5a, 0x29, 0xaf, 0x0d, 0x2e, 0x37, 0x56, 0x64, 0xbb, 0x5a, 0x85, 0xae, 0x48, 0x40, 0xcd, 0x36,
-	0xbe, 0x4f, 0x0f, 0x75, 0xd4, 0x91, 0x73, 0xcb, 0xc1, 0x89, 0x02, 0xca, 0xd5, 0x05, 0xc8, 0x36,
-	0x64, 0x3c, 0x66, 0x63, 0xd4, 0x28, 0x5b, 0xd2, 0x66, 0xc9, 0xa5, 0x11, 0x8a, 0xec, 0xc2, 0x72,
-	0xd7, 0xf2, 0x2c, 0x07, 0x03, 0xae, 0x2d, 0x14, 0x16, 0xb6, 0xb2, 0xa5, 0xc2, 0x34, 0xc6, 0x53,
-	0x74, 0x9d, 0x53, 0x81, 0xf6, 0x31, 0x62, 0x40, 0x87, 0x0c, 0xf2, 0x14, 0x6e, 0x7b, 0x28, 0x9e,
-	0xb3, 0xa0, 0xd3, 0x6c, 0x31, 0x26, 0xb8, 0x08, 0x2c, 0xbf, 0xd9, 0xc1, 0x3e, 0xd7, 0x32, 0x51,
-	0xad, 0x77, 0xa7, 0xd5, 0xaa, 0x7a, 0xed, 0xa0, 0x1f, 0x59, 0xf3, 0x00, 0xfb, 0xf4, 0x96, 0x2c,
-	0x50, 0x4e, 0xf8, 0x0f, 0xb0, 0xcf, 0x8d, 0xcf, 0x21, 0x77, 0x88, 0x56, 0x20, 0x5a, 0x68, 0x89,
-	0xe4, 0x38, 0xae, 0x65, 0x83, 0x71, 0x0c, 0x6f, 0x8e, 0x55, 0xe0, 0x3e, 0xf3, 0x38, 0x92, 0x4f,
-	0x41, 0xf5, 0x31, 0x70, 0x99, 0x2d, 0x0f, 0xf3, 0x1d, 0x33, 0x9e, 0x0a, 0x33, 0x99, 0x0a, 0xb3,
-	0x22, 0xa7, 0xa2, 0xbc, 0x7c, 0x71, 0xb9, 0x91, 0x3a, 0xff, 0x6b, 0x43, 0xa1, 0x92, 0x62, 0xfc,
-	0x9c, 0x86, 0xb7, 0x1f, 0xfb, 0xb6, 0x25, 0xb0, 0x61, 0xf1, 0xce, 0x89, 0xb0, 0x44, 0x8f, 0xdf,
-	0x48, 0x1b, 0x79, 0x02, 0x4b, 0xbd, 0xa8, 0x50, 0xe2, 0xf9, 0xee, 0x34, 0x9f, 0x66, 0xf4, 0x32,
-	0x47, 0x91, 0x18, 0x41, 0x93, 0x62, 0x3a, 0x83, 0xdc, 0x64, 0x92, 0x6c, 0xc2, 0x92, 0xb0, 0x78,
-	0x67, 0x24, 0x0b, 0x06, 0x97, 0x1b, 0x6a, 0x08, 0xab, 0x55, 0xa8, 0x1a, 0xa6, 0x6a, 0x36, 0xf9,
-	0x04, 0x54, 0x1e, 0x91, 0xe4, 0xd4, 0xe4, 0xa7, 0xe9, 0x19, 0x53, 0x22, 0xd1, 0x86, 0x0e, 0xda,
-	0xab, 0x2a, 0x63, 0xaf, 0x8d, 0x5d, 0x58, 0x0d, 0xa3, 0x37, 0xb3, 0xc8, 0xd8, 0x93, 0xec, 0xe4,
-	0x1b, 0x30, 0x61, 0x31, 0xd4, 0xca, 0x35, 0x25, 0x32, 0x4c, 0x9b, 0x25, 0x90, 0xc6, 0x30, 0xa3,
-	0x0c, 0x64, 0x9f, 0x73, 0xd7, 0xf1, 0xba, 0xe8, 0x89, 0x1b, 0x6a, 0xf8, 0x06, 0x60, 0x54, 0x83,
-	0x98, 0x90, 0x09, 0x4b, 0xcb, 0xc9, 0x99, 0x29, 0xe0, 0x30, 0x45, 0x23, 0x1c, 0xf9, 0x08, 0x54,
-	0x8e, 0xed, 0x00, 0x85, 0xf4, 0x54, 0x9f, 0xc6, 0x38, 0x89, 0x10, 0x87, 0x29, 0x2a, 0xb1, 0x65,
-	0x15, 0x32, 0xae, 0xc0, 0xae, 0xf1, 0x22, 0x0d, 0xb9, 0x51, 0xf3, 0x83, 0x53, 0xcb, 0x73, 0x90,
-	0xec, 0x01, 0x58, 0xc3, 0x98, 0x14, 0x32, 0xf5, 0xa8, 0x46, 0x4c, 0x3a, 0xc6, 0x20, 0x75, 0x50,
-	0xad, 0x76, 0xb4, 0xcb, 0x42, 0x49, 0xeb, 0xa5, 0x8f, 0xaf, 0xe6, 0xc6, 0x5d, 0xc7, 0x02, 0xfb,
-	0x11, 0x99, 0xca, 0x22, 0x46, 0x6b, 0x5c, 0x62, 0x9c, 0x23, 0x45, 0x50, 0x1f, 0x1f, 0x57, 0xf6,
-	0x1b, 0xd5, 0x5c, 0x4a, 0xd7, 0x7f, 0xfa, 0xb5, 0x70, 0x7b, 0x12, 0x21, 0xc7, 0xb2, 0x08, 0x2a,
-	0xad, 0xd6, 0x1f, 0x3d, 0xa9, 0xe6, 0x94, 0xe9, 0x38, 0x8a, 0x5d, 0xf6, 0x0c, 0x8d, 0x7f, 0x95,
-	0xff, 0x1d, 0x64, 0x32, 0x0e, 0x9f, 0x41, 0x26, 0xbc, 0x16, 0x22, 0x0f, 0xd6, 0x4b, 0xf7, 0xae,
-	0x7e, 0x8f, 0x84, 0x65, 0x36, 0xfa, 0x3e, 0xd2, 0x88, 0x48, 0xee, 0x00, 0x58, 0xbe, 0x7f, 0xe6,
-	0x22, 0x6f, 0x0a, 0x16, 0x2f, 0x65, 0xba, 0x22, 0x23, 0x0d, 0x16, 0xa6, 0x03, 0xe4, 0xbd, 0x33,
-	0xc1, 0x9b, 0xae, 0xa7, 0x2d, 0xc4, 0x69, 0x19, 0xa9, 0x79, 0x64, 0x0f, 0x96, 0xda, 0x91, 0x39,
-	0xc9, 0xa2, 0x7b, 0x6f, 0x1e, 0x27, 0x69, 0x42, 0x32, 0xee, 0x42, 0x26, 0xd4, 0x42, 0x56, 0x61,
-	0xf9, 0xe0, 0x51, 0xfd, 0xf8, 0x61, 0x35, 0xf4, 0x8b, 0xbc, 0x01, 0xd9, 0xda, 0xd1, 0x01, 0xad,
-	0xd6, 0xab, 0x47, 0x8d, 0xfd, 0x87, 0x39, 0xa5, 0x74, 0xbe, 0x08, 0x50, 0x19, 0xde, 0x91, 0xe4,
-	0x6b, 0x58, 0x92, 0x73, 0x4a, 0x8c, 0xe9, 0xc3, 0x34, 0x7e, 0x7d, 0xe9, 0x57, 0x61, 0xa4, 0x23,
-	0xc6, 0xe6, 0xef, 0xbf, 0xfd, 0x73, 0x9e, 0xbe, 0x03, 0xab, 0x11, 0xe6, 0xfd, 0x70, 0x11, 0x63,
-	0x00, 0x6b, 0xf1, 0x93, 0x5c, 0xf3, 0xf7, 0x15, 0xf2, 0x2d, 0xac, 0x0c, 0x97, 0x29, 0x99, 0xfa,
-	0xae, 0x93, 0xdb, 0x5a, 0xbf, 0xfb, 0x1a, 0x94, 0xdc, 0x12, 0xf3, 0x08, 0x20, 0xbf, 0x28, 0x90,
-	0x9b, 0xdc, 0x33, 0xe4, 0xde, 0x35, 0x76, 0xa6, 0xbe, 0x3d, 0x1f, 0xf8, 0x3a, 0xa2, 0x7a, 0xb0,
-	0x18, 0x6d, 0x28, 0x52, 0x98, 0xb5, 0x0a, 0x86, 0xdd, 0x67, 0x23, 0x92, 0x73, 0x28, 0xce, 0xd1,
-	0xf1, 0xc7, 0xb4, 0x72, 0x5f, 0x21, 0x3f, 0x28, 0x90, 0x1d, 0x1b, 0x6d, 0x52, 0x7c, 0xcd, 0xec,
-	0x27, 0x1a, 0x8a, 0xf3, 0x7d, 0x23, 0x73, 0x4e, 0x44, 0x59, 0xbb, 0x78, 0x99, 0x4f, 0xfd, 0xf9,
-	0x32, 0x9f, 0xfa, 0x6e, 0x90, 0x57, 0x2e, 0x06, 0x79, 0xe5, 0x8f, 0x41, 0x5e, 0xf9, 0x7b, 0x90,
-	0x57, 0x5a, 0x6a, 0x74, 0x97, 0x7e, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0xa6, 0xd5,
-	0x2c, 0xde, 0x09, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x8e, 0x13, 0x3f, 0x27, 0xa9, 0x19, 0xaa, 0x62, 0x2c, 0xd5, 0x71, 0xb7, 0x24,
+	0x8a, 0xd4, 0xb0, 0x29, 0xa1, 0x70, 0x21, 0x0a, 0xc4, 0xb1, 0x45, 0xac, 0x36, 0xa9, 0x35, 0x71,
+	0xd3, 0xa3, 0xb5, 0xf6, 0x4e, 0x37, 0x8b, 0xed, 0x9d, 0x65, 0x66, 0x9c, 0xe2, 0x03, 0x12, 0x07,
+	0x2a, 0x21, 0xc4, 0x01, 0x71, 0xca, 0x85, 0xbf, 0xc0, 0xef, 0x88, 0x2a, 0x0e, 0x1c, 0x39, 0x05,
+	0xea, 0x1f, 0x80, 0x38, 0x73, 0x42, 0x3b, 0x3b, 0x6b, 0x3b, 0xce, 0x3a, 0x75, 0x72, 0xb2, 0xf7,
+	0xcd, 0xf7, 0xbd, 0xf9, 0xe6, 0xed, 0xf7, 0xde, 0x2c, 0x64, 0x2c, 0x87, 0x7b, 0xa6, 0x68, 0x1e,
+	0x13, 0x66, 0x78, 0x8c, 0x0a, 0x8a, 0x90, 0x45, 0x9b, 0x2d, 0xc2, 0x0c, 0xfe, 0xd2, 0x64, 0x9d,
+	0x96, 0x23, 0x8c, 0x93, 0x8f, 0x72, 0x69, 0xd1, 0xf3, 0x08, 0x0f, 0x00, 0xb9, 0x45, 0xda, 0xf8,
+	0x8a, 0x34, 0x45, 0xf8, 0x78, 0xdb, 0xa6, 0x36, 0x95, 0x7f, 0x37, 0xfc, 0x7f, 0x2a, 0xfa, 0xae,
+	0xd7, 0xee, 0xda, 0x8e, 0xbb, 0x11, 0xfc, 0xa8, 0x60, 0xde, 0xa6, 0xd4, 0x6e, 0x93, 0x0d, 0xf9,
+	0xd4, 0xe8, 0xbe, 0xd8, 0xb0, 0xba, 0xcc, 0x14, 0x0e, 0x55, 0xeb, 0xfa, 0x2b, 0x0d, 0x96, 0x0e,
+	0x09, 0xe7, 0x0e, 0x75, 0x31, 0xf9, 0xba, 0x4b, 0xb8, 0x40, 0x65, 0x48, 0x5b, 0x84, 0x37, 0x99,
+	0xe3, 0xf9, 0xb8, 0xac, 0x56, 0xd0, 0xd6, 0xd2, 0x9b, 0xf7, 0x8d, 0xcb, 0x1a, 0x8d, 0x03, 0x6a,
+	0x91, 0xd2, 0x10, 0x8a, 0x47, 0x79, 0x68, 0x1d, 0x80, 0x07, 0x89, 0xeb, 0x8e, 0x95, 0x8d, 0x17,
+	0xb4, 0xb5, 0x54, 0x71, 0xb1, 0x7f, 0xbe, 0x9c, 0x52, 0xdb, 0x55, 0x4a, 0x38, 0xa5, 0x00, 0x15,
+	0x4b, 0x7f, 0x1d, 0x1f, 0xe8, 0xd8, 0x27, 0x9c, 0x9b, 0x36, 0x19, 0x4b, 0xa0, 0x5d, 0x9d, 0x00,
+	0xad, 0x43, 0xc2, 0xa5, 0x16, 0x91, 0x1b, 0xa5, 0x37, 0xb3, 0x93, 0xe4, 0x62, 0x89, 0x42, 0x5b,
+	0x30, 0xdf, 0x31, 0x5d, 0xd3, 0x26, 0x8c, 0x67, 0x67, 0x0a, 0x33, 0x6b, 0xe9, 0xcd, 0x42, 0x14,
+	0xe3, 0x39, 0x71, 0xec, 0x63, 0x41, 0xac, 0x2a, 0x21, 0x0c, 0x0f, 0x18, 0xe8, 0x39, 0xdc, 0x71,
+	0x89, 0x78, 0x49, 0x59, 0xab, 0xde, 0xa0, 0x54, 0x70, 0xc1, 0x4c, 0xaf, 0xde, 0x22, 0x3d, 0x9e,
+	0x4d, 0xc8, 0x5c, 0xf7, 0xa2, 0x72, 0x95, 0xdd, 0x26, 0xeb, 0xc9, 0xd2, 0x3c, 0x26, 0x3d, 0x7c,
+	0x5b, 0x25, 0x28, 0x86, 0xfc, 0xc7, 0xa4, 0xc7, 0x51, 0x19, 0x16, 0x19, 0xed, 0x0a, 0xc7, 0xb5,
+	0xeb, 0xc2, 0x6c, 0xb4, 0x49, 0x76, 0xb6, 0xa0, 0x4d, 0xd2, 0x86, 0x03, 0x60, 0xcd, 0xc7, 0xe1,
+	0x05, 0x36, 0xf2, 0xa4, 0x7f, 0x01, 0x99, 0x3d, 0x62, 0x32, 0xd1, 0x20, 0xa6, 0x08, 0xdf, 0xea,
+	0xb5, 0xaa, 0xa9, 0x57, 0xe1, 0x9d, 0x91, 0x0c, 0xdc, 0xa3, 0x2e, 0x27, 0xe8, 0x33, 0x48, 0x7a,
+	0x84, 0x39, 0xd4, 0x52, 0x9e, 0x78, 0xdf, 0x08, 0xcc, 0x65, 0x84, 0xe6, 0x32, 0x4a, 0xca, 0x5c,
+	0xc5, 0xf9, 0xb3, 0xf3, 0xe5, 0xd8, 0xe9, 0x5f, 0xcb, 0x1a, 0x56, 0x14, 0xfd, 0xe7, 0x38, 0xbc,
+	0xf7, 0xcc, 0xb3, 0x4c, 0x41, 0x6a, 0x26, 0x6f, 0x1d, 0x0a, 0x53, 0x74, 0xf9, 0x8d, 0xb4, 0xa1,
+	0x23, 0x98, 0xeb, 0xca, 0x44, 0xe1, 0xab, 0xdb, 0x8a, 0x2a, 0xcf, 0x84, 0xbd, 0x8c, 0x61, 0x24,
+	0x40, 0xe0, 0x30, 0x59, 0x8e, 0x42, 0x66, 0x7c, 0x11, 0xdd, 0x87, 0x39, 0x61, 0xf2, 0xd6, 0x50,
+	0x16, 0xf4, 0xcf, 0x97, 0x93, 0x3e, 0xac, 0x52, 0xc2, 0x49, 0x7f, 0xa9, 0x62, 0xa1, 0x4f, 0x21,
+	0xc9, 0x25, 0x49, 0x99, 0x2f, 0x1f, 0xa5, 0x67, 0x44, 0x89, 0x42, 0xeb, 0x39, 0xc8, 0x5e, 0x56,
+	0x19, 0xd4, 0x5a, 0xdf, 0x82, 0x05, 0x3f, 0x7a, 0xb3, 0x12, 0xe9, 0xdb, 0x8a, 0x1d, 0xb6, 0x92,
+	0x01, 0xb3, 0xbe, 0x56, 0x9e, 0xd5, 0x0a, 0x33, 0x93, 0xba, 0xc3, 0x27, 0xe0, 0x00, 0xa6, 0x17,
+	0x01, 0xed, 0x70, 0xee, 0xd8, 0x6e, 0x87, 0xb8, 0xe2, 0x86, 0x1a, 0x7e, 0xd7, 0x00, 0x86, 0x49,
+	0x90, 0x01, 0x09, 0x3f, 0xb7, 0xb2, 0xce, 0x44, 0x05, 0x7b, 0x31, 0x2c, 0x71, 0xe8, 0x11, 0x24,
+	0x39, 0x69, 0x32, 0x22, 0x54, 0x51, 0x73, 0x51, 0x8c, 0x43, 0x89, 0xd8, 0x8b, 0x61, 0x85, 0x45,
+	0x55, 0x39, 0x5d, 0x9b, 0xf4, 0x84, 0xb0, 0x5e, 0x9d, 0x91, 0x26, 0x65, 0x56, 0x76, 0x66, 0xf2,
+	0x00, 0x2b, 0x85, 0x58, 0x2c, 0xa1, 0x7b, 0x31, 0x7c, 0xcb, 0xba, 0x18, 0x2a, 0x26, 0x21, 0xe1,
+	0x08, 0xd2, 0xd1, 0xff, 0x8d, 0xc3, 0xad, 0x31, 0x78, 0x50, 0x10, 0x76, 0xe2, 0x34, 0xc9, 0xa5,
+	0x82, 0xc8, 0x68, 0x50, 0x90, 0xe0, 0xaf, 0x85, 0xee, 0xc1, 0x42, 0x88, 0x76, 0xcd, 0x4e, 0x30,
+	0xa9, 0x52, 0x38, 0xad, 0x62, 0x07, 0x66, 0x87, 0xa0, 0x2f, 0x61, 0x5e, 0xcd, 0x85, 0xd0, 0xdb,
+	0x0f, 0xa6, 0x90, 0x6d, 0x1c, 0x04, 0x1c, 0x3c, 0x20, 0xa3, 0x47, 0x30, 0xeb, 0x51, 0x26, 0xc2,
+	0x81, 0x14, 0xe9, 0xc8, 0x2a, 0x65, 0x62, 0x97, 0xba, 0x2f, 0x1c, 0x1b, 0x07, 0xe0, 0xdc, 0x4f,
+	0x1a, 0xcc, 0xa9, 0x5c, 0xfe, 0xd9, 0xc2, 0x19, 0x77, 0xf1, 0x6c, 0x0a, 0xe0, 0x9f, 0x4d, 0x01,
+	0xe4, 0xf4, 0x85, 0x13, 0x87, 0x89, 0xae, 0xd9, 0xae, 0x3b, 0xde, 0xe8, 0xb0, 0x3f, 0x0a, 0xa2,
+	0x95, 0x2a, 0x4e, 0x29, 0x40, 0xc5, 0x43, 0x2b, 0xb0, 0x24, 0xbb, 0xca, 0xb4, 0x2c, 0x46, 0x38,
+	0x57, 0x8d, 0x9c, 0xc2, 0x8b, 0x7e, 0x74, 0x27, 0x0c, 0xea, 0xaf, 0xe2, 0x90, 0x19, 0x3a, 0x68,
+	0xf7, 0xd8, 0x74, 0x6d, 0x82, 0xb6, 0x01, 0xcc, 0x41, 0x4c, 0xb9, 0x29, 0xf2, 0x78, 0x43, 0x26,
+	0x1e, 0x61, 0xa0, 0x7d, 0x48, 0x9a, 0x4d, 0x79, 0xb1, 0xf9, 0x2a, 0x97, 0x36, 0x3f, 0xb9, 0x9a,
+	0x1b, 0xec, 0x3a, 0x12, 0xd8, 0x91, 0x64, 0xac, 0x92, 0xe8, 0x0d, 0xc8, 0x8c, 0xaf, 0xa1, 0x55,
+	0x48, 0x3e, 0xab, 0x96, 0x76, 0x6a, 0xe5, 0x4c, 0x2c, 0x97, 0xfb, 0xf1, 0xd7, 0xc2, 0x9d, 0x71,
+	0x84, 0x1a, 0x2e, 0xab, 0x90, 0xc4, 0xe5, 0xfd, 0xa7, 0x47, 0xe5, 0x8c, 0x16, 0x8d, 0xc3, 0xa4,
+	0x43, 0x4f, 0x88, 0xfe, 0x9f, 0x76, 0xa1, 0x1d, 0xc3, 0xa6, 0xfe, 0x1c, 0x12, 0xfe, 0x37, 0x82,
+	0xac, 0xc1, 0x52, 0xb4, 0x51, 0x2e, 0xb3, 0x8c, 0x5a, 0xcf, 0x23, 0x58, 0x12, 0xd1, 0x5d, 0x00,
+	0xd3, 0xf3, 0xda, 0x0e, 0xe1, 0x75, 0x41, 0x95, 0x1d, 0x53, 0x2a, 0x52, 0xa3, 0xfe, 0x32, 0x23,
+	0xbc, 0xdb, 0x16, 0xbc, 0xee, 0xb8, 0xb2, 0x8b, 0x52, 0x38, 0xa5, 0x22, 0x15, 0x17, 0x6d, 0xc3,
+	0x5c, 0x53, 0x16, 0x27, 0x34, 0xd9, 0x07, 0xd3, 0x54, 0x12, 0x87, 0x24, 0x7d, 0x05, 0x12, 0xbe,
+	0x16, 0xb4, 0x00, 0xf3, 0xbb, 0x4f, 0xf7, 0xab, 0x4f, 0xca, 0x7e, 0xbd, 0xd0, 0x2d, 0x48, 0x57,
+	0x0e, 0x76, 0x71, 0x79, 0xbf, 0x7c, 0x50, 0xdb, 0x79, 0x92, 0xd1, 0x36, 0x4f, 0x67, 0x01, 0x4a,
+	0x83, 0x0f, 0x26, 0xf4, 0x0d, 0xcc, 0xa9, 0x69, 0x83, 0xf4, 0xe8, 0x89, 0x30, 0xfa, 0x2d, 0x93,
+	0xbb, 0x0a, 0xa3, 0x2a, 0xa2, 0xdf, 0x7f, 0xfd, 0xdb, 0x3f, 0xa7, 0xf1, 0xbb, 0xb0, 0x20, 0x31,
+	0x1f, 0xfa, 0x8e, 0x26, 0x0c, 0x16, 0x83, 0x27, 0x75, 0xe7, 0x3f, 0xd4, 0xd0, 0xb7, 0x90, 0x1a,
+	0x5c, 0x89, 0x28, 0xf2, 0xac, 0xe3, 0x77, 0x6e, 0x6e, 0xe5, 0x2d, 0x28, 0x35, 0xeb, 0xa7, 0x11,
+	0x80, 0x7e, 0xd1, 0x20, 0x33, 0x7e, 0x5b, 0xa0, 0x07, 0xd7, 0xb8, 0xf9, 0x72, 0xeb, 0xd3, 0x81,
+	0xaf, 0x23, 0xaa, 0x0b, 0xb3, 0x3e, 0x95, 0xa3, 0xc2, 0xa4, 0x79, 0x3e, 0xd8, 0x7d, 0x32, 0x22,
+	0x7c, 0x0f, 0xab, 0x53, 0xec, 0xf8, 0x43, 0x5c, 0x7b, 0xa8, 0xa1, 0xef, 0x35, 0x48, 0x8f, 0x58,
+	0x1b, 0xad, 0xbe, 0xc5, 0xfb, 0xa1, 0x86, 0xd5, 0xe9, 0x7a, 0x64, 0x4a, 0x47, 0x14, 0xb3, 0x67,
+	0x6f, 0xf2, 0xb1, 0x3f, 0xdf, 0xe4, 0x63, 0xdf, 0xf5, 0xf3, 0xda, 0x59, 0x3f, 0xaf, 0xfd, 0xd1,
+	0xcf, 0x6b, 0x7f, 0xf7, 0xf3, 0x5a, 0x23, 0x29, 0xbf, 0x88, 0x3e, 0xfe, 0x7f, 0x00, 0x58, 0x0c,
+	0x5a, 0x09, 0xeb, 0x0b, 0x00, 0x00,
 }
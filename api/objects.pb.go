@@ -203,6 +203,9 @@ type NetworkAttachment struct {
 	Addresses []string `protobuf:"bytes,2,rep,name=addresses" json:"addresses,omitempty"`
 	// List of aliases by which a task is resolved in a network
 	Aliases []string `protobuf:"bytes,3,rep,name=aliases" json:"aliases,omitempty"`
+	// MAC address of the interface attached to this network. If
+	// empty, the network driver picks one.
+	MacAddress string `protobuf:"bytes,4,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
 }
 
 func (m *NetworkAttachment) Reset()                    { *m = NetworkAttachment{} }
@@ -248,6 +251,10 @@ type Cluster struct {
 	// If the key is empty, the node will be unlocked (will not require a key
 	// to start up from a shut down state).
 	UnlockKeys []*EncryptionKey `protobuf:"bytes,9,rep,name=unlock_keys,json=unlockKeys" json:"unlock_keys,omitempty"`
+	// SchemaVersion is the version of the store schema the cluster's data
+	// was last migrated to. Managers which only support older versions are
+	// not allowed to join the cluster.
+	SchemaVersion uint32 `protobuf:"varint,10,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 }
 
 func (m *Cluster) Reset()                    { *m = Cluster{} }
@@ -266,12 +273,63 @@ type Secret struct {
 	Spec SecretSpec `protobuf:"bytes,3,opt,name=spec" json:"spec"`
 	// Whether the secret is an internal secret (not set by a user) or not.
 	Internal bool `protobuf:"varint,4,opt,name=internal,proto3" json:"internal,omitempty"`
+	// Version is the version of the secret data held by this object. Secrets
+	// created through CreateSecret hold version 1. Rotating the data of a
+	// secret stores the new data in a separate object with the next version,
+	// so the data held by a secret object never changes.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// VersionOf is the ID of the secret this object holds a later version
+	// of, or empty for secrets created through CreateSecret. Secret versions
+	// are not listed, can't be looked up by name, and are removed along with
+	// the secret they belong to.
+	VersionOf string `protobuf:"bytes,6,opt,name=version_of,json=versionOf,proto3" json:"version_of,omitempty"`
+	// LatestVersion is the most recent version of the secret data. It is only
+	// set on secrets created through CreateSecret.
+	LatestVersion uint64 `protobuf:"varint,7,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
 }
 
 func (m *Secret) Reset()                    { *m = Secret{} }
 func (*Secret) ProtoMessage()               {}
 func (*Secret) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{8} }
 
+// Role represents a named set of permissions on the Control API granted to
+// a set of users.
+type Role struct {
+	ID   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta Meta     `protobuf:"bytes,2,opt,name=meta" json:"meta"`
+	Spec RoleSpec `protobuf:"bytes,3,opt,name=spec" json:"spec"`
+}
+
+func (m *Role) Reset()                    { *m = Role{} }
+func (*Role) ProtoMessage()               {}
+func (*Role) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{9} }
+
+// AuditEvent records a call to a Control API method that modifies the
+// cluster state. Audit events are created by the leader and only the most
+// recent ones are kept.
+type AuditEvent struct {
+	ID   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta Meta   `protobuf:"bytes,2,opt,name=meta" json:"meta"`
+	// Method is the name of the Control API method that was called.
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// ObjectID is the ID of the object created, modified or removed by the
+	// call, if known.
+	ObjectID string `protobuf:"bytes,4,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	// Caller identifies the node or user that made the call.
+	Caller AuditCaller `protobuf:"bytes,5,opt,name=caller" json:"caller"`
+	// SpecDiff is a unified diff between the spec of the object before and
+	// after the call. Secret payloads are never included.
+	SpecDiff string `protobuf:"bytes,6,opt,name=spec_diff,json=specDiff,proto3" json:"spec_diff,omitempty"`
+	// Error is the error returned by the call, or empty if it succeeded.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// Sequence numbers the audit events in the order they are recorded.
+	Sequence uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *AuditEvent) Reset()                    { *m = AuditEvent{} }
+func (*AuditEvent) ProtoMessage()               {}
+func (*AuditEvent) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{10} }
+
 func init() {
 	proto.RegisterType((*Meta)(nil), "docker.swarmkit.v1.Meta")
 	proto.RegisterType((*Node)(nil), "docker.swarmkit.v1.Node")
@@ -283,6 +341,8 @@ func init() {
 	proto.RegisterType((*Network)(nil), "docker.swarmkit.v1.Network")
 	proto.RegisterType((*Cluster)(nil), "docker.swarmkit.v1.Cluster")
 	proto.RegisterType((*Secret)(nil), "docker.swarmkit.v1.Secret")
+	proto.RegisterType((*Role)(nil), "docker.swarmkit.v1.Role")
+	proto.RegisterType((*AuditEvent)(nil), "docker.swarmkit.v1.AuditEvent")
 }
 
 func (m *Meta) Copy() *Meta {
@@ -568,6 +628,40 @@ func (m *Secret) CopyFrom(src interface{}) {
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Spec, &o.Spec)
 }
 
+func (m *Role) Copy() *Role {
+	if m == nil {
+		return nil
+	}
+	o := &Role{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *Role) CopyFrom(src interface{}) {
+
+	o := src.(*Role)
+	*m = *o
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Meta, &o.Meta)
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Spec, &o.Spec)
+}
+
+func (m *AuditEvent) Copy() *AuditEvent {
+	if m == nil {
+		return nil
+	}
+	o := &AuditEvent{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *AuditEvent) CopyFrom(src interface{}) {
+
+	o := src.(*AuditEvent)
+	*m = *o
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Meta, &o.Meta)
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Caller, &o.Caller)
+}
+
 func (m *Meta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1030,6 +1124,12 @@ func (m *NetworkAttachment) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.MacAddress) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.MacAddress)))
+		i += copy(dAtA[i:], m.MacAddress)
+	}
 	return i, nil
 }
 
@@ -1195,6 +1295,11 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.SchemaVersion != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.SchemaVersion))
+	}
 	return i, nil
 }
 
@@ -1245,6 +1350,131 @@ func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.Version))
+	}
+	if len(m.VersionOf) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.VersionOf)))
+		i += copy(dAtA[i:], m.VersionOf)
+	}
+	if m.LatestVersion != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.LatestVersion))
+	}
+	return i, nil
+}
+
+func (m *Role) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Role) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
+	n35, err := m.Meta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n35
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
+	n36, err := m.Spec.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n36
+	return i, nil
+}
+
+func (m *AuditEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
+	n37, err := m.Meta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.ObjectID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.ObjectID)))
+		i += copy(dAtA[i:], m.ObjectID)
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintObjects(dAtA, i, uint64(m.Caller.Size()))
+	n38, err := m.Caller.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	if len(m.SpecDiff) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.SpecDiff)))
+		i += copy(dAtA[i:], m.SpecDiff)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Sequence != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.Sequence))
+	}
 	return i, nil
 }
 
@@ -1454,6 +1684,10 @@ func (m *NetworkAttachment) Size() (n int) {
 			n += 1 + l + sovObjects(uint64(l))
 		}
 	}
+	l = len(m.MacAddress)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
 	return n
 }
 
@@ -1520,6 +1754,9 @@ func (m *Cluster) Size() (n int) {
 			n += 1 + l + sovObjects(uint64(l))
 		}
 	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovObjects(uint64(m.SchemaVersion))
+	}
 	return n
 }
 
@@ -1537,6 +1774,63 @@ func (m *Secret) Size() (n int) {
 	if m.Internal {
 		n += 2
 	}
+	if m.Version != 0 {
+		n += 1 + sovObjects(uint64(m.Version))
+	}
+	l = len(m.VersionOf)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovObjects(uint64(m.LatestVersion))
+	}
+	return n
+}
+
+func (m *Role) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	l = m.Meta.Size()
+	n += 1 + l + sovObjects(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovObjects(uint64(l))
+	return n
+}
+
+func (m *AuditEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	l = m.Meta.Size()
+	n += 1 + l + sovObjects(uint64(l))
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	l = len(m.ObjectID)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	l = m.Caller.Size()
+	n += 1 + l + sovObjects(uint64(l))
+	l = len(m.SpecDiff)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovObjects(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -1651,6 +1945,7 @@ func (this *NetworkAttachment) String() string {
 		`Network:` + strings.Replace(fmt.Sprintf("%v", this.Network), "Network", "Network", 1) + `,`,
 		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
 		`Aliases:` + fmt.Sprintf("%v", this.Aliases) + `,`,
+		`MacAddress:` + fmt.Sprintf("%v", this.MacAddress) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1692,6 +1987,7 @@ func (this *Cluster) String() string {
 		`EncryptionKeyLamportClock:` + fmt.Sprintf("%v", this.EncryptionKeyLamportClock) + `,`,
 		`BlacklistedCertificates:` + mapStringForBlacklistedCertificates + `,`,
 		`UnlockKeys:` + strings.Replace(fmt.Sprintf("%v", this.UnlockKeys), "EncryptionKey", "EncryptionKey", 1) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1705,6 +2001,38 @@ func (this *Secret) String() string {
 		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "Meta", "Meta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SecretSpec", "SecretSpec", 1), `&`, ``, 1) + `,`,
 		`Internal:` + fmt.Sprintf("%v", this.Internal) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`VersionOf:` + fmt.Sprintf("%v", this.VersionOf) + `,`,
+		`LatestVersion:` + fmt.Sprintf("%v", this.LatestVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Role) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Role{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "Meta", "Meta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RoleSpec", "RoleSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditEvent{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "Meta", "Meta", 1), `&`, ``, 1) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`Caller:` + strings.Replace(strings.Replace(this.Caller.String(), "AuditCaller", "AuditCaller", 1), `&`, ``, 1) + `,`,
+		`SpecDiff:` + fmt.Sprintf("%v", this.SpecDiff) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Sequence:` + fmt.Sprintf("%v", this.Sequence) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3213,6 +3541,35 @@ func (m *NetworkAttachment) Unmarshal(dAtA []byte) error {
 			}
 			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MacAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MacAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjects(dAtA[iNdEx:])
@@ -3789,6 +4146,25 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjects(dAtA[iNdEx:])
@@ -3948,6 +4324,486 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Internal = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionOf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			m.LatestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Role) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecDiff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecDiff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjects(dAtA[iNdEx:])
@@ -4077,79 +4933,92 @@ var (
 func init() { proto.RegisterFile("objects.proto", fileDescriptorObjects) }
 
 var fileDescriptorObjects = []byte{
-	// 1183 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x8e, 0x1b, 0x45,
-	0x17, 0x4d, 0x7b, 0x7a, 0x6c, 0xf7, 0xf5, 0x78, 0xf4, 0x7d, 0x45, 0x14, 0x9a, 0x61, 0xb0, 0x07,
-	0x47, 0xa0, 0x08, 0x45, 0x0e, 0x84, 0x80, 0x26, 0x40, 0x04, 0xb6, 0x67, 0x04, 0x56, 0x08, 0x44,
-	0x95, 0x90, 0x2c, 0xad, 0x9a, 0xee, 0x8a, 0x69, 0xdc, 0xee, 0x6a, 0x55, 0x95, 0x1d, 0x79, 0x87,
-	0x78, 0x00, 0x5e, 0x00, 0x09, 0xb1, 0xe6, 0x0d, 0x78, 0x83, 0x2c, 0x58, 0xb0, 0x83, 0x95, 0x45,
-	0xfc, 0x24, 0xa8, 0x7e, 0xda, 0xee, 0x91, 0xdb, 0x4e, 0x22, 0x45, 0xd9, 0xd5, 0x75, 0x9d, 0x73,
-	0xff, 0xea, 0xd4, 0xed, 0x32, 0xd4, 0xd9, 0xd9, 0x0f, 0x34, 0x90, 0xa2, 0x9d, 0x72, 0x26, 0x19,
-	0x42, 0x21, 0x0b, 0x46, 0x94, 0xb7, 0xc5, 0x63, 0xc2, 0xc7, 0xa3, 0x48, 0xb6, 0xa7, 0x1f, 0x1c,
-	0xd4, 0xe4, 0x2c, 0xa5, 0x16, 0x70, 0x50, 0x13, 0x29, 0x0d, 0x32, 0xa3, 0x39, 0x64, 0x6c, 0x18,
-	0xd3, 0x6b, 0xda, 0x3a, 0x9b, 0x3c, 0xba, 0x26, 0xa3, 0x31, 0x15, 0x92, 0x8c, 0x53, 0x0b, 0xb8,
-	0x38, 0x64, 0x43, 0xa6, 0x97, 0xd7, 0xd4, 0xca, 0xfc, 0xda, 0xfa, 0xc3, 0x01, 0xf7, 0x0e, 0x95,
-	0x04, 0x7d, 0x0a, 0x95, 0x29, 0xe5, 0x22, 0x62, 0x89, 0xef, 0x1c, 0x39, 0x57, 0x6a, 0xd7, 0xdf,
-	0x6c, 0xaf, 0xc7, 0x6f, 0x3f, 0x30, 0x90, 0xae, 0xfb, 0x64, 0xde, 0xbc, 0x80, 0x33, 0x06, 0xba,
-	0x09, 0x10, 0x70, 0x4a, 0x24, 0x0d, 0x07, 0x44, 0xfa, 0x25, 0xcd, 0x3f, 0x68, 0x9b, 0x8c, 0xda,
-	0x59, 0x46, 0xed, 0xfb, 0x59, 0x46, 0xd8, 0xb3, 0xe8, 0x8e, 0x54, 0xd4, 0x49, 0x1a, 0x66, 0xd4,
-	0x9d, 0x67, 0x53, 0x2d, 0xba, 0x23, 0x5b, 0xbf, 0xb8, 0xe0, 0x7e, 0xc3, 0x42, 0x8a, 0x2e, 0x41,
-	0x29, 0x0a, 0x75, 0xda, 0x5e, 0xb7, 0xbc, 0x98, 0x37, 0x4b, 0xfd, 0x13, 0x5c, 0x8a, 0x42, 0x74,
-	0x1d, 0xdc, 0x31, 0x95, 0xc4, 0x26, 0xe4, 0x17, 0x15, 0xa4, 0x6a, 0xb7, 0xd5, 0x68, 0x2c, 0xfa,
-	0x18, 0x5c, 0xd5, 0x56, 0x9b, 0xc9, 0x61, 0x11, 0x47, 0xc5, 0xbc, 0x97, 0xd2, 0x20, 0xe3, 0x29,
-	0x3c, 0x3a, 0x85, 0x5a, 0x48, 0x45, 0xc0, 0xa3, 0x54, 0xaa, 0x1e, 0xba, 0x9a, 0x7e, 0x79, 0x13,
-	0xfd, 0x64, 0x05, 0xc5, 0x79, 0x1e, 0xfa, 0x0c, 0xca, 0x42, 0x12, 0x39, 0x11, 0xfe, 0xae, 0xf6,
-	0xd0, 0xd8, 0x98, 0x80, 0x46, 0xd9, 0x14, 0x2c, 0x07, 0x7d, 0x05, 0xfb, 0x63, 0x92, 0x90, 0x21,
-	0xe5, 0x03, 0xeb, 0xa5, 0xac, 0xbd, 0xbc, 0x5d, 0x58, 0xba, 0x41, 0x1a, 0x47, 0xb8, 0x3e, 0xce,
-	0x9b, 0xe8, 0x14, 0x80, 0x48, 0x49, 0x82, 0xef, 0xc7, 0x34, 0x91, 0x7e, 0x45, 0x7b, 0x79, 0xa7,
-	0x30, 0x17, 0x2a, 0x1f, 0x33, 0x3e, 0xea, 0x2c, 0xc1, 0x38, 0x47, 0x44, 0x5f, 0x42, 0x2d, 0xa0,
-	0x5c, 0x46, 0x8f, 0xa2, 0x80, 0x48, 0xea, 0x57, 0xb5, 0x9f, 0x66, 0x91, 0x9f, 0xde, 0x0a, 0x66,
-	0x8b, 0xca, 0x33, 0xd1, 0xfb, 0xe0, 0x72, 0x16, 0x53, 0xdf, 0x3b, 0x72, 0xae, 0xec, 0x6f, 0x3e,
-	0x16, 0xcc, 0x62, 0x8a, 0x35, 0xb2, 0xf5, 0x77, 0x09, 0x2a, 0xf7, 0x28, 0x9f, 0x46, 0xc1, 0xcb,
-	0x15, 0xc8, 0xcd, 0x73, 0x02, 0x29, 0xac, 0xc5, 0x86, 0x5d, 0xd3, 0xc8, 0x31, 0x54, 0x69, 0x12,
-	0xa6, 0x2c, 0x4a, 0xa4, 0x15, 0x48, 0x61, 0x21, 0xa7, 0x16, 0x83, 0x97, 0x68, 0x74, 0x0a, 0x75,
-	0xa3, 0xfb, 0xc1, 0x39, 0x75, 0x1c, 0x15, 0xd1, 0xbf, 0xd3, 0x40, 0x7b, 0xac, 0x7b, 0x93, 0x9c,
-	0x85, 0x4e, 0xa0, 0x9e, 0x72, 0x3a, 0x8d, 0xd8, 0x44, 0x0c, 0x74, 0x11, 0xe5, 0xe7, 0x2a, 0x02,
-	0xef, 0x65, 0x2c, 0x65, 0xb5, 0x7e, 0x2d, 0x41, 0x35, 0xcb, 0x11, 0xdd, 0xb0, 0xed, 0x70, 0x36,
-	0x27, 0x94, 0x61, 0xb5, 0x2b, 0xd3, 0x89, 0x1b, 0xb0, 0x9b, 0x32, 0x2e, 0x85, 0x5f, 0x3a, 0xda,
-	0xd9, 0xa4, 0xf2, 0xbb, 0x8c, 0xcb, 0x1e, 0x4b, 0x1e, 0x45, 0x43, 0x6c, 0xc0, 0xe8, 0x21, 0xd4,
-	0xa6, 0x11, 0x97, 0x13, 0x12, 0x0f, 0xa2, 0x54, 0xf8, 0x3b, 0x9a, 0xfb, 0xee, 0xb6, 0x90, 0xed,
-	0x07, 0x06, 0xdf, 0xbf, 0xdb, 0xdd, 0x5f, 0xcc, 0x9b, 0xb0, 0x34, 0x05, 0x06, 0xeb, 0xaa, 0x9f,
-	0x8a, 0x83, 0x3b, 0xe0, 0x2d, 0x77, 0xd0, 0x55, 0x80, 0xc4, 0x88, 0x7a, 0xb0, 0x14, 0x4d, 0x7d,
-	0x31, 0x6f, 0x7a, 0x56, 0xea, 0xfd, 0x13, 0xec, 0x59, 0x40, 0x3f, 0x44, 0x08, 0x5c, 0x12, 0x86,
-	0x5c, 0x4b, 0xc8, 0xc3, 0x7a, 0xdd, 0xfa, 0x73, 0x17, 0xdc, 0xfb, 0x44, 0x8c, 0x5e, 0xf5, 0x60,
-	0x52, 0x31, 0xd7, 0x44, 0x77, 0x15, 0x40, 0x98, 0xa3, 0x54, 0xe5, 0xb8, 0xab, 0x72, 0xec, 0x01,
-	0xab, 0x72, 0x2c, 0xc0, 0x94, 0x23, 0x62, 0x26, 0xb5, 0xbe, 0x5c, 0xac, 0xd7, 0xe8, 0x32, 0x54,
-	0x12, 0x16, 0x6a, 0x7a, 0x59, 0xd3, 0x61, 0x31, 0x6f, 0x96, 0xd5, 0x75, 0xeb, 0x9f, 0xe0, 0xb2,
-	0xda, 0xea, 0x87, 0xea, 0xa6, 0x93, 0x24, 0x61, 0x92, 0xa8, 0x31, 0x26, 0xec, 0xc4, 0x28, 0x14,
-	0x56, 0x67, 0x05, 0xcb, 0x6e, 0x7a, 0x8e, 0x89, 0x1e, 0xc0, 0x6b, 0x59, 0xbe, 0x79, 0x87, 0xd5,
-	0x17, 0x71, 0x88, 0xac, 0x87, 0xdc, 0x4e, 0x6e, 0xb2, 0x7a, 0x9b, 0x27, 0xab, 0xee, 0x60, 0xd1,
-	0x64, 0xed, 0x42, 0x3d, 0xa4, 0x22, 0xe2, 0x34, 0xd4, 0x37, 0x90, 0xfa, 0xa0, 0x07, 0xd1, 0x5b,
-	0xdb, 0x9c, 0x50, 0xbc, 0x67, 0x39, 0xda, 0x42, 0x1d, 0xa8, 0x5a, 0xdd, 0x08, 0xbf, 0xa6, 0xb5,
-	0xfb, 0x9c, 0x13, 0x75, 0x49, 0x3b, 0x37, 0x41, 0xf6, 0x5e, 0x68, 0x82, 0xdc, 0x04, 0x88, 0xd9,
-	0x70, 0x10, 0xf2, 0x68, 0x4a, 0xb9, 0x5f, 0xb7, 0xdf, 0xd9, 0x02, 0xee, 0x89, 0x46, 0x60, 0x2f,
-	0x66, 0x43, 0xb3, 0x6c, 0xfd, 0xe4, 0xc0, 0xff, 0xd7, 0x92, 0x42, 0x1f, 0x41, 0xc5, 0xa6, 0xb5,
-	0xed, 0xc1, 0x60, 0x79, 0x38, 0xc3, 0xa2, 0x43, 0xf0, 0xd4, 0x1d, 0xa1, 0x42, 0x50, 0x73, 0xfb,
-	0x3d, 0xbc, 0xfa, 0x01, 0xf9, 0x50, 0x21, 0x71, 0x44, 0xd4, 0xde, 0x8e, 0xde, 0xcb, 0xcc, 0xd6,
-	0xcf, 0x25, 0xa8, 0x58, 0x67, 0xaf, 0x7a, 0x9c, 0xdb, 0xb0, 0x6b, 0x37, 0xeb, 0x16, 0xec, 0x99,
-	0x76, 0x5a, 0x49, 0xb8, 0xcf, 0x6c, 0x6a, 0xcd, 0xe0, 0x8d, 0x1c, 0x6e, 0x81, 0x1b, 0xa5, 0x64,
-	0x6c, 0x47, 0x79, 0x61, 0xe4, 0xfe, 0xdd, 0xce, 0x9d, 0x6f, 0x53, 0xa3, 0xec, 0xea, 0x62, 0xde,
-	0x74, 0xd5, 0x0f, 0x58, 0xd3, 0x5a, 0xbf, 0xed, 0x42, 0xa5, 0x17, 0x4f, 0x84, 0xa4, 0xfc, 0x55,
-	0x37, 0xc4, 0x86, 0x5d, 0x6b, 0x48, 0x0f, 0x2a, 0x9c, 0x31, 0x39, 0x08, 0xc8, 0xb6, 0x5e, 0x60,
-	0xc6, 0x64, 0xaf, 0xd3, 0xdd, 0x57, 0x44, 0x35, 0x48, 0x8c, 0x8d, 0xcb, 0x8a, 0xda, 0x23, 0xe8,
-	0x21, 0x5c, 0xca, 0xc6, 0xef, 0x19, 0x63, 0x52, 0x48, 0x4e, 0xd2, 0xc1, 0x88, 0xce, 0xd4, 0x37,
-	0x6f, 0x67, 0xd3, 0x5b, 0xe6, 0x34, 0x09, 0xf8, 0x4c, 0x37, 0xea, 0x36, 0x9d, 0xe1, 0x8b, 0xd6,
-	0x41, 0x37, 0xe3, 0xdf, 0xa6, 0x33, 0x81, 0x3e, 0x87, 0x43, 0xba, 0x84, 0x29, 0x8f, 0x83, 0x98,
-	0x8c, 0xd5, 0x87, 0x65, 0x10, 0xc4, 0x2c, 0x18, 0xe9, 0xd9, 0xe6, 0xe2, 0x37, 0x68, 0xde, 0xd5,
-	0xd7, 0x06, 0xd1, 0x53, 0x00, 0x24, 0xc0, 0x3f, 0x8b, 0x49, 0x30, 0x8a, 0x23, 0xa1, 0x9e, 0xab,
-	0xb9, 0xe7, 0x89, 0x1a, 0x4f, 0x2a, 0xb7, 0xe3, 0x2d, 0xdd, 0x6a, 0x77, 0x57, 0xdc, 0xdc, 0x63,
-	0x47, 0x9c, 0x26, 0x92, 0xcf, 0xf0, 0xeb, 0x67, 0xc5, 0xbb, 0xa8, 0x0b, 0xb5, 0x49, 0xa2, 0xc2,
-	0x9b, 0x1e, 0x78, 0xcf, 0xdb, 0x03, 0x30, 0x2c, 0x55, 0xf9, 0xc1, 0x14, 0x0e, 0xb7, 0x05, 0x47,
-	0xff, 0x83, 0x9d, 0x11, 0x9d, 0x19, 0xfd, 0x60, 0xb5, 0x44, 0x5f, 0xc0, 0xee, 0x94, 0xc4, 0x13,
-	0x6a, 0x95, 0xf3, 0x5e, 0x51, 0xbc, 0x62, 0x97, 0xd8, 0x10, 0x3f, 0x29, 0x1d, 0x3b, 0xad, 0xdf,
-	0x1d, 0x28, 0xdf, 0xa3, 0x01, 0xa7, 0xf2, 0xa5, 0x2a, 0xf4, 0xf8, 0x9c, 0x42, 0x1b, 0xc5, 0x8f,
-	0x17, 0x15, 0x75, 0x4d, 0xa0, 0x07, 0x50, 0x8d, 0x12, 0x49, 0x79, 0x42, 0x62, 0xad, 0xd0, 0x2a,
-	0x5e, 0xda, 0x5d, 0xff, 0xc9, 0xd3, 0xc6, 0x85, 0x7f, 0x9e, 0x36, 0x2e, 0xfc, 0xb8, 0x68, 0x38,
-	0x4f, 0x16, 0x0d, 0xe7, 0xaf, 0x45, 0xc3, 0xf9, 0x77, 0xd1, 0x70, 0xce, 0xca, 0xfa, 0x6f, 0xc8,
-	0x87, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xbb, 0xa8, 0xb4, 0xa0, 0x0d, 0x00, 0x00,
+	// 1381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x8e, 0x1b, 0xc5,
+	0x16, 0x8e, 0x3d, 0x3d, 0xb6, 0xfb, 0x78, 0x3c, 0xba, 0xb7, 0x6e, 0x94, 0xdb, 0x77, 0x32, 0xb1,
+	0xe7, 0x3a, 0x0a, 0x0a, 0x28, 0x72, 0x20, 0x04, 0x34, 0x01, 0x22, 0xf0, 0xcf, 0x08, 0xac, 0x10,
+	0x12, 0x55, 0x42, 0xb2, 0x6c, 0xd5, 0x74, 0x97, 0x9d, 0xc6, 0xed, 0xae, 0xa6, 0xaa, 0xec, 0x68,
+	0x76, 0xac, 0xb3, 0xe0, 0x05, 0x90, 0xd8, 0xb0, 0xe2, 0x11, 0x78, 0x02, 0xb2, 0x60, 0xc1, 0x0e,
+	0x56, 0x23, 0xe2, 0x27, 0x41, 0xf5, 0xd3, 0x1e, 0x0f, 0x6e, 0x3b, 0x89, 0x14, 0xcd, 0xae, 0x4e,
+	0xf5, 0xf7, 0x9d, 0x3a, 0xe7, 0xd4, 0x57, 0xa7, 0xaa, 0xa1, 0xc6, 0x0e, 0xbf, 0xa1, 0x81, 0x14,
+	0xad, 0x94, 0x33, 0xc9, 0x10, 0x0a, 0x59, 0x30, 0xa2, 0xbc, 0x25, 0x9e, 0x12, 0x3e, 0x1e, 0x45,
+	0xb2, 0x35, 0x7d, 0x6f, 0xa7, 0x2a, 0x8f, 0x52, 0x6a, 0x01, 0x3b, 0x55, 0x91, 0xd2, 0x20, 0x33,
+	0x1a, 0x43, 0xc6, 0x86, 0x31, 0xbd, 0xae, 0xad, 0xc3, 0xc9, 0xe0, 0xba, 0x8c, 0xc6, 0x54, 0x48,
+	0x32, 0x4e, 0x2d, 0xe0, 0xfc, 0x90, 0x0d, 0x99, 0x1e, 0x5e, 0x57, 0x23, 0x33, 0xdb, 0xfc, 0xa5,
+	0x00, 0xce, 0x5d, 0x2a, 0x09, 0xfa, 0x18, 0xca, 0x53, 0xca, 0x45, 0xc4, 0x12, 0xaf, 0xb0, 0x57,
+	0xb8, 0x5a, 0xbd, 0x71, 0xb1, 0xb5, 0xbc, 0x7e, 0xeb, 0x91, 0x81, 0x74, 0x9c, 0xe7, 0xc7, 0x8d,
+	0x73, 0x38, 0x63, 0xa0, 0x5b, 0x00, 0x01, 0xa7, 0x44, 0xd2, 0xd0, 0x27, 0xd2, 0x2b, 0x6a, 0xfe,
+	0x4e, 0xcb, 0x44, 0xd4, 0xca, 0x22, 0x6a, 0x3d, 0xcc, 0x22, 0xc2, 0xae, 0x45, 0xb7, 0xa5, 0xa2,
+	0x4e, 0xd2, 0x30, 0xa3, 0x6e, 0xbc, 0x9c, 0x6a, 0xd1, 0x6d, 0xd9, 0xfc, 0xc1, 0x01, 0xe7, 0x2b,
+	0x16, 0x52, 0x74, 0x01, 0x8a, 0x51, 0xa8, 0xc3, 0x76, 0x3b, 0xa5, 0xd9, 0x71, 0xa3, 0xd8, 0xef,
+	0xe1, 0x62, 0x14, 0xa2, 0x1b, 0xe0, 0x8c, 0xa9, 0x24, 0x36, 0x20, 0x2f, 0x2f, 0x21, 0x95, 0xbb,
+	0xcd, 0x46, 0x63, 0xd1, 0x87, 0xe0, 0xa8, 0xb2, 0xda, 0x48, 0x76, 0xf3, 0x38, 0x6a, 0xcd, 0x07,
+	0x29, 0x0d, 0x32, 0x9e, 0xc2, 0xa3, 0x03, 0xa8, 0x86, 0x54, 0x04, 0x3c, 0x4a, 0xa5, 0xaa, 0xa1,
+	0xa3, 0xe9, 0x97, 0x57, 0xd1, 0x7b, 0x27, 0x50, 0xbc, 0xc8, 0x43, 0x9f, 0x40, 0x49, 0x48, 0x22,
+	0x27, 0xc2, 0xdb, 0xd4, 0x1e, 0xea, 0x2b, 0x03, 0xd0, 0x28, 0x1b, 0x82, 0xe5, 0xa0, 0x2f, 0x60,
+	0x7b, 0x4c, 0x12, 0x32, 0xa4, 0xdc, 0xb7, 0x5e, 0x4a, 0xda, 0xcb, 0xff, 0x73, 0x53, 0x37, 0x48,
+	0xe3, 0x08, 0xd7, 0xc6, 0x8b, 0x26, 0x3a, 0x00, 0x20, 0x52, 0x92, 0xe0, 0xc9, 0x98, 0x26, 0xd2,
+	0x2b, 0x6b, 0x2f, 0x57, 0x72, 0x63, 0xa1, 0xf2, 0x29, 0xe3, 0xa3, 0xf6, 0x1c, 0x8c, 0x17, 0x88,
+	0xe8, 0x73, 0xa8, 0x06, 0x94, 0xcb, 0x68, 0x10, 0x05, 0x44, 0x52, 0xaf, 0xa2, 0xfd, 0x34, 0xf2,
+	0xfc, 0x74, 0x4f, 0x60, 0x36, 0xa9, 0x45, 0x26, 0x7a, 0x17, 0x1c, 0xce, 0x62, 0xea, 0xb9, 0x7b,
+	0x85, 0xab, 0xdb, 0xab, 0xb7, 0x05, 0xb3, 0x98, 0x62, 0x8d, 0x6c, 0xfe, 0x51, 0x84, 0xf2, 0x03,
+	0xca, 0xa7, 0x51, 0xf0, 0x66, 0x05, 0x72, 0xeb, 0x94, 0x40, 0x72, 0x73, 0xb1, 0xcb, 0x2e, 0x69,
+	0x64, 0x1f, 0x2a, 0x34, 0x09, 0x53, 0x16, 0x25, 0xd2, 0x0a, 0x24, 0x37, 0x91, 0x03, 0x8b, 0xc1,
+	0x73, 0x34, 0x3a, 0x80, 0x9a, 0xd1, 0xbd, 0x7f, 0x4a, 0x1d, 0x7b, 0x79, 0xf4, 0xaf, 0x35, 0xd0,
+	0x6e, 0xeb, 0xd6, 0x64, 0xc1, 0x42, 0x3d, 0xa8, 0xa5, 0x9c, 0x4e, 0x23, 0x36, 0x11, 0xbe, 0x4e,
+	0xa2, 0xf4, 0x4a, 0x49, 0xe0, 0xad, 0x8c, 0xa5, 0xac, 0xe6, 0x8f, 0x45, 0xa8, 0x64, 0x31, 0xa2,
+	0x9b, 0xb6, 0x1c, 0x85, 0xd5, 0x01, 0x65, 0x58, 0xed, 0xca, 0x54, 0xe2, 0x26, 0x6c, 0xa6, 0x8c,
+	0x4b, 0xe1, 0x15, 0xf7, 0x36, 0x56, 0xa9, 0xfc, 0x3e, 0xe3, 0xb2, 0xcb, 0x92, 0x41, 0x34, 0xc4,
+	0x06, 0x8c, 0x1e, 0x43, 0x75, 0x1a, 0x71, 0x39, 0x21, 0xb1, 0x1f, 0xa5, 0xc2, 0xdb, 0xd0, 0xdc,
+	0xb7, 0xd6, 0x2d, 0xd9, 0x7a, 0x64, 0xf0, 0xfd, 0xfb, 0x9d, 0xed, 0xd9, 0x71, 0x03, 0xe6, 0xa6,
+	0xc0, 0x60, 0x5d, 0xf5, 0x53, 0xb1, 0x73, 0x17, 0xdc, 0xf9, 0x17, 0x74, 0x0d, 0x20, 0x31, 0xa2,
+	0xf6, 0xe7, 0xa2, 0xa9, 0xcd, 0x8e, 0x1b, 0xae, 0x95, 0x7a, 0xbf, 0x87, 0x5d, 0x0b, 0xe8, 0x87,
+	0x08, 0x81, 0x43, 0xc2, 0x90, 0x6b, 0x09, 0xb9, 0x58, 0x8f, 0x9b, 0xbf, 0x6d, 0x82, 0xf3, 0x90,
+	0x88, 0xd1, 0x59, 0x37, 0x26, 0xb5, 0xe6, 0x92, 0xe8, 0xae, 0x01, 0x08, 0xb3, 0x95, 0x2a, 0x1d,
+	0xe7, 0x24, 0x1d, 0xbb, 0xc1, 0x2a, 0x1d, 0x0b, 0x30, 0xe9, 0x88, 0x98, 0x49, 0xad, 0x2f, 0x07,
+	0xeb, 0x31, 0xba, 0x0c, 0xe5, 0x84, 0x85, 0x9a, 0x5e, 0xd2, 0x74, 0x98, 0x1d, 0x37, 0x4a, 0xea,
+	0xb8, 0xf5, 0x7b, 0xb8, 0xa4, 0x3e, 0xf5, 0x43, 0x75, 0xd2, 0x49, 0x92, 0x30, 0x49, 0x54, 0x1b,
+	0x13, 0x5e, 0x79, 0xb5, 0xb0, 0xda, 0x27, 0xb0, 0xec, 0xa4, 0x2f, 0x30, 0xd1, 0x23, 0xf8, 0x4f,
+	0x16, 0xef, 0xa2, 0xc3, 0xca, 0xeb, 0x38, 0x44, 0xd6, 0xc3, 0xc2, 0x97, 0x85, 0xce, 0xea, 0xae,
+	0xee, 0xac, 0xba, 0x82, 0x79, 0x9d, 0xb5, 0x03, 0xb5, 0x90, 0x8a, 0x88, 0xd3, 0x50, 0x9f, 0x40,
+	0xea, 0x81, 0x6e, 0x44, 0x97, 0xd6, 0x39, 0xa1, 0x78, 0xcb, 0x72, 0xb4, 0x85, 0xda, 0x50, 0xb1,
+	0xba, 0x11, 0x5e, 0x75, 0x6f, 0xe3, 0xd5, 0x3b, 0xea, 0x9c, 0x76, 0xaa, 0x83, 0x6c, 0xbd, 0x56,
+	0x07, 0xb9, 0x05, 0x10, 0xb3, 0xa1, 0x1f, 0xf2, 0x68, 0x4a, 0xb9, 0x57, 0xb3, 0xf7, 0x6c, 0x0e,
+	0xb7, 0xa7, 0x11, 0xd8, 0x8d, 0xd9, 0xd0, 0x0c, 0x9b, 0x3f, 0x15, 0xe0, 0xdf, 0x4b, 0x41, 0xa1,
+	0x0f, 0xa0, 0x6c, 0xc3, 0x5a, 0xf7, 0x60, 0xb0, 0x3c, 0x9c, 0x61, 0xd1, 0x2e, 0xb8, 0xea, 0x8c,
+	0x50, 0x21, 0xa8, 0x39, 0xfd, 0x2e, 0x3e, 0x99, 0x40, 0x1e, 0x94, 0x49, 0x1c, 0x11, 0x41, 0xcd,
+	0xe9, 0x76, 0x71, 0x66, 0xa2, 0x06, 0x54, 0xc7, 0x24, 0xf0, 0x2d, 0xd4, 0xe8, 0x18, 0xc3, 0x98,
+	0x04, 0x6d, 0x33, 0xd3, 0xfc, 0xbe, 0x08, 0x65, 0xbb, 0xda, 0x59, 0xf7, 0x7b, 0xbb, 0xec, 0xd2,
+	0xd1, 0xbb, 0x0d, 0x5b, 0xa6, 0xde, 0x56, 0x33, 0xce, 0x4b, 0xab, 0x5e, 0x35, 0x78, 0xa3, 0x97,
+	0xdb, 0xe0, 0x44, 0x29, 0x19, 0x7b, 0x9b, 0xab, 0x57, 0xee, 0xdf, 0x6f, 0xdf, 0xbd, 0x97, 0x1a,
+	0xe9, 0x57, 0x66, 0xc7, 0x0d, 0x47, 0x4d, 0x60, 0x4d, 0x6b, 0xfe, 0xba, 0x09, 0xe5, 0x6e, 0x3c,
+	0x11, 0x92, 0xf2, 0xb3, 0x2e, 0x88, 0x5d, 0x76, 0xa9, 0x20, 0x5d, 0x28, 0x73, 0xc6, 0xa4, 0x1f,
+	0x90, 0x75, 0xb5, 0xc0, 0x8c, 0xc9, 0x6e, 0xbb, 0xb3, 0xad, 0x88, 0xaa, 0xd3, 0x18, 0x1b, 0x97,
+	0x14, 0xb5, 0x4b, 0xd0, 0x63, 0xb8, 0x90, 0xf5, 0xe7, 0x43, 0xc6, 0xa4, 0x90, 0x9c, 0xa4, 0xfe,
+	0x88, 0x1e, 0xa9, 0x4b, 0x71, 0x63, 0xd5, 0x63, 0xe7, 0x20, 0x09, 0xf8, 0x91, 0x2e, 0xd4, 0x1d,
+	0x7a, 0x84, 0xcf, 0x5b, 0x07, 0x9d, 0x8c, 0x7f, 0x87, 0x1e, 0x09, 0xf4, 0x29, 0xec, 0xd2, 0x39,
+	0x4c, 0x79, 0xf4, 0x63, 0x32, 0x56, 0x37, 0x8f, 0x1f, 0xc4, 0x2c, 0x18, 0xe9, 0xe6, 0xe7, 0xe0,
+	0xff, 0xd1, 0x45, 0x57, 0x5f, 0x1a, 0x44, 0x57, 0x01, 0x90, 0x00, 0xef, 0x30, 0x26, 0xc1, 0x28,
+	0x8e, 0x84, 0x7a, 0xcf, 0x2e, 0xbc, 0x5f, 0x54, 0xff, 0x52, 0xb1, 0xed, 0xaf, 0xa9, 0x56, 0xab,
+	0x73, 0xc2, 0x5d, 0x78, 0x0d, 0x89, 0x83, 0x44, 0xf2, 0x23, 0xfc, 0xdf, 0xc3, 0xfc, 0xaf, 0xa8,
+	0x03, 0xd5, 0x49, 0xa2, 0x96, 0x37, 0x35, 0x70, 0x5f, 0xb5, 0x06, 0x60, 0x58, 0x3a, 0xf3, 0x2b,
+	0xb0, 0x2d, 0x82, 0x27, 0x74, 0x4c, 0xfc, 0xec, 0x1f, 0x40, 0xb5, 0xb7, 0x1a, 0xae, 0x99, 0x59,
+	0xfb, 0xea, 0xdf, 0x99, 0xc2, 0xee, 0xba, 0x18, 0xd1, 0xbf, 0x60, 0x63, 0x44, 0x8f, 0x8c, 0xcc,
+	0xb0, 0x1a, 0xa2, 0xcf, 0x60, 0x73, 0x4a, 0xe2, 0x09, 0xb5, 0x02, 0x7b, 0x27, 0x2f, 0xac, 0x7c,
+	0x97, 0xd8, 0x10, 0x3f, 0x2a, 0xee, 0x17, 0x9a, 0xcf, 0x8a, 0x50, 0x7a, 0x40, 0x03, 0x4e, 0xe5,
+	0x1b, 0x15, 0xf2, 0xfe, 0x29, 0x21, 0xd7, 0xf3, 0x1f, 0x41, 0x6a, 0xd5, 0x25, 0x1d, 0xef, 0x40,
+	0x25, 0x4a, 0x24, 0xe5, 0x09, 0x89, 0xb5, 0x90, 0x2b, 0x78, 0x6e, 0xab, 0x16, 0x96, 0x15, 0xd1,
+	0x5c, 0xa2, 0x99, 0x89, 0x2e, 0x01, 0xd8, 0xa1, 0xcf, 0x06, 0xe6, 0x2a, 0xc5, 0xae, 0x9d, 0xb9,
+	0x37, 0x50, 0x9b, 0x10, 0xab, 0x5a, 0xca, 0xf9, 0x26, 0x94, 0x35, 0xbf, 0x66, 0x66, 0xed, 0x26,
+	0x34, 0x9f, 0x15, 0xc0, 0x51, 0xcf, 0xdc, 0xb3, 0x7e, 0x5c, 0xa8, 0x35, 0xff, 0x59, 0x88, 0xe6,
+	0xcf, 0x45, 0x80, 0xf6, 0x24, 0x8c, 0xe4, 0xc1, 0x54, 0xdd, 0x09, 0x6f, 0x32, 0xa4, 0x0b, 0x50,
+	0x1a, 0x53, 0xf9, 0x84, 0x85, 0x3a, 0x28, 0x17, 0x5b, 0x0b, 0xbd, 0x0d, 0xae, 0xf9, 0x4f, 0x3e,
+	0x79, 0xce, 0x6c, 0xcd, 0x8e, 0x1b, 0x95, 0x7b, 0x7a, 0xb2, 0xdf, 0xc3, 0x15, 0xf3, 0xb9, 0x1f,
+	0xa2, 0xdb, 0x50, 0x0a, 0x48, 0x1c, 0x53, 0xbe, 0xae, 0x85, 0xea, 0xf0, 0xbb, 0x1a, 0x96, 0xdd,
+	0xf9, 0x86, 0x84, 0x2e, 0x82, 0xab, 0x92, 0xf4, 0xc3, 0x68, 0x90, 0x6d, 0x57, 0x45, 0x4d, 0xf4,
+	0xa2, 0xc1, 0x00, 0x9d, 0x87, 0x4d, 0xca, 0x39, 0xe3, 0x7a, 0x93, 0x5c, 0x6c, 0x0c, 0x25, 0x0c,
+	0x41, 0xbf, 0x9d, 0xd0, 0x24, 0x30, 0x3f, 0x3b, 0x0e, 0x9e, 0xdb, 0x1d, 0xef, 0xf9, 0x8b, 0xfa,
+	0xb9, 0x3f, 0x5f, 0xd4, 0xcf, 0x7d, 0x37, 0xab, 0x17, 0x9e, 0xcf, 0xea, 0x85, 0xdf, 0x67, 0xf5,
+	0xc2, 0x5f, 0xb3, 0x7a, 0xe1, 0xb0, 0xa4, 0xff, 0x73, 0xdf, 0xff, 0x7b, 0x00, 0x0f, 0x74, 0x92,
+	0xc8, 0x01, 0x10, 0x00, 0x00,
 }
@@ -64,6 +64,29 @@ func (x StoreActionKind) String() string {
 }
 func (StoreActionKind) EnumDescriptor() ([]byte, []int) { return fileDescriptorRaft, []int{0} }
 
+type StreamRaftMessageRequest_Compression int32
+
+const (
+	StreamRaftMessageRequest_NONE StreamRaftMessageRequest_Compression = 0
+	StreamRaftMessageRequest_GZIP StreamRaftMessageRequest_Compression = 1
+)
+
+var StreamRaftMessageRequest_Compression_name = map[int32]string{
+	0: "NONE",
+	1: "GZIP",
+}
+var StreamRaftMessageRequest_Compression_value = map[string]int32{
+	"NONE": 0,
+	"GZIP": 1,
+}
+
+func (x StreamRaftMessageRequest_Compression) String() string {
+	return proto.EnumName(StreamRaftMessageRequest_Compression_name, int32(x))
+}
+func (StreamRaftMessageRequest_Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorRaft, []int{7, 0}
+}
+
 type RaftMember struct {
 	// RaftID specifies the internal ID used by the manager in a raft context, it can never be modified
 	// and is used only for information purposes
@@ -83,6 +106,9 @@ func (*RaftMember) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []i
 type JoinRequest struct {
 	// Addr specifies the address of the member
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// SchemaVersion is the newest store schema version supported by the
+	// joining manager.
+	SchemaVersion uint32 `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 }
 
 func (m *JoinRequest) Reset()                    { *m = JoinRequest{} }
@@ -133,6 +159,38 @@ func (m *ProcessRaftMessageResponse) Reset()                    { *m = ProcessRa
 func (*ProcessRaftMessageResponse) ProtoMessage()               {}
 func (*ProcessRaftMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{6} }
 
+type StreamRaftMessageRequest struct {
+	// Message is the raft message, without its snapshot data. It is only
+	// set on the first request of the stream, along with SnapshotSize,
+	// Checksum and Compression.
+	Message *raftpb.Message `protobuf:"bytes,1,opt,name=message" json:"message,omitempty"`
+	// SnapshotSize is the size of the snapshot data, before compression.
+	SnapshotSize uint64 `protobuf:"varint,2,opt,name=snapshot_size,json=snapshotSize,proto3" json:"snapshot_size,omitempty"`
+	// Checksum is the SHA-256 checksum of the snapshot data, before
+	// compression.
+	Checksum []byte `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Compression is the algorithm the snapshot data is compressed with.
+	Compression StreamRaftMessageRequest_Compression `protobuf:"varint,4,opt,name=compression,proto3,enum=docker.swarmkit.v1.StreamRaftMessageRequest_Compression" json:"compression,omitempty"`
+	// Offset is the offset of Chunk in the compressed snapshot data.
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Chunk is a part of the compressed snapshot data.
+	Chunk []byte `protobuf:"bytes,6,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (m *StreamRaftMessageRequest) Reset()                    { *m = StreamRaftMessageRequest{} }
+func (*StreamRaftMessageRequest) ProtoMessage()               {}
+func (*StreamRaftMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{7} }
+
+type StreamRaftMessageResponse struct {
+	// Offset is the amount of compressed snapshot data the member already
+	// has, from which chunks must be sent.
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (m *StreamRaftMessageResponse) Reset()                    { *m = StreamRaftMessageResponse{} }
+func (*StreamRaftMessageResponse) ProtoMessage()               {}
+func (*StreamRaftMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{8} }
+
 type ResolveAddressRequest struct {
 	// raft_id is the ID to resolve to an address.
 	RaftID uint64 `protobuf:"varint,1,opt,name=raft_id,json=raftId,proto3" json:"raft_id,omitempty"`
@@ -140,7 +198,7 @@ type ResolveAddressRequest struct {
 
 func (m *ResolveAddressRequest) Reset()                    { *m = ResolveAddressRequest{} }
 func (*ResolveAddressRequest) ProtoMessage()               {}
-func (*ResolveAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{7} }
+func (*ResolveAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{9} }
 
 type ResolveAddressResponse struct {
 	// Addr specifies the address of the member
@@ -149,7 +207,7 @@ type ResolveAddressResponse struct {
 
 func (m *ResolveAddressResponse) Reset()                    { *m = ResolveAddressResponse{} }
 func (*ResolveAddressResponse) ProtoMessage()               {}
-func (*ResolveAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{8} }
+func (*ResolveAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{10} }
 
 // Contains one of many protobuf encoded objects to replicate
 // over the raft backend with a request ID to track when the
@@ -161,7 +219,7 @@ type InternalRaftRequest struct {
 
 func (m *InternalRaftRequest) Reset()                    { *m = InternalRaftRequest{} }
 func (*InternalRaftRequest) ProtoMessage()               {}
-func (*InternalRaftRequest) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{9} }
+func (*InternalRaftRequest) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{11} }
 
 // StoreAction defines a target and operation to apply on the storage system.
 type StoreAction struct {
@@ -173,12 +231,14 @@ type StoreAction struct {
 	//	*StoreAction_Network
 	//	*StoreAction_Cluster
 	//	*StoreAction_Secret
+	//	*StoreAction_Role
+	//	*StoreAction_AuditEvent
 	Target isStoreAction_Target `protobuf_oneof:"target"`
 }
 
 func (m *StoreAction) Reset()                    { *m = StoreAction{} }
 func (*StoreAction) ProtoMessage()               {}
-func (*StoreAction) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{10} }
+func (*StoreAction) Descriptor() ([]byte, []int) { return fileDescriptorRaft, []int{12} }
 
 type isStoreAction_Target interface {
 	isStoreAction_Target()
@@ -204,6 +264,12 @@ type StoreAction_Cluster struct {
 type StoreAction_Secret struct {
 	Secret *Secret `protobuf:"bytes,7,opt,name=secret,oneof"`
 }
+type StoreAction_Role struct {
+	Role *Role `protobuf:"bytes,8,opt,name=role,oneof"`
+}
+type StoreAction_AuditEvent struct {
+	AuditEvent *AuditEvent `protobuf:"bytes,9,opt,name=audit_event,json=auditEvent,oneof"`
+}
 
 func (*StoreAction_Node) isStoreAction_Target()    {}
 func (*StoreAction_Service) isStoreAction_Target() {}
@@ -211,6 +277,8 @@ func (*StoreAction_Task) isStoreAction_Target()    {}
 func (*StoreAction_Network) isStoreAction_Target() {}
 func (*StoreAction_Cluster) isStoreAction_Target() {}
 func (*StoreAction_Secret) isStoreAction_Target()  {}
+func (*StoreAction_Role) isStoreAction_Target()    {}
+func (*StoreAction_AuditEvent) isStoreAction_Target() {}
 
 func (m *StoreAction) GetTarget() isStoreAction_Target {
 	if m != nil {
@@ -261,6 +329,20 @@ func (m *StoreAction) GetSecret() *Secret {
 	return nil
 }
 
+func (m *StoreAction) GetRole() *Role {
+	if x, ok := m.GetTarget().(*StoreAction_Role); ok {
+		return x.Role
+	}
+	return nil
+}
+
+func (m *StoreAction) GetAuditEvent() *AuditEvent {
+	if x, ok := m.GetTarget().(*StoreAction_AuditEvent); ok {
+		return x.AuditEvent
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*StoreAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _StoreAction_OneofMarshaler, _StoreAction_OneofUnmarshaler, _StoreAction_OneofSizer, []interface{}{
@@ -270,6 +352,8 @@ func (*StoreAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) e
 		(*StoreAction_Network)(nil),
 		(*StoreAction_Cluster)(nil),
 		(*StoreAction_Secret)(nil),
+		(*StoreAction_Role)(nil),
+		(*StoreAction_AuditEvent)(nil),
 	}
 }
 
@@ -307,6 +391,16 @@ func _StoreAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Secret); err != nil {
 			return err
 		}
+	case *StoreAction_Role:
+		_ = b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Role); err != nil {
+			return err
+		}
+	case *StoreAction_AuditEvent:
+		_ = b.EncodeVarint(9<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AuditEvent); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("StoreAction.Target has unexpected type %T", x)
@@ -365,6 +459,22 @@ func _StoreAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.Target = &StoreAction_Secret{msg}
 		return true, err
+	case 8: // target.role
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Role)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_Role{msg}
+		return true, err
+	case 9: // target.audit_event
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(AuditEvent)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_AuditEvent{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -404,6 +514,16 @@ func _StoreAction_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(7<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *StoreAction_Role:
+		s := proto.Size(x.Role)
+		n += proto.SizeVarint(8<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StoreAction_AuditEvent:
+		s := proto.Size(x.AuditEvent)
+		n += proto.SizeVarint(9<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -419,11 +539,14 @@ func init() {
 	proto.RegisterType((*LeaveResponse)(nil), "docker.swarmkit.v1.LeaveResponse")
 	proto.RegisterType((*ProcessRaftMessageRequest)(nil), "docker.swarmkit.v1.ProcessRaftMessageRequest")
 	proto.RegisterType((*ProcessRaftMessageResponse)(nil), "docker.swarmkit.v1.ProcessRaftMessageResponse")
+	proto.RegisterType((*StreamRaftMessageRequest)(nil), "docker.swarmkit.v1.StreamRaftMessageRequest")
+	proto.RegisterType((*StreamRaftMessageResponse)(nil), "docker.swarmkit.v1.StreamRaftMessageResponse")
 	proto.RegisterType((*ResolveAddressRequest)(nil), "docker.swarmkit.v1.ResolveAddressRequest")
 	proto.RegisterType((*ResolveAddressResponse)(nil), "docker.swarmkit.v1.ResolveAddressResponse")
 	proto.RegisterType((*InternalRaftRequest)(nil), "docker.swarmkit.v1.InternalRaftRequest")
 	proto.RegisterType((*StoreAction)(nil), "docker.swarmkit.v1.StoreAction")
 	proto.RegisterEnum("docker.swarmkit.v1.StoreActionKind", StoreActionKind_name, StoreActionKind_value)
+	proto.RegisterEnum("docker.swarmkit.v1.StreamRaftMessageRequest_Compression", StreamRaftMessageRequest_Compression_name, StreamRaftMessageRequest_Compression_value)
 }
 
 type authenticatedWrapperRaftServer struct {
@@ -446,6 +569,14 @@ func (p *authenticatedWrapperRaftServer) ProcessRaftMessage(ctx context.Context,
 	return p.local.ProcessRaftMessage(ctx, r)
 }
 
+func (p *authenticatedWrapperRaftServer) StreamRaftMessage(stream Raft_StreamRaftMessageServer) error {
+
+	if err := p.authorize(stream.Context(), []string{"swarm-manager"}); err != nil {
+		return err
+	}
+	return p.local.StreamRaftMessage(stream)
+}
+
 func (p *authenticatedWrapperRaftServer) ResolveAddress(ctx context.Context, r *ResolveAddressRequest) (*ResolveAddressResponse, error) {
 
 	if err := p.authorize(ctx, []string{"swarm-manager"}); err != nil {
@@ -580,6 +711,21 @@ func (m *ProcessRaftMessageResponse) Copy() *ProcessRaftMessageResponse {
 }
 
 func (m *ProcessRaftMessageResponse) CopyFrom(src interface{}) {}
+func (m *StreamRaftMessageResponse) Copy() *StreamRaftMessageResponse {
+	if m == nil {
+		return nil
+	}
+	o := &StreamRaftMessageResponse{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *StreamRaftMessageResponse) CopyFrom(src interface{}) {
+
+	o := src.(*StreamRaftMessageResponse)
+	*m = *o
+}
+
 func (m *ResolveAddressRequest) Copy() *ResolveAddressRequest {
 	if m == nil {
 		return nil
@@ -684,6 +830,18 @@ func (m *StoreAction) CopyFrom(src interface{}) {
 			}
 			github_com_docker_swarmkit_api_deepcopy.Copy(v.Secret, o.GetSecret())
 			m.Target = &v
+		case *StoreAction_Role:
+			v := StoreAction_Role{
+				Role: &Role{},
+			}
+			github_com_docker_swarmkit_api_deepcopy.Copy(v.Role, o.GetRole())
+			m.Target = &v
+		case *StoreAction_AuditEvent:
+			v := StoreAction_AuditEvent{
+				AuditEvent: &AuditEvent{},
+			}
+			github_com_docker_swarmkit_api_deepcopy.Copy(v.AuditEvent, o.GetAuditEvent())
+			m.Target = &v
 		}
 	}
 
@@ -703,6 +861,15 @@ type RaftClient interface {
 	// ProcessRaftMessage sends a raft message to be processed on a raft member, it is
 	// called from the RaftMember willing to send a message to its destination ('To' field)
 	ProcessRaftMessage(ctx context.Context, in *ProcessRaftMessageRequest, opts ...grpc.CallOption) (*ProcessRaftMessageResponse, error)
+	// StreamRaftMessage sends a raft message carrying a snapshot to be
+	// processed on a raft member. The snapshot data is sent in chunks, so
+	// that it isn't limited by the maximum size of a gRPC message.
+	//
+	// The first request of the stream carries the message, without the
+	// snapshot data, and the member answers with the offset to send the
+	// chunks from, which is not 0 when resuming an interrupted transfer of
+	// the same snapshot. The stream ends once the message is processed.
+	StreamRaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_StreamRaftMessageClient, error)
 	// ResolveAddress returns the address where the node with the given ID can be reached.
 	ResolveAddress(ctx context.Context, in *ResolveAddressRequest, opts ...grpc.CallOption) (*ResolveAddressResponse, error)
 }
@@ -724,6 +891,37 @@ func (c *raftClient) ProcessRaftMessage(ctx context.Context, in *ProcessRaftMess
 	return out, nil
 }
 
+func (c *raftClient) StreamRaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_StreamRaftMessageClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Raft_serviceDesc.Streams[0], c.cc, "/docker.swarmkit.v1.Raft/StreamRaftMessage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &raftStreamRaftMessageClient{stream}
+	return x, nil
+}
+
+type Raft_StreamRaftMessageClient interface {
+	Send(*StreamRaftMessageRequest) error
+	Recv() (*StreamRaftMessageResponse, error)
+	grpc.ClientStream
+}
+
+type raftStreamRaftMessageClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftStreamRaftMessageClient) Send(m *StreamRaftMessageRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *raftStreamRaftMessageClient) Recv() (*StreamRaftMessageResponse, error) {
+	m := new(StreamRaftMessageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *raftClient) ResolveAddress(ctx context.Context, in *ResolveAddressRequest, opts ...grpc.CallOption) (*ResolveAddressResponse, error) {
 	out := new(ResolveAddressResponse)
 	err := grpc.Invoke(ctx, "/docker.swarmkit.v1.Raft/ResolveAddress", in, out, c.cc, opts...)
@@ -739,6 +937,15 @@ type RaftServer interface {
 	// ProcessRaftMessage sends a raft message to be processed on a raft member, it is
 	// called from the RaftMember willing to send a message to its destination ('To' field)
 	ProcessRaftMessage(context.Context, *ProcessRaftMessageRequest) (*ProcessRaftMessageResponse, error)
+	// StreamRaftMessage sends a raft message carrying a snapshot to be
+	// processed on a raft member. The snapshot data is sent in chunks, so
+	// that it isn't limited by the maximum size of a gRPC message.
+	//
+	// The first request of the stream carries the message, without the
+	// snapshot data, and the member answers with the offset to send the
+	// chunks from, which is not 0 when resuming an interrupted transfer of
+	// the same snapshot. The stream ends once the message is processed.
+	StreamRaftMessage(Raft_StreamRaftMessageServer) error
 	// ResolveAddress returns the address where the node with the given ID can be reached.
 	ResolveAddress(context.Context, *ResolveAddressRequest) (*ResolveAddressResponse, error)
 }
@@ -765,6 +972,32 @@ func _Raft_ProcessRaftMessage_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Raft_StreamRaftMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RaftServer).StreamRaftMessage(&raftStreamRaftMessageServer{stream})
+}
+
+type Raft_StreamRaftMessageServer interface {
+	Send(*StreamRaftMessageResponse) error
+	Recv() (*StreamRaftMessageRequest, error)
+	grpc.ServerStream
+}
+
+type raftStreamRaftMessageServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftStreamRaftMessageServer) Send(m *StreamRaftMessageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *raftStreamRaftMessageServer) Recv() (*StreamRaftMessageRequest, error) {
+	m := new(StreamRaftMessageRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Raft_ResolveAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveAddressRequest)
 	if err := dec(in); err != nil {
@@ -796,7 +1029,14 @@ var _Raft_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Raft_ResolveAddress_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRaftMessage",
+			Handler:       _Raft_StreamRaftMessage_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "raft.proto",
 }
 
@@ -965,6 +1205,11 @@ func (m *JoinRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaft(dAtA, i, uint64(len(m.Addr)))
 		i += copy(dAtA[i:], m.Addr)
 	}
+	if m.SchemaVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.SchemaVersion))
+	}
 	return i, nil
 }
 
@@ -1112,6 +1357,84 @@ func (m *ProcessRaftMessageResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *StreamRaftMessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRaftMessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Message != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Message.Size()))
+		n6, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.SnapshotSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.SnapshotSize))
+	}
+	if len(m.Checksum) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(len(m.Checksum)))
+		i += copy(dAtA[i:], m.Checksum)
+	}
+	if m.Compression != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Compression))
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Offset))
+	}
+	if len(m.Chunk) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(len(m.Chunk)))
+		i += copy(dAtA[i:], m.Chunk)
+	}
+	return i, nil
+}
+
+func (m *StreamRaftMessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamRaftMessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Offset))
+	}
+	return i, nil
+}
+
 func (m *ResolveAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1215,11 +1538,11 @@ func (m *StoreAction) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaft(dAtA, i, uint64(m.Action))
 	}
 	if m.Target != nil {
-		nn6, err := m.Target.MarshalTo(dAtA[i:])
+		nn7, err := m.Target.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn6
+		i += nn7
 	}
 	return i, nil
 }
@@ -1230,11 +1553,11 @@ func (m *StoreAction_Node) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaft(dAtA, i, uint64(m.Node.Size()))
-		n7, err := m.Node.MarshalTo(dAtA[i:])
+		n8, err := m.Node.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	return i, nil
 }
@@ -1244,11 +1567,11 @@ func (m *StoreAction_Service) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintRaft(dAtA, i, uint64(m.Service.Size()))
-		n8, err := m.Service.MarshalTo(dAtA[i:])
+		n9, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	return i, nil
 }
@@ -1258,11 +1581,11 @@ func (m *StoreAction_Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintRaft(dAtA, i, uint64(m.Task.Size()))
-		n9, err := m.Task.MarshalTo(dAtA[i:])
+		n10, err := m.Task.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	return i, nil
 }
@@ -1272,11 +1595,11 @@ func (m *StoreAction_Network) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintRaft(dAtA, i, uint64(m.Network.Size()))
-		n10, err := m.Network.MarshalTo(dAtA[i:])
+		n11, err := m.Network.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	return i, nil
 }
@@ -1286,11 +1609,11 @@ func (m *StoreAction_Cluster) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintRaft(dAtA, i, uint64(m.Cluster.Size()))
-		n11, err := m.Cluster.MarshalTo(dAtA[i:])
+		n12, err := m.Cluster.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	return i, nil
 }
@@ -1300,11 +1623,39 @@ func (m *StoreAction_Secret) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintRaft(dAtA, i, uint64(m.Secret.Size()))
-		n12, err := m.Secret.MarshalTo(dAtA[i:])
+		n13, err := m.Secret.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
+	}
+	return i, nil
+}
+func (m *StoreAction_Role) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Role != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.Role.Size()))
+		n14, err := m.Role.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+func (m *StoreAction_AuditEvent) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AuditEvent != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.AuditEvent.Size()))
+		n15, err := m.AuditEvent.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
 	}
 	return i, nil
 }
@@ -1443,6 +1794,74 @@ func (p *raftProxyRaftServer) ProcessRaftMessage(ctx context.Context, r *Process
 	return resp, err
 }
 
+type Raft_StreamRaftMessageServerWrapper struct {
+	Raft_StreamRaftMessageServer
+	ctx context.Context
+}
+
+func (s Raft_StreamRaftMessageServerWrapper) Context() context.Context {
+	return s.ctx
+}
+
+func (p *raftProxyRaftServer) StreamRaftMessage(stream Raft_StreamRaftMessageServer) error {
+	ctx := stream.Context()
+	conn, err := p.connSelector.LeaderConn(ctx)
+	if err != nil {
+		if err == raftselector.ErrIsLeader {
+			ctx, err = p.runCtxMods(ctx, p.localCtxMods)
+			if err != nil {
+				return err
+			}
+			streamWrapper := Raft_StreamRaftMessageServerWrapper{
+				Raft_StreamRaftMessageServer: stream,
+				ctx:                          ctx,
+			}
+			return p.local.StreamRaftMessage(streamWrapper)
+		}
+		return err
+	}
+	ctx, err = p.runCtxMods(ctx, p.remoteCtxMods)
+	if err != nil {
+		return err
+	}
+	clientStream, err := NewRaftClient(conn).StreamRaftMessage(ctx)
+
+	if err != nil {
+		return err
+	}
+	errc := make(chan error, 1)
+	go func() {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			close(errc)
+			return
+		}
+		if err != nil {
+			errc <- err
+			return
+		}
+		if err := clientStream.Send(msg); err != nil {
+			errc <- err
+			return
+		}
+	}()
+
+	for {
+		msg, err := clientStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+	clientStream.CloseSend()
+	return <-errc
+}
+
 func (p *raftProxyRaftServer) ResolveAddress(ctx context.Context, r *ResolveAddressRequest) (*ResolveAddressResponse, error) {
 
 	conn, err := p.connSelector.LeaderConn(ctx)
@@ -1646,6 +2065,9 @@ func (m *JoinRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRaft(uint64(l))
 	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovRaft(uint64(m.SchemaVersion))
+	}
 	return n
 }
 
@@ -1703,6 +2125,42 @@ func (m *ProcessRaftMessageResponse) Size() (n int) {
 	return n
 }
 
+func (m *StreamRaftMessageRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	if m.SnapshotSize != 0 {
+		n += 1 + sovRaft(uint64(m.SnapshotSize))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	if m.Compression != 0 {
+		n += 1 + sovRaft(uint64(m.Compression))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovRaft(uint64(m.Offset))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamRaftMessageResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovRaft(uint64(m.Offset))
+	}
+	return n
+}
+
 func (m *ResolveAddressRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -1803,6 +2261,24 @@ func (m *StoreAction_Secret) Size() (n int) {
 	}
 	return n
 }
+func (m *StoreAction_Role) Size() (n int) {
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = m.Role.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
+func (m *StoreAction_AuditEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.AuditEvent != nil {
+		l = m.AuditEvent.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
 
 func sovRaft(x uint64) (n int) {
 	for {
@@ -1836,6 +2312,7 @@ func (this *JoinRequest) String() string {
 	}
 	s := strings.Join([]string{`&JoinRequest{`,
 		`Addr:` + fmt.Sprintf("%v", this.Addr) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1890,22 +2367,47 @@ func (this *ProcessRaftMessageResponse) String() string {
 	}, "")
 	return s
 }
-func (this *ResolveAddressRequest) String() string {
+func (this *StreamRaftMessageRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ResolveAddressRequest{`,
-		`RaftID:` + fmt.Sprintf("%v", this.RaftID) + `,`,
+	s := strings.Join([]string{`&StreamRaftMessageRequest{`,
+		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "Message", "raftpb.Message", 1) + `,`,
+		`SnapshotSize:` + fmt.Sprintf("%v", this.SnapshotSize) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Chunk:` + fmt.Sprintf("%v", this.Chunk) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *ResolveAddressResponse) String() string {
+func (this *StreamRaftMessageResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&ResolveAddressResponse{`,
-		`Addr:` + fmt.Sprintf("%v", this.Addr) + `,`,
+	s := strings.Join([]string{`&StreamRaftMessageResponse{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResolveAddressRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResolveAddressRequest{`,
+		`RaftID:` + fmt.Sprintf("%v", this.RaftID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResolveAddressResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResolveAddressResponse{`,
+		`Addr:` + fmt.Sprintf("%v", this.Addr) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1992,6 +2494,26 @@ func (this *StoreAction_Secret) String() string {
 	}, "")
 	return s
 }
+func (this *StoreAction_Role) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StoreAction_Role{`,
+		`Role:` + strings.Replace(fmt.Sprintf("%v", this.Role), "Role", "Role", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StoreAction_AuditEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StoreAction_AuditEvent{`,
+		`AuditEvent:` + strings.Replace(fmt.Sprintf("%v", this.AuditEvent), "AuditEvent", "AuditEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRaft(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2215,6 +2737,25 @@ func (m *JoinRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaft(dAtA[iNdEx:])
@@ -2664,6 +3205,277 @@ func (m *ProcessRaftMessageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StreamRaftMessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRaftMessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRaftMessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &raftpb.Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotSize", wireType)
+			}
+			m.SnapshotSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= (StreamRaftMessageRequest_Compression(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamRaftMessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamRaftMessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamRaftMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResolveAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3152,6 +3964,70 @@ func (m *StoreAction) Unmarshal(dAtA []byte) error {
 			}
 			m.Target = &StoreAction_Secret{v}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Role{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_Role{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AuditEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_AuditEvent{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaft(dAtA[iNdEx:])
@@ -3281,61 +4157,75 @@ var (
 func init() { proto.RegisterFile("raft.proto", fileDescriptorRaft) }
 
 var fileDescriptorRaft = []byte{
-	// 885 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x73, 0xdb, 0x44,
-	0x14, 0xc7, 0x2d, 0x59, 0x95, 0xe1, 0xb9, 0x89, 0x33, 0x1b, 0x12, 0x54, 0xc1, 0x28, 0xaa, 0xca,
-	0x4c, 0xdd, 0x0e, 0x91, 0x07, 0xc1, 0x4c, 0x19, 0xe0, 0x12, 0x27, 0x9e, 0x89, 0x69, 0xeb, 0x74,
-	0x94, 0x04, 0x7a, 0x0b, 0xb2, 0xb4, 0x71, 0x85, 0x63, 0xad, 0xd9, 0x5d, 0x3b, 0xc3, 0x85, 0xe9,
-	0x91, 0xc9, 0x95, 0xe1, 0xc7, 0xa5, 0x27, 0x38, 0xf7, 0x0f, 0xe0, 0x2f, 0xc8, 0x70, 0xe2, 0x06,
-	0xa7, 0x0c, 0xf5, 0x1f, 0x00, 0xff, 0x02, 0xb3, 0x2b, 0x29, 0x49, 0x1d, 0xc5, 0xc9, 0x25, 0x59,
-	0xaf, 0x3e, 0xdf, 0xf7, 0xdd, 0xf7, 0x56, 0xef, 0x09, 0x80, 0x06, 0xfb, 0xdc, 0x1d, 0x52, 0xc2,
-	0x09, 0x42, 0x11, 0x09, 0xfb, 0x98, 0xba, 0xec, 0x30, 0xa0, 0x83, 0x7e, 0xcc, 0xdd, 0xf1, 0x07,
-	0xe6, 0x1c, 0xe9, 0x7e, 0x8d, 0x43, 0xce, 0x52, 0xc4, 0xac, 0xf2, 0x6f, 0x87, 0x38, 0xff, 0xb1,
-	0xda, 0x8b, 0xf9, 0xb3, 0x51, 0xd7, 0x0d, 0xc9, 0xa0, 0x11, 0x12, 0x8a, 0x09, 0x6b, 0x60, 0x1e,
-	0x46, 0x0d, 0x11, 0x52, 0xfe, 0x19, 0x76, 0x1b, 0x67, 0xe1, 0xcd, 0xb7, 0x7a, 0xa4, 0x47, 0xe4,
-	0xb2, 0x21, 0x56, 0xd9, 0xee, 0xe2, 0xf0, 0x60, 0xd4, 0x8b, 0x93, 0x46, 0xfa, 0x2f, 0xdd, 0x74,
-	0x5e, 0x2a, 0x00, 0x7e, 0xb0, 0xcf, 0x1f, 0xe3, 0x41, 0x17, 0x53, 0x74, 0x07, 0x2a, 0x22, 0xce,
-	0x5e, 0x1c, 0x19, 0x8a, 0xad, 0xd4, 0xb5, 0x26, 0x4c, 0x4e, 0x56, 0x74, 0x01, 0xb4, 0x37, 0x7c,
-	0x5d, 0x3c, 0x6a, 0x47, 0x02, 0x4a, 0x48, 0x84, 0x05, 0xa4, 0xda, 0x4a, 0xfd, 0xcd, 0x14, 0xea,
-	0x90, 0x08, 0x0b, 0x48, 0x3c, 0x6a, 0x47, 0x08, 0x81, 0x16, 0x44, 0x11, 0x35, 0xca, 0x82, 0xf0,
-	0xe5, 0x1a, 0x35, 0x41, 0x67, 0x3c, 0xe0, 0x23, 0x66, 0x68, 0xb6, 0x52, 0xaf, 0x7a, 0xef, 0xb9,
-	0x17, 0xeb, 0xe0, 0x9e, 0x9d, 0x66, 0x5b, 0xb2, 0x4d, 0xed, 0xf8, 0x64, 0xa5, 0xe4, 0x67, 0x4a,
-	0xe7, 0x36, 0x54, 0x3f, 0x27, 0x71, 0xe2, 0xe3, 0x6f, 0x46, 0x98, 0xf1, 0x53, 0x1b, 0xe5, 0xcc,
-	0xc6, 0xf9, 0x49, 0x81, 0x9b, 0x29, 0xc3, 0x86, 0x24, 0x61, 0xf8, 0x7a, 0x59, 0x7d, 0x0c, 0x95,
-	0x81, 0xb4, 0x65, 0x86, 0x6a, 0x97, 0xeb, 0x55, 0xcf, 0x9a, 0x7d, 0x3a, 0x3f, 0xc7, 0xd1, 0x5d,
-	0xa8, 0x51, 0x3c, 0x20, 0x63, 0x1c, 0xed, 0xe5, 0x11, 0xca, 0x76, 0xb9, 0xae, 0xf9, 0xf3, 0xd9,
-	0x76, 0x2a, 0x60, 0x4e, 0x13, 0x6e, 0x3e, 0xc2, 0xc1, 0x18, 0xe7, 0x87, 0xf7, 0x40, 0x13, 0xd5,
-	0x92, 0x87, 0xba, 0xda, 0x4f, 0xb2, 0x4e, 0x0d, 0xe6, 0xb2, 0x18, 0x69, 0x72, 0xce, 0x23, 0xb8,
-	0xf5, 0x84, 0x92, 0x10, 0x33, 0x96, 0xb2, 0x8c, 0x05, 0xbd, 0x53, 0x87, 0x7b, 0x22, 0x29, 0xb9,
-	0x93, 0x99, 0xd4, 0xdc, 0xf4, 0x75, 0x71, 0x73, 0x30, 0x7f, 0xfe, 0x89, 0xf6, 0xfc, 0x67, 0xa7,
-	0xe4, 0xbc, 0x0b, 0x66, 0x51, 0xb4, 0xcc, 0xeb, 0x33, 0x58, 0xf2, 0x31, 0x23, 0x07, 0x63, 0xbc,
-	0x16, 0x45, 0x54, 0x40, 0x99, 0xcf, 0x75, 0x2a, 0xec, 0xbc, 0x0f, 0xcb, 0xd3, 0xea, 0xec, 0x82,
-	0x8a, 0x6e, 0x71, 0x1f, 0x16, 0xdb, 0x09, 0xc7, 0x34, 0x09, 0x0e, 0x44, 0x9c, 0xdc, 0x69, 0x19,
-	0xd4, 0x53, 0x13, 0x7d, 0x72, 0xb2, 0xa2, 0xb6, 0x37, 0x7c, 0x35, 0x8e, 0xd0, 0x03, 0xd0, 0x83,
-	0x90, 0xc7, 0x24, 0xc9, 0x6e, 0x6f, 0xa5, 0xa8, 0x9a, 0xdb, 0x9c, 0x50, 0xbc, 0x26, 0x31, 0x3f,
-	0xc3, 0x9d, 0x1f, 0xcb, 0x50, 0x3d, 0xb7, 0x8f, 0x3e, 0x3d, 0x0d, 0x24, 0x4c, 0xe6, 0xbd, 0x3b,
-	0x57, 0x04, 0x7a, 0x18, 0x27, 0x51, 0x1e, 0x0c, 0xb9, 0xd9, 0x8d, 0xaa, 0xb2, 0xd8, 0x46, 0x91,
-	0x54, 0xf4, 0xc9, 0x66, 0x29, 0xbd, 0x4d, 0xf4, 0x00, 0x2a, 0x0c, 0xd3, 0x71, 0x1c, 0x62, 0xd9,
-	0x28, 0x55, 0xef, 0x9d, 0x42, 0xb7, 0x14, 0xd9, 0x2c, 0xf9, 0x39, 0x2d, 0x8c, 0x78, 0xc0, 0xfa,
-	0x59, 0x23, 0x15, 0x1a, 0xed, 0x04, 0xac, 0x2f, 0x8c, 0x04, 0x27, 0x8c, 0x12, 0xcc, 0x0f, 0x09,
-	0xed, 0x1b, 0x37, 0x2e, 0x37, 0xea, 0xa4, 0x88, 0x30, 0xca, 0x68, 0x21, 0x0c, 0x0f, 0x46, 0x8c,
-	0x63, 0x6a, 0xe8, 0x97, 0x0b, 0xd7, 0x53, 0x44, 0x08, 0x33, 0x1a, 0x7d, 0x04, 0x3a, 0xc3, 0x21,
-	0xc5, 0xdc, 0xa8, 0x48, 0x9d, 0x59, 0x9c, 0x99, 0x20, 0x36, 0x45, 0x7b, 0xcb, 0x55, 0xf3, 0x0d,
-	0xd0, 0x79, 0x40, 0x7b, 0x98, 0xdf, 0xff, 0x4f, 0x81, 0xda, 0x54, 0x99, 0xd1, 0x5d, 0xa8, 0xec,
-	0x76, 0x1e, 0x76, 0xb6, 0xbe, 0xec, 0x2c, 0x94, 0x4c, 0xf3, 0xe8, 0x85, 0xbd, 0x3c, 0x45, 0xec,
-	0x26, 0xfd, 0x84, 0x1c, 0x26, 0xc8, 0x83, 0xc5, 0xed, 0x9d, 0x2d, 0xbf, 0xb5, 0xb7, 0xb6, 0xbe,
-	0xd3, 0xde, 0xea, 0xec, 0xad, 0xfb, 0xad, 0xb5, 0x9d, 0xd6, 0x82, 0x62, 0xde, 0x3a, 0x7a, 0x61,
-	0x2f, 0x4d, 0x89, 0xd6, 0x29, 0x0e, 0x38, 0xbe, 0xa0, 0xd9, 0x7d, 0xb2, 0x21, 0x34, 0x6a, 0xa1,
-	0x66, 0x77, 0x18, 0x15, 0x69, 0xfc, 0xd6, 0xe3, 0xad, 0x2f, 0x5a, 0x0b, 0xe5, 0x42, 0x8d, 0x2f,
-	0xa7, 0x81, 0xf9, 0xf6, 0xf7, 0xbf, 0x5a, 0xa5, 0xdf, 0x7f, 0xb3, 0xa6, 0xb3, 0xf3, 0x7e, 0x50,
-	0x41, 0x13, 0xaf, 0x3a, 0x3a, 0x52, 0x00, 0x5d, 0xec, 0x42, 0xb4, 0x5a, 0x54, 0xc1, 0x4b, 0x7b,
-	0xdf, 0x74, 0xaf, 0x8b, 0x67, 0xcd, 0xbd, 0xf4, 0xc7, 0xcb, 0x7f, 0x7f, 0x51, 0x6b, 0x30, 0x27,
-	0xf9, 0xd5, 0x41, 0x90, 0x04, 0x3d, 0x4c, 0xd1, 0x77, 0x30, 0xff, 0x7a, 0xd7, 0xa2, 0x7b, 0x85,
-	0x83, 0xaa, 0x68, 0x2e, 0x98, 0xf7, 0xaf, 0x83, 0xce, 0xf4, 0xf7, 0xfe, 0x52, 0x60, 0xfe, 0x6c,
-	0x0a, 0xb2, 0x67, 0xf1, 0x10, 0x7d, 0x05, 0x9a, 0x98, 0xef, 0xa8, 0xb0, 0xc7, 0xcf, 0x7d, 0x1d,
-	0x4c, 0xfb, 0x72, 0x60, 0x76, 0xd2, 0x21, 0xdc, 0x90, 0x53, 0x16, 0x15, 0x46, 0x38, 0x3f, 0xc4,
-	0xcd, 0xdb, 0x33, 0x88, 0x99, 0x26, 0x4d, 0xe3, 0xf8, 0x95, 0x55, 0xfa, 0xfb, 0x95, 0x55, 0x7a,
-	0x3e, 0xb1, 0x94, 0xe3, 0x89, 0xa5, 0xfc, 0x39, 0xb1, 0x94, 0x7f, 0x26, 0x96, 0xf2, 0xb4, 0xfc,
-	0x54, 0xeb, 0xea, 0xf2, 0x03, 0xfd, 0xe1, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x5a, 0xbd,
-	0x08, 0x38, 0x08, 0x00, 0x00,
+	// 1109 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0x2d, 0x5b, 0x71, 0xd2, 0xe7, 0x38, 0x09, 0xdb, 0x36, 0xa8, 0x82, 0x71, 0x5c, 0x05,
+	0xa6, 0x6e, 0xa7, 0x91, 0xc1, 0x65, 0xa6, 0x1d, 0xe0, 0x62, 0x27, 0x1e, 0x62, 0xda, 0x3a, 0x19,
+	0x25, 0x29, 0x9d, 0x5e, 0x8c, 0x22, 0x6d, 0x6c, 0x61, 0x5b, 0x6b, 0x76, 0xd7, 0xce, 0xd0, 0x03,
+	0xd3, 0x63, 0x27, 0xdc, 0x81, 0x4b, 0x0f, 0x0c, 0x9c, 0x7b, 0x87, 0x4f, 0x90, 0xe1, 0xc4, 0x0d,
+	0x4e, 0x19, 0xea, 0x0f, 0x00, 0x5f, 0x81, 0xd9, 0x95, 0xe4, 0x98, 0x44, 0x76, 0xc3, 0x70, 0x49,
+	0x76, 0x57, 0xbf, 0xf7, 0xfe, 0xef, 0x3d, 0xbd, 0x7d, 0x16, 0x00, 0xb5, 0x0f, 0xb8, 0xd9, 0xa3,
+	0x84, 0x13, 0x84, 0x5c, 0xe2, 0xb4, 0x31, 0x35, 0xd9, 0xa1, 0x4d, 0xbb, 0x6d, 0x8f, 0x9b, 0x83,
+	0xf7, 0xf5, 0x2c, 0xd9, 0xff, 0x02, 0x3b, 0x9c, 0x05, 0x88, 0x9e, 0xe1, 0x5f, 0xf5, 0x70, 0xb4,
+	0x59, 0x6b, 0x7a, 0xbc, 0xd5, 0xdf, 0x37, 0x1d, 0xd2, 0x2d, 0x3a, 0x84, 0x62, 0xc2, 0x8a, 0x98,
+	0x3b, 0x6e, 0x51, 0xb8, 0x94, 0x7f, 0x7a, 0xfb, 0xc5, 0x53, 0xf7, 0xfa, 0x95, 0x26, 0x69, 0x12,
+	0xb9, 0x2c, 0x8a, 0x55, 0x78, 0x7a, 0xb9, 0xd7, 0xe9, 0x37, 0x3d, 0xbf, 0x18, 0xfc, 0x0b, 0x0e,
+	0x8d, 0x97, 0x0a, 0x80, 0x65, 0x1f, 0xf0, 0x87, 0xb8, 0xbb, 0x8f, 0x29, 0x5a, 0x85, 0x59, 0xe1,
+	0xa7, 0xe1, 0xb9, 0x9a, 0x92, 0x57, 0x0a, 0x6a, 0x05, 0x86, 0x27, 0x2b, 0x69, 0x01, 0xd4, 0x36,
+	0xac, 0xb4, 0x78, 0x54, 0x73, 0x05, 0xe4, 0x13, 0x17, 0x0b, 0x28, 0x99, 0x57, 0x0a, 0x97, 0x02,
+	0xa8, 0x4e, 0x5c, 0x2c, 0x20, 0xf1, 0xa8, 0xe6, 0x22, 0x04, 0xaa, 0xed, 0xba, 0x54, 0x4b, 0x09,
+	0xc2, 0x92, 0x6b, 0x54, 0x81, 0x34, 0xe3, 0x36, 0xef, 0x33, 0x4d, 0xcd, 0x2b, 0x85, 0x4c, 0xe9,
+	0x1d, 0xf3, 0x7c, 0x1d, 0xcc, 0xd3, 0x68, 0x76, 0x24, 0x5b, 0x51, 0x8f, 0x4f, 0x56, 0x12, 0x56,
+	0x68, 0x69, 0x6c, 0x42, 0xe6, 0x53, 0xe2, 0xf9, 0x16, 0xfe, 0xb2, 0x8f, 0x19, 0x1f, 0xc9, 0x28,
+	0x63, 0x32, 0xef, 0xc2, 0x02, 0x73, 0x5a, 0xb8, 0x6b, 0x37, 0x06, 0x98, 0x32, 0x8f, 0xf8, 0x32,
+	0xcc, 0xac, 0x95, 0x0d, 0x4e, 0x1f, 0x05, 0x87, 0xc6, 0xb7, 0x0a, 0xcc, 0x07, 0xae, 0x58, 0x8f,
+	0xf8, 0x0c, 0x5f, 0x2c, 0xf9, 0x7b, 0x30, 0xdb, 0x95, 0xd1, 0x31, 0x2d, 0x99, 0x4f, 0x15, 0x32,
+	0xa5, 0xdc, 0xf4, 0x24, 0xac, 0x08, 0x47, 0x37, 0x60, 0x91, 0xe2, 0x2e, 0x19, 0x60, 0xb7, 0x11,
+	0x79, 0x48, 0xe5, 0x53, 0x05, 0xd5, 0x5a, 0x08, 0x8f, 0x03, 0x03, 0x66, 0x54, 0x60, 0xfe, 0x01,
+	0xb6, 0x07, 0x38, 0xca, 0xb1, 0x04, 0xaa, 0x28, 0xaa, 0x0c, 0xea, 0xf5, 0x7a, 0x92, 0x35, 0x16,
+	0x21, 0x1b, 0xfa, 0x08, 0x92, 0x33, 0x1e, 0xc0, 0xb5, 0x6d, 0x4a, 0x1c, 0xcc, 0x58, 0xc0, 0x32,
+	0x66, 0x37, 0x47, 0x0a, 0x37, 0x45, 0x52, 0xf2, 0x24, 0x14, 0x59, 0x34, 0x83, 0xae, 0x32, 0x23,
+	0x30, 0x7a, 0xfe, 0xa1, 0xfa, 0xec, 0x3b, 0x23, 0x61, 0xbc, 0x0d, 0x7a, 0x9c, 0xb7, 0x50, 0xeb,
+	0xe7, 0x24, 0x68, 0x3b, 0x9c, 0x62, 0xbb, 0xfb, 0xbf, 0xb4, 0xd0, 0x2a, 0x64, 0x99, 0x6f, 0xf7,
+	0x58, 0x8b, 0xf0, 0x06, 0xf3, 0x9e, 0x62, 0xf9, 0x1e, 0x55, 0x6b, 0x3e, 0x3a, 0xdc, 0xf1, 0x9e,
+	0x62, 0xa4, 0xc3, 0x9c, 0xd3, 0xc2, 0x4e, 0x9b, 0xf5, 0xbb, 0xb2, 0xd9, 0xe6, 0xad, 0xd1, 0x1e,
+	0x3d, 0x81, 0x8c, 0x43, 0xba, 0x3d, 0x8a, 0x99, 0x6c, 0x03, 0xd1, 0x75, 0x0b, 0xa5, 0x7b, 0x71,
+	0x05, 0x9c, 0x14, 0xae, 0xb9, 0x7e, 0x6a, 0x6f, 0x8d, 0x3b, 0x43, 0xcb, 0x90, 0x26, 0x07, 0x07,
+	0x0c, 0x73, 0x6d, 0x46, 0x46, 0x15, 0xee, 0xd0, 0x15, 0x98, 0x71, 0x5a, 0x7d, 0xbf, 0xad, 0xa5,
+	0x65, 0x30, 0xc1, 0xc6, 0xb8, 0x0e, 0x99, 0x31, 0x4f, 0x68, 0x0e, 0xd4, 0xfa, 0x56, 0xbd, 0xba,
+	0x94, 0x10, 0xab, 0x4f, 0x9e, 0xd4, 0xb6, 0x97, 0x94, 0xb0, 0xb2, 0x77, 0xe0, 0x5a, 0x4c, 0x2c,
+	0x61, 0x87, 0x9e, 0x6a, 0x2a, 0xe3, 0x9a, 0xc6, 0xc7, 0x70, 0xd5, 0xc2, 0x8c, 0x74, 0x06, 0xb8,
+	0xec, 0xba, 0x42, 0x23, 0x2a, 0xf6, 0x45, 0x5a, 0xda, 0xb8, 0x0d, 0xcb, 0x67, 0xad, 0x43, 0xbd,
+	0x98, 0xdb, 0x65, 0x1c, 0xc0, 0xe5, 0x9a, 0xcf, 0x31, 0xf5, 0xed, 0x8e, 0xf0, 0x13, 0x29, 0x2d,
+	0x43, 0x72, 0x24, 0x92, 0x1e, 0x9e, 0xac, 0x24, 0x6b, 0x1b, 0x56, 0xd2, 0x73, 0xd1, 0x5d, 0x48,
+	0xdb, 0x0e, 0x0f, 0x2e, 0xa1, 0xb8, 0x2e, 0x2b, 0xf1, 0xd5, 0x27, 0x14, 0x97, 0x25, 0x66, 0x85,
+	0xb8, 0xf1, 0x5c, 0x85, 0xcc, 0xd8, 0x39, 0xfa, 0x68, 0xe4, 0x48, 0x91, 0xaf, 0x71, 0xf5, 0x35,
+	0x8e, 0xee, 0x7b, 0xbe, 0x1b, 0x39, 0x43, 0x66, 0x78, 0x85, 0x92, 0xb2, 0xe3, 0xb4, 0x38, 0x53,
+	0x31, 0xbf, 0x36, 0x13, 0xc1, 0xf5, 0x41, 0x77, 0x61, 0x96, 0x61, 0x3a, 0xf0, 0x1c, 0x2c, 0x7b,
+	0x2a, 0x53, 0x7a, 0x2b, 0x56, 0x2d, 0x40, 0x36, 0x13, 0x56, 0x44, 0x0b, 0x21, 0x6e, 0xb3, 0xb6,
+	0xa6, 0x4e, 0x16, 0xda, 0xb5, 0x59, 0x5b, 0x08, 0x09, 0x4e, 0x08, 0xf9, 0x98, 0x1f, 0x12, 0xda,
+	0xd6, 0x66, 0x26, 0x0b, 0xd5, 0x03, 0x44, 0x08, 0x85, 0xb4, 0x30, 0x74, 0x3a, 0x7d, 0xc6, 0x31,
+	0xd5, 0xd2, 0x93, 0x0d, 0xd7, 0x03, 0x44, 0x18, 0x86, 0x34, 0xfa, 0x00, 0xd2, 0x0c, 0x3b, 0x14,
+	0x73, 0x6d, 0x56, 0xda, 0xe9, 0xf1, 0x99, 0x09, 0x62, 0x53, 0x8c, 0x5d, 0xb9, 0x12, 0x79, 0x51,
+	0xd2, 0xc1, 0xda, 0xdc, 0xe4, 0xbc, 0x2c, 0xd2, 0x91, 0x05, 0x14, 0x1c, 0x2a, 0x43, 0xc6, 0xee,
+	0xbb, 0x1e, 0x6f, 0xe0, 0x01, 0xf6, 0xb9, 0x76, 0x69, 0xf2, 0xe8, 0x2a, 0x0b, 0xac, 0x2a, 0xa8,
+	0xcd, 0x84, 0x05, 0xf6, 0x68, 0x57, 0x99, 0x83, 0x34, 0xb7, 0x69, 0x13, 0xf3, 0x5b, 0x7f, 0x2b,
+	0xb0, 0x78, 0xe6, 0xcd, 0xa2, 0x1b, 0x30, 0xbb, 0x57, 0xbf, 0x5f, 0xdf, 0xfa, 0xac, 0xbe, 0x94,
+	0xd0, 0xf5, 0xa3, 0x17, 0xf9, 0xe5, 0x33, 0xc4, 0x9e, 0xdf, 0xf6, 0xc9, 0xa1, 0x8f, 0x4a, 0x70,
+	0x79, 0x67, 0x77, 0xcb, 0xaa, 0x36, 0xca, 0xeb, 0xbb, 0xb5, 0xad, 0x7a, 0x63, 0xdd, 0xaa, 0x96,
+	0x77, 0xab, 0x4b, 0x8a, 0x7e, 0xed, 0xe8, 0x45, 0xfe, 0xea, 0x19, 0xa3, 0x75, 0x8a, 0x6d, 0x8e,
+	0xcf, 0xd9, 0xec, 0x6d, 0x6f, 0x08, 0x9b, 0x64, 0xac, 0xcd, 0x5e, 0xcf, 0x8d, 0xb3, 0xb1, 0xaa,
+	0x0f, 0xb7, 0x1e, 0x55, 0x97, 0x52, 0xb1, 0x36, 0x96, 0x9c, 0xf8, 0xfa, 0x9b, 0xcf, 0x7f, 0xcc,
+	0x25, 0x7e, 0xf9, 0x29, 0x77, 0x36, 0xbb, 0xd2, 0x0f, 0x29, 0x50, 0xc5, 0xed, 0x42, 0x47, 0x0a,
+	0xa0, 0xf3, 0x93, 0x16, 0xad, 0xc5, 0x55, 0x72, 0xe2, 0x7c, 0xd7, 0xcd, 0x8b, 0xe2, 0xe1, 0x00,
+	0xbf, 0xfa, 0xeb, 0xcb, 0xbf, 0xbe, 0x4f, 0x2e, 0x42, 0x56, 0xf2, 0x6b, 0x5d, 0xdb, 0xb7, 0x9b,
+	0x98, 0xa2, 0x6f, 0x14, 0x78, 0xe3, 0xdc, 0x70, 0x42, 0xb7, 0xff, 0xcb, 0x3c, 0xd5, 0xd7, 0x2e,
+	0x48, 0x4f, 0x8d, 0xa4, 0xa0, 0xbc, 0xa7, 0xa0, 0xaf, 0x61, 0xe1, 0xdf, 0x63, 0x0b, 0xdd, 0x8c,
+	0x6d, 0xcb, 0xb8, 0xc1, 0xa8, 0xdf, 0xba, 0x08, 0x3a, 0x35, 0x86, 0xd2, 0xef, 0x0a, 0x2c, 0x9c,
+	0xfe, 0xee, 0xb2, 0x96, 0xd7, 0x43, 0x9f, 0x83, 0x2a, 0xbe, 0x28, 0x50, 0xec, 0x90, 0x1b, 0xfb,
+	0x6c, 0xd1, 0xf3, 0x93, 0x81, 0xe9, 0xaf, 0xc0, 0x81, 0x19, 0xf9, 0xbb, 0x8e, 0x62, 0x3d, 0x8c,
+	0x7f, 0x36, 0xe8, 0xd7, 0xa7, 0x10, 0x53, 0x45, 0x2a, 0xda, 0xf1, 0xab, 0x5c, 0xe2, 0x8f, 0x57,
+	0xb9, 0xc4, 0xb3, 0x61, 0x4e, 0x39, 0x1e, 0xe6, 0x94, 0xdf, 0x86, 0x39, 0xe5, 0xcf, 0x61, 0x4e,
+	0x79, 0x9c, 0x7a, 0xac, 0xee, 0xa7, 0xe5, 0x97, 0xe3, 0x9d, 0x7f, 0x06, 0x00, 0xb6, 0x3a, 0x50,
+	0xc6, 0xd1, 0x0a, 0x00, 0x00,
 }
@@ -49,6 +49,8 @@ type StoreSnapshot struct {
 	Tasks    []*Task    `protobuf:"bytes,4,rep,name=tasks" json:"tasks,omitempty"`
 	Clusters []*Cluster `protobuf:"bytes,5,rep,name=clusters" json:"clusters,omitempty"`
 	Secrets  []*Secret  `protobuf:"bytes,6,rep,name=secrets" json:"secrets,omitempty"`
+	Roles    []*Role    `protobuf:"bytes,7,rep,name=roles" json:"roles,omitempty"`
+	AuditEvents []*AuditEvent `protobuf:"bytes,8,rep,name=audit_events,json=auditEvents" json:"audit_events,omitempty"`
 }
 
 func (m *StoreSnapshot) Reset()                    { *m = StoreSnapshot{} }
@@ -143,6 +145,22 @@ func (m *StoreSnapshot) CopyFrom(src interface{}) {
 		}
 	}
 
+	if o.Roles != nil {
+		m.Roles = make([]*Role, len(o.Roles))
+		for i := range m.Roles {
+			m.Roles[i] = &Role{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.Roles[i], o.Roles[i])
+		}
+	}
+
+	if o.AuditEvents != nil {
+		m.AuditEvents = make([]*AuditEvent, len(o.AuditEvents))
+		for i := range m.AuditEvents {
+			m.AuditEvents[i] = &AuditEvent{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.AuditEvents[i], o.AuditEvents[i])
+		}
+	}
+
 }
 
 func (m *ClusterSnapshot) Copy() *ClusterSnapshot {
@@ -277,6 +295,30 @@ func (m *StoreSnapshot) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Roles) > 0 {
+		for _, msg := range m.Roles {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintSnapshot(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.AuditEvents) > 0 {
+		for _, msg := range m.AuditEvents {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintSnapshot(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -433,6 +475,18 @@ func (m *StoreSnapshot) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	if len(m.AuditEvents) > 0 {
+		for _, e := range m.AuditEvents {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -492,6 +546,8 @@ func (this *StoreSnapshot) String() string {
 		`Tasks:` + strings.Replace(fmt.Sprintf("%v", this.Tasks), "Task", "Task", 1) + `,`,
 		`Clusters:` + strings.Replace(fmt.Sprintf("%v", this.Clusters), "Cluster", "Cluster", 1) + `,`,
 		`Secrets:` + strings.Replace(fmt.Sprintf("%v", this.Secrets), "Secret", "Secret", 1) + `,`,
+		`Roles:` + strings.Replace(fmt.Sprintf("%v", this.Roles), "Role", "Role", 1) + `,`,
+		`AuditEvents:` + strings.Replace(fmt.Sprintf("%v", this.AuditEvents), "AuditEvent", "AuditEvent", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -742,6 +798,68 @@ func (m *StoreSnapshot) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &Role{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEvents = append(m.AuditEvents, &AuditEvent{})
+			if err := m.AuditEvents[len(m.AuditEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1143,31 +1261,33 @@ var (
 func init() { proto.RegisterFile("snapshot.proto", fileDescriptorSnapshot) }
 
 var fileDescriptorSnapshot = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x92, 0xbd, 0x6e, 0xd4, 0x40,
-	0x10, 0xc7, 0xbd, 0xbe, 0x0f, 0x47, 0x13, 0x25, 0xc0, 0x8a, 0x62, 0x65, 0x24, 0x73, 0x18, 0x8a,
-	0xab, 0x0c, 0x1c, 0x48, 0xd0, 0x40, 0x11, 0x2a, 0x0a, 0x52, 0xec, 0xa1, 0x88, 0xd6, 0x67, 0x4f,
-	0x12, 0x63, 0xec, 0x3d, 0xed, 0x2c, 0x4e, 0xcb, 0xe3, 0x5d, 0x99, 0x92, 0x0a, 0x11, 0x37, 0xbc,
-	0x06, 0xb2, 0xd7, 0xb6, 0x4e, 0xc2, 0x47, 0x37, 0xb6, 0x7e, 0xff, 0x8f, 0x1d, 0x0d, 0x9c, 0x52,
-	0x19, 0x6f, 0xe9, 0x5a, 0x99, 0x68, 0xab, 0x95, 0x51, 0x9c, 0xa7, 0x2a, 0xc9, 0x51, 0x47, 0x74,
-	0x13, 0xeb, 0x22, 0xcf, 0x4c, 0x54, 0xbd, 0xf4, 0x4f, 0xd4, 0xe6, 0x2b, 0x26, 0x86, 0x2c, 0xe2,
-	0x83, 0x8e, 0x2f, 0x3b, 0xdc, 0x7f, 0x78, 0xa5, 0xae, 0x54, 0x3b, 0x3e, 0x6f, 0x26, 0xfb, 0x37,
-	0xbc, 0x75, 0xe1, 0x64, 0x6d, 0x94, 0xc6, 0x75, 0x67, 0xce, 0x23, 0x98, 0x95, 0x2a, 0x45, 0x12,
-	0x6c, 0x31, 0x59, 0x1e, 0xaf, 0x44, 0xf4, 0x6f, 0x4c, 0x74, 0xae, 0x52, 0x94, 0x16, 0xe3, 0x6f,
-	0xe0, 0x88, 0x50, 0x57, 0x59, 0x82, 0x24, 0xdc, 0x56, 0xf2, 0x68, 0x4c, 0xb2, 0xb6, 0x8c, 0x1c,
-	0xe0, 0x46, 0x58, 0xa2, 0xb9, 0x51, 0x3a, 0x27, 0x31, 0x39, 0x2c, 0x3c, 0xb7, 0x8c, 0x1c, 0xe0,
-	0xa6, 0xa1, 0x89, 0x29, 0x27, 0x31, 0x3d, 0xdc, 0xf0, 0x73, 0x4c, 0xb9, 0xb4, 0x58, 0x13, 0x94,
-	0x7c, 0xfb, 0x4e, 0x06, 0x35, 0x89, 0xd9, 0xe1, 0xa0, 0x0f, 0x96, 0x91, 0x03, 0xcc, 0x5f, 0x83,
-	0x47, 0x98, 0x68, 0x34, 0x24, 0xe6, 0xad, 0xce, 0x1f, 0x7f, 0x59, 0x83, 0xc8, 0x1e, 0x0d, 0x11,
-	0xee, 0x75, 0x56, 0xc3, 0x4e, 0xdf, 0x82, 0x57, 0x60, 0xb1, 0x69, 0x0a, 0xd8, 0xad, 0x06, 0x63,
-	0x46, 0x32, 0xbe, 0x34, 0x9f, 0x5a, 0x4c, 0xf6, 0x38, 0x17, 0xe0, 0x69, 0x2c, 0x54, 0x85, 0x69,
-	0xbb, 0xdc, 0xa9, 0xec, 0x3f, 0xc3, 0x3f, 0x0c, 0x8e, 0x86, 0x80, 0xf7, 0xe0, 0x55, 0xa8, 0x29,
-	0x53, 0xa5, 0x60, 0x0b, 0xb6, 0x3c, 0x5d, 0x3d, 0x1b, 0x6d, 0xda, 0x1f, 0xd0, 0x85, 0x65, 0x65,
-	0x2f, 0xe2, 0x1f, 0x01, 0xba, 0xc4, 0xeb, 0x6c, 0x2b, 0xdc, 0x05, 0x5b, 0x1e, 0xaf, 0x9e, 0xfe,
-	0x67, 0x49, 0xbd, 0xd3, 0xd9, 0x74, 0xf7, 0xeb, 0xb1, 0x23, 0xf7, 0xc4, 0xfc, 0x1d, 0xcc, 0xa8,
-	0x39, 0x28, 0x31, 0x69, 0x5d, 0x9e, 0x8c, 0x16, 0xd9, 0xbf, 0xb8, 0xce, 0xc3, 0xaa, 0xc2, 0x07,
-	0xe0, 0x75, 0xed, 0xf8, 0x1c, 0xdc, 0x8b, 0x17, 0xf7, 0x9d, 0x33, 0xb1, 0xbb, 0x0b, 0x9c, 0x9f,
-	0x77, 0x81, 0xf3, 0xa3, 0x0e, 0xd8, 0xae, 0x0e, 0xd8, 0x6d, 0x1d, 0xb0, 0xdf, 0x75, 0xc0, 0xbe,
-	0xb8, 0x9b, 0x79, 0x7b, 0xc6, 0xaf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x5b, 0x6d, 0xab,
-	0x1d, 0x03, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0xf9, 0xe5, 0xe8, 0x85, 0x16, 0x38, 0x31, 0x9c, 0x82, 0x64, 0x42, 0x60, 0xc8,
+	0x64, 0x20, 0x20, 0xc1, 0x02, 0x52, 0x8b, 0x18, 0x18, 0xe8, 0x70, 0x41, 0x15, 0x1b, 0x72, 0xec,
+	0xd7, 0xd6, 0x38, 0xf1, 0x45, 0xf7, 0xae, 0xee, 0xca, 0xff, 0xc6, 0x92, 0x91, 0x91, 0x09, 0xd1,
+	0x2c, 0xfc, 0x1b, 0xe8, 0x7c, 0xb6, 0x55, 0x09, 0xbb, 0xdb, 0xb3, 0xf5, 0xf9, 0xbc, 0xf7, 0xb5,
+	0xdf, 0x1d, 0x1c, 0x52, 0x16, 0x6e, 0xe9, 0x42, 0x99, 0x60, 0xab, 0x95, 0x51, 0x9c, 0xc7, 0x2a,
+	0x4a, 0x51, 0x07, 0x74, 0x15, 0xea, 0x4d, 0x9a, 0x98, 0x20, 0x7f, 0x31, 0x39, 0x50, 0xab, 0x6f,
+	0x18, 0x19, 0x72, 0xc8, 0x04, 0x74, 0x78, 0x56, 0xe2, 0x93, 0x07, 0xe7, 0xea, 0x5c, 0x15, 0xe5,
+	0x33, 0x5b, 0xb9, 0xb7, 0xb3, 0x1f, 0x3d, 0x38, 0x58, 0x1a, 0xa5, 0x71, 0x59, 0x36, 0xe7, 0x01,
+	0x0c, 0x32, 0x15, 0x23, 0x09, 0x36, 0xed, 0xcd, 0xc7, 0x0b, 0x11, 0xfc, 0x3f, 0x26, 0x38, 0x51,
+	0x31, 0x4a, 0x87, 0xf1, 0xd7, 0x30, 0x22, 0xd4, 0x79, 0x12, 0x21, 0x89, 0x6e, 0xa1, 0x3c, 0x6c,
+	0x52, 0x96, 0x8e, 0x91, 0x35, 0x6c, 0xc5, 0x0c, 0xcd, 0x95, 0xd2, 0x29, 0x89, 0x5e, 0xbb, 0x78,
+	0xe2, 0x18, 0x59, 0xc3, 0x36, 0xa1, 0x09, 0x29, 0x25, 0xd1, 0x6f, 0x4f, 0xf8, 0x39, 0xa4, 0x54,
+	0x3a, 0xcc, 0x0e, 0x8a, 0xd6, 0x97, 0x64, 0x50, 0x93, 0x18, 0xb4, 0x0f, 0x7a, 0xef, 0x18, 0x59,
+	0xc3, 0xfc, 0x15, 0x78, 0x84, 0x91, 0x46, 0x43, 0x62, 0x58, 0x78, 0x93, 0xe6, 0x2f, 0xb3, 0x88,
+	0xac, 0x50, 0x1b, 0x4f, 0xab, 0x35, 0x92, 0xf0, 0xda, 0xe3, 0x49, 0xb5, 0x46, 0xe9, 0x30, 0x7e,
+	0x04, 0x77, 0xc2, 0xcb, 0x38, 0x31, 0x5f, 0x31, 0xc7, 0xcc, 0x90, 0x18, 0x15, 0x9a, 0xdf, 0xa4,
+	0x1d, 0x59, 0xee, 0x83, 0xc5, 0xe4, 0x38, 0xac, 0x6b, 0x9a, 0x21, 0xdc, 0x2d, 0xd3, 0xd7, 0x6b,
+	0x7c, 0x03, 0xde, 0x06, 0x37, 0x2b, 0xd4, 0xd5, 0x22, 0x1b, 0x1b, 0xca, 0xf0, 0xcc, 0x7c, 0x2a,
+	0x30, 0x59, 0xe1, 0x5c, 0x80, 0xa7, 0x71, 0xa3, 0x72, 0x8c, 0x8b, 0x7d, 0xf6, 0x65, 0xf5, 0x38,
+	0xfb, 0xcb, 0x60, 0x54, 0x0f, 0x78, 0x07, 0x5e, 0x8e, 0x9a, 0x12, 0x95, 0x09, 0x36, 0x65, 0xf3,
+	0xc3, 0xc5, 0xd3, 0xc6, 0x9f, 0x53, 0xe2, 0xc1, 0xa9, 0x63, 0x65, 0x25, 0xf1, 0x8f, 0x00, 0xe5,
+	0xc4, 0x8b, 0x64, 0x2b, 0xba, 0x53, 0x36, 0x1f, 0x2f, 0x9e, 0xdc, 0xb2, 0x97, 0xaa, 0xd3, 0x71,
+	0x7f, 0xf7, 0xfb, 0x51, 0x47, 0xde, 0x90, 0xf9, 0x5b, 0x18, 0x90, 0x3d, 0xc3, 0xa2, 0x57, 0x74,
+	0x79, 0xdc, 0x18, 0xe4, 0xe6, 0x21, 0x2f, 0x7b, 0x38, 0x6b, 0x76, 0x1f, 0xbc, 0x32, 0x1d, 0x1f,
+	0x42, 0xf7, 0xf4, 0xf9, 0xbd, 0xce, 0xb1, 0xd8, 0x5d, 0xfb, 0x9d, 0x5f, 0xd7, 0x7e, 0xe7, 0xfb,
+	0xde, 0x67, 0xbb, 0xbd, 0xcf, 0x7e, 0xee, 0x7d, 0xf6, 0x67, 0xef, 0xb3, 0x2f, 0xdd, 0xd5, 0xb0,
+	0xb8, 0x39, 0x2f, 0xff, 0x0d, 0x00, 0xe0, 0x82, 0x1a, 0x3c, 0x90, 0x03, 0x00, 0x00,
 }
@@ -71,7 +71,9 @@ var NodeSpec_Availability_value = map[string]int32{
 func (x NodeSpec_Availability) String() string {
 	return proto.EnumName(NodeSpec_Availability_name, int32(x))
 }
-func (NodeSpec_Availability) EnumDescriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{0, 1} }
+func (NodeSpec_Availability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorSpecs, []int{0, 1}
+}
 
 // ResolutionMode specifies the mode of resolution to use for
 // internal loadbalancing between tasks which are all within
@@ -106,7 +108,7 @@ func (x EndpointSpec_ResolutionMode) String() string {
 	return proto.EnumName(EndpointSpec_ResolutionMode_name, int32(x))
 }
 func (EndpointSpec_ResolutionMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorSpecs, []int{8, 0}
+	return fileDescriptorSpecs, []int{9, 0}
 }
 
 type NodeSpec struct {
@@ -290,6 +292,7 @@ type TaskSpec struct {
 	//	*TaskSpec_Attachment
 	//	*TaskSpec_Container
 	//	*TaskSpec_Plugin
+	//	*TaskSpec_Router
 	Runtime isTaskSpec_Runtime `protobuf_oneof:"runtime"`
 	// Resource requirements for the container.
 	Resources *ResourceRequirements `protobuf:"bytes,2,opt,name=resources" json:"resources,omitempty"`
@@ -330,10 +333,14 @@ type TaskSpec_Container struct {
 type TaskSpec_Plugin struct {
 	Plugin *PluginSpec `protobuf:"bytes,10,opt,name=plugin,oneof"`
 }
+type TaskSpec_Router struct {
+	Router *RouterSpec `protobuf:"bytes,11,opt,name=router,oneof"`
+}
 
 func (*TaskSpec_Attachment) isTaskSpec_Runtime() {}
 func (*TaskSpec_Container) isTaskSpec_Runtime()  {}
 func (*TaskSpec_Plugin) isTaskSpec_Runtime()     {}
+func (*TaskSpec_Router) isTaskSpec_Runtime()     {}
 
 func (m *TaskSpec) GetRuntime() isTaskSpec_Runtime {
 	if m != nil {
@@ -363,12 +370,20 @@ func (m *TaskSpec) GetPlugin() *PluginSpec {
 	return nil
 }
 
+func (m *TaskSpec) GetRouter() *RouterSpec {
+	if x, ok := m.GetRuntime().(*TaskSpec_Router); ok {
+		return x.Router
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*TaskSpec) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _TaskSpec_OneofMarshaler, _TaskSpec_OneofUnmarshaler, _TaskSpec_OneofSizer, []interface{}{
 		(*TaskSpec_Attachment)(nil),
 		(*TaskSpec_Container)(nil),
 		(*TaskSpec_Plugin)(nil),
+		(*TaskSpec_Router)(nil),
 	}
 }
 
@@ -391,6 +406,11 @@ func _TaskSpec_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Plugin); err != nil {
 			return err
 		}
+	case *TaskSpec_Router:
+		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Router); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("TaskSpec.Runtime has unexpected type %T", x)
@@ -425,6 +445,14 @@ func _TaskSpec_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Runtime = &TaskSpec_Plugin{msg}
 		return true, err
+	case 11: // runtime.router
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RouterSpec)
+		err := b.DecodeMessage(msg)
+		m.Runtime = &TaskSpec_Router{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -449,6 +477,11 @@ func _TaskSpec_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(10<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TaskSpec_Router:
+		s := proto.Size(x.Router)
+		n += proto.SizeVarint(11<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -602,6 +635,21 @@ func (m *PluginSpec) Reset()                    { *m = PluginSpec{} }
 func (*PluginSpec) ProtoMessage()               {}
 func (*PluginSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{7} }
 
+// RouterSpec specifies runtime parameters for a built-in HTTP router task,
+// which proxies requests to services according to the cluster routing table.
+// Router tasks run as containers attached to the task networks, and only
+// route to services on those networks.
+type RouterSpec struct {
+	// ListenPort is the host port the router accepts requests on.
+	ListenPort uint32 `protobuf:"varint,1,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	// Image is the router container image, which runs swarm-router.
+	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (m *RouterSpec) Reset()                    { *m = RouterSpec{} }
+func (*RouterSpec) ProtoMessage()               {}
+func (*RouterSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{8} }
+
 // EndpointSpec defines the properties that can be configured to
 // access and loadbalance the service.
 type EndpointSpec struct {
@@ -609,11 +657,14 @@ type EndpointSpec struct {
 	// List of exposed ports that this service is accessible from
 	// external to the cluster.
 	Ports []*PortConfig `protobuf:"bytes,2,rep,name=ports" json:"ports,omitempty"`
+	// Routes through which the service is reachable over HTTP using the
+	// built-in router.
+	Routes []*Route `protobuf:"bytes,3,rep,name=routes" json:"routes,omitempty"`
 }
 
 func (m *EndpointSpec) Reset()                    { *m = EndpointSpec{} }
 func (*EndpointSpec) ProtoMessage()               {}
-func (*EndpointSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{8} }
+func (*EndpointSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{9} }
 
 // NetworkSpec specifies user defined network parameters.
 type NetworkSpec struct {
@@ -638,7 +689,7 @@ type NetworkSpec struct {
 
 func (m *NetworkSpec) Reset()                    { *m = NetworkSpec{} }
 func (*NetworkSpec) ProtoMessage()               {}
-func (*NetworkSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{9} }
+func (*NetworkSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{10} }
 
 // ClusterSpec specifies global cluster settings.
 type ClusterSpec struct {
@@ -659,22 +710,50 @@ type ClusterSpec struct {
 	TaskDefaults TaskDefaults `protobuf:"bytes,7,opt,name=task_defaults,json=taskDefaults" json:"task_defaults"`
 	// EncryptionConfig defines the cluster's encryption settings.
 	EncryptionConfig EncryptionConfig `protobuf:"bytes,8,opt,name=encryption_config,json=encryptionConfig" json:"encryption_config"`
+	// PortAllocation defines the ranges published ports are allocated from.
+	PortAllocation PortAllocationConfig `protobuf:"bytes,9,opt,name=port_allocation,json=portAllocation" json:"port_allocation"`
+	// DefaultAddressPools defines the address pools networks are allocated
+	// subnets from.
+	DefaultAddressPools DefaultAddressPoolsConfig `protobuf:"bytes,10,opt,name=default_address_pools,json=defaultAddressPools" json:"default_address_pools"`
+	// ManagerScaling defines how the number of managers is maintained
+	// automatically.
+	ManagerScaling ManagerScalingConfig `protobuf:"bytes,11,opt,name=manager_scaling,json=managerScaling" json:"manager_scaling"`
 }
 
 func (m *ClusterSpec) Reset()                    { *m = ClusterSpec{} }
 func (*ClusterSpec) ProtoMessage()               {}
-func (*ClusterSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{10} }
+func (*ClusterSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{11} }
 
 // SecretSpec specifies a user-provided secret.
 type SecretSpec struct {
 	Annotations Annotations `protobuf:"bytes,1,opt,name=annotations" json:"annotations"`
 	// Data is the secret payload - the maximum size is 500KB (that is, 500*1024 bytes)
 	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Driver is the name of the secrets driver used to fetch the secret's
+	// value from an external secret store. If it is set, Data must be empty:
+	// the value is fetched by the dispatcher when the secret is sent to a
+	// node, and is never stored.
+	Driver *Driver `protobuf:"bytes,3,opt,name=driver" json:"driver,omitempty"`
 }
 
 func (m *SecretSpec) Reset()                    { *m = SecretSpec{} }
 func (*SecretSpec) ProtoMessage()               {}
-func (*SecretSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{11} }
+func (*SecretSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{12} }
+
+// RoleSpec specifies a set of permissions on the Control API and the users
+// they are granted to.
+type RoleSpec struct {
+	Annotations Annotations `protobuf:"bytes,1,opt,name=annotations" json:"annotations"`
+	// Rules lists the permissions granted by this role.
+	Rules []RoleRule `protobuf:"bytes,2,rep,name=rules" json:"rules"`
+	// Subjects binds the role to users. Each entry is matched against the
+	// common name of a client certificate issued with the swarm-user role.
+	Subjects []string `protobuf:"bytes,3,rep,name=subjects" json:"subjects,omitempty"`
+}
+
+func (m *RoleSpec) Reset()                    { *m = RoleSpec{} }
+func (*RoleSpec) ProtoMessage()               {}
+func (*RoleSpec) Descriptor() ([]byte, []int) { return fileDescriptorSpecs, []int{13} }
 
 func init() {
 	proto.RegisterType((*NodeSpec)(nil), "docker.swarmkit.v1.NodeSpec")
@@ -687,10 +766,12 @@ func init() {
 	proto.RegisterType((*ContainerSpec_PullOptions)(nil), "docker.swarmkit.v1.ContainerSpec.PullOptions")
 	proto.RegisterType((*ContainerSpec_DNSConfig)(nil), "docker.swarmkit.v1.ContainerSpec.DNSConfig")
 	proto.RegisterType((*PluginSpec)(nil), "docker.swarmkit.v1.PluginSpec")
+	proto.RegisterType((*RouterSpec)(nil), "docker.swarmkit.v1.RouterSpec")
 	proto.RegisterType((*EndpointSpec)(nil), "docker.swarmkit.v1.EndpointSpec")
 	proto.RegisterType((*NetworkSpec)(nil), "docker.swarmkit.v1.NetworkSpec")
 	proto.RegisterType((*ClusterSpec)(nil), "docker.swarmkit.v1.ClusterSpec")
 	proto.RegisterType((*SecretSpec)(nil), "docker.swarmkit.v1.SecretSpec")
+	proto.RegisterType((*RoleSpec)(nil), "docker.swarmkit.v1.RoleSpec")
 	proto.RegisterEnum("docker.swarmkit.v1.NodeSpec_Membership", NodeSpec_Membership_name, NodeSpec_Membership_value)
 	proto.RegisterEnum("docker.swarmkit.v1.NodeSpec_Availability", NodeSpec_Availability_name, NodeSpec_Availability_value)
 	proto.RegisterEnum("docker.swarmkit.v1.EndpointSpec_ResolutionMode", EndpointSpec_ResolutionMode_name, EndpointSpec_ResolutionMode_value)
@@ -848,6 +929,12 @@ func (m *TaskSpec) CopyFrom(src interface{}) {
 			}
 			github_com_docker_swarmkit_api_deepcopy.Copy(v.Plugin, o.GetPlugin())
 			m.Runtime = &v
+		case *TaskSpec_Router:
+			v := TaskSpec_Router{
+				Router: &RouterSpec{},
+			}
+			github_com_docker_swarmkit_api_deepcopy.Copy(v.Router, o.GetRouter())
+			m.Runtime = &v
 		}
 	}
 
@@ -1006,6 +1093,21 @@ func (m *PluginSpec) CopyFrom(src interface{}) {
 	*m = *o
 }
 
+func (m *RouterSpec) Copy() *RouterSpec {
+	if m == nil {
+		return nil
+	}
+	o := &RouterSpec{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *RouterSpec) CopyFrom(src interface{}) {
+
+	o := src.(*RouterSpec)
+	*m = *o
+}
+
 func (m *EndpointSpec) Copy() *EndpointSpec {
 	if m == nil {
 		return nil
@@ -1027,6 +1129,14 @@ func (m *EndpointSpec) CopyFrom(src interface{}) {
 		}
 	}
 
+	if o.Routes != nil {
+		m.Routes = make([]*Route, len(o.Routes))
+		for i := range m.Routes {
+			m.Routes[i] = &Route{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.Routes[i], o.Routes[i])
+		}
+	}
+
 }
 
 func (m *NetworkSpec) Copy() *NetworkSpec {
@@ -1074,6 +1184,9 @@ func (m *ClusterSpec) CopyFrom(src interface{}) {
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.CAConfig, &o.CAConfig)
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.TaskDefaults, &o.TaskDefaults)
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.EncryptionConfig, &o.EncryptionConfig)
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.PortAllocation, &o.PortAllocation)
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.DefaultAddressPools, &o.DefaultAddressPools)
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.ManagerScaling, &o.ManagerScaling)
 }
 
 func (m *SecretSpec) Copy() *SecretSpec {
@@ -1090,6 +1203,38 @@ func (m *SecretSpec) CopyFrom(src interface{}) {
 	o := src.(*SecretSpec)
 	*m = *o
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Annotations, &o.Annotations)
+	if o.Driver != nil {
+		m.Driver = &Driver{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.Driver, o.Driver)
+	}
+}
+
+func (m *RoleSpec) Copy() *RoleSpec {
+	if m == nil {
+		return nil
+	}
+	o := &RoleSpec{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *RoleSpec) CopyFrom(src interface{}) {
+
+	o := src.(*RoleSpec)
+	*m = *o
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Annotations, &o.Annotations)
+	if o.Rules != nil {
+		m.Rules = make([]RoleRule, len(o.Rules))
+		for i := range m.Rules {
+			github_com_docker_swarmkit_api_deepcopy.Copy(&m.Rules[i], &o.Rules[i])
+		}
+	}
+
+	if o.Subjects != nil {
+		m.Subjects = make([]string, len(o.Subjects))
+		copy(m.Subjects, o.Subjects)
+	}
+
 }
 
 func (m *NodeSpec) Marshal() (dAtA []byte, err error) {
@@ -1409,6 +1554,20 @@ func (m *TaskSpec_Plugin) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *TaskSpec_Router) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Router != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintSpecs(dAtA, i, uint64(m.Router.Size()))
+		n18, err := m.Router.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
 func (m *NetworkAttachmentSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1544,21 +1703,21 @@ func (m *ContainerSpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(m.StopGracePeriod.Size()))
-		n18, err := m.StopGracePeriod.MarshalTo(dAtA[i:])
+		n19, err := m.StopGracePeriod.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	if m.PullOptions != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(m.PullOptions.Size()))
-		n19, err := m.PullOptions.MarshalTo(dAtA[i:])
+		n20, err := m.PullOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	if len(m.Groups) > 0 {
 		for _, s := range m.Groups {
@@ -1607,11 +1766,11 @@ func (m *ContainerSpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(m.DNSConfig.Size()))
-		n20, err := m.DNSConfig.MarshalTo(dAtA[i:])
+		n21, err := m.DNSConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n21
 	}
 	if m.Healthcheck != nil {
 		dAtA[i] = 0x82
@@ -1619,11 +1778,11 @@ func (m *ContainerSpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(m.Healthcheck.Size()))
-		n21, err := m.Healthcheck.MarshalTo(dAtA[i:])
+		n22, err := m.Healthcheck.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if len(m.Hosts) > 0 {
 		for _, s := range m.Hosts {
@@ -1790,6 +1949,35 @@ func (m *PluginSpec) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RouterSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouterSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ListenPort != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintSpecs(dAtA, i, uint64(m.ListenPort))
+	}
+	if len(m.Image) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSpecs(dAtA, i, uint64(len(m.Image)))
+		i += copy(dAtA[i:], m.Image)
+	}
+	return i, nil
+}
+
 func (m *EndpointSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1822,6 +2010,18 @@ func (m *EndpointSpec) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Routes) > 0 {
+		for _, msg := range m.Routes {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintSpecs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1843,20 +2043,20 @@ func (m *NetworkSpec) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.Annotations.Size()))
-	n22, err := m.Annotations.MarshalTo(dAtA[i:])
+	n23, err := m.Annotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n23
 	if m.DriverConfig != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(m.DriverConfig.Size()))
-		n23, err := m.DriverConfig.MarshalTo(dAtA[i:])
+		n24, err := m.DriverConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if m.Ipv6Enabled {
 		dAtA[i] = 0x18
@@ -1882,11 +2082,11 @@ func (m *NetworkSpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(m.IPAM.Size()))
-		n24, err := m.IPAM.MarshalTo(dAtA[i:])
+		n25, err := m.IPAM.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	if m.Attachable {
 		dAtA[i] = 0x30
@@ -1919,67 +2119,91 @@ func (m *ClusterSpec) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.Annotations.Size()))
-	n25, err := m.Annotations.MarshalTo(dAtA[i:])
+	n26, err := m.Annotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n26
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.AcceptancePolicy.Size()))
-	n26, err := m.AcceptancePolicy.MarshalTo(dAtA[i:])
+	n27, err := m.AcceptancePolicy.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n27
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.Orchestration.Size()))
-	n27, err := m.Orchestration.MarshalTo(dAtA[i:])
+	n28, err := m.Orchestration.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n28
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.Raft.Size()))
-	n28, err := m.Raft.MarshalTo(dAtA[i:])
+	n29, err := m.Raft.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n29
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.Dispatcher.Size()))
-	n29, err := m.Dispatcher.MarshalTo(dAtA[i:])
+	n30, err := m.Dispatcher.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n30
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.CAConfig.Size()))
-	n30, err := m.CAConfig.MarshalTo(dAtA[i:])
+	n31, err := m.CAConfig.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n31
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.TaskDefaults.Size()))
-	n31, err := m.TaskDefaults.MarshalTo(dAtA[i:])
+	n32, err := m.TaskDefaults.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n32
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.EncryptionConfig.Size()))
-	n32, err := m.EncryptionConfig.MarshalTo(dAtA[i:])
+	n33, err := m.EncryptionConfig.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n33
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintSpecs(dAtA, i, uint64(m.PortAllocation.Size()))
+	n34, err := m.PortAllocation.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n34
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintSpecs(dAtA, i, uint64(m.DefaultAddressPools.Size()))
+	n35, err := m.DefaultAddressPools.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n35
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintSpecs(dAtA, i, uint64(m.ManagerScaling.Size()))
+	n36, err := m.ManagerScaling.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n36
 	return i, nil
 }
 
@@ -2001,17 +2225,80 @@ func (m *SecretSpec) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintSpecs(dAtA, i, uint64(m.Annotations.Size()))
-	n33, err := m.Annotations.MarshalTo(dAtA[i:])
+	n37, err := m.Annotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n37
 	if len(m.Data) > 0 {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintSpecs(dAtA, i, uint64(len(m.Data)))
 		i += copy(dAtA[i:], m.Data)
 	}
+	if m.Driver != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSpecs(dAtA, i, uint64(m.Driver.Size()))
+		n38, err := m.Driver.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+
+func (m *RoleSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintSpecs(dAtA, i, uint64(m.Annotations.Size()))
+	n39, err := m.Annotations.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n39
+	if len(m.Rules) > 0 {
+		for _, msg := range m.Rules {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintSpecs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Subjects) > 0 {
+		for _, s := range m.Subjects {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -2185,6 +2472,15 @@ func (m *TaskSpec_Plugin) Size() (n int) {
 	}
 	return n
 }
+func (m *TaskSpec_Router) Size() (n int) {
+	var l int
+	_ = l
+	if m.Router != nil {
+		l = m.Router.Size()
+		n += 1 + l + sovSpecs(uint64(l))
+	}
+	return n
+}
 func (m *NetworkAttachmentSpec) Size() (n int) {
 	var l int
 	_ = l
@@ -2340,6 +2636,19 @@ func (m *PluginSpec) Size() (n int) {
 	return n
 }
 
+func (m *RouterSpec) Size() (n int) {
+	var l int
+	_ = l
+	if m.ListenPort != 0 {
+		n += 1 + sovSpecs(uint64(m.ListenPort))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovSpecs(uint64(l))
+	}
+	return n
+}
+
 func (m *EndpointSpec) Size() (n int) {
 	var l int
 	_ = l
@@ -2352,6 +2661,12 @@ func (m *EndpointSpec) Size() (n int) {
 			n += 1 + l + sovSpecs(uint64(l))
 		}
 	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovSpecs(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2399,6 +2714,12 @@ func (m *ClusterSpec) Size() (n int) {
 	n += 1 + l + sovSpecs(uint64(l))
 	l = m.EncryptionConfig.Size()
 	n += 1 + l + sovSpecs(uint64(l))
+	l = m.PortAllocation.Size()
+	n += 1 + l + sovSpecs(uint64(l))
+	l = m.DefaultAddressPools.Size()
+	n += 1 + l + sovSpecs(uint64(l))
+	l = m.ManagerScaling.Size()
+	n += 1 + l + sovSpecs(uint64(l))
 	return n
 }
 
@@ -2411,6 +2732,30 @@ func (m *SecretSpec) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecs(uint64(l))
 	}
+	if m.Driver != nil {
+		l = m.Driver.Size()
+		n += 1 + l + sovSpecs(uint64(l))
+	}
+	return n
+}
+
+func (m *RoleSpec) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Annotations.Size()
+	n += 1 + l + sovSpecs(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovSpecs(uint64(l))
+		}
+	}
+	if len(m.Subjects) > 0 {
+		for _, s := range m.Subjects {
+			l = len(s)
+			n += 1 + l + sovSpecs(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2541,6 +2886,16 @@ func (this *TaskSpec_Plugin) String() string {
 	}, "")
 	return s
 }
+func (this *TaskSpec_Router) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskSpec_Router{`,
+		`Router:` + strings.Replace(fmt.Sprintf("%v", this.Router), "RouterSpec", "RouterSpec", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NetworkAttachmentSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -2622,6 +2977,17 @@ func (this *PluginSpec) String() string {
 	}, "")
 	return s
 }
+func (this *RouterSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RouterSpec{`,
+		`ListenPort:` + fmt.Sprintf("%v", this.ListenPort) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EndpointSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -2629,6 +2995,7 @@ func (this *EndpointSpec) String() string {
 	s := strings.Join([]string{`&EndpointSpec{`,
 		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
 		`Ports:` + strings.Replace(fmt.Sprintf("%v", this.Ports), "PortConfig", "PortConfig", 1) + `,`,
+		`Routes:` + strings.Replace(fmt.Sprintf("%v", this.Routes), "Route", "Route", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2661,6 +3028,9 @@ func (this *ClusterSpec) String() string {
 		`CAConfig:` + strings.Replace(strings.Replace(this.CAConfig.String(), "CAConfig", "CAConfig", 1), `&`, ``, 1) + `,`,
 		`TaskDefaults:` + strings.Replace(strings.Replace(this.TaskDefaults.String(), "TaskDefaults", "TaskDefaults", 1), `&`, ``, 1) + `,`,
 		`EncryptionConfig:` + strings.Replace(strings.Replace(this.EncryptionConfig.String(), "EncryptionConfig", "EncryptionConfig", 1), `&`, ``, 1) + `,`,
+		`PortAllocation:` + strings.Replace(strings.Replace(this.PortAllocation.String(), "PortAllocationConfig", "PortAllocationConfig", 1), `&`, ``, 1) + `,`,
+		`DefaultAddressPools:` + strings.Replace(strings.Replace(this.DefaultAddressPools.String(), "DefaultAddressPoolsConfig", "DefaultAddressPoolsConfig", 1), `&`, ``, 1) + `,`,
+		`ManagerScaling:` + strings.Replace(strings.Replace(this.ManagerScaling.String(), "ManagerScalingConfig", "ManagerScalingConfig", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2672,6 +3042,19 @@ func (this *SecretSpec) String() string {
 	s := strings.Join([]string{`&SecretSpec{`,
 		`Annotations:` + strings.Replace(strings.Replace(this.Annotations.String(), "Annotations", "Annotations", 1), `&`, ``, 1) + `,`,
 		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Driver:` + strings.Replace(fmt.Sprintf("%v", this.Driver), "Driver", "Driver", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoleSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoleSpec{`,
+		`Annotations:` + strings.Replace(strings.Replace(this.Annotations.String(), "Annotations", "Annotations", 1), `&`, ``, 1) + `,`,
+		`Rules:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Rules), "RoleRule", "RoleRule", 1), `&`, ``, 1) + `,`,
+		`Subjects:` + fmt.Sprintf("%v", this.Subjects) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3551,11 +3934,43 @@ func (m *TaskSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Runtime = &TaskSpec_Plugin{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSpecs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Router", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RouterSpec{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Runtime = &TaskSpec_Router{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthSpecs
@@ -4656,6 +5071,104 @@ func (m *PluginSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RouterSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouterSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouterSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListenPort", wireType)
+			}
+			m.ListenPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListenPort |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EndpointSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4735,6 +5248,37 @@ func (m *EndpointSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &Route{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecs(dAtA[iNdEx:])
@@ -5231,6 +5775,96 @@ func (m *ClusterSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PortAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAddressPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultAddressPools.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagerScaling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ManagerScaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecs(dAtA[iNdEx:])
@@ -5342,6 +5976,179 @@ func (m *SecretSpec) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Driver == nil {
+				m.Driver = &Driver{}
+			}
+			if err := m.Driver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Annotations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, RoleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecs(dAtA[iNdEx:])
@@ -5471,114 +6278,126 @@ var (
 func init() { proto.RegisterFile("specs.proto", fileDescriptorSpecs) }
 
 var fileDescriptorSpecs = []byte{
-	// 1730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x57, 0x41, 0x73, 0x1b, 0xb7,
-	0x15, 0x26, 0x25, 0x8a, 0x5a, 0xbe, 0xa5, 0x6c, 0x0a, 0x4d, 0xd2, 0x35, 0xdd, 0x90, 0x34, 0xe3,
-	0xa6, 0x4a, 0x33, 0xa5, 0xa6, 0x6a, 0x27, 0x75, 0xea, 0x66, 0x5a, 0x52, 0x64, 0x65, 0x55, 0x95,
-	0xcc, 0x01, 0x15, 0x77, 0x7c, 0xe2, 0x80, 0xbb, 0x10, 0xb9, 0xa3, 0xe5, 0x62, 0x0b, 0x60, 0x99,
-	0xe1, 0xad, 0xc7, 0x8c, 0xcf, 0xbd, 0x6a, 0x7a, 0xe8, 0x6f, 0xe8, 0x7f, 0xf0, 0xb1, 0xc7, 0x9e,
-	0x34, 0x0d, 0xff, 0x42, 0x7f, 0x40, 0x3b, 0xc0, 0x82, 0xe4, 0x32, 0x59, 0xc5, 0x9e, 0xa9, 0x6f,
-	0xc0, 0xdb, 0xef, 0x7b, 0x78, 0x78, 0xf8, 0xf0, 0xf0, 0x16, 0x6c, 0x11, 0x51, 0x57, 0xb4, 0x22,
-	0xce, 0x24, 0x43, 0xc8, 0x63, 0xee, 0x35, 0xe5, 0x2d, 0xf1, 0x15, 0xe1, 0xd3, 0x6b, 0x5f, 0xb6,
-	0x66, 0x3f, 0xaf, 0xda, 0x72, 0x1e, 0x51, 0x03, 0xa8, 0xbe, 0x37, 0x66, 0x63, 0xa6, 0x87, 0x87,
-	0x6a, 0x64, 0xac, 0xb5, 0x31, 0x63, 0xe3, 0x80, 0x1e, 0xea, 0xd9, 0x28, 0xbe, 0x3a, 0xf4, 0x62,
-	0x4e, 0xa4, 0xcf, 0xc2, 0xe4, 0x7b, 0xf3, 0xa6, 0x00, 0xd6, 0x05, 0xf3, 0xe8, 0x20, 0xa2, 0x2e,
-	0x3a, 0x01, 0x9b, 0x84, 0x21, 0x93, 0x1a, 0x20, 0x9c, 0x7c, 0x23, 0x7f, 0x60, 0x1f, 0xd5, 0x5b,
-	0xdf, 0x5d, 0xb9, 0xd5, 0x5e, 0xc3, 0x3a, 0x85, 0xd7, 0xb7, 0xf5, 0x1c, 0x4e, 0x33, 0xd1, 0x6f,
-	0xa1, 0xec, 0x51, 0xe1, 0x73, 0xea, 0x0d, 0x39, 0x0b, 0xa8, 0xb3, 0xd5, 0xc8, 0x1f, 0xdc, 0x3b,
-	0xfa, 0x51, 0x96, 0x27, 0xb5, 0x38, 0x66, 0x01, 0xc5, 0xb6, 0x61, 0xa8, 0x09, 0x3a, 0x01, 0x98,
-	0xd2, 0xe9, 0x88, 0x72, 0x31, 0xf1, 0x23, 0x67, 0x5b, 0xd3, 0x7f, 0x72, 0x17, 0x5d, 0xc5, 0xde,
-	0x3a, 0x5f, 0xc1, 0x71, 0x8a, 0x8a, 0xce, 0xa1, 0x4c, 0x66, 0xc4, 0x0f, 0xc8, 0xc8, 0x0f, 0x7c,
-	0x39, 0x77, 0x0a, 0xda, 0xd5, 0x27, 0xdf, 0xeb, 0xaa, 0x9d, 0x22, 0xe0, 0x0d, 0x7a, 0xd3, 0x03,
-	0x58, 0x2f, 0x84, 0x3e, 0x86, 0xdd, 0x7e, 0xef, 0xa2, 0x7b, 0x7a, 0x71, 0x52, 0xc9, 0x55, 0x1f,
-	0xbc, 0xba, 0x69, 0xbc, 0xaf, 0x7c, 0xac, 0x01, 0x7d, 0x1a, 0x7a, 0x7e, 0x38, 0x46, 0x07, 0x60,
-	0xb5, 0x8f, 0x8f, 0x7b, 0xfd, 0xcb, 0x5e, 0xb7, 0x92, 0xaf, 0x56, 0x5f, 0xdd, 0x34, 0x3e, 0xd8,
-	0x04, 0xb6, 0x5d, 0x97, 0x46, 0x92, 0x7a, 0xd5, 0xc2, 0xd7, 0x7f, 0xaf, 0xe5, 0x9a, 0x5f, 0xe7,
-	0xa1, 0x9c, 0x0e, 0x02, 0x7d, 0x0c, 0xc5, 0xf6, 0xf1, 0xe5, 0xe9, 0x8b, 0x5e, 0x25, 0xb7, 0xa6,
-	0xa7, 0x11, 0x6d, 0x57, 0xfa, 0x33, 0x8a, 0x1e, 0xc3, 0x4e, 0xbf, 0xfd, 0xe5, 0xa0, 0x57, 0xc9,
-	0xaf, 0xc3, 0x49, 0xc3, 0xfa, 0x24, 0x16, 0x1a, 0xd5, 0xc5, 0xed, 0xd3, 0x8b, 0xca, 0x56, 0x36,
-	0xaa, 0xcb, 0x89, 0x1f, 0x9a, 0x50, 0xfe, 0x56, 0x00, 0x7b, 0x40, 0xf9, 0xcc, 0x77, 0xdf, 0xb1,
-	0x44, 0x3e, 0x83, 0x82, 0x24, 0xe2, 0x5a, 0x4b, 0xc3, 0xce, 0x96, 0xc6, 0x25, 0x11, 0xd7, 0x6a,
-	0x51, 0x43, 0xd7, 0x78, 0xa5, 0x0c, 0x4e, 0xa3, 0xc0, 0x77, 0x89, 0xa4, 0x9e, 0x56, 0x86, 0x7d,
-	0xf4, 0xe3, 0x2c, 0x36, 0x5e, 0xa1, 0x4c, 0xfc, 0xcf, 0x72, 0x38, 0x45, 0x45, 0x4f, 0xa1, 0x38,
-	0x0e, 0xd8, 0x88, 0x04, 0x5a, 0x13, 0xf6, 0xd1, 0xa3, 0x2c, 0x27, 0x27, 0x1a, 0xb1, 0x76, 0x60,
-	0x28, 0xe8, 0x09, 0x14, 0xe3, 0xc8, 0x23, 0x92, 0x3a, 0x45, 0x4d, 0x6e, 0x64, 0x91, 0xbf, 0xd4,
-	0x88, 0x63, 0x16, 0x5e, 0xf9, 0x63, 0x6c, 0xf0, 0xe8, 0x0c, 0xac, 0x90, 0xca, 0xaf, 0x18, 0xbf,
-	0x16, 0xce, 0x6e, 0x63, 0xfb, 0xc0, 0x3e, 0xfa, 0x34, 0x53, 0x8c, 0x09, 0xa6, 0x2d, 0x25, 0x71,
-	0x27, 0x53, 0x1a, 0xca, 0xc4, 0x4d, 0x67, 0xcb, 0xc9, 0xe3, 0x95, 0x03, 0xf4, 0x1b, 0xb0, 0x68,
-	0xe8, 0x45, 0xcc, 0x0f, 0xa5, 0x63, 0xdd, 0x1d, 0x48, 0xcf, 0x60, 0x54, 0x32, 0xf1, 0x8a, 0xa1,
-	0xd8, 0x9c, 0x05, 0xc1, 0x88, 0xb8, 0xd7, 0x4e, 0xe9, 0x2d, 0xb7, 0xb1, 0x62, 0x74, 0x8a, 0x50,
-	0x98, 0x32, 0x8f, 0x36, 0x0f, 0x61, 0xff, 0x3b, 0xa9, 0x46, 0x55, 0xb0, 0x4c, 0xaa, 0x13, 0x8d,
-	0x14, 0xf0, 0x6a, 0xde, 0xbc, 0x0f, 0x7b, 0x1b, 0x69, 0x6d, 0xbe, 0x2e, 0x80, 0xb5, 0x3c, 0x6b,
-	0xd4, 0x86, 0x92, 0xcb, 0x42, 0x49, 0xfc, 0x90, 0x72, 0x23, 0xaf, 0xcc, 0x93, 0x39, 0x5e, 0x82,
-	0x14, 0xeb, 0x59, 0x0e, 0xaf, 0x59, 0xe8, 0xf7, 0x50, 0xe2, 0x54, 0xb0, 0x98, 0xbb, 0x54, 0x18,
-	0x7d, 0x1d, 0x64, 0x2b, 0x24, 0x01, 0x61, 0xfa, 0xe7, 0xd8, 0xe7, 0x54, 0x65, 0x59, 0xe0, 0x35,
-	0x15, 0x3d, 0x85, 0x5d, 0x4e, 0x85, 0x24, 0x5c, 0x7e, 0x9f, 0x44, 0x70, 0x02, 0xe9, 0xb3, 0xc0,
-	0x77, 0xe7, 0x78, 0xc9, 0x40, 0x4f, 0xa1, 0x14, 0x05, 0xc4, 0xd5, 0x5e, 0x9d, 0x1d, 0x4d, 0xff,
-	0x30, 0x8b, 0xde, 0x5f, 0x82, 0xf0, 0x1a, 0x8f, 0x3e, 0x07, 0x08, 0xd8, 0x78, 0xe8, 0x71, 0x7f,
-	0x46, 0xb9, 0x91, 0x58, 0x35, 0x8b, 0xdd, 0xd5, 0x08, 0x5c, 0x0a, 0xd8, 0x38, 0x19, 0xa2, 0x93,
-	0xff, 0x4b, 0x5f, 0x29, 0x6d, 0x9d, 0x01, 0x90, 0xd5, 0x57, 0xa3, 0xae, 0x4f, 0xde, 0xca, 0x95,
-	0x39, 0x91, 0x14, 0x1d, 0x3d, 0x82, 0xf2, 0x15, 0xe3, 0x2e, 0x1d, 0x9a, 0x5b, 0x53, 0xd2, 0x9a,
-	0xb0, 0xb5, 0x2d, 0xd1, 0x97, 0xba, 0x52, 0x51, 0x10, 0x8f, 0xfd, 0xd0, 0x01, 0xbd, 0x56, 0x2d,
-	0x3b, 0x5b, 0x0a, 0x61, 0x16, 0x30, 0xf8, 0x4e, 0x09, 0x76, 0x79, 0x1c, 0x4a, 0x7f, 0x4a, 0x9b,
-	0x67, 0xf0, 0x7e, 0x66, 0x38, 0xe8, 0x08, 0xca, 0x2b, 0x81, 0x0c, 0x7d, 0x4f, 0x2b, 0xab, 0xd4,
-	0xb9, 0xbf, 0xb8, 0xad, 0xdb, 0x2b, 0x25, 0x9d, 0x76, 0xb1, 0xbd, 0x02, 0x9d, 0x7a, 0xcd, 0xbf,
-	0x5a, 0xb0, 0xb7, 0x21, 0x33, 0xf4, 0x1e, 0xec, 0xf8, 0x53, 0x32, 0xa6, 0x09, 0x1d, 0x27, 0x13,
-	0xd4, 0x83, 0x62, 0x40, 0x46, 0x34, 0x50, 0x62, 0x53, 0x09, 0xff, 0xd9, 0x1b, 0xf5, 0xda, 0xfa,
-	0xa3, 0xc6, 0xf7, 0x42, 0xc9, 0xe7, 0xd8, 0x90, 0x91, 0x03, 0xbb, 0x2e, 0x9b, 0x4e, 0x49, 0xa8,
-	0xca, 0xda, 0xf6, 0x41, 0x09, 0x2f, 0xa7, 0x08, 0x41, 0x81, 0xf0, 0xb1, 0x70, 0x0a, 0xda, 0xac,
-	0xc7, 0xa8, 0x02, 0xdb, 0x34, 0x9c, 0x39, 0x3b, 0xda, 0xa4, 0x86, 0xca, 0xe2, 0xf9, 0x89, 0x5a,
-	0x4a, 0x58, 0x0d, 0x15, 0x2f, 0x16, 0x94, 0x3b, 0xbb, 0xda, 0xa4, 0xc7, 0xe8, 0x57, 0x50, 0x9c,
-	0xb2, 0x38, 0x94, 0xc2, 0xb1, 0x74, 0xb0, 0x0f, 0xb2, 0x82, 0x3d, 0x57, 0x08, 0x53, 0x76, 0x0d,
-	0x1c, 0xf5, 0x60, 0x5f, 0x48, 0x16, 0x0d, 0xc7, 0x9c, 0xb8, 0x74, 0x18, 0x51, 0xee, 0x33, 0xcf,
-	0x94, 0x8d, 0x07, 0xad, 0xa4, 0xcb, 0x68, 0x2d, 0xbb, 0x8c, 0x56, 0xd7, 0x74, 0x19, 0xf8, 0xbe,
-	0xe2, 0x9c, 0x28, 0x4a, 0x5f, 0x33, 0x50, 0x1f, 0xca, 0x51, 0x1c, 0x04, 0x43, 0x16, 0x25, 0x2f,
-	0x48, 0x72, 0xd8, 0x6f, 0x91, 0xb2, 0x7e, 0x1c, 0x04, 0xcf, 0x13, 0x12, 0xb6, 0xa3, 0xf5, 0x04,
-	0x7d, 0x00, 0xc5, 0x31, 0x67, 0x71, 0x24, 0x1c, 0x5b, 0x27, 0xc3, 0xcc, 0xd0, 0x17, 0xb0, 0x2b,
-	0xa8, 0xcb, 0xa9, 0x14, 0x4e, 0x59, 0x6f, 0xf5, 0xa3, 0xac, 0x45, 0x06, 0x1a, 0x82, 0xe9, 0x15,
-	0xe5, 0x34, 0x74, 0x29, 0x5e, 0x72, 0xd0, 0x03, 0xd8, 0x96, 0x72, 0xee, 0xec, 0x35, 0xf2, 0x07,
-	0x56, 0x67, 0x77, 0x71, 0x5b, 0xdf, 0xbe, 0xbc, 0x7c, 0x89, 0x95, 0x4d, 0x55, 0xb7, 0x09, 0x13,
-	0x32, 0x24, 0x53, 0xea, 0xdc, 0xd3, 0xb9, 0x5d, 0xcd, 0xd1, 0x4b, 0x00, 0x2f, 0x14, 0x43, 0x57,
-	0x5f, 0x27, 0xe7, 0xbe, 0xde, 0xdd, 0xa7, 0x6f, 0xde, 0x5d, 0xf7, 0x62, 0x60, 0x2a, 0xfc, 0xde,
-	0xe2, 0xb6, 0x5e, 0x5a, 0x4d, 0x71, 0xc9, 0x0b, 0x45, 0x32, 0x44, 0x1d, 0xb0, 0x27, 0x94, 0x04,
-	0x72, 0xe2, 0x4e, 0xa8, 0x7b, 0xed, 0x54, 0xee, 0x2e, 0xd9, 0xcf, 0x34, 0xcc, 0x78, 0x48, 0x93,
-	0x94, 0x82, 0x55, 0xa8, 0xc2, 0xd9, 0xd7, 0xb9, 0x4a, 0x26, 0xe8, 0x43, 0x00, 0x16, 0xd1, 0x70,
-	0x28, 0xa4, 0xe7, 0x87, 0x0e, 0x52, 0x5b, 0xc6, 0x25, 0x65, 0x19, 0x28, 0x03, 0x7a, 0xa8, 0x0a,
-	0x2a, 0xf1, 0x86, 0x2c, 0x0c, 0xe6, 0xce, 0x0f, 0xf4, 0x57, 0x4b, 0x19, 0x9e, 0x87, 0xc1, 0x1c,
-	0xd5, 0xc1, 0xd6, 0xba, 0x10, 0xfe, 0x38, 0x24, 0x81, 0xf3, 0x9e, 0xce, 0x07, 0x28, 0xd3, 0x40,
-	0x5b, 0xaa, 0x9f, 0x83, 0x9d, 0x92, 0xbb, 0x92, 0xe9, 0x35, 0x9d, 0x9b, 0x1b, 0xa4, 0x86, 0x2a,
-	0xa6, 0x19, 0x09, 0xe2, 0xa4, 0x4d, 0x2c, 0xe1, 0x64, 0xf2, 0xeb, 0xad, 0x27, 0xf9, 0xea, 0x11,
-	0xd8, 0xa9, 0x63, 0x47, 0x1f, 0xc1, 0x1e, 0xa7, 0x63, 0x5f, 0x48, 0x3e, 0x1f, 0x92, 0x58, 0x4e,
-	0x9c, 0xdf, 0x69, 0x42, 0x79, 0x69, 0x6c, 0xc7, 0x72, 0x52, 0x1d, 0xc2, 0x3a, 0x7b, 0xa8, 0x01,
-	0xb6, 0x3a, 0x15, 0x41, 0xf9, 0x8c, 0x72, 0xf5, 0x14, 0xa9, 0x4d, 0xa7, 0x4d, 0x4a, 0x3d, 0x82,
-	0x12, 0xee, 0x4e, 0xf4, 0xe5, 0x2d, 0x61, 0x33, 0x53, 0xb7, 0x71, 0x29, 0x51, 0x73, 0x1b, 0xcd,
-	0xb4, 0xd9, 0x04, 0x58, 0x97, 0xa1, 0xec, 0x92, 0xd0, 0xfc, 0x4f, 0x1e, 0xca, 0xe9, 0x57, 0x17,
-	0x1d, 0x27, 0xaf, 0xa5, 0x46, 0xdd, 0x3b, 0x3a, 0x7c, 0xd3, 0x2b, 0xad, 0xdf, 0xa6, 0x20, 0x56,
-	0x0b, 0x9e, 0xab, 0x06, 0x59, 0x93, 0xd1, 0x2f, 0x61, 0x27, 0x62, 0x5c, 0x2e, 0xeb, 0x4c, 0x76,
-	0x85, 0x64, 0x7c, 0x59, 0xcb, 0x13, 0x70, 0x73, 0x02, 0xf7, 0x36, 0xbd, 0xa1, 0xc7, 0xb0, 0xfd,
-	0xe2, 0xb4, 0x5f, 0xc9, 0x55, 0x1f, 0xbe, 0xba, 0x69, 0xfc, 0x70, 0xf3, 0xe3, 0x0b, 0x9f, 0xcb,
-	0x98, 0x04, 0xa7, 0x7d, 0xf4, 0x53, 0xd8, 0xe9, 0x5e, 0x0c, 0x30, 0xae, 0xe4, 0xab, 0xf5, 0x57,
-	0x37, 0x8d, 0x87, 0x9b, 0x38, 0xf5, 0x89, 0xc5, 0xa1, 0x87, 0xd9, 0x68, 0xd5, 0x2c, 0xfe, 0x63,
-	0x0b, 0x6c, 0x53, 0x7e, 0xdf, 0xf5, 0xff, 0xc4, 0x5e, 0xf2, 0x16, 0x2e, 0xef, 0xd5, 0xd6, 0x1b,
-	0x9f, 0xc4, 0x72, 0x42, 0x30, 0x3a, 0x78, 0x04, 0x65, 0x3f, 0x9a, 0x7d, 0x36, 0xa4, 0x21, 0x19,
-	0x05, 0xa6, 0x6f, 0xb4, 0xb0, 0xad, 0x6c, 0xbd, 0xc4, 0xa4, 0x2e, 0xb5, 0x1f, 0x4a, 0xca, 0x43,
-	0xd3, 0x11, 0x5a, 0x78, 0x35, 0x47, 0x5f, 0x40, 0xc1, 0x8f, 0xc8, 0xd4, 0xbc, 0xe3, 0x99, 0x3b,
-	0x38, 0xed, 0xb7, 0xcf, 0x8d, 0x4e, 0x3b, 0xd6, 0xe2, 0xb6, 0x5e, 0x50, 0x06, 0xac, 0x69, 0xa8,
-	0xb6, 0x7c, 0x4a, 0xd5, 0x4a, 0xba, 0x40, 0x5b, 0x38, 0x65, 0x69, 0xfe, 0xb7, 0x00, 0xf6, 0x71,
-	0x10, 0x0b, 0x69, 0x9e, 0x99, 0x77, 0x96, 0xb7, 0x97, 0xb0, 0x4f, 0xf4, 0xaf, 0x05, 0x09, 0x55,
-	0xcd, 0xd6, 0x2d, 0x8a, 0xc9, 0xdd, 0xe3, 0x4c, 0x77, 0x2b, 0x70, 0xd2, 0xce, 0x74, 0x8a, 0xca,
-	0xa7, 0x93, 0xc7, 0x15, 0xf2, 0xad, 0x2f, 0x68, 0x00, 0x7b, 0x8c, 0xbb, 0x13, 0x2a, 0x64, 0x52,
-	0xe9, 0x4d, 0x2b, 0x9e, 0xf9, 0x93, 0xf6, 0x3c, 0x0d, 0x34, 0x65, 0x2e, 0x89, 0x76, 0xd3, 0x07,
-	0x7a, 0x02, 0x05, 0x4e, 0xae, 0x96, 0xed, 0x56, 0xa6, 0xbe, 0x31, 0xb9, 0x92, 0x1b, 0x2e, 0x34,
-	0x03, 0xfd, 0x01, 0xc0, 0xf3, 0x45, 0x44, 0xa4, 0x3b, 0xa1, 0xdc, 0x9c, 0x53, 0xe6, 0x16, 0xbb,
-	0x2b, 0xd4, 0x86, 0x97, 0x14, 0x1b, 0x9d, 0x41, 0xc9, 0x25, 0x4b, 0xa5, 0x15, 0xef, 0xfe, 0x3f,
-	0x39, 0x6e, 0x1b, 0x17, 0x15, 0xe5, 0x62, 0x71, 0x5b, 0xb7, 0x96, 0x16, 0x6c, 0xb9, 0xc4, 0x28,
-	0xef, 0x0c, 0xf6, 0xd4, 0x7f, 0xcb, 0xd0, 0xa3, 0x57, 0x24, 0x0e, 0xa4, 0xd0, 0x8f, 0xf1, 0x1d,
-	0x65, 0x5b, 0x35, 0xc1, 0x5d, 0x83, 0x33, 0x71, 0x95, 0x65, 0xca, 0x86, 0xfe, 0x04, 0xfb, 0x34,
-	0x74, 0xf9, 0x5c, 0xeb, 0x6c, 0x19, 0xa1, 0x75, 0xf7, 0x66, 0x7b, 0x2b, 0xf0, 0xc6, 0x66, 0x2b,
-	0xf4, 0x5b, 0xf6, 0xa6, 0x0f, 0x90, 0x3c, 0x84, 0xef, 0x56, 0x7f, 0x08, 0x0a, 0x1e, 0x91, 0x44,
-	0x4b, 0xae, 0x8c, 0xf5, 0xb8, 0xe3, 0xbc, 0xfe, 0xa6, 0x96, 0xfb, 0xd7, 0x37, 0xb5, 0xdc, 0x5f,
-	0x16, 0xb5, 0xfc, 0xeb, 0x45, 0x2d, 0xff, 0xcf, 0x45, 0x2d, 0xff, 0xef, 0x45, 0x2d, 0x3f, 0x2a,
-	0xea, 0xf6, 0xe1, 0x17, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x91, 0xbf, 0x5e, 0xca, 0xf8, 0x10,
-	0x00, 0x00,
+	// 1925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x23, 0xb7,
+	0x15, 0xb7, 0x6c, 0xfd, 0x7d, 0x23, 0xdb, 0x32, 0x93, 0x4d, 0x67, 0xb5, 0x89, 0xac, 0x55, 0xb6,
+	0xa9, 0xd3, 0xa2, 0x32, 0xe2, 0x16, 0xe9, 0xa6, 0xdb, 0xa0, 0x95, 0x2c, 0xd5, 0xeb, 0xba, 0xf6,
+	0x0a, 0x94, 0xb3, 0xc1, 0x9e, 0x04, 0x6a, 0x86, 0x96, 0xa6, 0x1e, 0x0d, 0xa7, 0x24, 0xc7, 0x81,
+	0x6e, 0x45, 0x4f, 0xc1, 0x9e, 0x7b, 0x2a, 0x60, 0xf4, 0x50, 0xa0, 0x40, 0x3f, 0x40, 0xbf, 0xc3,
+	0x1e, 0x7b, 0xec, 0xc9, 0x68, 0xfc, 0x49, 0x0a, 0x72, 0x38, 0xfa, 0x93, 0x1d, 0xed, 0x2e, 0x50,
+	0xdf, 0xc8, 0xc7, 0xdf, 0xef, 0xf1, 0x91, 0xfa, 0xf1, 0xbd, 0x37, 0x02, 0x4b, 0x84, 0xd4, 0x11,
+	0xcd, 0x90, 0x33, 0xc9, 0x10, 0x72, 0x99, 0x73, 0x49, 0x79, 0x53, 0x7c, 0x43, 0xf8, 0xe4, 0xd2,
+	0x93, 0xcd, 0xab, 0xcf, 0xaa, 0x96, 0x9c, 0x86, 0xd4, 0x00, 0xaa, 0xef, 0x8f, 0xd8, 0x88, 0xe9,
+	0xe1, 0xbe, 0x1a, 0x19, 0x6b, 0x6d, 0xc4, 0xd8, 0xc8, 0xa7, 0xfb, 0x7a, 0x36, 0x8c, 0x2e, 0xf6,
+	0xdd, 0x88, 0x13, 0xe9, 0xb1, 0x20, 0x5e, 0x6f, 0x5c, 0x67, 0xa1, 0x78, 0xc6, 0x5c, 0xda, 0x0f,
+	0xa9, 0x83, 0x8e, 0xc0, 0x22, 0x41, 0xc0, 0xa4, 0x06, 0x08, 0x3b, 0x53, 0xcf, 0xec, 0x59, 0x07,
+	0xbb, 0xcd, 0xd7, 0x77, 0x6e, 0xb6, 0xe6, 0xb0, 0x76, 0xf6, 0xd5, 0xcd, 0xee, 0x1a, 0x5e, 0x64,
+	0xa2, 0x5f, 0x43, 0xd9, 0xa5, 0xc2, 0xe3, 0xd4, 0x1d, 0x70, 0xe6, 0x53, 0x7b, 0xbd, 0x9e, 0xd9,
+	0xdb, 0x3a, 0xf8, 0x30, 0xcd, 0x93, 0xda, 0x1c, 0x33, 0x9f, 0x62, 0xcb, 0x30, 0xd4, 0x04, 0x1d,
+	0x01, 0x4c, 0xe8, 0x64, 0x48, 0xb9, 0x18, 0x7b, 0xa1, 0xbd, 0xa1, 0xe9, 0x3f, 0x5a, 0x45, 0x57,
+	0xb1, 0x37, 0x4f, 0x67, 0x70, 0xbc, 0x40, 0x45, 0xa7, 0x50, 0x26, 0x57, 0xc4, 0xf3, 0xc9, 0xd0,
+	0xf3, 0x3d, 0x39, 0xb5, 0xb3, 0xda, 0xd5, 0xa7, 0x6f, 0x74, 0xd5, 0x5a, 0x20, 0xe0, 0x25, 0x7a,
+	0xc3, 0x05, 0x98, 0x6f, 0x84, 0x3e, 0x81, 0x42, 0xaf, 0x7b, 0xd6, 0x39, 0x3e, 0x3b, 0xaa, 0xac,
+	0x55, 0xef, 0xbf, 0xbc, 0xae, 0xdf, 0x53, 0x3e, 0xe6, 0x80, 0x1e, 0x0d, 0x5c, 0x2f, 0x18, 0xa1,
+	0x3d, 0x28, 0xb6, 0x0e, 0x0f, 0xbb, 0xbd, 0xf3, 0x6e, 0xa7, 0x92, 0xa9, 0x56, 0x5f, 0x5e, 0xd7,
+	0x3f, 0x58, 0x06, 0xb6, 0x1c, 0x87, 0x86, 0x92, 0xba, 0xd5, 0xec, 0xb7, 0x7f, 0xaf, 0xad, 0x35,
+	0xbe, 0xcd, 0x40, 0x79, 0x31, 0x08, 0xf4, 0x09, 0xe4, 0x5b, 0x87, 0xe7, 0xc7, 0xcf, 0xbb, 0x95,
+	0xb5, 0x39, 0x7d, 0x11, 0xd1, 0x72, 0xa4, 0x77, 0x45, 0xd1, 0x23, 0xc8, 0xf5, 0x5a, 0x5f, 0xf5,
+	0xbb, 0x95, 0xcc, 0x3c, 0x9c, 0x45, 0x58, 0x8f, 0x44, 0x42, 0xa3, 0x3a, 0xb8, 0x75, 0x7c, 0x56,
+	0x59, 0x4f, 0x47, 0x75, 0x38, 0xf1, 0x02, 0x13, 0xca, 0xdf, 0xb2, 0x60, 0xf5, 0x29, 0xbf, 0xf2,
+	0x9c, 0x3b, 0x96, 0xc8, 0xe7, 0x90, 0x95, 0x44, 0x5c, 0x6a, 0x69, 0x58, 0xe9, 0xd2, 0x38, 0x27,
+	0xe2, 0x52, 0x6d, 0x6a, 0xe8, 0x1a, 0xaf, 0x94, 0xc1, 0x69, 0xe8, 0x7b, 0x0e, 0x91, 0xd4, 0xd5,
+	0xca, 0xb0, 0x0e, 0x7e, 0x98, 0xc6, 0xc6, 0x33, 0x94, 0x89, 0xff, 0xe9, 0x1a, 0x5e, 0xa0, 0xa2,
+	0x27, 0x90, 0x1f, 0xf9, 0x6c, 0x48, 0x7c, 0xad, 0x09, 0xeb, 0xe0, 0x61, 0x9a, 0x93, 0x23, 0x8d,
+	0x98, 0x3b, 0x30, 0x14, 0xf4, 0x18, 0xf2, 0x51, 0xe8, 0x12, 0x49, 0xed, 0xbc, 0x26, 0xd7, 0xd3,
+	0xc8, 0x5f, 0x69, 0xc4, 0x21, 0x0b, 0x2e, 0xbc, 0x11, 0x36, 0x78, 0x74, 0x02, 0xc5, 0x80, 0xca,
+	0x6f, 0x18, 0xbf, 0x14, 0x76, 0xa1, 0xbe, 0xb1, 0x67, 0x1d, 0xfc, 0x24, 0x55, 0x8c, 0x31, 0xa6,
+	0x25, 0x25, 0x71, 0xc6, 0x13, 0x1a, 0xc8, 0xd8, 0x4d, 0x7b, 0xdd, 0xce, 0xe0, 0x99, 0x03, 0xf4,
+	0x2b, 0x28, 0xd2, 0xc0, 0x0d, 0x99, 0x17, 0x48, 0xbb, 0xb8, 0x3a, 0x90, 0xae, 0xc1, 0xa8, 0xcb,
+	0xc4, 0x33, 0x86, 0x62, 0x73, 0xe6, 0xfb, 0x43, 0xe2, 0x5c, 0xda, 0xa5, 0x77, 0x3c, 0xc6, 0x8c,
+	0xd1, 0xce, 0x43, 0x76, 0xc2, 0x5c, 0xda, 0xd8, 0x87, 0x9d, 0xd7, 0xae, 0x1a, 0x55, 0xa1, 0x68,
+	0xae, 0x3a, 0xd6, 0x48, 0x16, 0xcf, 0xe6, 0x8d, 0x6d, 0xd8, 0x5c, 0xba, 0xd6, 0xc6, 0x9f, 0x73,
+	0x50, 0x4c, 0x7e, 0x6b, 0xd4, 0x82, 0x92, 0xc3, 0x02, 0x49, 0xbc, 0x80, 0x72, 0x3b, 0xb3, 0xfa,
+	0x97, 0x39, 0x4c, 0x40, 0x8a, 0xf5, 0x74, 0x0d, 0xcf, 0x59, 0xe8, 0xb7, 0x50, 0xe2, 0x54, 0xb0,
+	0x88, 0x3b, 0x54, 0x18, 0x7d, 0xed, 0xa5, 0x2b, 0x24, 0x06, 0x61, 0xfa, 0xc7, 0xc8, 0xe3, 0x54,
+	0xdd, 0xb2, 0xc0, 0x73, 0x2a, 0x7a, 0x02, 0x05, 0x4e, 0x85, 0x24, 0x5c, 0xbe, 0x49, 0x22, 0x38,
+	0x86, 0xf4, 0x98, 0xef, 0x39, 0x53, 0x9c, 0x30, 0xd0, 0x13, 0x28, 0x85, 0x3e, 0x71, 0xb4, 0x57,
+	0x3b, 0xa7, 0xe9, 0x1f, 0xa5, 0xd1, 0x7b, 0x09, 0x08, 0xcf, 0xf1, 0xe8, 0x0b, 0x00, 0x9f, 0x8d,
+	0x06, 0x2e, 0xf7, 0xae, 0x28, 0x37, 0x12, 0xab, 0xa6, 0xb1, 0x3b, 0x1a, 0x81, 0x4b, 0x3e, 0x1b,
+	0xc5, 0x43, 0x74, 0xf4, 0x7f, 0xe9, 0x6b, 0x41, 0x5b, 0x27, 0x00, 0x64, 0xb6, 0x6a, 0xd4, 0xf5,
+	0xe9, 0x3b, 0xb9, 0x32, 0xbf, 0xc8, 0x02, 0x1d, 0x3d, 0x84, 0xf2, 0x05, 0xe3, 0x0e, 0x1d, 0x98,
+	0x57, 0x53, 0xd2, 0x9a, 0xb0, 0xb4, 0x2d, 0xd6, 0x97, 0x7a, 0x52, 0xa1, 0x1f, 0x8d, 0xbc, 0xc0,
+	0x06, 0xbd, 0x57, 0x2d, 0xfd, 0xb6, 0x14, 0xc2, 0x6c, 0x60, 0xf0, 0x8a, 0xc9, 0x59, 0x24, 0x29,
+	0xb7, 0xad, 0xd5, 0x4c, 0xac, 0x11, 0x09, 0x33, 0xc6, 0xb7, 0x4b, 0x50, 0xe0, 0x51, 0x20, 0xbd,
+	0x09, 0x6d, 0x9c, 0xc0, 0xbd, 0xd4, 0x83, 0xa0, 0x03, 0x28, 0xcf, 0xa4, 0x35, 0xf0, 0x5c, 0xad,
+	0xc9, 0x52, 0x7b, 0xfb, 0xf6, 0x66, 0xd7, 0x9a, 0x69, 0xf0, 0xb8, 0x83, 0xad, 0x19, 0xe8, 0xd8,
+	0x6d, 0xfc, 0xa5, 0x08, 0x9b, 0x4b, 0x02, 0x45, 0xef, 0x43, 0xce, 0x9b, 0x90, 0x11, 0x8d, 0xe9,
+	0x38, 0x9e, 0xa0, 0x2e, 0xe4, 0x7d, 0x32, 0xa4, 0xbe, 0x92, 0xa9, 0xfa, 0xa9, 0x7e, 0xfa, 0x56,
+	0xa5, 0x37, 0x7f, 0xaf, 0xf1, 0xdd, 0x40, 0xf2, 0x29, 0x36, 0x64, 0x64, 0x43, 0xc1, 0x61, 0x93,
+	0x09, 0x09, 0x54, 0x42, 0xdc, 0xd8, 0x2b, 0xe1, 0x64, 0x8a, 0x10, 0x64, 0x09, 0x1f, 0x09, 0x3b,
+	0xab, 0xcd, 0x7a, 0x8c, 0x2a, 0xb0, 0x41, 0x83, 0x2b, 0x3b, 0xa7, 0x4d, 0x6a, 0xa8, 0x2c, 0xae,
+	0x17, 0xeb, 0xac, 0x84, 0xd5, 0x50, 0xf1, 0x22, 0x41, 0xb9, 0x5d, 0xd0, 0x26, 0x3d, 0x46, 0xbf,
+	0x80, 0xfc, 0x84, 0x45, 0x81, 0x14, 0x76, 0x51, 0x07, 0x7b, 0x3f, 0x2d, 0xd8, 0x53, 0x85, 0x30,
+	0x09, 0xdb, 0xc0, 0x51, 0x17, 0x76, 0x84, 0x64, 0xe1, 0x60, 0xc4, 0x89, 0x43, 0x07, 0x21, 0xe5,
+	0x1e, 0x73, 0x4d, 0xc2, 0xb9, 0xdf, 0x8c, 0xfb, 0x93, 0x66, 0xd2, 0x9f, 0x34, 0x3b, 0xa6, 0x3f,
+	0xc1, 0xdb, 0x8a, 0x73, 0xa4, 0x28, 0x3d, 0xcd, 0x40, 0x3d, 0x28, 0x87, 0x91, 0xef, 0x0f, 0x58,
+	0x18, 0xd7, 0x9e, 0x58, 0x26, 0xef, 0x70, 0x65, 0xbd, 0xc8, 0xf7, 0x9f, 0xc5, 0x24, 0x6c, 0x85,
+	0xf3, 0x09, 0xfa, 0x00, 0xf2, 0x23, 0xce, 0xa2, 0x50, 0xd8, 0x96, 0xbe, 0x0c, 0x33, 0x43, 0x5f,
+	0x42, 0x41, 0x50, 0x87, 0x53, 0x29, 0xec, 0xb2, 0x3e, 0xea, 0xc7, 0x69, 0x9b, 0xf4, 0x35, 0x04,
+	0xd3, 0x0b, 0xca, 0x69, 0xe0, 0x50, 0x9c, 0x70, 0xd0, 0x7d, 0xd8, 0x90, 0x72, 0x6a, 0x6f, 0xd6,
+	0x33, 0x7b, 0xc5, 0x76, 0xe1, 0xf6, 0x66, 0x77, 0xe3, 0xfc, 0xfc, 0x05, 0x56, 0x36, 0x95, 0x17,
+	0xc7, 0x4c, 0xc8, 0x80, 0x4c, 0xa8, 0xbd, 0xa5, 0xef, 0x76, 0x36, 0x47, 0x2f, 0x00, 0xdc, 0x40,
+	0x0c, 0x1c, 0xfd, 0x10, 0xed, 0xed, 0x7a, 0x66, 0xd5, 0xdb, 0x5d, 0x3e, 0x5d, 0xe7, 0xac, 0x6f,
+	0x6a, 0xc3, 0xe6, 0xed, 0xcd, 0x6e, 0x69, 0x36, 0xc5, 0x25, 0x37, 0x10, 0xf1, 0x10, 0xb5, 0xc1,
+	0x1a, 0x53, 0xe2, 0xcb, 0xb1, 0x33, 0xa6, 0xce, 0xa5, 0x5d, 0x59, 0x9d, 0xec, 0x9f, 0x6a, 0x98,
+	0xf1, 0xb0, 0x48, 0x52, 0x0a, 0x56, 0xa1, 0x0a, 0x7b, 0x47, 0xdf, 0x55, 0x3c, 0x41, 0x1f, 0x01,
+	0xb0, 0x90, 0x06, 0x03, 0x21, 0x5d, 0x2f, 0xb0, 0x91, 0x3a, 0x32, 0x2e, 0x29, 0x4b, 0x5f, 0x19,
+	0xd0, 0x03, 0x95, 0x8a, 0x89, 0x3b, 0x60, 0x81, 0x3f, 0xb5, 0xdf, 0xd3, 0xab, 0x45, 0x65, 0x78,
+	0x16, 0xf8, 0x53, 0xb4, 0x0b, 0x96, 0xd6, 0x85, 0xf0, 0x46, 0x01, 0xf1, 0xed, 0xf7, 0xf5, 0x7d,
+	0x80, 0x32, 0xf5, 0xb5, 0xa5, 0xfa, 0x05, 0x58, 0x0b, 0x72, 0x57, 0x32, 0xbd, 0xa4, 0x53, 0xf3,
+	0x82, 0xd4, 0x50, 0xc5, 0x74, 0x45, 0xfc, 0x28, 0x6e, 0x30, 0x4b, 0x38, 0x9e, 0xfc, 0x72, 0xfd,
+	0x71, 0xa6, 0x7a, 0x00, 0xd6, 0xc2, 0xcf, 0x8e, 0x3e, 0x86, 0x4d, 0x4e, 0x47, 0x9e, 0x90, 0x7c,
+	0x3a, 0x20, 0x91, 0x1c, 0xdb, 0xbf, 0xd1, 0x84, 0x72, 0x62, 0x6c, 0x45, 0x72, 0x5c, 0x1d, 0xc0,
+	0xfc, 0xf6, 0x50, 0x1d, 0x2c, 0xf5, 0xab, 0x08, 0xca, 0xaf, 0x28, 0x57, 0x45, 0x4c, 0x1d, 0x7a,
+	0xd1, 0xa4, 0xd4, 0x23, 0x28, 0xe1, 0xce, 0x58, 0x3f, 0xde, 0x12, 0x36, 0x33, 0xf5, 0x1a, 0x13,
+	0x89, 0x9a, 0xd7, 0x68, 0xa6, 0x8d, 0x06, 0xc0, 0x3c, 0x81, 0xa5, 0xa7, 0x84, 0xc6, 0x21, 0xc0,
+	0x3c, 0x55, 0xa9, 0x2b, 0xf2, 0x3d, 0x21, 0x69, 0x30, 0x08, 0x19, 0x97, 0x1a, 0xb9, 0x89, 0x21,
+	0x36, 0xf5, 0x18, 0x97, 0x73, 0x27, 0xeb, 0x8b, 0x4e, 0xfe, 0xb1, 0x0e, 0xe5, 0xc5, 0xa2, 0x8f,
+	0x0e, 0xe3, 0x62, 0xad, 0x1d, 0x6c, 0x1d, 0xec, 0xbf, 0xad, 0x49, 0xd0, 0xa5, 0xd1, 0x8f, 0x54,
+	0xd4, 0xa7, 0xaa, 0x3f, 0xd7, 0x64, 0xf4, 0x73, 0xc8, 0xa9, 0x28, 0x92, 0x64, 0x95, 0x9e, 0xa0,
+	0x19, 0x4f, 0x4a, 0x49, 0x0c, 0x46, 0x9f, 0x99, 0xec, 0x1c, 0xdf, 0xc6, 0x8a, 0xb4, 0xa1, 0x8f,
+	0x6c, 0xd2, 0xb2, 0x68, 0x8c, 0x61, 0x6b, 0x39, 0x00, 0xf4, 0x08, 0x36, 0x9e, 0x1f, 0xf7, 0x2a,
+	0x6b, 0xd5, 0x07, 0x2f, 0xaf, 0xeb, 0x3f, 0x58, 0x5e, 0x7c, 0xee, 0x71, 0x19, 0x11, 0xff, 0xb8,
+	0x87, 0x7e, 0x0c, 0xb9, 0xce, 0x59, 0x1f, 0xe3, 0x4a, 0xa6, 0xba, 0xfb, 0xf2, 0xba, 0xfe, 0x60,
+	0x19, 0xa7, 0x96, 0x58, 0x14, 0xb8, 0x98, 0x0d, 0x67, 0xed, 0xed, 0xbf, 0xd6, 0xc1, 0x32, 0x69,
+	0xff, 0xae, 0xbf, 0x80, 0x36, 0xe3, 0xea, 0x9d, 0xbc, 0xe7, 0xf5, 0xb7, 0x16, 0xf1, 0x72, 0x4c,
+	0x30, 0xfa, 0x7b, 0x08, 0x65, 0x2f, 0xbc, 0xfa, 0x7c, 0x40, 0x03, 0x32, 0xf4, 0x4d, 0xa7, 0x5b,
+	0xc4, 0x96, 0xb2, 0x75, 0x63, 0x93, 0x4a, 0x26, 0x5e, 0x20, 0x29, 0x0f, 0x4c, 0x0f, 0x5b, 0xc4,
+	0xb3, 0x39, 0xfa, 0x12, 0xb2, 0x5e, 0x48, 0x26, 0x76, 0x6e, 0xf5, 0x09, 0x8e, 0x7b, 0xad, 0x53,
+	0xf3, 0x3e, 0xda, 0xc5, 0xdb, 0x9b, 0xdd, 0xac, 0x32, 0x60, 0x4d, 0x43, 0xb5, 0xa4, 0xf8, 0xab,
+	0x9d, 0x74, 0x61, 0x28, 0xe2, 0x05, 0x4b, 0xe3, 0xaf, 0x05, 0xb0, 0x0e, 0xfd, 0x48, 0x24, 0x3a,
+	0xbd, 0xb3, 0x7b, 0x7b, 0x01, 0x3b, 0x44, 0x7f, 0x0c, 0x91, 0x40, 0xd5, 0x0a, 0xdd, 0x54, 0x99,
+	0xbb, 0x7b, 0x94, 0xea, 0x6e, 0x06, 0x8e, 0x1b, 0xb0, 0x76, 0x5e, 0xf9, 0xb4, 0x33, 0xb8, 0x42,
+	0xbe, 0xb7, 0x82, 0xfa, 0xb0, 0xc9, 0xb8, 0x33, 0xa6, 0x42, 0xc6, 0x15, 0xc6, 0x7c, 0x3c, 0xa4,
+	0x7e, 0x56, 0x3e, 0x5b, 0x04, 0x9a, 0xf4, 0x1a, 0x47, 0xbb, 0xec, 0x03, 0x3d, 0x86, 0x2c, 0x27,
+	0x17, 0x49, 0x83, 0x98, 0xde, 0x79, 0x90, 0x0b, 0xb9, 0xe4, 0x42, 0x33, 0xd0, 0xef, 0x00, 0x5c,
+	0x4f, 0x84, 0x44, 0x3a, 0x63, 0xca, 0xed, 0xdc, 0xea, 0x23, 0x76, 0x66, 0xa8, 0x25, 0x2f, 0x0b,
+	0x6c, 0x74, 0x02, 0x25, 0x87, 0x24, 0x4a, 0xcb, 0xaf, 0xfe, 0xa2, 0x3a, 0x6c, 0x19, 0x17, 0x15,
+	0xe5, 0xe2, 0xf6, 0x66, 0xb7, 0x98, 0x58, 0x70, 0xd1, 0x21, 0xf1, 0x08, 0x9d, 0xc0, 0xa6, 0xfa,
+	0xd2, 0x1a, 0xb8, 0xf4, 0x82, 0x44, 0xbe, 0x14, 0x76, 0x61, 0x75, 0xb9, 0x50, 0x6d, 0x7b, 0xc7,
+	0xe0, 0x4c, 0x5c, 0x65, 0xb9, 0x60, 0x43, 0x5f, 0xc3, 0x0e, 0x0d, 0x1c, 0x3e, 0xd5, 0x3a, 0x4b,
+	0x22, 0x2c, 0xae, 0x3e, 0x6c, 0x77, 0x06, 0x5e, 0x3a, 0x6c, 0x85, 0x7e, 0xcf, 0x8e, 0xbe, 0x86,
+	0x6d, 0x95, 0x5f, 0x06, 0xc4, 0xf7, 0x99, 0x13, 0xff, 0x9e, 0xa5, 0xd5, 0xad, 0xbe, 0x4a, 0x4b,
+	0xad, 0x19, 0x72, 0xc9, 0xf5, 0x56, 0xb8, 0xb4, 0x86, 0x46, 0x70, 0xcf, 0x9c, 0x7c, 0x40, 0x5c,
+	0x97, 0x53, 0x21, 0x06, 0x21, 0x63, 0xfe, 0x1b, 0xfb, 0x0d, 0x73, 0xdc, 0x56, 0x8c, 0xef, 0x29,
+	0xf8, 0xd2, 0x1e, 0xef, 0xb9, 0xaf, 0x03, 0xd4, 0x09, 0x26, 0x24, 0x20, 0x23, 0xca, 0x07, 0xc2,
+	0x21, 0xbe, 0x17, 0x8c, 0x6c, 0x6b, 0xf5, 0x09, 0x4e, 0x63, 0x68, 0x3f, 0x46, 0x2e, 0x9f, 0x60,
+	0xb2, 0xb4, 0xd6, 0xb8, 0xce, 0x00, 0xc4, 0xcd, 0xc9, 0xdd, 0xbe, 0x4d, 0x04, 0x59, 0x97, 0x48,
+	0xa2, 0x9f, 0x63, 0x19, 0xeb, 0x31, 0x3a, 0x80, 0xbc, 0xf9, 0x4a, 0xd9, 0x78, 0x6b, 0x82, 0x33,
+	0xc8, 0xc6, 0x3f, 0x33, 0x50, 0x54, 0xff, 0xf2, 0xdc, 0x6d, 0x74, 0x8f, 0x21, 0xc7, 0x23, 0x9f,
+	0x26, 0xd5, 0xe9, 0xc3, 0xf4, 0x32, 0xe3, 0x53, 0x1c, 0xf9, 0xd4, 0xf0, 0x63, 0x82, 0xca, 0xa3,
+	0x22, 0x1a, 0xfe, 0x81, 0x3a, 0x32, 0xa9, 0xd8, 0xb3, 0x79, 0xdb, 0x7e, 0xf5, 0x5d, 0x6d, 0xed,
+	0x3f, 0xdf, 0xd5, 0xd6, 0xfe, 0x74, 0x5b, 0xcb, 0xbc, 0xba, 0xad, 0x65, 0xfe, 0x7d, 0x5b, 0xcb,
+	0xfc, 0xf7, 0xb6, 0x96, 0x19, 0xe6, 0x75, 0xcb, 0xfa, 0xb3, 0xff, 0x0d, 0x00, 0x27, 0x1a, 0x0f,
+	0xd9, 0xa6, 0x13, 0x00, 0x00,
 }
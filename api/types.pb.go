@@ -38,8 +38,11 @@
 		PortStatus
 		TaskStatus
 		NetworkAttachmentConfig
+		SlotAddress
 		IPAMConfig
 		PortConfig
+		Route
+		RoutingTable
 		Driver
 		IPAMOptions
 		Peer
@@ -53,6 +56,10 @@
 		DispatcherConfig
 		RaftConfig
 		EncryptionConfig
+		PortRange
+		PortAllocationConfig
+		DefaultAddressPoolsConfig
+		ManagerScalingConfig
 		SpreadOver
 		PlacementPreference
 		Placement
@@ -65,6 +72,8 @@
 		BlacklistedCertificate
 		HealthConfig
 		MaybeEncryptedRecord
+		RoleRule
+		AuditCaller
 		NodeSpec
 		ServiceSpec
 		ReplicatedService
@@ -73,10 +82,12 @@
 		NetworkAttachmentSpec
 		ContainerSpec
 		PluginSpec
+		RouterSpec
 		EndpointSpec
 		NetworkSpec
 		ClusterSpec
 		SecretSpec
+		RoleSpec
 		Meta
 		Node
 		Service
@@ -86,6 +97,8 @@
 		Network
 		Cluster
 		Secret
+		Role
+		AuditEvent
 		GetNodeRequest
 		GetNodeResponse
 		ListNodesRequest
@@ -114,6 +127,8 @@
 		CreateNetworkResponse
 		GetNetworkRequest
 		GetNetworkResponse
+		UpdateNetworkRequest
+		UpdateNetworkResponse
 		RemoveNetworkRequest
 		RemoveNetworkResponse
 		ListNetworksRequest
@@ -135,6 +150,31 @@
 		CreateSecretResponse
 		RemoveSecretRequest
 		RemoveSecretResponse
+		GetRoleRequest
+		GetRoleResponse
+		UpdateRoleRequest
+		UpdateRoleResponse
+		ListRolesRequest
+		ListRolesResponse
+		CreateRoleRequest
+		CreateRoleResponse
+		RemoveRoleRequest
+		RemoveRoleResponse
+		IssueUserCertificateRequest
+		IssueUserCertificateResponse
+		ListAuditEventsRequest
+		ListAuditEventsResponse
+		ListPublishedPortsRequest
+		ListPublishedPortsResponse
+		GetClusterQuorumRequest
+		GetClusterQuorumResponse
+		InspectAllocatorRequest
+		InspectAllocatorResponse
+		TransactionPrecondition
+		TransactionOperation
+		TransactionResult
+		TransactionRequest
+		TransactionResponse
 		SessionRequest
 		SessionMessage
 		HeartbeatRequest
@@ -145,6 +185,7 @@
 		TasksMessage
 		AssignmentsRequest
 		Assignment
+		DiscoveryRecord
 		AssignmentChange
 		AssignmentsMessage
 		NodeCertificateStatusRequest
@@ -165,6 +206,8 @@
 		LeaveResponse
 		ProcessRaftMessageRequest
 		ProcessRaftMessageResponse
+		StreamRaftMessageRequest
+		StreamRaftMessageResponse
 		ResolveAddressRequest
 		ResolveAddressResponse
 		InternalRaftRequest
@@ -534,7 +577,7 @@ func (x IPAMConfig_AddressFamily) String() string {
 	return proto.EnumName(IPAMConfig_AddressFamily_name, int32(x))
 }
 func (IPAMConfig_AddressFamily) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{19, 0}
+	return fileDescriptorTypes, []int{20, 0}
 }
 
 type PortConfig_Protocol int32
@@ -542,21 +585,24 @@ type PortConfig_Protocol int32
 const (
 	ProtocolTCP PortConfig_Protocol = 0
 	ProtocolUDP PortConfig_Protocol = 1
+	ProtocolSCTP PortConfig_Protocol = 2
 )
 
 var PortConfig_Protocol_name = map[int32]string{
 	0: "TCP",
 	1: "UDP",
+	2: "SCTP",
 }
 var PortConfig_Protocol_value = map[string]int32{
 	"TCP": 0,
 	"UDP": 1,
+	"SCTP": 2,
 }
 
 func (x PortConfig_Protocol) String() string {
 	return proto.EnumName(PortConfig_Protocol_name, int32(x))
 }
-func (PortConfig_Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20, 0} }
+func (PortConfig_Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21, 0} }
 
 // PublishMode controls how ports are published on the swarm.
 type PortConfig_PublishMode int32
@@ -584,7 +630,7 @@ func (x PortConfig_PublishMode) String() string {
 	return proto.EnumName(PortConfig_PublishMode_name, int32(x))
 }
 func (PortConfig_PublishMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{20, 1}
+	return fileDescriptorTypes, []int{21, 1}
 }
 
 type IssuanceStatus_State int32
@@ -624,7 +670,9 @@ var IssuanceStatus_State_value = map[string]int32{
 func (x IssuanceStatus_State) String() string {
 	return proto.EnumName(IssuanceStatus_State_name, int32(x))
 }
-func (IssuanceStatus_State) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25, 0} }
+func (IssuanceStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{28, 0}
+}
 
 type ExternalCA_CAProtocol int32
 
@@ -643,7 +691,7 @@ func (x ExternalCA_CAProtocol) String() string {
 	return proto.EnumName(ExternalCA_CAProtocol_name, int32(x))
 }
 func (ExternalCA_CAProtocol) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{27, 0}
+	return fileDescriptorTypes, []int{30, 0}
 }
 
 // Encryption algorithm that can implemented using this key
@@ -664,7 +712,7 @@ func (x EncryptionKey_Algorithm) String() string {
 	return proto.EnumName(EncryptionKey_Algorithm_name, int32(x))
 }
 func (EncryptionKey_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{40, 0}
+	return fileDescriptorTypes, []int{47, 0}
 }
 
 type MaybeEncryptedRecord_Algorithm int32
@@ -687,7 +735,7 @@ func (x MaybeEncryptedRecord_Algorithm) String() string {
 	return proto.EnumName(MaybeEncryptedRecord_Algorithm_name, int32(x))
 }
 func (MaybeEncryptedRecord_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{45, 0}
+	return fileDescriptorTypes, []int{52, 0}
 }
 
 // Version tracks the last time an object in the store was updated.
@@ -1131,12 +1179,30 @@ type NetworkAttachmentConfig struct {
 	// preferred. If these addresses are not available then the
 	// attachment might fail.
 	Addresses []string `protobuf:"bytes,3,rep,name=addresses" json:"addresses,omitempty"`
+	// SlotAddresses specifies, by slot, the addresses the tasks of a
+	// replicated service are attached with. The addresses are reserved
+	// for the service, so a task replacing another one in the same slot
+	// gets the same addresses.
+	SlotAddresses map[uint64]*SlotAddress `protobuf:"bytes,4,rep,name=slot_addresses,json=slotAddresses" json:"slot_addresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *NetworkAttachmentConfig) Reset()                    { *m = NetworkAttachmentConfig{} }
 func (*NetworkAttachmentConfig) ProtoMessage()               {}
 func (*NetworkAttachmentConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{18} }
 
+// SlotAddress specifies the addresses of the tasks of a slot on a network.
+type SlotAddress struct {
+	// Addresses specifies a list of ipv4 and ipv6 addresses.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	// MacAddress specifies the MAC address of the interface. If empty,
+	// the network driver picks one.
+	MacAddress string `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+}
+
+func (m *SlotAddress) Reset()                    { *m = SlotAddress{} }
+func (*SlotAddress) ProtoMessage()               {}
+func (*SlotAddress) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
+
 // IPAMConfig specifies parameters for IP Address Management.
 type IPAMConfig struct {
 	Family IPAMConfig_AddressFamily `protobuf:"varint,1,opt,name=family,proto3,enum=docker.swarmkit.v1.IPAMConfig_AddressFamily" json:"family,omitempty"`
@@ -1156,7 +1222,7 @@ type IPAMConfig struct {
 
 func (m *IPAMConfig) Reset()                    { *m = IPAMConfig{} }
 func (*IPAMConfig) ProtoMessage()               {}
-func (*IPAMConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{19} }
+func (*IPAMConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
 
 // PortConfig specifies an exposed port which can be
 // addressed using the given name. This can be later queried
@@ -1182,7 +1248,59 @@ type PortConfig struct {
 
 func (m *PortConfig) Reset()                    { *m = PortConfig{} }
 func (*PortConfig) ProtoMessage()               {}
-func (*PortConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{20} }
+func (*PortConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
+
+// Route routes HTTP requests to the tasks of a service through the
+// built-in router. A request matches a route when its host is one of the
+// route's hostnames and its path starts with the route's path prefix on a
+// path segment boundary, so that "/api" matches "/api/v1" but not "/apis".
+type Route struct {
+	// Hostnames the route matches. If empty, the route matches requests for
+	// any host.
+	Hostnames []string `protobuf:"bytes,1,rep,name=hostnames" json:"hostnames,omitempty"`
+	// PathPrefix the request path must start with. If empty, "/" is used.
+	PathPrefix string `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// TargetPort is the port of the tasks that requests are proxied to.
+	TargetPort uint32 `protobuf:"varint,3,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	// Network is the ID of the network whose task addresses requests are
+	// proxied to. If empty, the first network the service is attached to is
+	// used.
+	Network string `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (m *Route) Reset()                    { *m = Route{} }
+func (*Route) ProtoMessage()               {}
+func (*Route) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
+
+// RoutingTable is the routing table of the built-in router, compiled by the
+// manager from the routes of all services.
+type RoutingTable struct {
+	// Entries are sorted by decreasing path prefix length, so that the
+	// first matching entry is the most specific one.
+	Entries []*RoutingTable_Entry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+}
+
+func (m *RoutingTable) Reset()                    { *m = RoutingTable{} }
+func (*RoutingTable) ProtoMessage()               {}
+func (*RoutingTable) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
+
+type RoutingTable_Entry struct {
+	// ServiceID is the ID of the service requests are routed to.
+	ServiceID string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Hostnames and PathPrefix are copied from the service route.
+	Hostnames  []string `protobuf:"bytes,2,rep,name=hostnames" json:"hostnames,omitempty"`
+	PathPrefix string   `protobuf:"bytes,3,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// Backends are the addresses, in "ip:port" form, of the running
+	// tasks of the service.
+	Backends []string `protobuf:"bytes,4,rep,name=backends" json:"backends,omitempty"`
+	// NetworkID is the ID of the network the backend addresses belong
+	// to.
+	NetworkID string `protobuf:"bytes,5,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+}
+
+func (m *RoutingTable_Entry) Reset()                    { *m = RoutingTable_Entry{} }
+func (*RoutingTable_Entry) ProtoMessage()               {}
+func (*RoutingTable_Entry) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23, 0} }
 
 // Driver is a generic driver type to be used throughout the API. For now, a
 // driver is simply a name and set of options. The field contents depend on the
@@ -1195,7 +1313,7 @@ type Driver struct {
 
 func (m *Driver) Reset()                    { *m = Driver{} }
 func (*Driver) ProtoMessage()               {}
-func (*Driver) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{21} }
+func (*Driver) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
 
 type IPAMOptions struct {
 	Driver  *Driver       `protobuf:"bytes,1,opt,name=driver" json:"driver,omitempty"`
@@ -1204,7 +1322,7 @@ type IPAMOptions struct {
 
 func (m *IPAMOptions) Reset()                    { *m = IPAMOptions{} }
 func (*IPAMOptions) ProtoMessage()               {}
-func (*IPAMOptions) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{22} }
+func (*IPAMOptions) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
 
 // Peer should be used anywhere where we are describing a remote peer.
 type Peer struct {
@@ -1214,7 +1332,7 @@ type Peer struct {
 
 func (m *Peer) Reset()                    { *m = Peer{} }
 func (*Peer) ProtoMessage()               {}
-func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{23} }
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
 
 // WeightedPeer should be used anywhere where we are describing a remote peer
 // with a weight.
@@ -1225,7 +1343,7 @@ type WeightedPeer struct {
 
 func (m *WeightedPeer) Reset()                    { *m = WeightedPeer{} }
 func (*WeightedPeer) ProtoMessage()               {}
-func (*WeightedPeer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{24} }
+func (*WeightedPeer) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
 
 type IssuanceStatus struct {
 	State IssuanceStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=docker.swarmkit.v1.IssuanceStatus_State" json:"state,omitempty"`
@@ -1237,7 +1355,7 @@ type IssuanceStatus struct {
 
 func (m *IssuanceStatus) Reset()                    { *m = IssuanceStatus{} }
 func (*IssuanceStatus) ProtoMessage()               {}
-func (*IssuanceStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{25} }
+func (*IssuanceStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
 
 type AcceptancePolicy struct {
 	Policies []*AcceptancePolicy_RoleAdmissionPolicy `protobuf:"bytes,1,rep,name=policies" json:"policies,omitempty"`
@@ -1245,7 +1363,7 @@ type AcceptancePolicy struct {
 
 func (m *AcceptancePolicy) Reset()                    { *m = AcceptancePolicy{} }
 func (*AcceptancePolicy) ProtoMessage()               {}
-func (*AcceptancePolicy) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{26} }
+func (*AcceptancePolicy) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
 
 type AcceptancePolicy_RoleAdmissionPolicy struct {
 	Role NodeRole `protobuf:"varint,1,opt,name=role,proto3,enum=docker.swarmkit.v1.NodeRole" json:"role,omitempty"`
@@ -1260,7 +1378,7 @@ type AcceptancePolicy_RoleAdmissionPolicy struct {
 func (m *AcceptancePolicy_RoleAdmissionPolicy) Reset()      { *m = AcceptancePolicy_RoleAdmissionPolicy{} }
 func (*AcceptancePolicy_RoleAdmissionPolicy) ProtoMessage() {}
 func (*AcceptancePolicy_RoleAdmissionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{26, 0}
+	return fileDescriptorTypes, []int{29, 0}
 }
 
 type AcceptancePolicy_RoleAdmissionPolicy_Secret struct {
@@ -1275,7 +1393,7 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy_Secret) Reset() {
 }
 func (*AcceptancePolicy_RoleAdmissionPolicy_Secret) ProtoMessage() {}
 func (*AcceptancePolicy_RoleAdmissionPolicy_Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{26, 0, 0}
+	return fileDescriptorTypes, []int{29, 0, 0}
 }
 
 type ExternalCA struct {
@@ -1290,7 +1408,7 @@ type ExternalCA struct {
 
 func (m *ExternalCA) Reset()                    { *m = ExternalCA{} }
 func (*ExternalCA) ProtoMessage()               {}
-func (*ExternalCA) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27} }
+func (*ExternalCA) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
 
 type CAConfig struct {
 	// NodeCertExpiry is the duration certificates should be issued for
@@ -1303,7 +1421,7 @@ type CAConfig struct {
 
 func (m *CAConfig) Reset()                    { *m = CAConfig{} }
 func (*CAConfig) ProtoMessage()               {}
-func (*CAConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{28} }
+func (*CAConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
 
 // OrchestrationConfig defines cluster-level orchestration settings.
 type OrchestrationConfig struct {
@@ -1314,7 +1432,7 @@ type OrchestrationConfig struct {
 
 func (m *OrchestrationConfig) Reset()                    { *m = OrchestrationConfig{} }
 func (*OrchestrationConfig) ProtoMessage()               {}
-func (*OrchestrationConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{29} }
+func (*OrchestrationConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
 
 // TaskDefaults specifies default values for task creation.
 type TaskDefaults struct {
@@ -1328,7 +1446,7 @@ type TaskDefaults struct {
 
 func (m *TaskDefaults) Reset()                    { *m = TaskDefaults{} }
 func (*TaskDefaults) ProtoMessage()               {}
-func (*TaskDefaults) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{30} }
+func (*TaskDefaults) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
 
 // DispatcherConfig defines cluster-level dispatcher settings.
 type DispatcherConfig struct {
@@ -1336,11 +1454,23 @@ type DispatcherConfig struct {
 	// dispatcher.
 	// Note: can't use stdduration because this field needs to be nullable.
 	HeartbeatPeriod *google_protobuf1.Duration `protobuf:"bytes,1,opt,name=heartbeat_period,json=heartbeatPeriod" json:"heartbeat_period,omitempty"`
+	// TaskStatusRateLimit is the number of task status updates per second
+	// each node may report. A status update for a task whose previous
+	// update is still pending replaces it and is not counted. Zero means no
+	// limit.
+	TaskStatusRateLimit uint32 `protobuf:"varint,2,opt,name=task_status_rate_limit,json=taskStatusRateLimit,proto3" json:"task_status_rate_limit,omitempty"`
+	// TaskStatusBurst is the number of task status updates a node may
+	// report at once. Zero means the rate limit is used as the burst.
+	TaskStatusBurst uint32 `protobuf:"varint,3,opt,name=task_status_burst,json=taskStatusBurst,proto3" json:"task_status_burst,omitempty"`
+	// MaxPendingTaskUpdates is the number of task status updates the
+	// dispatcher holds before writing them to the store. Updates beyond it
+	// are rejected and retried by the agents. Zero means the default.
+	MaxPendingTaskUpdates uint32 `protobuf:"varint,4,opt,name=max_pending_task_updates,json=maxPendingTaskUpdates,proto3" json:"max_pending_task_updates,omitempty"`
 }
 
 func (m *DispatcherConfig) Reset()                    { *m = DispatcherConfig{} }
 func (*DispatcherConfig) ProtoMessage()               {}
-func (*DispatcherConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{31} }
+func (*DispatcherConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
 
 // RaftConfig defines raft settings for the cluster.
 type RaftConfig struct {
@@ -1358,11 +1488,15 @@ type RaftConfig struct {
 	// ElectionTick defines the amount of ticks (in seconds) needed
 	// without a leader to trigger a new election.
 	ElectionTick uint32 `protobuf:"varint,5,opt,name=election_tick,json=electionTick,proto3" json:"election_tick,omitempty"`
+	// SnapshotLogSize is the total size in bytes of the log entries after
+	// which a snapshot is taken, even if SnapshotInterval has not been
+	// reached. 0 means the default of 64MiB.
+	SnapshotLogSize uint64 `protobuf:"varint,6,opt,name=snapshot_log_size,json=snapshotLogSize,proto3" json:"snapshot_log_size,omitempty"`
 }
 
 func (m *RaftConfig) Reset()                    { *m = RaftConfig{} }
 func (*RaftConfig) ProtoMessage()               {}
-func (*RaftConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{32} }
+func (*RaftConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
 
 type EncryptionConfig struct {
 	// AutoLockManagers specifies whether or not managers TLS keys and raft data
@@ -1373,7 +1507,67 @@ type EncryptionConfig struct {
 
 func (m *EncryptionConfig) Reset()                    { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage()               {}
-func (*EncryptionConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{33} }
+func (*EncryptionConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
+
+// PortRange is an inclusive range of port numbers.
+type PortRange struct {
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *PortRange) Reset()                    { *m = PortRange{} }
+func (*PortRange) ProtoMessage()               {}
+func (*PortRange) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
+
+// PortAllocationConfig defines how published ports are allocated to services.
+type PortAllocationConfig struct {
+	// DynamicRange is the range published ports are allocated from when a
+	// service doesn't specify one. If unset, it is 30000-32767.
+	DynamicRange *PortRange `protobuf:"bytes,1,opt,name=dynamic_range,json=dynamicRange" json:"dynamic_range,omitempty"`
+	// Reserved lists ports which are never published, neither when they are
+	// allocated dynamically, nor when a service specifies them. Ports which
+	// are already published when they become reserved are left as they are.
+	Reserved []PortRange `protobuf:"bytes,2,rep,name=reserved" json:"reserved"`
+}
+
+func (m *PortAllocationConfig) Reset()                    { *m = PortAllocationConfig{} }
+func (*PortAllocationConfig) ProtoMessage()               {}
+func (*PortAllocationConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
+
+// DefaultAddressPoolsConfig defines the address pools the subnets of networks
+// are allocated from when they don't specify one.
+type DefaultAddressPoolsConfig struct {
+	// Pools lists the IPv4 address pools, in CIDR format. Networks which use
+	// the default IPAM driver and don't specify a subnet are allocated one
+	// from these pools. If empty, the IPAM driver's built-in pools are used.
+	Pools []string `protobuf:"bytes,1,rep,name=pools" json:"pools,omitempty"`
+	// SubnetSize is the prefix length of the subnets allocated from Pools.
+	// If zero, it is 24.
+	SubnetSize uint32 `protobuf:"varint,2,opt,name=subnet_size,json=subnetSize,proto3" json:"subnet_size,omitempty"`
+}
+
+func (m *DefaultAddressPoolsConfig) Reset()                    { *m = DefaultAddressPoolsConfig{} }
+func (*DefaultAddressPoolsConfig) ProtoMessage()               {}
+func (*DefaultAddressPoolsConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
+
+// ManagerScalingConfig defines how the number of managers is maintained by
+// promoting and demoting nodes automatically.
+type ManagerScalingConfig struct {
+	// DesiredManagers is the number of managers to maintain. It must be
+	// odd. If zero, managers are not scaled automatically.
+	DesiredManagers uint32 `protobuf:"varint,1,opt,name=desired_managers,json=desiredManagers,proto3" json:"desired_managers,omitempty"`
+	// Placement restricts the nodes which may be promoted with its
+	// constraints, and spreads the managers over the values of its spread
+	// preferences, such as node.labels.zone.
+	Placement *Placement `protobuf:"bytes,2,opt,name=placement" json:"placement,omitempty"`
+	// DownGracePeriod is how long a manager must be down before another
+	// node is promoted to replace it. If unset, it is one minute.
+	DownGracePeriod *google_protobuf1.Duration `protobuf:"bytes,3,opt,name=down_grace_period,json=downGracePeriod" json:"down_grace_period,omitempty"`
+}
+
+func (m *ManagerScalingConfig) Reset()                    { *m = ManagerScalingConfig{} }
+func (*ManagerScalingConfig) ProtoMessage()               {}
+func (*ManagerScalingConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
 
 type SpreadOver struct {
 	SpreadDescriptor string `protobuf:"bytes,1,opt,name=spread_descriptor,json=spreadDescriptor,proto3" json:"spread_descriptor,omitempty"`
@@ -1381,7 +1575,7 @@ type SpreadOver struct {
 
 func (m *SpreadOver) Reset()                    { *m = SpreadOver{} }
 func (*SpreadOver) ProtoMessage()               {}
-func (*SpreadOver) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{34} }
+func (*SpreadOver) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
 
 type PlacementPreference struct {
 	// Types that are valid to be assigned to Preference:
@@ -1391,7 +1585,7 @@ type PlacementPreference struct {
 
 func (m *PlacementPreference) Reset()                    { *m = PlacementPreference{} }
 func (*PlacementPreference) ProtoMessage()               {}
-func (*PlacementPreference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{35} }
+func (*PlacementPreference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
 
 type isPlacementPreference_Preference interface {
 	isPlacementPreference_Preference()
@@ -1486,7 +1680,7 @@ type Placement struct {
 
 func (m *Placement) Reset()                    { *m = Placement{} }
 func (*Placement) ProtoMessage()               {}
-func (*Placement) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{36} }
+func (*Placement) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
 
 // JoinToken contains the join tokens for workers and managers.
 type JoinTokens struct {
@@ -1498,7 +1692,7 @@ type JoinTokens struct {
 
 func (m *JoinTokens) Reset()                    { *m = JoinTokens{} }
 func (*JoinTokens) ProtoMessage()               {}
-func (*JoinTokens) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{37} }
+func (*JoinTokens) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
 
 type RootCA struct {
 	// CAKey is the root CA private key.
@@ -1513,7 +1707,7 @@ type RootCA struct {
 
 func (m *RootCA) Reset()                    { *m = RootCA{} }
 func (*RootCA) ProtoMessage()               {}
-func (*RootCA) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{38} }
+func (*RootCA) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
 
 type Certificate struct {
 	Role        NodeRole       `protobuf:"varint,1,opt,name=role,proto3,enum=docker.swarmkit.v1.NodeRole" json:"role,omitempty"`
@@ -1526,7 +1720,7 @@ type Certificate struct {
 
 func (m *Certificate) Reset()                    { *m = Certificate{} }
 func (*Certificate) ProtoMessage()               {}
-func (*Certificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{39} }
+func (*Certificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
 
 // Symmetric keys to encrypt inter-agent communication.
 type EncryptionKey struct {
@@ -1542,7 +1736,7 @@ type EncryptionKey struct {
 
 func (m *EncryptionKey) Reset()                    { *m = EncryptionKey{} }
 func (*EncryptionKey) ProtoMessage()               {}
-func (*EncryptionKey) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{40} }
+func (*EncryptionKey) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
 
 // ManagerStatus provides informations about the state of a manager in the cluster.
 type ManagerStatus struct {
@@ -1559,7 +1753,7 @@ type ManagerStatus struct {
 
 func (m *ManagerStatus) Reset()                    { *m = ManagerStatus{} }
 func (*ManagerStatus) ProtoMessage()               {}
-func (*ManagerStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
+func (*ManagerStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
 
 // SecretReference is the linkage between a service and a secret that it uses.
 type SecretReference struct {
@@ -1575,11 +1769,27 @@ type SecretReference struct {
 	// Types that are valid to be assigned to Target:
 	//	*SecretReference_File
 	Target isSecretReference_Target `protobuf_oneof:"target"`
+	// SecretVersion pins the reference to a version of the secret data. If
+	// zero and TrackLatest isn't set, the reference is pinned to the latest
+	// version when the service is created or updated. On tasks,
+	// SecretVersion is the version the reference was resolved to, and
+	// SecretID the ID of the object holding that version.
+	SecretVersion uint64 `protobuf:"varint,4,opt,name=secret_version,json=secretVersion,proto3" json:"secret_version,omitempty"`
+	// TrackLatest rolls the tasks of the service onto new versions of the
+	// secret as it is rotated, following the service's UpdateConfig. It
+	// can't be combined with SecretVersion.
+	TrackLatest bool `protobuf:"varint,5,opt,name=track_latest,json=trackLatest,proto3" json:"track_latest,omitempty"`
+	// Templated expands the secret payload as a template on the agent
+	// before it is exposed to the task. The template has access to the same
+	// values as templated fields of the ContainerSpec, the hostname of the
+	// node, the task's environment, and the other secrets referenced by the
+	// task, by name.
+	Templated bool `protobuf:"varint,6,opt,name=templated,proto3" json:"templated,omitempty"`
 }
 
 func (m *SecretReference) Reset()                    { *m = SecretReference{} }
 func (*SecretReference) ProtoMessage()               {}
-func (*SecretReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
+func (*SecretReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
 
 type isSecretReference_Target interface {
 	isSecretReference_Target()
@@ -1677,7 +1887,7 @@ type SecretReference_FileTarget struct {
 func (m *SecretReference_FileTarget) Reset()      { *m = SecretReference_FileTarget{} }
 func (*SecretReference_FileTarget) ProtoMessage() {}
 func (*SecretReference_FileTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{42, 0}
+	return fileDescriptorTypes, []int{49, 0}
 }
 
 // BlacklistedCertificate is a record for a blacklisted certificate. It does not
@@ -1691,7 +1901,7 @@ type BlacklistedCertificate struct {
 
 func (m *BlacklistedCertificate) Reset()                    { *m = BlacklistedCertificate{} }
 func (*BlacklistedCertificate) ProtoMessage()               {}
-func (*BlacklistedCertificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
+func (*BlacklistedCertificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
 
 // HealthConfig holds configuration settings for the HEALTHCHECK feature.
 type HealthConfig struct {
@@ -1717,7 +1927,7 @@ type HealthConfig struct {
 
 func (m *HealthConfig) Reset()                    { *m = HealthConfig{} }
 func (*HealthConfig) ProtoMessage()               {}
-func (*HealthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
+func (*HealthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
 
 type MaybeEncryptedRecord struct {
 	Algorithm MaybeEncryptedRecord_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=docker.swarmkit.v1.MaybeEncryptedRecord_Algorithm" json:"algorithm,omitempty"`
@@ -1727,7 +1937,42 @@ type MaybeEncryptedRecord struct {
 
 func (m *MaybeEncryptedRecord) Reset()                    { *m = MaybeEncryptedRecord{} }
 func (*MaybeEncryptedRecord) ProtoMessage()               {}
-func (*MaybeEncryptedRecord) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
+func (*MaybeEncryptedRecord) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
+
+// RoleRule grants access to a set of Control API methods, optionally
+// restricted to objects carrying a set of labels.
+type RoleRule struct {
+	// Methods lists the Control API methods this rule applies to, such as
+	// "UpdateService". A trailing "*" matches every method with the given
+	// prefix, so "List*" grants all list calls and "*" grants every call.
+	Methods []string `protobuf:"bytes,1,rep,name=methods" json:"methods,omitempty"`
+	// Labels restricts the rule to objects whose labels contain all of
+	// these key/value pairs. An empty value matches any value for the
+	// key. If labels is empty, the rule applies to all objects.
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *RoleRule) Reset()                    { *m = RoleRule{} }
+func (*RoleRule) ProtoMessage()               {}
+func (*RoleRule) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
+
+// AuditCaller identifies the caller of a Control API method, as reported by
+// its TLS certificate.
+type AuditCaller struct {
+	// ID is the node ID or user name from the certificate common name.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Roles are the roles from the certificate organizational units.
+	Roles []string `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+	// ForwardedBy is the ID of the manager that forwarded the call, if the
+	// call did not reach the leader directly.
+	ForwardedBy string `protobuf:"bytes,3,opt,name=forwarded_by,json=forwardedBy,proto3" json:"forwarded_by,omitempty"`
+	// RemoteAddr is the address the call was made from.
+	RemoteAddr string `protobuf:"bytes,4,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+}
+
+func (m *AuditCaller) Reset()                    { *m = AuditCaller{} }
+func (*AuditCaller) ProtoMessage()               {}
+func (*AuditCaller) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{54} }
 
 func init() {
 	proto.RegisterType((*Version)(nil), "docker.swarmkit.v1.Version")
@@ -1752,8 +1997,12 @@ func init() {
 	proto.RegisterType((*PortStatus)(nil), "docker.swarmkit.v1.PortStatus")
 	proto.RegisterType((*TaskStatus)(nil), "docker.swarmkit.v1.TaskStatus")
 	proto.RegisterType((*NetworkAttachmentConfig)(nil), "docker.swarmkit.v1.NetworkAttachmentConfig")
+	proto.RegisterType((*SlotAddress)(nil), "docker.swarmkit.v1.SlotAddress")
 	proto.RegisterType((*IPAMConfig)(nil), "docker.swarmkit.v1.IPAMConfig")
 	proto.RegisterType((*PortConfig)(nil), "docker.swarmkit.v1.PortConfig")
+	proto.RegisterType((*Route)(nil), "docker.swarmkit.v1.Route")
+	proto.RegisterType((*RoutingTable)(nil), "docker.swarmkit.v1.RoutingTable")
+	proto.RegisterType((*RoutingTable_Entry)(nil), "docker.swarmkit.v1.RoutingTable.Entry")
 	proto.RegisterType((*Driver)(nil), "docker.swarmkit.v1.Driver")
 	proto.RegisterType((*IPAMOptions)(nil), "docker.swarmkit.v1.IPAMOptions")
 	proto.RegisterType((*Peer)(nil), "docker.swarmkit.v1.Peer")
@@ -1769,6 +2018,10 @@ func init() {
 	proto.RegisterType((*DispatcherConfig)(nil), "docker.swarmkit.v1.DispatcherConfig")
 	proto.RegisterType((*RaftConfig)(nil), "docker.swarmkit.v1.RaftConfig")
 	proto.RegisterType((*EncryptionConfig)(nil), "docker.swarmkit.v1.EncryptionConfig")
+	proto.RegisterType((*PortRange)(nil), "docker.swarmkit.v1.PortRange")
+	proto.RegisterType((*PortAllocationConfig)(nil), "docker.swarmkit.v1.PortAllocationConfig")
+	proto.RegisterType((*DefaultAddressPoolsConfig)(nil), "docker.swarmkit.v1.DefaultAddressPoolsConfig")
+	proto.RegisterType((*ManagerScalingConfig)(nil), "docker.swarmkit.v1.ManagerScalingConfig")
 	proto.RegisterType((*SpreadOver)(nil), "docker.swarmkit.v1.SpreadOver")
 	proto.RegisterType((*PlacementPreference)(nil), "docker.swarmkit.v1.PlacementPreference")
 	proto.RegisterType((*Placement)(nil), "docker.swarmkit.v1.Placement")
@@ -1782,6 +2035,8 @@ func init() {
 	proto.RegisterType((*BlacklistedCertificate)(nil), "docker.swarmkit.v1.BlacklistedCertificate")
 	proto.RegisterType((*HealthConfig)(nil), "docker.swarmkit.v1.HealthConfig")
 	proto.RegisterType((*MaybeEncryptedRecord)(nil), "docker.swarmkit.v1.MaybeEncryptedRecord")
+	proto.RegisterType((*RoleRule)(nil), "docker.swarmkit.v1.RoleRule")
+	proto.RegisterType((*AuditCaller)(nil), "docker.swarmkit.v1.AuditCaller")
 	proto.RegisterEnum("docker.swarmkit.v1.TaskState", TaskState_name, TaskState_value)
 	proto.RegisterEnum("docker.swarmkit.v1.NodeRole", NodeRole_name, NodeRole_value)
 	proto.RegisterEnum("docker.swarmkit.v1.RaftMemberStatus_Reachability", RaftMemberStatus_Reachability_name, RaftMemberStatus_Reachability_value)
@@ -2250,6 +2505,34 @@ func (m *NetworkAttachmentConfig) CopyFrom(src interface{}) {
 		copy(m.Addresses, o.Addresses)
 	}
 
+	if o.SlotAddresses != nil {
+		m.SlotAddresses = make(map[uint64]*SlotAddress, len(o.SlotAddresses))
+		for k, v := range o.SlotAddresses {
+			m.SlotAddresses[k] = &SlotAddress{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.SlotAddresses[k], v)
+		}
+	}
+
+}
+
+func (m *SlotAddress) Copy() *SlotAddress {
+	if m == nil {
+		return nil
+	}
+	o := &SlotAddress{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *SlotAddress) CopyFrom(src interface{}) {
+
+	o := src.(*SlotAddress)
+	*m = *o
+	if o.Addresses != nil {
+		m.Addresses = make([]string, len(o.Addresses))
+		copy(m.Addresses, o.Addresses)
+	}
+
 }
 
 func (m *IPAMConfig) Copy() *IPAMConfig {
@@ -2289,6 +2572,74 @@ func (m *PortConfig) CopyFrom(src interface{}) {
 	*m = *o
 }
 
+func (m *Route) Copy() *Route {
+	if m == nil {
+		return nil
+	}
+	o := &Route{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *Route) CopyFrom(src interface{}) {
+
+	o := src.(*Route)
+	*m = *o
+	if o.Hostnames != nil {
+		m.Hostnames = make([]string, len(o.Hostnames))
+		copy(m.Hostnames, o.Hostnames)
+	}
+
+}
+
+func (m *RoutingTable) Copy() *RoutingTable {
+	if m == nil {
+		return nil
+	}
+	o := &RoutingTable{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *RoutingTable) CopyFrom(src interface{}) {
+
+	o := src.(*RoutingTable)
+	*m = *o
+	if o.Entries != nil {
+		m.Entries = make([]*RoutingTable_Entry, len(o.Entries))
+		for i := range m.Entries {
+			m.Entries[i] = &RoutingTable_Entry{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.Entries[i], o.Entries[i])
+		}
+	}
+
+}
+
+func (m *RoutingTable_Entry) Copy() *RoutingTable_Entry {
+	if m == nil {
+		return nil
+	}
+	o := &RoutingTable_Entry{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *RoutingTable_Entry) CopyFrom(src interface{}) {
+
+	o := src.(*RoutingTable_Entry)
+	*m = *o
+	if o.Hostnames != nil {
+		m.Hostnames = make([]string, len(o.Hostnames))
+		copy(m.Hostnames, o.Hostnames)
+	}
+
+	if o.Backends != nil {
+		m.Backends = make([]string, len(o.Backends))
+		copy(m.Backends, o.Backends)
+	}
+
+}
+
 func (m *Driver) Copy() *Driver {
 	if m == nil {
 		return nil
@@ -2576,6 +2927,90 @@ func (m *EncryptionConfig) CopyFrom(src interface{}) {
 	*m = *o
 }
 
+func (m *PortRange) Copy() *PortRange {
+	if m == nil {
+		return nil
+	}
+	o := &PortRange{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *PortRange) CopyFrom(src interface{}) {
+
+	o := src.(*PortRange)
+	*m = *o
+}
+
+func (m *PortAllocationConfig) Copy() *PortAllocationConfig {
+	if m == nil {
+		return nil
+	}
+	o := &PortAllocationConfig{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *PortAllocationConfig) CopyFrom(src interface{}) {
+
+	o := src.(*PortAllocationConfig)
+	*m = *o
+	if o.DynamicRange != nil {
+		m.DynamicRange = &PortRange{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.DynamicRange, o.DynamicRange)
+	}
+	if o.Reserved != nil {
+		m.Reserved = make([]PortRange, len(o.Reserved))
+		for i := range m.Reserved {
+			github_com_docker_swarmkit_api_deepcopy.Copy(&m.Reserved[i], &o.Reserved[i])
+		}
+	}
+
+}
+
+func (m *DefaultAddressPoolsConfig) Copy() *DefaultAddressPoolsConfig {
+	if m == nil {
+		return nil
+	}
+	o := &DefaultAddressPoolsConfig{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *DefaultAddressPoolsConfig) CopyFrom(src interface{}) {
+
+	o := src.(*DefaultAddressPoolsConfig)
+	*m = *o
+	if o.Pools != nil {
+		m.Pools = make([]string, len(o.Pools))
+		copy(m.Pools, o.Pools)
+	}
+
+}
+
+func (m *ManagerScalingConfig) Copy() *ManagerScalingConfig {
+	if m == nil {
+		return nil
+	}
+	o := &ManagerScalingConfig{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *ManagerScalingConfig) CopyFrom(src interface{}) {
+
+	o := src.(*ManagerScalingConfig)
+	*m = *o
+	if o.Placement != nil {
+		m.Placement = &Placement{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.Placement, o.Placement)
+	}
+	if o.DownGracePeriod != nil {
+		m.DownGracePeriod = &google_protobuf1.Duration{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.DownGracePeriod, o.DownGracePeriod)
+	}
+}
+
 func (m *SpreadOver) Copy() *SpreadOver {
 	if m == nil {
 		return nil
@@ -2825,6 +3260,53 @@ func (m *MaybeEncryptedRecord) CopyFrom(src interface{}) {
 	*m = *o
 }
 
+func (m *RoleRule) Copy() *RoleRule {
+	if m == nil {
+		return nil
+	}
+	o := &RoleRule{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *RoleRule) CopyFrom(src interface{}) {
+
+	o := src.(*RoleRule)
+	*m = *o
+	if o.Methods != nil {
+		m.Methods = make([]string, len(o.Methods))
+		copy(m.Methods, o.Methods)
+	}
+
+	if o.Labels != nil {
+		m.Labels = make(map[string]string, len(o.Labels))
+		for k, v := range o.Labels {
+			m.Labels[k] = v
+		}
+	}
+
+}
+
+func (m *AuditCaller) Copy() *AuditCaller {
+	if m == nil {
+		return nil
+	}
+	o := &AuditCaller{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *AuditCaller) CopyFrom(src interface{}) {
+
+	o := src.(*AuditCaller)
+	*m = *o
+	if o.Roles != nil {
+		m.Roles = make([]string, len(o.Roles))
+		copy(m.Roles, o.Roles)
+	}
+
+}
+
 func (m *Version) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3740,6 +4222,72 @@ func (m *NetworkAttachmentConfig) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.SlotAddresses) > 0 {
+		for k, _ := range m.SlotAddresses {
+			dAtA[i] = 0x22
+			i++
+			v := m.SlotAddresses[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovTypes(uint64(msgSize))
+			}
+			mapSize := 1 + sovTypes(uint64(k)) + msgSize
+			i = encodeVarintTypes(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(k))
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintTypes(dAtA, i, uint64(v.Size()))
+				n20, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n20
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *SlotAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlotAddress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.MacAddress) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MacAddress)))
+		i += copy(dAtA[i:], m.MacAddress)
+	}
 	return i, nil
 }
 
@@ -3845,7 +4393,7 @@ func (m *PortConfig) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Driver) Marshal() (dAtA []byte, err error) {
+func (m *Route) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3855,38 +4403,47 @@ func (m *Driver) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Driver) MarshalTo(dAtA []byte) (int, error) {
+func (m *Route) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if len(m.Options) > 0 {
-		for k, _ := range m.Options {
-			dAtA[i] = 0x12
-			i++
-			v := m.Options[k]
-			mapSize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + len(v) + sovTypes(uint64(len(v)))
-			i = encodeVarintTypes(dAtA, i, uint64(mapSize))
+	if len(m.Hostnames) > 0 {
+		for _, s := range m.Hostnames {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintTypes(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
 			i++
-			i = encodeVarintTypes(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.PathPrefix) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PathPrefix)))
+		i += copy(dAtA[i:], m.PathPrefix)
+	}
+	if m.TargetPort != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetPort))
+	}
+	if len(m.Network) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Network)))
+		i += copy(dAtA[i:], m.Network)
+	}
 	return i, nil
 }
 
-func (m *IPAMOptions) Marshal() (dAtA []byte, err error) {
+func (m *RoutingTable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3896,24 +4453,161 @@ func (m *IPAMOptions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IPAMOptions) MarshalTo(dAtA []byte) (int, error) {
+func (m *RoutingTable) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Driver != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Driver.Size()))
-		n20, err := m.Driver.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
-	}
-	if len(m.Configs) > 0 {
-		for _, msg := range m.Configs {
-			dAtA[i] = 0x1a
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RoutingTable_Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoutingTable_Entry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ServiceID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ServiceID)))
+		i += copy(dAtA[i:], m.ServiceID)
+	}
+	if len(m.Hostnames) > 0 {
+		for _, s := range m.Hostnames {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.PathPrefix) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PathPrefix)))
+		i += copy(dAtA[i:], m.PathPrefix)
+	}
+	if len(m.Backends) > 0 {
+		for _, s := range m.Backends {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NetworkID) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NetworkID)))
+		i += copy(dAtA[i:], m.NetworkID)
+	}
+	return i, nil
+}
+
+func (m *Driver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Driver) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Options) > 0 {
+		for k, _ := range m.Options {
+			dAtA[i] = 0x12
+			i++
+			v := m.Options[k]
+			mapSize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + len(v) + sovTypes(uint64(len(v)))
+			i = encodeVarintTypes(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *IPAMOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IPAMOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Driver != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Driver.Size()))
+		n21, err := m.Driver.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.Configs) > 0 {
+		for _, msg := range m.Configs {
+			dAtA[i] = 0x1a
 			i++
 			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -3975,11 +4669,11 @@ func (m *WeightedPeer) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Peer.Size()))
-		n21, err := m.Peer.MarshalTo(dAtA[i:])
+		n22, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n22
 	}
 	if m.Weight != 0 {
 		dAtA[i] = 0x10
@@ -4082,11 +4776,11 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy) MarshalTo(dAtA []byte) (int, erro
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Secret.Size()))
-		n22, err := m.Secret.MarshalTo(dAtA[i:])
+		n23, err := m.Secret.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n23
 	}
 	return i, nil
 }
@@ -4186,11 +4880,11 @@ func (m *CAConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.NodeCertExpiry.Size()))
-		n23, err := m.NodeCertExpiry.MarshalTo(dAtA[i:])
+		n24, err := m.NodeCertExpiry.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n24
 	}
 	if len(m.ExternalCAs) > 0 {
 		for _, msg := range m.ExternalCAs {
@@ -4249,11 +4943,11 @@ func (m *TaskDefaults) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.LogDriver.Size()))
-		n24, err := m.LogDriver.MarshalTo(dAtA[i:])
+		n25, err := m.LogDriver.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n25
 	}
 	return i, nil
 }
@@ -4277,11 +4971,26 @@ func (m *DispatcherConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.HeartbeatPeriod.Size()))
-		n25, err := m.HeartbeatPeriod.MarshalTo(dAtA[i:])
+		n26, err := m.HeartbeatPeriod.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n26
+	}
+	if m.TaskStatusRateLimit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.TaskStatusRateLimit))
+	}
+	if m.TaskStatusBurst != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.TaskStatusBurst))
+	}
+	if m.MaxPendingTaskUpdates != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxPendingTaskUpdates))
 	}
 	return i, nil
 }
@@ -4326,6 +5035,11 @@ func (m *RaftConfig) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.ElectionTick))
 	}
+	if m.SnapshotLogSize != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.SnapshotLogSize))
+	}
 	return i, nil
 }
 
@@ -4357,7 +5071,7 @@ func (m *EncryptionConfig) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SpreadOver) Marshal() (dAtA []byte, err error) {
+func (m *PortRange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4367,21 +5081,25 @@ func (m *SpreadOver) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpreadOver) MarshalTo(dAtA []byte) (int, error) {
+func (m *PortRange) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpreadDescriptor) > 0 {
-		dAtA[i] = 0xa
+	if m.Start != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.SpreadDescriptor)))
-		i += copy(dAtA[i:], m.SpreadDescriptor)
+		i = encodeVarintTypes(dAtA, i, uint64(m.Start))
+	}
+	if m.End != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.End))
 	}
 	return i, nil
 }
 
-func (m *PlacementPreference) Marshal() (dAtA []byte, err error) {
+func (m *PortAllocationConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4391,36 +5109,37 @@ func (m *PlacementPreference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PlacementPreference) MarshalTo(dAtA []byte) (int, error) {
+func (m *PortAllocationConfig) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Preference != nil {
-		nn26, err := m.Preference.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn26
-	}
-	return i, nil
-}
-
-func (m *PlacementPreference_Spread) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Spread != nil {
+	if m.DynamicRange != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(m.Spread.Size()))
-		n27, err := m.Spread.MarshalTo(dAtA[i:])
+		i = encodeVarintTypes(dAtA, i, uint64(m.DynamicRange.Size()))
+		n27, err := m.DynamicRange.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n27
 	}
+	if len(m.Reserved) > 0 {
+		for _, msg := range m.Reserved {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
-func (m *Placement) Marshal() (dAtA []byte, err error) {
+
+func (m *DefaultAddressPoolsConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4430,13 +5149,13 @@ func (m *Placement) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Placement) MarshalTo(dAtA []byte) (int, error) {
+func (m *DefaultAddressPoolsConfig) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Constraints) > 0 {
-		for _, s := range m.Constraints {
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
 			dAtA[i] = 0xa
 			i++
 			l = len(s)
@@ -4450,22 +5169,15 @@ func (m *Placement) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Preferences) > 0 {
-		for _, msg := range m.Preferences {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.SubnetSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubnetSize))
 	}
 	return i, nil
 }
 
-func (m *JoinTokens) Marshal() (dAtA []byte, err error) {
+func (m *ManagerScalingConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4475,27 +5187,40 @@ func (m *JoinTokens) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JoinTokens) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManagerScalingConfig) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Worker) > 0 {
-		dAtA[i] = 0xa
+	if m.DesiredManagers != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Worker)))
-		i += copy(dAtA[i:], m.Worker)
+		i = encodeVarintTypes(dAtA, i, uint64(m.DesiredManagers))
 	}
-	if len(m.Manager) > 0 {
+	if m.Placement != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Manager)))
-		i += copy(dAtA[i:], m.Manager)
+		i = encodeVarintTypes(dAtA, i, uint64(m.Placement.Size()))
+		n28, err := m.Placement.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.DownGracePeriod != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.DownGracePeriod.Size()))
+		n29, err := m.DownGracePeriod.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
 	return i, nil
 }
 
-func (m *RootCA) Marshal() (dAtA []byte, err error) {
+func (m *SpreadOver) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4505,41 +5230,21 @@ func (m *RootCA) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RootCA) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpreadOver) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.CAKey) > 0 {
+	if len(m.SpreadDescriptor) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CAKey)))
-		i += copy(dAtA[i:], m.CAKey)
-	}
-	if len(m.CACert) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CACert)))
-		i += copy(dAtA[i:], m.CACert)
-	}
-	if len(m.CACertHash) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CACertHash)))
-		i += copy(dAtA[i:], m.CACertHash)
-	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintTypes(dAtA, i, uint64(m.JoinTokens.Size()))
-	n28, err := m.JoinTokens.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SpreadDescriptor)))
+		i += copy(dAtA[i:], m.SpreadDescriptor)
 	}
-	i += n28
 	return i, nil
 }
 
-func (m *Certificate) Marshal() (dAtA []byte, err error) {
+func (m *PlacementPreference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4549,13 +5254,171 @@ func (m *Certificate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Certificate) MarshalTo(dAtA []byte) (int, error) {
+func (m *PlacementPreference) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		dAtA[i] = 0x8
+	if m.Preference != nil {
+		nn30, err := m.Preference.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn30
+	}
+	return i, nil
+}
+
+func (m *PlacementPreference_Spread) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Spread != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Spread.Size()))
+		n31, err := m.Spread.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+func (m *Placement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Placement) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for _, s := range m.Constraints {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Preferences) > 0 {
+		for _, msg := range m.Preferences {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *JoinTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinTokens) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Worker) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Worker)))
+		i += copy(dAtA[i:], m.Worker)
+	}
+	if len(m.Manager) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Manager)))
+		i += copy(dAtA[i:], m.Manager)
+	}
+	return i, nil
+}
+
+func (m *RootCA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RootCA) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.CAKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CAKey)))
+		i += copy(dAtA[i:], m.CAKey)
+	}
+	if len(m.CACert) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CACert)))
+		i += copy(dAtA[i:], m.CACert)
+	}
+	if len(m.CACertHash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CACertHash)))
+		i += copy(dAtA[i:], m.CACertHash)
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintTypes(dAtA, i, uint64(m.JoinTokens.Size()))
+	n32, err := m.JoinTokens.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	return i, nil
+}
+
+func (m *Certificate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Certificate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		dAtA[i] = 0x8
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Role))
 	}
@@ -4568,11 +5431,11 @@ func (m *Certificate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintTypes(dAtA, i, uint64(m.Status.Size()))
-	n29, err := m.Status.MarshalTo(dAtA[i:])
+	n33, err := m.Status.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n33
 	if len(m.Certificate) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -4700,11 +5563,36 @@ func (m *SecretReference) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.SecretName)
 	}
 	if m.Target != nil {
-		nn30, err := m.Target.MarshalTo(dAtA[i:])
+		nn34, err := m.Target.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn30
+		i += nn34
+	}
+	if m.SecretVersion != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.SecretVersion))
+	}
+	if m.TrackLatest {
+		dAtA[i] = 0x28
+		i++
+		if m.TrackLatest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Templated {
+		dAtA[i] = 0x30
+		i++
+		if m.Templated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
@@ -4715,11 +5603,11 @@ func (m *SecretReference_File) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.File.Size()))
-		n31, err := m.File.MarshalTo(dAtA[i:])
+		n35, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n35
 	}
 	return i, nil
 }
@@ -4783,11 +5671,11 @@ func (m *BlacklistedCertificate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Expiry.Size()))
-		n32, err := m.Expiry.MarshalTo(dAtA[i:])
+		n36, err := m.Expiry.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n36
 	}
 	return i, nil
 }
@@ -4826,21 +5714,21 @@ func (m *HealthConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Interval.Size()))
-		n33, err := m.Interval.MarshalTo(dAtA[i:])
+		n37, err := m.Interval.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n37
 	}
 	if m.Timeout != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Timeout.Size()))
-		n34, err := m.Timeout.MarshalTo(dAtA[i:])
+		n38, err := m.Timeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n38
 	}
 	if m.Retries != 0 {
 		dAtA[i] = 0x20
@@ -4885,62 +5773,163 @@ func (m *MaybeEncryptedRecord) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Types(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Types(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-
-func (m *Version) Size() (n int) {
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovTypes(uint64(m.Index))
+func (m *RoleRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Annotations) Size() (n int) {
+func (m *RoleRule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + len(v) + sovTypes(uint64(len(v)))
-			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		for k, _ := range m.Labels {
+			dAtA[i] = 0x12
+			i++
+			v := m.Labels[k]
+			mapSize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + len(v) + sovTypes(uint64(len(v)))
+			i = encodeVarintTypes(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *Resources) Size() (n int) {
+func (m *AuditCaller) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditCaller) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ForwardedBy) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForwardedBy)))
+		i += copy(dAtA[i:], m.ForwardedBy)
+	}
+	if len(m.RemoteAddr) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RemoteAddr)))
+		i += copy(dAtA[i:], m.RemoteAddr)
+	}
+	return i, nil
+}
+
+func encodeFixed64Types(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Types(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+
+func (m *Version) Size() (n int) {
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *Annotations) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + len(v) + sovTypes(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Resources) Size() (n int) {
 	var l int
 	_ = l
 	if m.NanoCPUs != 0 {
@@ -5303,6 +6292,35 @@ func (m *NetworkAttachmentConfig) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.SlotAddresses) > 0 {
+		for k, v := range m.SlotAddresses {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTypes(uint64(l))
+			}
+			mapEntrySize := 1 + sovTypes(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SlotAddress) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.MacAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -5357,6 +6375,71 @@ func (m *PortConfig) Size() (n int) {
 	return n
 }
 
+func (m *Route) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Hostnames) > 0 {
+		for _, s := range m.Hostnames {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PathPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TargetPort != 0 {
+		n += 1 + sovTypes(uint64(m.TargetPort))
+	}
+	l = len(m.Network)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *RoutingTable) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoutingTable_Entry) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ServiceID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Hostnames) > 0 {
+		for _, s := range m.Hostnames {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PathPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Backends) > 0 {
+		for _, s := range m.Backends {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.NetworkID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Driver) Size() (n int) {
 	var l int
 	_ = l
@@ -5536,6 +6619,15 @@ func (m *DispatcherConfig) Size() (n int) {
 		l = m.HeartbeatPeriod.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.TaskStatusRateLimit != 0 {
+		n += 1 + sovTypes(uint64(m.TaskStatusRateLimit))
+	}
+	if m.TaskStatusBurst != 0 {
+		n += 1 + sovTypes(uint64(m.TaskStatusBurst))
+	}
+	if m.MaxPendingTaskUpdates != 0 {
+		n += 1 + sovTypes(uint64(m.MaxPendingTaskUpdates))
+	}
 	return n
 }
 
@@ -5557,6 +6649,9 @@ func (m *RaftConfig) Size() (n int) {
 	if m.ElectionTick != 0 {
 		n += 1 + sovTypes(uint64(m.ElectionTick))
 	}
+	if m.SnapshotLogSize != 0 {
+		n += 1 + sovTypes(uint64(m.SnapshotLogSize))
+	}
 	return n
 }
 
@@ -5569,6 +6664,66 @@ func (m *EncryptionConfig) Size() (n int) {
 	return n
 }
 
+func (m *PortRange) Size() (n int) {
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovTypes(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovTypes(uint64(m.End))
+	}
+	return n
+}
+
+func (m *PortAllocationConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.DynamicRange != nil {
+		l = m.DynamicRange.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Reserved) > 0 {
+		for _, e := range m.Reserved {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DefaultAddressPoolsConfig) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SubnetSize != 0 {
+		n += 1 + sovTypes(uint64(m.SubnetSize))
+	}
+	return n
+}
+
+func (m *ManagerScalingConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.DesiredManagers != 0 {
+		n += 1 + sovTypes(uint64(m.DesiredManagers))
+	}
+	if m.Placement != nil {
+		l = m.Placement.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DownGracePeriod != nil {
+		l = m.DownGracePeriod.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *SpreadOver) Size() (n int) {
 	var l int
 	_ = l
@@ -5725,6 +6880,15 @@ func (m *SecretReference) Size() (n int) {
 	if m.Target != nil {
 		n += m.Target.Size()
 	}
+	if m.SecretVersion != 0 {
+		n += 1 + sovTypes(uint64(m.SecretVersion))
+	}
+	if m.TrackLatest {
+		n += 2
+	}
+	if m.Templated {
+		n += 2
+	}
 	return n
 }
 
@@ -5808,6 +6972,50 @@ func (m *MaybeEncryptedRecord) Size() (n int) {
 	return n
 }
 
+func (m *RoleRule) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + len(v) + sovTypes(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *AuditCaller) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.ForwardedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	for {
 		n++
@@ -6115,10 +7323,32 @@ func (this *NetworkAttachmentConfig) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForSlotAddresses := make([]uint64, 0, len(this.SlotAddresses))
+	for k, _ := range this.SlotAddresses {
+		keysForSlotAddresses = append(keysForSlotAddresses, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint64s(keysForSlotAddresses)
+	mapStringForSlotAddresses := "map[uint64]*SlotAddress{"
+	for _, k := range keysForSlotAddresses {
+		mapStringForSlotAddresses += fmt.Sprintf("%v: %v,", k, this.SlotAddresses[k])
+	}
+	mapStringForSlotAddresses += "}"
 	s := strings.Join([]string{`&NetworkAttachmentConfig{`,
 		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
 		`Aliases:` + fmt.Sprintf("%v", this.Aliases) + `,`,
 		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`SlotAddresses:` + mapStringForSlotAddresses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SlotAddress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SlotAddress{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`MacAddress:` + fmt.Sprintf("%v", this.MacAddress) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6161,6 +7391,43 @@ func (this *PortConfig) String() string {
 	}, "")
 	return s
 }
+func (this *Route) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Route{`,
+		`Hostnames:` + fmt.Sprintf("%v", this.Hostnames) + `,`,
+		`PathPrefix:` + fmt.Sprintf("%v", this.PathPrefix) + `,`,
+		`TargetPort:` + fmt.Sprintf("%v", this.TargetPort) + `,`,
+		`Network:` + fmt.Sprintf("%v", this.Network) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoutingTable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoutingTable{`,
+		`Entries:` + strings.Replace(fmt.Sprintf("%v", this.Entries), "RoutingTable_Entry", "RoutingTable_Entry", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RoutingTable_Entry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RoutingTable_Entry{`,
+		`ServiceID:` + fmt.Sprintf("%v", this.ServiceID) + `,`,
+		`Hostnames:` + fmt.Sprintf("%v", this.Hostnames) + `,`,
+		`PathPrefix:` + fmt.Sprintf("%v", this.PathPrefix) + `,`,
+		`Backends:` + fmt.Sprintf("%v", this.Backends) + `,`,
+		`NetworkID:` + fmt.Sprintf("%v", this.NetworkID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Driver) String() string {
 	if this == nil {
 		return "nil"
@@ -6318,6 +7585,9 @@ func (this *DispatcherConfig) String() string {
 	}
 	s := strings.Join([]string{`&DispatcherConfig{`,
 		`HeartbeatPeriod:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatPeriod), "Duration", "google_protobuf1.Duration", 1) + `,`,
+		`TaskStatusRateLimit:` + fmt.Sprintf("%v", this.TaskStatusRateLimit) + `,`,
+		`TaskStatusBurst:` + fmt.Sprintf("%v", this.TaskStatusBurst) + `,`,
+		`MaxPendingTaskUpdates:` + fmt.Sprintf("%v", this.MaxPendingTaskUpdates) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6332,6 +7602,7 @@ func (this *RaftConfig) String() string {
 		`LogEntriesForSlowFollowers:` + fmt.Sprintf("%v", this.LogEntriesForSlowFollowers) + `,`,
 		`HeartbeatTick:` + fmt.Sprintf("%v", this.HeartbeatTick) + `,`,
 		`ElectionTick:` + fmt.Sprintf("%v", this.ElectionTick) + `,`,
+		`SnapshotLogSize:` + fmt.Sprintf("%v", this.SnapshotLogSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6346,6 +7617,51 @@ func (this *EncryptionConfig) String() string {
 	}, "")
 	return s
 }
+func (this *PortRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortRange{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortAllocationConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortAllocationConfig{`,
+		`DynamicRange:` + strings.Replace(fmt.Sprintf("%v", this.DynamicRange), "PortRange", "PortRange", 1) + `,`,
+		`Reserved:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Reserved), "PortRange", "PortRange", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DefaultAddressPoolsConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DefaultAddressPoolsConfig{`,
+		`Pools:` + fmt.Sprintf("%v", this.Pools) + `,`,
+		`SubnetSize:` + fmt.Sprintf("%v", this.SubnetSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ManagerScalingConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ManagerScalingConfig{`,
+		`DesiredManagers:` + fmt.Sprintf("%v", this.DesiredManagers) + `,`,
+		`Placement:` + strings.Replace(fmt.Sprintf("%v", this.Placement), "Placement", "Placement", 1) + `,`,
+		`DownGracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.DownGracePeriod), "Duration", "google_protobuf1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SpreadOver) String() string {
 	if this == nil {
 		return "nil"
@@ -6459,6 +7775,9 @@ func (this *SecretReference) String() string {
 		`SecretID:` + fmt.Sprintf("%v", this.SecretID) + `,`,
 		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
 		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`SecretVersion:` + fmt.Sprintf("%v", this.SecretVersion) + `,`,
+		`TrackLatest:` + fmt.Sprintf("%v", this.TrackLatest) + `,`,
+		`Templated:` + fmt.Sprintf("%v", this.Templated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6521,6 +7840,40 @@ func (this *MaybeEncryptedRecord) String() string {
 	}, "")
 	return s
 }
+func (this *RoleRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&RoleRule{`,
+		`Methods:` + fmt.Sprintf("%v", this.Methods) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditCaller) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditCaller{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`ForwardedBy:` + fmt.Sprintf("%v", this.ForwardedBy) + `,`,
+		`RemoteAddr:` + fmt.Sprintf("%v", this.RemoteAddr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringTypes(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -9585,6 +10938,117 @@ func (m *NetworkAttachmentConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.SlotAddresses == nil {
+				m.SlotAddresses = make(map[uint64]*SlotAddress)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &SlotAddress{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.SlotAddresses[mapkey] = mapvalue
+			} else {
+				var mapvalue *SlotAddress
+				m.SlotAddresses[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -9606,7 +11070,7 @@ func (m *NetworkAttachmentConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPAMConfig) Unmarshal(dAtA []byte) error {
+func (m *SlotAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9629,34 +11093,15 @@ func (m *IPAMConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPAMConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlotAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPAMConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlotAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
-			}
-			m.Family = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Family |= (IPAMConfig_AddressFamily(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subnet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9681,11 +11126,11 @@ func (m *IPAMConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subnet = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MacAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9710,13 +11155,140 @@ func (m *IPAMConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Range = string(dAtA[iNdEx:postIndex])
+			m.MacAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPAMConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPAMConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPAMConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
+			}
+			m.Family = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Family |= (IPAMConfig_AddressFamily(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subnet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subnet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Range = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateway", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10033,7 +11605,7 @@ func (m *PortConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Driver) Unmarshal(dAtA []byte) error {
+func (m *Route) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10056,15 +11628,15 @@ func (m *Driver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Driver: wiretype end group for non-group")
+			return fmt.Errorf("proto: Route: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Driver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Route: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostnames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10089,13 +11661,13 @@ func (m *Driver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Hostnames = append(m.Hostnames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10105,19 +11677,26 @@ func (m *Driver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.PathPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPort", wireType)
+			}
+			m.TargetPort = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10127,12 +11706,16 @@ func (m *Driver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.TargetPort |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Network", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10142,70 +11725,20 @@ func (m *Driver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Options == nil {
-				m.Options = make(map[string]string)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Options[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Options[mapkey] = mapvalue
-			}
+			m.Network = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10228,7 +11761,7 @@ func (m *Driver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPAMOptions) Unmarshal(dAtA []byte) error {
+func (m *RoutingTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10251,48 +11784,15 @@ func (m *IPAMOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPAMOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoutingTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPAMOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoutingTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Driver == nil {
-				m.Driver = &Driver{}
-			}
-			if err := m.Driver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10316,8 +11816,8 @@ func (m *IPAMOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Configs = append(m.Configs, &IPAMConfig{})
-			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &RoutingTable_Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10342,7 +11842,7 @@ func (m *IPAMOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Peer) Unmarshal(dAtA []byte) error {
+func (m *RoutingTable_Entry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10365,15 +11865,15 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Peer: wiretype end group for non-group")
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Peer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10398,11 +11898,11 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeID = string(dAtA[iNdEx:postIndex])
+			m.ServiceID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostnames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10427,63 +11927,42 @@ func (m *Peer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Hostnames = append(m.Hostnames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathPrefix", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WeightedPeer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedPeer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedPeer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PathPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backends", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10493,30 +11972,26 @@ func (m *WeightedPeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Backends = append(m.Backends, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkID", wireType)
 			}
-			m.Weight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10526,11 +12001,21 @@ func (m *WeightedPeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -10552,7 +12037,7 @@ func (m *WeightedPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IssuanceStatus) Unmarshal(dAtA []byte) error {
+func (m *Driver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10575,17 +12060,17 @@ func (m *IssuanceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IssuanceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Driver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IssuanceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Driver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10595,16 +12080,26 @@ func (m *IssuanceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (IssuanceStatus_State(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10614,20 +12109,107 @@ func (m *IssuanceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Options == nil {
+				m.Options = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Options[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Options[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10650,7 +12232,7 @@ func (m *IssuanceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AcceptancePolicy) Unmarshal(dAtA []byte) error {
+func (m *IPAMOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10673,15 +12255,15 @@ func (m *AcceptancePolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AcceptancePolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPAMOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AcceptancePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPAMOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10705,8 +12287,41 @@ func (m *AcceptancePolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, &AcceptancePolicy_RoleAdmissionPolicy{})
-			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Driver == nil {
+				m.Driver = &Driver{}
+			}
+			if err := m.Driver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, &IPAMConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10731,7 +12346,7 @@ func (m *AcceptancePolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AcceptancePolicy_RoleAdmissionPolicy) Unmarshal(dAtA []byte) error {
+func (m *Peer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10754,17 +12369,17 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleAdmissionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Peer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleAdmissionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Peer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10774,36 +12389,26 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= (NodeRole(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Autoaccept", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Autoaccept = bool(v != 0)
-		case 3:
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10813,24 +12418,20 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secret == nil {
-				m.Secret = &AcceptancePolicy_RoleAdmissionPolicy_Secret{}
-			}
-			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10853,7 +12454,7 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AcceptancePolicy_RoleAdmissionPolicy_Secret) Unmarshal(dAtA []byte) error {
+func (m *WeightedPeer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10876,17 +12477,17 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy_Secret) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedPeer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedPeer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10896,28 +12497,30 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy_Secret) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if m.Peer == nil {
+				m.Peer = &Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -10927,21 +12530,11 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy_Secret) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Weight |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Alg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -10963,7 +12556,7 @@ func (m *AcceptancePolicy_RoleAdmissionPolicy_Secret) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *ExternalCA) Unmarshal(dAtA []byte) error {
+func (m *IssuanceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10986,17 +12579,17 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExternalCA: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssuanceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExternalCA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssuanceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Protocol = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11006,14 +12599,14 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Protocol |= (ExternalCA_CAProtocol(b) & 0x7F) << shift
+				m.State |= (IssuanceStatus_State(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11038,11 +12631,61 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptancePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptancePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptancePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11066,7 +12709,66 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.Policies = append(m.Policies, &AcceptancePolicy_RoleAdmissionPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptancePolicy_RoleAdmissionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleAdmissionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleAdmissionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11076,12 +12778,16 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.Role |= (NodeRole(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoaccept", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11091,30 +12797,328 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			m.Autoaccept = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Options == nil {
-				m.Options = make(map[string]string)
+			if m.Secret == nil {
+				m.Secret = &AcceptancePolicy_RoleAdmissionPolicy_Secret{}
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptancePolicy_RoleAdmissionPolicy_Secret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalCA) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalCA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalCA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= (ExternalCA_CAProtocol(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Options == nil {
+				m.Options = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
 					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
 					}
@@ -11125,37 +13129,765 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Options[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Options[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CAConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CAConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CAConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCertExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeCertExpiry == nil {
+				m.NodeCertExpiry = &google_protobuf1.Duration{}
+			}
+			if err := m.NodeCertExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalCAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalCAs = append(m.ExternalCAs, &ExternalCA{})
+			if err := m.ExternalCAs[len(m.ExternalCAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrchestrationConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrchestrationConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrchestrationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskHistoryRetentionLimit", wireType)
+			}
+			m.TaskHistoryRetentionLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskHistoryRetentionLimit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskDefaults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogDriver", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogDriver == nil {
+				m.LogDriver = &Driver{}
+			}
+			if err := m.LogDriver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DispatcherConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DispatcherConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DispatcherConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatPeriod == nil {
+				m.HeartbeatPeriod = &google_protobuf1.Duration{}
+			}
+			if err := m.HeartbeatPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStatusRateLimit", wireType)
+			}
+			m.TaskStatusRateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskStatusRateLimit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskStatusBurst", wireType)
+			}
+			m.TaskStatusBurst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskStatusBurst |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingTaskUpdates", wireType)
+			}
+			m.MaxPendingTaskUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingTaskUpdates |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepOldSnapshots", wireType)
+			}
+			m.KeepOldSnapshots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepOldSnapshots |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntriesForSlowFollowers", wireType)
+			}
+			m.LogEntriesForSlowFollowers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogEntriesForSlowFollowers |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTick", wireType)
+			}
+			m.HeartbeatTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatTick |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionTick", wireType)
+			}
+			m.ElectionTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionTick |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotLogSize", wireType)
+			}
+			m.SnapshotLogSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotLogSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoLockManagers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoLockManagers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthTypes
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Options[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Options[mapkey] = mapvalue
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11177,7 +13909,7 @@ func (m *ExternalCA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CAConfig) Unmarshal(dAtA []byte) error {
+func (m *PortAllocationConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11200,15 +13932,15 @@ func (m *CAConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CAConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PortAllocationConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CAConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PortAllocationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeCertExpiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11232,16 +13964,16 @@ func (m *CAConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeCertExpiry == nil {
-				m.NodeCertExpiry = &google_protobuf1.Duration{}
+			if m.DynamicRange == nil {
+				m.DynamicRange = &PortRange{}
 			}
-			if err := m.NodeCertExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DynamicRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalCAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11265,8 +13997,8 @@ func (m *CAConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalCAs = append(m.ExternalCAs, &ExternalCA{})
-			if err := m.ExternalCAs[len(m.ExternalCAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reserved = append(m.Reserved, PortRange{})
+			if err := m.Reserved[len(m.Reserved)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11291,7 +14023,7 @@ func (m *CAConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrchestrationConfig) Unmarshal(dAtA []byte) error {
+func (m *DefaultAddressPoolsConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11314,17 +14046,46 @@ func (m *OrchestrationConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrchestrationConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DefaultAddressPoolsConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrchestrationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DefaultAddressPoolsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskHistoryRetentionLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetSize", wireType)
 			}
-			m.TaskHistoryRetentionLimit = 0
+			m.SubnetSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11334,7 +14095,7 @@ func (m *OrchestrationConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskHistoryRetentionLimit |= (int64(b) & 0x7F) << shift
+				m.SubnetSize |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -11360,7 +14121,7 @@ func (m *OrchestrationConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskDefaults) Unmarshal(dAtA []byte) error {
+func (m *ManagerScalingConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11383,15 +14144,34 @@ func (m *TaskDefaults) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskDefaults: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagerScalingConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagerScalingConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredManagers", wireType)
+			}
+			m.DesiredManagers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredManagers |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogDriver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Placement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11415,10 +14195,43 @@ func (m *TaskDefaults) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogDriver == nil {
-				m.LogDriver = &Driver{}
+			if m.Placement == nil {
+				m.Placement = &Placement{}
 			}
-			if err := m.LogDriver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Placement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownGracePeriod == nil {
+				m.DownGracePeriod = &google_protobuf1.Duration{}
+			}
+			if err := m.DownGracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11443,7 +14256,7 @@ func (m *TaskDefaults) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DispatcherConfig) Unmarshal(dAtA []byte) error {
+func (m *SpreadOver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11466,17 +14279,17 @@ func (m *DispatcherConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DispatcherConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpreadOver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DispatcherConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpreadOver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadDescriptor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11486,24 +14299,20 @@ func (m *DispatcherConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatPeriod == nil {
-				m.HeartbeatPeriod = &google_protobuf1.Duration{}
-			}
-			if err := m.HeartbeatPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpreadDescriptor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11526,7 +14335,7 @@ func (m *DispatcherConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftConfig) Unmarshal(dAtA []byte) error {
+func (m *PlacementPreference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11549,93 +14358,17 @@ func (m *RaftConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlacementPreference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlacementPreference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
-			}
-			m.SnapshotInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SnapshotInterval |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeepOldSnapshots", wireType)
-			}
-			m.KeepOldSnapshots = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.KeepOldSnapshots |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogEntriesForSlowFollowers", wireType)
-			}
-			m.LogEntriesForSlowFollowers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogEntriesForSlowFollowers |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTick", wireType)
-			}
-			m.HeartbeatTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HeartbeatTick |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectionTick", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
 			}
-			m.ElectionTick = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11645,11 +14378,24 @@ func (m *RaftConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElectionTick |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SpreadOver{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Preference = &PlacementPreference_Spread{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11671,7 +14417,7 @@ func (m *RaftConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
+func (m *Placement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11694,17 +14440,17 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptionConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Placement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Placement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoLockManagers", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11714,12 +14460,52 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoLockManagers = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preferences = append(m.Preferences, &PlacementPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11741,7 +14527,7 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpreadOver) Unmarshal(dAtA []byte) error {
+func (m *JoinTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11764,15 +14550,15 @@ func (m *SpreadOver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpreadOver: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpreadOver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadDescriptor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11797,7 +14583,36 @@ func (m *SpreadOver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpreadDescriptor = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11820,7 +14635,7 @@ func (m *SpreadOver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlacementPreference) Unmarshal(dAtA []byte) error {
+func (m *RootCA) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11843,15 +14658,106 @@ func (m *PlacementPreference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlacementPreference: wiretype end group for non-group")
+			return fmt.Errorf("proto: RootCA: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlacementPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RootCA: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spread", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CAKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CAKey = append(m.CAKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.CAKey == nil {
+				m.CAKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACert = append(m.CACert[:0], dAtA[iNdEx:postIndex]...)
+			if m.CACert == nil {
+				m.CACert = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACertHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11875,11 +14781,9 @@ func (m *PlacementPreference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SpreadOver{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JoinTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Preference = &PlacementPreference_Spread{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11902,7 +14806,7 @@ func (m *PlacementPreference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Placement) Unmarshal(dAtA []byte) error {
+func (m *Certificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11925,17 +14829,36 @@ func (m *Placement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Placement: wiretype end group for non-group")
+			return fmt.Errorf("proto: Certificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Placement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Certificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= (NodeRole(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CSR", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -11945,24 +14868,26 @@ func (m *Placement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Constraints = append(m.Constraints, string(dAtA[iNdEx:postIndex]))
+			m.CSR = append(m.CSR[:0], dAtA[iNdEx:postIndex]...)
+			if m.CSR == nil {
+				m.CSR = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11986,66 +14911,15 @@ func (m *Placement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Preferences = append(m.Preferences, &PlacementPreference{})
-			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JoinTokens) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JoinTokens: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinTokens: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12055,24 +14929,26 @@ func (m *JoinTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12097,7 +14973,7 @@ func (m *JoinTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.CN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12120,7 +14996,7 @@ func (m *JoinTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RootCA) Unmarshal(dAtA []byte) error {
+func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12143,17 +15019,17 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RootCA: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncryptionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RootCA: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncryptionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subsystem", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12163,28 +15039,26 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CAKey = append(m.CAKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.CAKey == nil {
-				m.CAKey = []byte{}
-			}
+			m.Subsystem = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACert", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
-			var byteLen int
+			m.Algorithm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12194,28 +15068,16 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Algorithm |= (EncryptionKey_Algorithm(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CACert = append(m.CACert[:0], dAtA[iNdEx:postIndex]...)
-			if m.CACert == nil {
-				m.CACert = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACertHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12225,26 +15087,28 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CACertHash = string(dAtA[iNdEx:postIndex])
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinTokens", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LamportTime", wireType)
 			}
-			var msglen int
+			m.LamportTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12254,22 +15118,11 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.LamportTime |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.JoinTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12291,7 +15144,7 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Certificate) Unmarshal(dAtA []byte) error {
+func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12314,17 +15167,17 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Certificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Certificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftID", wireType)
 			}
-			m.Role = 0
+			m.RaftID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12334,16 +15187,16 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= (NodeRole(b) & 0x7F) << shift
+				m.RaftID |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CSR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12353,28 +15206,26 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CSR = append(m.CSR[:0], dAtA[iNdEx:postIndex]...)
-			if m.CSR == nil {
-				m.CSR = []byte{}
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12384,58 +15235,17 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Leader = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Certificate == nil {
-				m.Certificate = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CN", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reachability", wireType)
 			}
-			var stringLen uint64
+			m.Reachability = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12445,21 +15255,11 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Reachability |= (RaftMemberStatus_Reachability(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CN = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12481,7 +15281,7 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
+func (m *SecretReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12504,15 +15304,15 @@ func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptionKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subsystem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12537,13 +15337,13 @@ func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subsystem = string(dAtA[iNdEx:postIndex])
+			m.SecretID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
-			m.Algorithm = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12553,16 +15353,26 @@ func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Algorithm |= (EncryptionKey_Algorithm(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12572,28 +15382,29 @@ func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			v := &SecretReference_FileTarget{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Target = &SecretReference_File{v}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LamportTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretVersion", wireType)
 			}
-			m.LamportTime = 0
+			m.SecretVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12603,11 +15414,51 @@ func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LamportTime |= (uint64(b) & 0x7F) << shift
+				m.SecretVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackLatest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackLatest = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Templated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12629,7 +15480,7 @@ func (m *EncryptionKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
+func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12652,17 +15503,17 @@ func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileTarget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileTarget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.RaftID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12672,14 +15523,24 @@ func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RaftID |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12704,13 +15565,13 @@ func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12720,17 +15581,26 @@ func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Leader = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reachability", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			m.Reachability = 0
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12740,7 +15610,7 @@ func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reachability |= (RaftMemberStatus_Reachability(b) & 0x7F) << shift
+				m.Mode |= (os.FileMode(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -12766,7 +15636,7 @@ func (m *ManagerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretReference) Unmarshal(dAtA []byte) error {
+func (m *BlacklistedCertificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12789,73 +15659,15 @@ func (m *SecretReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlacklistedCertificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlacklistedCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecretID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecretName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12879,11 +15691,12 @@ func (m *SecretReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SecretReference_FileTarget{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiry == nil {
+				m.Expiry = &google_protobuf.Timestamp{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Target = &SecretReference_File{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12906,7 +15719,7 @@ func (m *SecretReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
+func (m *HealthConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12929,15 +15742,15 @@ func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileTarget: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Test", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12962,13 +15775,13 @@ func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Test = append(m.Test, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -12978,26 +15791,30 @@ func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			if m.Interval == nil {
+				m.Interval = &google_protobuf1.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13007,26 +15824,30 @@ func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GID = string(dAtA[iNdEx:postIndex])
+			if m.Timeout == nil {
+				m.Timeout = &google_protobuf1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
-			m.Mode = 0
+			m.Retries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13036,7 +15857,7 @@ func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= (os.FileMode(b) & 0x7F) << shift
+				m.Retries |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -13062,7 +15883,7 @@ func (m *SecretReference_FileTarget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlacklistedCertificate) Unmarshal(dAtA []byte) error {
+func (m *MaybeEncryptedRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13085,17 +15906,36 @@ func (m *BlacklistedCertificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlacklistedCertificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaybeEncryptedRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlacklistedCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaybeEncryptedRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= (MaybeEncryptedRecord_Algorithm(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13105,23 +15945,52 @@ func (m *BlacklistedCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiry == nil {
-				m.Expiry = &google_protobuf.Timestamp{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13145,7 +16014,7 @@ func (m *BlacklistedCertificate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthConfig) Unmarshal(dAtA []byte) error {
+func (m *RoleRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13168,15 +16037,15 @@ func (m *HealthConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Test", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13201,11 +16070,11 @@ func (m *HealthConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Test = append(m.Test, string(dAtA[iNdEx:postIndex]))
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13229,18 +16098,7 @@ func (m *HealthConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interval == nil {
-				m.Interval = &google_protobuf1.Duration{}
-			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			var msglen int
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13250,30 +16108,12 @@ func (m *HealthConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Timeout == nil {
-				m.Timeout = &google_protobuf1.Duration{}
-			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
-			}
-			m.Retries = 0
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13283,11 +16123,71 @@ func (m *HealthConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Retries |= (int32(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Labels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Labels[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13309,7 +16209,7 @@ func (m *HealthConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaybeEncryptedRecord) Unmarshal(dAtA []byte) error {
+func (m *AuditCaller) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13332,17 +16232,17 @@ func (m *MaybeEncryptedRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaybeEncryptedRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuditCaller: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaybeEncryptedRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuditCaller: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Algorithm = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13352,16 +16252,26 @@ func (m *MaybeEncryptedRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Algorithm |= (MaybeEncryptedRecord_Algorithm(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13371,28 +16281,26 @@ func (m *MaybeEncryptedRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedBy", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13402,22 +16310,49 @@ func (m *MaybeEncryptedRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
-			if m.Nonce == nil {
-				m.Nonce = []byte{}
+			m.ForwardedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13548,259 +16483,299 @@ var (
 func init() { proto.RegisterFile("types.proto", fileDescriptorTypes) }
 
 var fileDescriptorTypes = []byte{
-	// 4064 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x5a, 0x4d, 0x6c, 0x1b, 0x49,
-	0x76, 0x16, 0x7f, 0x45, 0x3e, 0x52, 0x52, 0xbb, 0xec, 0xf5, 0xca, 0x1c, 0x8f, 0xc4, 0xe9, 0x19,
-	0xef, 0x78, 0xbc, 0x06, 0xc7, 0x96, 0x77, 0x16, 0x9e, 0x31, 0x76, 0x3d, 0xcd, 0x1f, 0x5b, 0x5c,
-	0x4b, 0x24, 0x51, 0xa4, 0xec, 0x9d, 0x43, 0x42, 0x94, 0xba, 0x4b, 0x54, 0x8f, 0x9a, 0x5d, 0x4c,
-	0x77, 0x53, 0x32, 0x13, 0x04, 0x31, 0x72, 0x48, 0x02, 0x9d, 0x72, 0x0c, 0x10, 0x08, 0x41, 0xb0,
-	0x39, 0x04, 0x39, 0xe4, 0x92, 0x43, 0x80, 0x5c, 0x32, 0xc7, 0x39, 0x6e, 0x12, 0x20, 0x58, 0x64,
-	0x01, 0x27, 0xab, 0x9c, 0x83, 0xe4, 0xb2, 0xc8, 0x25, 0x01, 0x82, 0xfa, 0xe9, 0x66, 0x93, 0xa6,
-	0x65, 0x4f, 0x76, 0x2f, 0x52, 0xd7, 0xab, 0xef, 0xbd, 0xfa, 0x79, 0xaf, 0xaa, 0xbe, 0x57, 0x45,
-	0x28, 0x04, 0x93, 0x11, 0xf5, 0x2b, 0x23, 0x8f, 0x05, 0x0c, 0x21, 0x8b, 0x99, 0x47, 0xd4, 0xab,
-	0xf8, 0x27, 0xc4, 0x1b, 0x1e, 0xd9, 0x41, 0xe5, 0xf8, 0x6e, 0x69, 0x73, 0xc0, 0xd8, 0xc0, 0xa1,
-	0x1f, 0x0b, 0xc4, 0xfe, 0xf8, 0xe0, 0xe3, 0xc0, 0x1e, 0x52, 0x3f, 0x20, 0xc3, 0x91, 0x54, 0x2a,
-	0x6d, 0xcc, 0x03, 0xac, 0xb1, 0x47, 0x02, 0x9b, 0xb9, 0xaa, 0xfe, 0xca, 0x80, 0x0d, 0x98, 0xf8,
-	0xfc, 0x98, 0x7f, 0x49, 0xa9, 0xbe, 0x09, 0xcb, 0x4f, 0xa9, 0xe7, 0xdb, 0xcc, 0x45, 0x57, 0x20,
-	0x63, 0xbb, 0x16, 0x7d, 0xbe, 0x9e, 0x28, 0x27, 0x6e, 0xa6, 0xb1, 0x2c, 0xe8, 0x7f, 0x9e, 0x80,
-	0x82, 0xe1, 0xba, 0x2c, 0x10, 0xb6, 0x7c, 0x84, 0x20, 0xed, 0x92, 0x21, 0x15, 0xa0, 0x3c, 0x16,
-	0xdf, 0xa8, 0x06, 0x59, 0x87, 0xec, 0x53, 0xc7, 0x5f, 0x4f, 0x96, 0x53, 0x37, 0x0b, 0x5b, 0xdf,
-	0xad, 0xbc, 0x3a, 0x80, 0x4a, 0xcc, 0x48, 0x65, 0x47, 0xa0, 0x1b, 0x6e, 0xe0, 0x4d, 0xb0, 0x52,
-	0x2d, 0x7d, 0x0a, 0x85, 0x98, 0x18, 0x69, 0x90, 0x3a, 0xa2, 0x13, 0xd5, 0x0c, 0xff, 0xe4, 0xfd,
-	0x3b, 0x26, 0xce, 0x98, 0xae, 0x27, 0x85, 0x4c, 0x16, 0x3e, 0x4b, 0xde, 0x4f, 0xe8, 0x5f, 0x40,
-	0x1e, 0x53, 0x9f, 0x8d, 0x3d, 0x93, 0xfa, 0xe8, 0x23, 0xc8, 0xbb, 0xc4, 0x65, 0x7d, 0x73, 0x34,
-	0xf6, 0x85, 0x7a, 0xaa, 0x5a, 0x3c, 0x7f, 0xb9, 0x99, 0x6b, 0x11, 0x97, 0xd5, 0x3a, 0x7b, 0x3e,
-	0xce, 0xf1, 0xea, 0xda, 0x68, 0xec, 0xa3, 0xf7, 0xa0, 0x38, 0xa4, 0x43, 0xe6, 0x4d, 0xfa, 0xfb,
-	0x93, 0x80, 0xfa, 0xc2, 0x70, 0x0a, 0x17, 0xa4, 0xac, 0xca, 0x45, 0xfa, 0x1f, 0x27, 0xe0, 0x4a,
-	0x68, 0x1b, 0xd3, 0xdf, 0x1a, 0xdb, 0x1e, 0x1d, 0x52, 0x37, 0xf0, 0xd1, 0x27, 0x90, 0x75, 0xec,
-	0xa1, 0x1d, 0xc8, 0x36, 0x0a, 0x5b, 0xef, 0x2e, 0x1a, 0x73, 0xd4, 0x2b, 0xac, 0xc0, 0xc8, 0x80,
-	0xa2, 0x47, 0x7d, 0xea, 0x1d, 0xcb, 0x99, 0x10, 0x4d, 0xbe, 0x51, 0x79, 0x46, 0x45, 0x7f, 0x04,
-	0xb9, 0x8e, 0x43, 0x82, 0x03, 0xe6, 0x0d, 0x91, 0x0e, 0x45, 0xe2, 0x99, 0x87, 0x76, 0x40, 0xcd,
-	0x60, 0xec, 0x85, 0x5e, 0x99, 0x91, 0xa1, 0xab, 0x90, 0x64, 0xb2, 0xa1, 0x7c, 0x35, 0x7b, 0xfe,
-	0x72, 0x33, 0xd9, 0xee, 0xe2, 0x24, 0xf3, 0xf5, 0x07, 0x70, 0xa9, 0xe3, 0x8c, 0x07, 0xb6, 0x5b,
-	0xa7, 0xbe, 0xe9, 0xd9, 0x23, 0x6e, 0x9d, 0xbb, 0x97, 0x47, 0x62, 0xe8, 0x5e, 0xfe, 0x1d, 0xb9,
-	0x3c, 0x39, 0x75, 0xb9, 0xfe, 0x87, 0x49, 0xb8, 0xd4, 0x70, 0x07, 0xb6, 0x4b, 0xe3, 0xda, 0x37,
-	0x60, 0x95, 0x0a, 0x61, 0xff, 0x58, 0x06, 0x95, 0xb2, 0xb3, 0x22, 0xa5, 0x61, 0xa4, 0x35, 0xe7,
-	0xe2, 0xe5, 0xee, 0xa2, 0xe1, 0xbf, 0x62, 0x7d, 0x51, 0xd4, 0xa0, 0x06, 0x2c, 0x8f, 0xc4, 0x20,
-	0xfc, 0xf5, 0x94, 0xb0, 0x75, 0x63, 0x91, 0xad, 0x57, 0xc6, 0x59, 0x4d, 0x7f, 0xfd, 0x72, 0x73,
-	0x09, 0x87, 0xba, 0xbf, 0x4a, 0xf0, 0xfd, 0x7b, 0x02, 0xd6, 0x5a, 0xcc, 0x9a, 0x99, 0x87, 0x12,
-	0xe4, 0x0e, 0x99, 0x1f, 0xc4, 0x16, 0x4a, 0x54, 0x46, 0xf7, 0x21, 0x37, 0x52, 0xee, 0x53, 0xde,
-	0xbf, 0xbe, 0xb8, 0xcb, 0x12, 0x83, 0x23, 0x34, 0x7a, 0x00, 0x79, 0x2f, 0x8c, 0x89, 0xf5, 0xd4,
-	0xdb, 0x04, 0xce, 0x14, 0x8f, 0x7e, 0x00, 0x59, 0xe9, 0x84, 0xf5, 0xb4, 0xd0, 0xbc, 0xf1, 0x56,
-	0x73, 0x8e, 0x95, 0x92, 0xfe, 0xb3, 0x04, 0x68, 0x98, 0x1c, 0x04, 0xbb, 0x74, 0xb8, 0x4f, 0xbd,
-	0x6e, 0x40, 0x82, 0xb1, 0x8f, 0xae, 0x42, 0xd6, 0xa1, 0xc4, 0xa2, 0x9e, 0x18, 0x64, 0x0e, 0xab,
-	0x12, 0xda, 0xe3, 0x41, 0x4e, 0xcc, 0x43, 0xb2, 0x6f, 0x3b, 0x76, 0x30, 0x11, 0xc3, 0x5c, 0x5d,
-	0xec, 0xe5, 0x79, 0x9b, 0x15, 0x1c, 0x53, 0xc4, 0x33, 0x66, 0xd0, 0x3a, 0x2c, 0x0f, 0xa9, 0xef,
-	0x93, 0x01, 0x15, 0xa3, 0xcf, 0xe3, 0xb0, 0xa8, 0x3f, 0x80, 0x62, 0x5c, 0x0f, 0x15, 0x60, 0x79,
-	0xaf, 0xf5, 0xa4, 0xd5, 0x7e, 0xd6, 0xd2, 0x96, 0xd0, 0x1a, 0x14, 0xf6, 0x5a, 0xb8, 0x61, 0xd4,
-	0xb6, 0x8d, 0xea, 0x4e, 0x43, 0x4b, 0xa0, 0x15, 0xc8, 0x4f, 0x8b, 0x49, 0xfd, 0x6f, 0x12, 0x00,
-	0xdc, 0x81, 0x6a, 0x50, 0x9f, 0x41, 0xc6, 0x0f, 0x48, 0x20, 0x1d, 0xb7, 0xba, 0xf5, 0xc1, 0xa2,
-	0x5e, 0x4f, 0xe1, 0x15, 0xfe, 0x8f, 0x62, 0xa9, 0x12, 0xef, 0x61, 0x72, 0xa6, 0x87, 0x7c, 0x0d,
-	0x11, 0xcb, 0xf2, 0x54, 0xc7, 0xc5, 0xb7, 0xfe, 0x00, 0x32, 0x42, 0x7b, 0xb6, 0xbb, 0x39, 0x48,
-	0xd7, 0xf9, 0x57, 0x02, 0xe5, 0x21, 0x83, 0x1b, 0x46, 0xfd, 0x0b, 0x2d, 0x89, 0x34, 0x28, 0xd6,
-	0x9b, 0xdd, 0x5a, 0xbb, 0xd5, 0x6a, 0xd4, 0x7a, 0x8d, 0xba, 0x96, 0xd2, 0x6f, 0x40, 0xa6, 0x39,
-	0xe4, 0x96, 0xaf, 0xf3, 0xa8, 0x38, 0xa0, 0x1e, 0x75, 0xcd, 0x30, 0xd8, 0xa6, 0x02, 0xfd, 0xa7,
-	0x79, 0xc8, 0xec, 0xb2, 0xb1, 0x1b, 0xa0, 0xad, 0xd8, 0xca, 0x5e, 0xdd, 0xda, 0x58, 0x34, 0x2c,
-	0x01, 0xac, 0xf4, 0x26, 0x23, 0xaa, 0x56, 0xfe, 0x55, 0xc8, 0xca, 0xf8, 0x51, 0xc3, 0x51, 0x25,
-	0x2e, 0x0f, 0x88, 0x37, 0xa0, 0x81, 0x1a, 0x8f, 0x2a, 0xa1, 0x9b, 0x90, 0xf3, 0x28, 0xb1, 0x98,
-	0xeb, 0x4c, 0x44, 0x98, 0xe5, 0xe4, 0xd6, 0x8b, 0x29, 0xb1, 0xda, 0xae, 0x33, 0xc1, 0x51, 0x2d,
-	0xda, 0x86, 0xe2, 0xbe, 0xed, 0x5a, 0x7d, 0x36, 0x92, 0xfb, 0x60, 0xe6, 0xf5, 0x41, 0x29, 0x7b,
-	0x55, 0xb5, 0x5d, 0xab, 0x2d, 0xc1, 0xb8, 0xb0, 0x3f, 0x2d, 0xa0, 0x16, 0xac, 0x1e, 0x33, 0x67,
-	0x3c, 0xa4, 0x91, 0xad, 0xac, 0xb0, 0xf5, 0xe1, 0xeb, 0x6d, 0x3d, 0x15, 0xf8, 0xd0, 0xda, 0xca,
-	0x71, 0xbc, 0x88, 0x9e, 0xc0, 0x4a, 0x30, 0x1c, 0x1d, 0xf8, 0x91, 0xb9, 0x65, 0x61, 0xee, 0x3b,
-	0x17, 0x4c, 0x18, 0x87, 0x87, 0xd6, 0x8a, 0x41, 0xac, 0x54, 0xfa, 0xfd, 0x14, 0x14, 0x62, 0x3d,
-	0x47, 0x5d, 0x28, 0x8c, 0x3c, 0x36, 0x22, 0x03, 0xb1, 0x97, 0x2b, 0x5f, 0xdc, 0x7d, 0xab, 0x51,
-	0x57, 0x3a, 0x53, 0x45, 0x1c, 0xb7, 0xa2, 0x9f, 0x25, 0xa1, 0x10, 0xab, 0x44, 0xb7, 0x20, 0x87,
-	0x3b, 0xb8, 0xf9, 0xd4, 0xe8, 0x35, 0xb4, 0xa5, 0xd2, 0xf5, 0xd3, 0xb3, 0xf2, 0xba, 0xb0, 0x16,
-	0x37, 0xd0, 0xf1, 0xec, 0x63, 0x1e, 0x7a, 0x37, 0x61, 0x39, 0x84, 0x26, 0x4a, 0xef, 0x9c, 0x9e,
-	0x95, 0xbf, 0x3d, 0x0f, 0x8d, 0x21, 0x71, 0x77, 0xdb, 0xc0, 0x8d, 0xba, 0x96, 0x5c, 0x8c, 0xc4,
-	0xdd, 0x43, 0xe2, 0x51, 0x0b, 0x7d, 0x07, 0xb2, 0x0a, 0x98, 0x2a, 0x95, 0x4e, 0xcf, 0xca, 0x57,
-	0xe7, 0x81, 0x53, 0x1c, 0xee, 0xee, 0x18, 0x4f, 0x1b, 0x5a, 0x7a, 0x31, 0x0e, 0x77, 0x1d, 0x72,
-	0x4c, 0xd1, 0x07, 0x90, 0x91, 0xb0, 0x4c, 0xe9, 0xda, 0xe9, 0x59, 0xf9, 0x5b, 0xaf, 0x98, 0xe3,
-	0xa8, 0xd2, 0xfa, 0x1f, 0xfd, 0x64, 0x63, 0xe9, 0xef, 0xfe, 0x62, 0x43, 0x9b, 0xaf, 0x2e, 0xfd,
-	0x4f, 0x02, 0x56, 0x66, 0x5c, 0x8e, 0x74, 0xc8, 0xba, 0xcc, 0x64, 0x23, 0xb9, 0xc5, 0xe7, 0xaa,
-	0x70, 0xfe, 0x72, 0x33, 0xdb, 0x62, 0x35, 0x36, 0x9a, 0x60, 0x55, 0x83, 0x9e, 0xcc, 0x1d, 0x52,
-	0xf7, 0xde, 0x32, 0x9e, 0x16, 0x1e, 0x53, 0x0f, 0x61, 0xc5, 0xf2, 0xec, 0x63, 0xea, 0xf5, 0x4d,
-	0xe6, 0x1e, 0xd8, 0x03, 0xb5, 0x7d, 0x97, 0x16, 0xd9, 0xac, 0x0b, 0x20, 0x2e, 0x4a, 0x85, 0x9a,
-	0xc0, 0xff, 0x0a, 0x07, 0x54, 0xe9, 0x29, 0x14, 0xe3, 0x11, 0x8a, 0xde, 0x05, 0xf0, 0xed, 0xdf,
-	0xa6, 0x8a, 0xf3, 0x08, 0x86, 0x84, 0xf3, 0x5c, 0x22, 0x18, 0x0f, 0xfa, 0x10, 0xd2, 0x43, 0x66,
-	0x49, 0x3b, 0x2b, 0xd5, 0xcb, 0xfc, 0x9c, 0xfc, 0x97, 0x97, 0x9b, 0x05, 0xe6, 0x57, 0x1e, 0xd9,
-	0x0e, 0xdd, 0x65, 0x16, 0xc5, 0x02, 0xa0, 0x1f, 0x43, 0x9a, 0x6f, 0x15, 0xe8, 0x1d, 0x48, 0x57,
-	0x9b, 0xad, 0xba, 0xb6, 0x54, 0xba, 0x74, 0x7a, 0x56, 0x5e, 0x11, 0x53, 0xc2, 0x2b, 0x78, 0xec,
-	0xa2, 0x4d, 0xc8, 0x3e, 0x6d, 0xef, 0xec, 0xed, 0xf2, 0xf0, 0xba, 0x7c, 0x7a, 0x56, 0x5e, 0x8b,
-	0xaa, 0xe5, 0xa4, 0xa1, 0x77, 0x21, 0xd3, 0xdb, 0xed, 0x3c, 0xea, 0x6a, 0xc9, 0x12, 0x3a, 0x3d,
-	0x2b, 0xaf, 0x46, 0xf5, 0xa2, 0xcf, 0xa5, 0x4b, 0xca, 0xab, 0xf9, 0x48, 0xae, 0xff, 0x32, 0x09,
-	0x2b, 0x98, 0x53, 0x5f, 0x2f, 0xe8, 0x30, 0xc7, 0x36, 0x27, 0xa8, 0x03, 0x79, 0x93, 0xb9, 0x96,
-	0x1d, 0x5b, 0x53, 0x5b, 0xaf, 0x39, 0x18, 0xa7, 0x5a, 0x61, 0xa9, 0x16, 0x6a, 0xe2, 0xa9, 0x11,
-	0xf4, 0x31, 0x64, 0x2c, 0xea, 0x90, 0x89, 0x3a, 0xa1, 0xaf, 0x55, 0x24, 0xb9, 0xae, 0x84, 0xe4,
-	0xba, 0x52, 0x57, 0xe4, 0x1a, 0x4b, 0x9c, 0xa0, 0x92, 0xe4, 0x79, 0x9f, 0x04, 0x01, 0x1d, 0x8e,
-	0x02, 0x79, 0x3c, 0xa7, 0x71, 0x61, 0x48, 0x9e, 0x1b, 0x4a, 0x84, 0xee, 0x42, 0xf6, 0xc4, 0x76,
-	0x2d, 0x76, 0xa2, 0x4e, 0xe0, 0x0b, 0x8c, 0x2a, 0xa0, 0x7e, 0xca, 0x4f, 0xdd, 0xb9, 0x6e, 0xf2,
-	0xf9, 0x6e, 0xb5, 0x5b, 0x8d, 0x70, 0xbe, 0x55, 0x7d, 0xdb, 0x6d, 0x31, 0x97, 0xaf, 0x15, 0x68,
-	0xb7, 0xfa, 0x8f, 0x8c, 0xe6, 0xce, 0x1e, 0xe6, 0x73, 0x7e, 0xe5, 0xf4, 0xac, 0xac, 0x45, 0x90,
-	0x47, 0xc4, 0x76, 0x38, 0x25, 0xbc, 0x06, 0x29, 0xa3, 0xf5, 0x85, 0x96, 0x2c, 0x69, 0xa7, 0x67,
-	0xe5, 0x62, 0x54, 0x6d, 0xb8, 0x93, 0xe9, 0x32, 0x9a, 0x6f, 0x57, 0xff, 0x79, 0x12, 0x8a, 0x7b,
-	0x23, 0x8b, 0x04, 0x54, 0xc6, 0x24, 0x2a, 0x43, 0x61, 0x44, 0x3c, 0xe2, 0x38, 0xd4, 0xb1, 0xfd,
-	0xa1, 0x4a, 0x1b, 0xe2, 0x22, 0xf4, 0xe9, 0xdb, 0x4e, 0x63, 0x35, 0xc7, 0xe3, 0xec, 0x4f, 0xfe,
-	0x75, 0x33, 0x11, 0x4e, 0xe8, 0x1e, 0xac, 0x1e, 0xc8, 0xde, 0xf6, 0x89, 0x29, 0x1c, 0x9b, 0x12,
-	0x8e, 0xad, 0x2c, 0x72, 0x6c, 0xbc, 0x5b, 0x15, 0x35, 0x48, 0x43, 0x68, 0xe1, 0x95, 0x83, 0x78,
-	0x11, 0xdd, 0x83, 0xe5, 0x21, 0x73, 0xed, 0x80, 0x79, 0x6f, 0xf6, 0x42, 0x88, 0x44, 0xb7, 0xe0,
-	0x12, 0x77, 0x6e, 0xd8, 0x1f, 0x51, 0x2d, 0x4e, 0xac, 0x24, 0x5e, 0x1b, 0x92, 0xe7, 0xaa, 0x41,
-	0xcc, 0xc5, 0xfa, 0xf7, 0x61, 0x65, 0xa6, 0x03, 0xfc, 0x14, 0xef, 0x18, 0x7b, 0xdd, 0x86, 0xb6,
-	0x84, 0x8a, 0x90, 0xab, 0xb5, 0x5b, 0xbd, 0x66, 0x6b, 0x8f, 0xd3, 0x90, 0x22, 0xe4, 0x70, 0x7b,
-	0x67, 0xa7, 0x6a, 0xd4, 0x9e, 0x68, 0x49, 0xfd, 0x3f, 0xa3, 0xd9, 0x55, 0x3c, 0xa4, 0x3a, 0xcb,
-	0x43, 0x6e, 0xbf, 0x7e, 0xdc, 0x8a, 0x89, 0x4c, 0x0b, 0x11, 0x1f, 0xf9, 0x14, 0x40, 0x38, 0x91,
-	0x5a, 0x7d, 0x12, 0x28, 0x27, 0x94, 0x5e, 0x19, 0x70, 0x2f, 0xcc, 0x24, 0x71, 0x5e, 0xa1, 0x8d,
-	0x00, 0xfd, 0x00, 0x8a, 0x26, 0x1b, 0x8e, 0x1c, 0xaa, 0x94, 0x53, 0x6f, 0x54, 0x2e, 0x44, 0x78,
-	0x23, 0x88, 0x33, 0xa1, 0xf4, 0x2c, 0x57, 0xfb, 0x83, 0x04, 0x14, 0x62, 0x5d, 0x9d, 0x25, 0x3f,
-	0x45, 0xc8, 0xed, 0x75, 0xea, 0x46, 0xaf, 0xd9, 0x7a, 0xac, 0x25, 0x10, 0x40, 0x56, 0x4c, 0x5d,
-	0x5d, 0x4b, 0x72, 0xd2, 0x56, 0x6b, 0xef, 0x76, 0x76, 0x1a, 0x82, 0xfe, 0xa0, 0x2b, 0xa0, 0x85,
-	0x93, 0xd7, 0xef, 0xf6, 0x0c, 0xcc, 0xa5, 0x69, 0x74, 0x19, 0xd6, 0x22, 0xa9, 0xd2, 0xcc, 0xa0,
-	0xab, 0x80, 0x22, 0xe1, 0xd4, 0x44, 0x56, 0xff, 0x5d, 0x58, 0xab, 0x31, 0x37, 0x20, 0xb6, 0x1b,
-	0x11, 0xda, 0x2d, 0x3e, 0x68, 0x25, 0xea, 0xdb, 0x96, 0xdc, 0x5f, 0xab, 0x6b, 0xe7, 0x2f, 0x37,
-	0x0b, 0x11, 0xb4, 0x59, 0xe7, 0x23, 0x0d, 0x0b, 0x16, 0x5f, 0x4b, 0x23, 0xdb, 0x12, 0x93, 0x9b,
-	0xa9, 0x2e, 0x9f, 0xbf, 0xdc, 0x4c, 0x75, 0x9a, 0x75, 0xcc, 0x65, 0xe8, 0x1d, 0xc8, 0xd3, 0xe7,
-	0x76, 0xd0, 0x37, 0xf9, 0x7e, 0xca, 0x27, 0x30, 0x83, 0x73, 0x5c, 0x50, 0xe3, 0xdb, 0x67, 0x15,
-	0xa0, 0xc3, 0xbc, 0x40, 0xb5, 0xfc, 0x3d, 0xc8, 0x8c, 0x98, 0x27, 0xb2, 0x49, 0x7e, 0xd8, 0x2c,
-	0xa4, 0x67, 0x1c, 0x2e, 0x63, 0x1c, 0x4b, 0xb0, 0xfe, 0xf7, 0x49, 0x80, 0x1e, 0xf1, 0x8f, 0x94,
-	0x91, 0xfb, 0x90, 0x8f, 0x6e, 0x05, 0x54, 0x5a, 0x7a, 0xa1, 0xb7, 0x23, 0x30, 0xba, 0x17, 0x06,
-	0x9b, 0xa4, 0xea, 0x0b, 0xd3, 0x8a, 0xb0, 0xa1, 0x45, 0x6c, 0x77, 0x96, 0x8f, 0xf3, 0xe3, 0x89,
-	0x7a, 0x9e, 0xf2, 0x3c, 0xff, 0x44, 0x35, 0xb1, 0x45, 0xcb, 0x49, 0x53, 0x64, 0xef, 0xfd, 0x45,
-	0x8d, 0xcc, 0x79, 0x64, 0x7b, 0x09, 0x4f, 0xf5, 0xd0, 0x43, 0x28, 0xf0, 0x71, 0xf7, 0x7d, 0x51,
-	0xa7, 0x78, 0xde, 0x6b, 0xa7, 0x4a, 0x5a, 0xc0, 0x30, 0x8a, 0xbe, 0xab, 0x1a, 0xac, 0x7a, 0x63,
-	0x97, 0x0f, 0x5b, 0xd9, 0xd0, 0x6d, 0xf8, 0x76, 0x8b, 0x06, 0x27, 0xcc, 0x3b, 0x32, 0x82, 0x80,
-	0x98, 0x87, 0x3c, 0xb9, 0x57, 0xdb, 0xdb, 0x94, 0xe4, 0x26, 0x66, 0x48, 0xee, 0x3a, 0x2c, 0x13,
-	0xc7, 0x26, 0x3e, 0x95, 0xcc, 0x20, 0x8f, 0xc3, 0x22, 0xa7, 0xe2, 0x9c, 0xd8, 0x53, 0xdf, 0xa7,
-	0x32, 0x1d, 0xcd, 0xe3, 0xa9, 0x40, 0xff, 0xa7, 0x24, 0x40, 0xb3, 0x63, 0xec, 0x2a, 0xf3, 0x75,
-	0xc8, 0x1e, 0x90, 0xa1, 0xed, 0x4c, 0x2e, 0x5a, 0xe0, 0x53, 0x7c, 0xc5, 0x90, 0x86, 0x1e, 0x09,
-	0x1d, 0xac, 0x74, 0x05, 0x43, 0x1f, 0xef, 0xbb, 0x34, 0x88, 0x18, 0xba, 0x28, 0x71, 0x3a, 0xe0,
-	0x11, 0x37, 0xf2, 0x8c, 0x2c, 0xf0, 0xae, 0x0f, 0x48, 0x40, 0x4f, 0xc8, 0x24, 0x5c, 0x95, 0xaa,
-	0x88, 0xb6, 0x39, 0x73, 0xf7, 0xa9, 0x77, 0x4c, 0xad, 0xf5, 0x8c, 0x08, 0xc1, 0x37, 0xf5, 0x07,
-	0x2b, 0xb8, 0x24, 0x3a, 0x91, 0x76, 0xe9, 0x81, 0x38, 0x9d, 0xa7, 0x55, 0xdf, 0x28, 0x99, 0xbe,
-	0x03, 0x2b, 0x33, 0xe3, 0x7c, 0x25, 0x35, 0x6a, 0x76, 0x9e, 0x7e, 0x4f, 0x4b, 0xab, 0xaf, 0xef,
-	0x6b, 0x59, 0xfd, 0xaf, 0x52, 0x72, 0x1d, 0xa9, 0x59, 0x5d, 0x7c, 0x3d, 0x95, 0x13, 0xd1, 0x6f,
-	0x32, 0x47, 0xc5, 0xf7, 0x87, 0x17, 0x2f, 0x2f, 0x4e, 0xb5, 0x05, 0x1c, 0x47, 0x8a, 0x68, 0x13,
-	0x0a, 0xd2, 0xff, 0x7d, 0x1e, 0x4f, 0x62, 0x5a, 0x57, 0x30, 0x48, 0x11, 0xd7, 0x44, 0x37, 0x60,
-	0x75, 0x34, 0xde, 0x77, 0x6c, 0xff, 0x90, 0x5a, 0x12, 0x93, 0x16, 0x98, 0x95, 0x48, 0x2a, 0x60,
-	0xbb, 0x50, 0x54, 0x82, 0xbe, 0xa0, 0x59, 0x19, 0xd1, 0xa1, 0x5b, 0x6f, 0xea, 0x90, 0x54, 0x11,
-	0xec, 0xab, 0x30, 0x9a, 0x16, 0xf4, 0x3a, 0xe4, 0xc2, 0xce, 0xa2, 0x75, 0x48, 0xf5, 0x6a, 0x1d,
-	0x6d, 0xa9, 0xb4, 0x76, 0x7a, 0x56, 0x2e, 0x84, 0xe2, 0x5e, 0xad, 0xc3, 0x6b, 0xf6, 0xea, 0x1d,
-	0x2d, 0x31, 0x5b, 0xb3, 0x57, 0xef, 0x94, 0xd2, 0xfc, 0xb8, 0xd7, 0x0f, 0xa0, 0x10, 0x6b, 0x01,
-	0xbd, 0x0f, 0xcb, 0xcd, 0xd6, 0x63, 0xdc, 0xe8, 0x76, 0xb5, 0xa5, 0xd2, 0xd5, 0xd3, 0xb3, 0x32,
-	0x8a, 0xd5, 0x36, 0xdd, 0x01, 0xf7, 0x0f, 0x7a, 0x17, 0xd2, 0xdb, 0xed, 0x6e, 0x2f, 0xe4, 0x75,
-	0x31, 0xc4, 0x36, 0xf3, 0x83, 0xd2, 0x65, 0xc5, 0x23, 0xe2, 0x86, 0xf5, 0x3f, 0x4d, 0x40, 0x56,
-	0xd2, 0xdb, 0x85, 0x8e, 0x32, 0x60, 0x39, 0x4c, 0xba, 0x24, 0xe7, 0xfe, 0xf0, 0xf5, 0xfc, 0xb8,
-	0xa2, 0xe8, 0xac, 0x0c, 0xbf, 0x50, 0xaf, 0xf4, 0x19, 0x14, 0xe3, 0x15, 0xdf, 0x28, 0xf8, 0x7e,
-	0x07, 0x0a, 0x3c, 0xbe, 0x43, 0x9e, 0xbc, 0x05, 0x59, 0x49, 0xc1, 0xa3, 0xad, 0xf4, 0xf5, 0x64,
-	0x5d, 0x21, 0xd1, 0x7d, 0x58, 0x96, 0x04, 0x3f, 0xbc, 0x8e, 0xda, 0xb8, 0x78, 0x15, 0xe1, 0x10,
-	0xae, 0x3f, 0x84, 0x74, 0x87, 0x52, 0x8f, 0xcf, 0xbd, 0xcb, 0x2c, 0x3a, 0x3d, 0x7d, 0x54, 0x6e,
-	0x62, 0xd1, 0x66, 0x9d, 0xe7, 0x26, 0x16, 0x6d, 0x5a, 0xd1, 0x6d, 0x42, 0x32, 0x76, 0x9b, 0xd0,
-	0x83, 0xe2, 0x33, 0x6a, 0x0f, 0x0e, 0x03, 0x6a, 0x09, 0x43, 0xb7, 0x21, 0x3d, 0xa2, 0x51, 0xe7,
-	0xd7, 0x17, 0x06, 0x18, 0xa5, 0x1e, 0x16, 0x28, 0xbe, 0x8f, 0x9c, 0x08, 0x6d, 0x75, 0x09, 0xaa,
-	0x4a, 0xfa, 0x3f, 0x26, 0x61, 0xb5, 0xe9, 0xfb, 0x63, 0xe2, 0x9a, 0x21, 0x31, 0xf9, 0xe1, 0x2c,
-	0x31, 0xb9, 0xb9, 0x70, 0x84, 0x33, 0x2a, 0xb3, 0x97, 0x24, 0xea, 0x70, 0x48, 0x46, 0x87, 0x83,
-	0xfe, 0x1f, 0x89, 0xf0, 0x26, 0xe4, 0x46, 0x6c, 0xb9, 0x97, 0xd6, 0x4f, 0xcf, 0xca, 0x57, 0xe2,
-	0x96, 0xe8, 0x9e, 0x7b, 0xe4, 0xb2, 0x13, 0x17, 0xbd, 0x07, 0x19, 0xdc, 0x68, 0x35, 0x9e, 0x69,
-	0x09, 0x19, 0x9e, 0x33, 0x20, 0x4c, 0x5d, 0x7a, 0xc2, 0x2d, 0x75, 0x1a, 0xad, 0x3a, 0x27, 0x12,
-	0xc9, 0x05, 0x96, 0x3a, 0xd4, 0xb5, 0x6c, 0x77, 0x80, 0xde, 0x87, 0x6c, 0xb3, 0xdb, 0xdd, 0x13,
-	0xb9, 0xea, 0xb7, 0x4f, 0xcf, 0xca, 0x97, 0x67, 0x50, 0xbc, 0x40, 0x2d, 0x0e, 0xe2, 0x8c, 0x9a,
-	0x53, 0x8c, 0x05, 0x20, 0x4e, 0xf7, 0x24, 0x08, 0xb7, 0x7b, 0x3c, 0x91, 0xce, 0x2c, 0x00, 0x61,
-	0xc6, 0xff, 0xaa, 0xe5, 0xf6, 0xf3, 0x24, 0x68, 0x86, 0x69, 0xd2, 0x51, 0xc0, 0xeb, 0x55, 0x12,
-	0xd3, 0x83, 0xdc, 0x88, 0x7f, 0xd9, 0x34, 0x24, 0x01, 0xf7, 0x17, 0x5e, 0xa3, 0xcf, 0xe9, 0x55,
-	0x30, 0x73, 0xa8, 0x61, 0x0d, 0x6d, 0xdf, 0xe7, 0xc9, 0xba, 0x90, 0xe1, 0xc8, 0x52, 0xe9, 0xbf,
-	0x12, 0x70, 0x79, 0x01, 0x02, 0xdd, 0x81, 0xb4, 0xc7, 0x9c, 0xd0, 0x87, 0xd7, 0x5f, 0x77, 0xc9,
-	0xc5, 0x55, 0xb1, 0x40, 0xa2, 0x0d, 0x00, 0x32, 0x0e, 0x18, 0x11, 0xed, 0x0b, 0xef, 0xe5, 0x70,
-	0x4c, 0x82, 0x9e, 0x41, 0xd6, 0xa7, 0xa6, 0x47, 0x43, 0xaa, 0xf8, 0xf0, 0xff, 0xdb, 0xfb, 0x4a,
-	0x57, 0x98, 0xc1, 0xca, 0x5c, 0xa9, 0x02, 0x59, 0x29, 0xe1, 0x61, 0x6f, 0x91, 0x80, 0x88, 0x4e,
-	0x17, 0xb1, 0xf8, 0xe6, 0xd1, 0x44, 0x9c, 0x41, 0x18, 0x4d, 0xc4, 0x19, 0xe8, 0x7f, 0x96, 0x04,
-	0x68, 0x3c, 0x0f, 0xa8, 0xe7, 0x12, 0xa7, 0x66, 0xa0, 0x46, 0x6c, 0xf7, 0x97, 0xa3, 0xfd, 0x68,
-	0xe1, 0xd5, 0x67, 0xa4, 0x51, 0xa9, 0x19, 0x0b, 0xf6, 0xff, 0x6b, 0x90, 0x1a, 0x7b, 0x8e, 0xba,
-	0x46, 0x17, 0x34, 0x6f, 0x0f, 0xef, 0x60, 0x2e, 0x43, 0x8d, 0xe9, 0xb6, 0x95, 0x7a, 0xfd, 0xfb,
-	0x47, 0xac, 0x81, 0x5f, 0xff, 0xd6, 0x75, 0x1b, 0x60, 0xda, 0x6b, 0xb4, 0x01, 0x99, 0xda, 0xa3,
-	0x6e, 0x77, 0x47, 0x5b, 0x92, 0x7b, 0xf3, 0xb4, 0x4a, 0x88, 0xf5, 0x9f, 0x24, 0x20, 0x57, 0x33,
-	0xd4, 0x89, 0x59, 0x03, 0x4d, 0x6c, 0x38, 0x26, 0xf5, 0x82, 0x3e, 0x7d, 0x3e, 0xb2, 0xbd, 0x89,
-	0xda, 0x33, 0x2e, 0x48, 0x8d, 0x56, 0xb9, 0x4a, 0x8d, 0x7a, 0x41, 0x43, 0x28, 0x20, 0x0c, 0x45,
-	0xaa, 0xc6, 0xd7, 0x37, 0x49, 0xb8, 0x7d, 0x6f, 0x5c, 0x3c, 0x0f, 0x92, 0x58, 0x4f, 0xcb, 0x3e,
-	0x2e, 0x84, 0x46, 0x6a, 0xc4, 0xd7, 0x9f, 0xc2, 0xe5, 0xb6, 0x67, 0x1e, 0x52, 0x3f, 0x90, 0x8d,
-	0xaa, 0xfe, 0x3e, 0x84, 0xeb, 0x01, 0xf1, 0x8f, 0xfa, 0x87, 0xb6, 0x1f, 0x30, 0x6f, 0xd2, 0xf7,
-	0x68, 0x40, 0x5d, 0x5e, 0xdf, 0x17, 0x4f, 0x2c, 0xea, 0x42, 0xe3, 0x1a, 0xc7, 0x6c, 0x4b, 0x08,
-	0x0e, 0x11, 0x3b, 0x1c, 0xa0, 0x37, 0xa1, 0xc8, 0xa9, 0x6c, 0x9d, 0x1e, 0x90, 0xb1, 0x13, 0xf8,
-	0x3c, 0x49, 0x72, 0xd8, 0xa0, 0xff, 0xd6, 0x7b, 0x7d, 0xde, 0x61, 0x03, 0xf9, 0xa9, 0xff, 0x18,
-	0xb4, 0xba, 0xed, 0x8f, 0x48, 0x60, 0x1e, 0x86, 0x37, 0x35, 0xa8, 0x0e, 0xda, 0x21, 0x25, 0x5e,
-	0xb0, 0x4f, 0x49, 0xd0, 0x1f, 0x51, 0xcf, 0x66, 0xd6, 0x9b, 0xe7, 0x73, 0x2d, 0x52, 0xe9, 0x08,
-	0x0d, 0xfd, 0xbf, 0x13, 0x00, 0x98, 0x1c, 0x84, 0xb4, 0xe6, 0xbb, 0x70, 0xc9, 0x77, 0xc9, 0xc8,
-	0x3f, 0x64, 0x41, 0xdf, 0x76, 0x03, 0xea, 0x1d, 0x13, 0x47, 0x25, 0xdc, 0x5a, 0x58, 0xd1, 0x54,
-	0x72, 0x74, 0x1b, 0xd0, 0x11, 0xa5, 0xa3, 0x3e, 0x73, 0xac, 0x7e, 0x58, 0x29, 0x1f, 0x80, 0xd2,
-	0x58, 0xe3, 0x35, 0x6d, 0xc7, 0xea, 0x86, 0x72, 0x54, 0x85, 0x0d, 0x3e, 0x7c, 0xea, 0x06, 0x9e,
-	0x4d, 0xfd, 0xfe, 0x01, 0xf3, 0xfa, 0xbe, 0xc3, 0x4e, 0xfa, 0x07, 0xcc, 0x71, 0xd8, 0x09, 0xf5,
-	0xc2, 0xbb, 0x8c, 0x92, 0xc3, 0x06, 0x0d, 0x09, 0x7a, 0xc4, 0xbc, 0xae, 0xc3, 0x4e, 0x1e, 0x85,
-	0x08, 0xce, 0x7d, 0xa6, 0x63, 0x0e, 0x6c, 0xf3, 0x28, 0xe4, 0x3e, 0x91, 0xb4, 0x67, 0x9b, 0x47,
-	0xe8, 0x7d, 0x58, 0xa1, 0x0e, 0x15, 0x69, 0xb1, 0x44, 0x65, 0x04, 0xaa, 0x18, 0x0a, 0x39, 0x48,
-	0xff, 0x1c, 0xb4, 0x86, 0x6b, 0x7a, 0x93, 0x51, 0xcc, 0xe7, 0xb7, 0x01, 0xf1, 0x9d, 0xa6, 0xef,
-	0x30, 0xf3, 0xa8, 0x3f, 0x24, 0x2e, 0x19, 0xf0, 0x7e, 0xc9, 0x47, 0x07, 0x8d, 0xd7, 0xec, 0x30,
-	0xf3, 0x68, 0x57, 0xc9, 0xf5, 0x4f, 0x01, 0xba, 0x23, 0x8f, 0x12, 0xab, 0xcd, 0x8f, 0x64, 0x3e,
-	0x75, 0xa2, 0xd4, 0xb7, 0xd4, 0xbb, 0x06, 0xf3, 0xd4, 0xa2, 0xd2, 0x64, 0x45, 0x3d, 0x92, 0xeb,
-	0xbf, 0x01, 0x97, 0x3b, 0x0e, 0x31, 0xc5, 0x1b, 0x5f, 0x27, 0xba, 0x45, 0x47, 0xf7, 0x21, 0x2b,
-	0xa1, 0xca, 0x93, 0x0b, 0x03, 0x7b, 0xda, 0xe6, 0xf6, 0x12, 0x56, 0xf8, 0x6a, 0x11, 0x60, 0x6a,
-	0x47, 0x7f, 0x0e, 0xf9, 0xc8, 0x3c, 0x2a, 0x03, 0xcf, 0x23, 0x79, 0x74, 0xdb, 0xae, 0x4a, 0xfc,
-	0xf2, 0x38, 0x2e, 0x42, 0x4d, 0x28, 0x8c, 0x22, 0xe5, 0x0b, 0x39, 0xd1, 0x82, 0x4e, 0xe3, 0xb8,
-	0xae, 0xfe, 0x43, 0x80, 0x1f, 0x31, 0xdb, 0xed, 0xb1, 0x23, 0xea, 0x8a, 0x87, 0x1b, 0x9e, 0xf2,
-	0xd0, 0x70, 0x22, 0x54, 0x49, 0x64, 0x74, 0x72, 0x16, 0xa3, 0xf7, 0x0b, 0x59, 0xd4, 0xbf, 0x4e,
-	0x40, 0x16, 0x33, 0x16, 0xd4, 0x0c, 0x54, 0x86, 0xac, 0x49, 0xfa, 0xe1, 0xd6, 0x54, 0xac, 0xe6,
-	0xcf, 0x5f, 0x6e, 0x66, 0x6a, 0xc6, 0x13, 0x3a, 0xc1, 0x19, 0x93, 0x3c, 0xa1, 0x13, 0xce, 0x61,
-	0x4c, 0x22, 0x36, 0x14, 0x61, 0xa6, 0x28, 0x39, 0x4c, 0xcd, 0xe0, 0x1b, 0x06, 0xce, 0x9a, 0x84,
-	0xff, 0x47, 0x77, 0xa0, 0xa8, 0x40, 0xfd, 0x43, 0xe2, 0x1f, 0xca, 0x44, 0xa5, 0xba, 0x7a, 0xfe,
-	0x72, 0x13, 0x24, 0x72, 0x9b, 0xf8, 0x87, 0x18, 0x24, 0x9a, 0x7f, 0xa3, 0x06, 0x14, 0xbe, 0x64,
-	0xb6, 0xdb, 0x0f, 0xc4, 0x20, 0xd4, 0xfd, 0xcd, 0x42, 0x57, 0x4c, 0x87, 0xaa, 0x1e, 0xfa, 0xe0,
-	0xcb, 0x48, 0xa2, 0xff, 0x73, 0x02, 0x0a, 0xdc, 0xa6, 0x7d, 0x60, 0x9b, 0x9c, 0x73, 0x7c, 0xf3,
-	0xa3, 0xf0, 0x1a, 0xa4, 0x4c, 0xdf, 0x53, 0x63, 0x13, 0x67, 0x41, 0xad, 0x8b, 0x31, 0x97, 0xa1,
-	0xcf, 0x21, 0xab, 0xb2, 0x53, 0x79, 0x0a, 0xea, 0x6f, 0x66, 0x47, 0xaa, 0x8b, 0x4a, 0x4f, 0x84,
-	0xc5, 0xb4, 0x77, 0x62, 0x94, 0x45, 0x1c, 0x17, 0xa1, 0xab, 0x90, 0x34, 0x5d, 0xb1, 0x76, 0xd4,
-	0x83, 0x6e, 0xad, 0x85, 0x93, 0xa6, 0xab, 0xff, 0x43, 0x02, 0x56, 0xa6, 0x4b, 0x87, 0x3b, 0xe2,
-	0x3a, 0xe4, 0xfd, 0xf1, 0xbe, 0x3f, 0xf1, 0x03, 0x3a, 0x0c, 0xdf, 0x86, 0x22, 0x01, 0x6a, 0x42,
-	0x9e, 0x38, 0x03, 0xe6, 0xd9, 0xc1, 0xe1, 0x50, 0x25, 0x46, 0x8b, 0x4f, 0xae, 0xb8, 0xcd, 0x8a,
-	0x11, 0xaa, 0xe0, 0xa9, 0x76, 0x78, 0x56, 0xa5, 0x44, 0x67, 0xc5, 0x59, 0xf5, 0x1e, 0x14, 0x1d,
-	0x32, 0x14, 0xe9, 0x3a, 0xcf, 0xb7, 0xc5, 0x38, 0xd2, 0xb8, 0xa0, 0x64, 0x3d, 0x7b, 0x48, 0x75,
-	0x1d, 0xf2, 0x91, 0x31, 0xb4, 0x06, 0x05, 0xa3, 0xd1, 0xed, 0xdf, 0xdd, 0xba, 0xdf, 0x7f, 0x5c,
-	0xdb, 0xd5, 0x96, 0x14, 0x55, 0xfa, 0xdb, 0x04, 0xac, 0xa8, 0x85, 0xad, 0xe8, 0xe7, 0xfb, 0xb0,
-	0xec, 0x91, 0x83, 0x20, 0x24, 0xc8, 0x69, 0x19, 0x5c, 0x7c, 0xaf, 0xe4, 0x04, 0x99, 0x57, 0x2d,
-	0x26, 0xc8, 0xb1, 0xd7, 0xca, 0xd4, 0x85, 0xaf, 0x95, 0xe9, 0x5f, 0xcb, 0x6b, 0xa5, 0xfe, 0xd7,
-	0x49, 0x58, 0x53, 0x4c, 0x26, 0xda, 0x47, 0x3e, 0x82, 0xbc, 0x24, 0x35, 0x53, 0x7a, 0x2f, 0x1e,
-	0xc8, 0x24, 0xae, 0x59, 0xc7, 0x39, 0x59, 0xdd, 0xb4, 0x78, 0xbe, 0xa9, 0xa0, 0xb1, 0xb7, 0x77,
-	0x90, 0xa2, 0x16, 0x4f, 0x96, 0xea, 0x90, 0x3e, 0xb0, 0x1d, 0xaa, 0xe2, 0x6c, 0xe1, 0xb5, 0xe8,
-	0x5c, 0xf3, 0xe2, 0x02, 0xbf, 0x27, 0x32, 0xd6, 0xed, 0x25, 0x2c, 0xb4, 0x4b, 0xbf, 0x07, 0x30,
-	0x95, 0x2e, 0x4c, 0xca, 0x38, 0xf1, 0x51, 0xf7, 0x5b, 0x21, 0xf1, 0x69, 0xd6, 0x31, 0x97, 0xf1,
-	0xaa, 0x81, 0x6d, 0xa9, 0x95, 0x2b, 0xaa, 0x1e, 0xf3, 0xaa, 0x81, 0x6d, 0x45, 0xaf, 0x08, 0xe9,
-	0x37, 0xbc, 0x22, 0x54, 0x73, 0xe1, 0x2d, 0x8b, 0xbe, 0x03, 0x57, 0xab, 0x0e, 0x31, 0x8f, 0x1c,
-	0xdb, 0x0f, 0xa8, 0x15, 0x5f, 0xa1, 0x5b, 0x90, 0x9d, 0x21, 0x26, 0x17, 0x5d, 0x6a, 0x29, 0xa4,
-	0xfe, 0x97, 0x09, 0x28, 0x6e, 0x53, 0xe2, 0x04, 0x87, 0xd3, 0x9b, 0x81, 0x80, 0xfa, 0x81, 0xda,
-	0x67, 0xc5, 0x37, 0xfa, 0x04, 0x72, 0xd1, 0x69, 0xfa, 0xc6, 0x9b, 0xfe, 0x08, 0x8a, 0xee, 0xc1,
-	0x32, 0x8f, 0x69, 0x36, 0x0e, 0xb9, 0xee, 0x45, 0x97, 0xc8, 0x0a, 0xc9, 0xf7, 0x56, 0x8f, 0x8a,
-	0xe3, 0x53, 0x4c, 0x4a, 0x06, 0x87, 0x45, 0xfd, 0x7f, 0x13, 0x70, 0x65, 0x97, 0x4c, 0xf6, 0xa9,
-	0x5a, 0x68, 0xd4, 0xc2, 0xd4, 0x64, 0x9e, 0x85, 0x3a, 0xf1, 0x05, 0x7a, 0xc1, 0xbb, 0xc6, 0x22,
-	0xe5, 0xc5, 0xeb, 0x34, 0x64, 0xd0, 0xc9, 0x18, 0x83, 0xbe, 0x02, 0x19, 0x97, 0xb9, 0x26, 0x55,
-	0xab, 0x57, 0x16, 0x74, 0x3b, 0xbe, 0x38, 0x4b, 0xd1, 0x93, 0x83, 0x78, 0x30, 0x68, 0xb1, 0x20,
-	0x6a, 0x0d, 0x7d, 0x0e, 0xa5, 0x6e, 0xa3, 0x86, 0x1b, 0xbd, 0x6a, 0xfb, 0xc7, 0xfd, 0xae, 0xb1,
-	0xd3, 0x35, 0xb6, 0xee, 0xf4, 0x3b, 0xed, 0x9d, 0x2f, 0xee, 0xde, 0xbb, 0xf3, 0x89, 0x96, 0x28,
-	0x95, 0x4f, 0xcf, 0xca, 0xd7, 0x5b, 0x46, 0x6d, 0x47, 0x46, 0xe3, 0x3e, 0x7b, 0xde, 0x25, 0x8e,
-	0x4f, 0xb6, 0xee, 0x74, 0x98, 0x33, 0xe1, 0x98, 0x5b, 0xbf, 0x4c, 0x41, 0x3e, 0xba, 0x5c, 0xe4,
-	0x41, 0xc5, 0x33, 0x3b, 0xd5, 0x54, 0x24, 0x6f, 0xd1, 0x13, 0xf4, 0xde, 0x34, 0xa7, 0xfb, 0x5c,
-	0xbe, 0x6c, 0x44, 0xd5, 0x61, 0x3e, 0xf7, 0x01, 0xe4, 0x8c, 0x6e, 0xb7, 0xf9, 0xb8, 0xd5, 0xa8,
-	0x6b, 0x5f, 0x25, 0x4a, 0xdf, 0x3a, 0x3d, 0x2b, 0x5f, 0x8a, 0x40, 0x86, 0xef, 0xdb, 0x03, 0x97,
-	0x5a, 0x02, 0x55, 0xab, 0x35, 0x3a, 0xbd, 0x46, 0x5d, 0x7b, 0x91, 0x9c, 0x47, 0x89, 0x1c, 0x45,
-	0xbc, 0x4f, 0xe6, 0x3b, 0xb8, 0xd1, 0x31, 0x30, 0x6f, 0xf0, 0xab, 0xa4, 0x4c, 0x35, 0xa7, 0x2d,
-	0x7a, 0x74, 0x44, 0x3c, 0xde, 0xe6, 0x46, 0xf8, 0x4e, 0xff, 0x22, 0x25, 0xdf, 0xb0, 0xa6, 0x37,
-	0xa5, 0x94, 0x58, 0x13, 0xde, 0x9a, 0xb8, 0xa2, 0x16, 0x66, 0x52, 0x73, 0xad, 0x75, 0x03, 0xe2,
-	0x05, 0xdc, 0x8a, 0x0e, 0xcb, 0x78, 0xaf, 0xd5, 0xe2, 0xa0, 0x17, 0xe9, 0xb9, 0xd1, 0xe1, 0xb1,
-	0xeb, 0x72, 0xcc, 0x0d, 0xc8, 0x85, 0x37, 0xd8, 0xda, 0x57, 0xe9, 0xb9, 0x0e, 0xd5, 0xc2, 0xeb,
-	0x77, 0xd1, 0xe0, 0xf6, 0x5e, 0x4f, 0xfc, 0x8c, 0xe0, 0x45, 0x66, 0xbe, 0xc1, 0xc3, 0x71, 0x60,
-	0xf1, 0x24, 0xba, 0x1c, 0x65, 0xb5, 0x5f, 0x65, 0x64, 0x9e, 0x10, 0x61, 0x54, 0x4a, 0xfb, 0x01,
-	0xe4, 0x70, 0xe3, 0x47, 0xf2, 0x17, 0x07, 0x2f, 0xb2, 0x73, 0x76, 0x30, 0xfd, 0x92, 0x9a, 0xaa,
-	0xb5, 0x36, 0xee, 0x6c, 0x1b, 0x62, 0xca, 0xe7, 0x51, 0x6d, 0x6f, 0x74, 0x48, 0x5c, 0x6a, 0x4d,
-	0x1f, 0xf2, 0xa2, 0xaa, 0x5b, 0xbf, 0x09, 0xb9, 0xf0, 0x60, 0x45, 0x1b, 0x90, 0x7d, 0xd6, 0xc6,
-	0x4f, 0x1a, 0x58, 0x5b, 0x92, 0x73, 0x18, 0xd6, 0x3c, 0x93, 0xcc, 0xa4, 0x0c, 0xcb, 0xbb, 0x46,
-	0xcb, 0x78, 0xdc, 0xc0, 0xe1, 0x85, 0x53, 0x08, 0x50, 0xa7, 0x43, 0x49, 0x53, 0x0d, 0x44, 0x36,
-	0xab, 0xeb, 0x5f, 0xff, 0x62, 0x63, 0xe9, 0x67, 0xbf, 0xd8, 0x58, 0x7a, 0x71, 0xbe, 0x91, 0xf8,
-	0xfa, 0x7c, 0x23, 0xf1, 0xd3, 0xf3, 0x8d, 0xc4, 0xbf, 0x9d, 0x6f, 0x24, 0xf6, 0xb3, 0x62, 0x9d,
-	0xde, 0xfb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xe3, 0x0e, 0xba, 0x76, 0x27, 0x00, 0x00,
+	// 4704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4d, 0x6c, 0x1c, 0x47,
+	0x76, 0xe6, 0xfc, 0x72, 0xe6, 0xcd, 0x90, 0x1c, 0x95, 0xb4, 0xf2, 0x68, 0x2c, 0x93, 0xe3, 0xb6,
+	0xbd, 0x96, 0xb5, 0xc2, 0x58, 0xa6, 0xd6, 0x1b, 0xd9, 0xc6, 0x5a, 0x9e, 0x3f, 0x89, 0xb3, 0xa2,
+	0x86, 0x83, 0x1a, 0x52, 0x8a, 0x0f, 0xc9, 0xa4, 0xd8, 0x5d, 0x1c, 0xb6, 0xd9, 0xd3, 0x3d, 0xe9,
+	0xee, 0x11, 0x35, 0x1b, 0x04, 0xab, 0xe4, 0x90, 0x04, 0x3c, 0x25, 0x97, 0x20, 0x40, 0x40, 0x04,
+	0x81, 0x73, 0xc8, 0x7d, 0x0f, 0x01, 0x72, 0x48, 0x7c, 0x49, 0xe0, 0xe3, 0x26, 0x01, 0x82, 0x45,
+	0x16, 0x50, 0xb2, 0xcc, 0x39, 0x48, 0x10, 0x60, 0x6f, 0x09, 0x10, 0xbc, 0xaa, 0xea, 0x9f, 0xa1,
+	0x86, 0xa4, 0x9d, 0xf5, 0x85, 0x9c, 0x7a, 0xf5, 0xbd, 0x57, 0x3f, 0xaf, 0xea, 0xd5, 0xfb, 0x69,
+	0x28, 0xf8, 0xd3, 0x31, 0xf7, 0x6a, 0x63, 0xd7, 0xf1, 0x1d, 0x42, 0x0c, 0x47, 0x3f, 0xe0, 0x6e,
+	0xcd, 0x3b, 0x64, 0xee, 0xe8, 0xc0, 0xf4, 0x6b, 0x4f, 0xdf, 0xab, 0xac, 0x0d, 0x1d, 0x67, 0x68,
+	0xf1, 0x77, 0x05, 0x62, 0x77, 0xb2, 0xf7, 0xae, 0x6f, 0x8e, 0xb8, 0xe7, 0xb3, 0xd1, 0x58, 0x32,
+	0x55, 0x56, 0x4f, 0x03, 0x8c, 0x89, 0xcb, 0x7c, 0xd3, 0xb1, 0x55, 0xff, 0x95, 0xa1, 0x33, 0x74,
+	0xc4, 0xcf, 0x77, 0xf1, 0x97, 0xa4, 0x6a, 0x6b, 0xb0, 0xf8, 0x98, 0xbb, 0x9e, 0xe9, 0xd8, 0xe4,
+	0x0a, 0x64, 0x4c, 0xdb, 0xe0, 0xcf, 0xca, 0x89, 0x6a, 0xe2, 0x46, 0x9a, 0xca, 0x86, 0xf6, 0xe7,
+	0x09, 0x28, 0xd4, 0x6d, 0xdb, 0xf1, 0x85, 0x2c, 0x8f, 0x10, 0x48, 0xdb, 0x6c, 0xc4, 0x05, 0x28,
+	0x4f, 0xc5, 0x6f, 0xd2, 0x84, 0xac, 0xc5, 0x76, 0xb9, 0xe5, 0x95, 0x93, 0xd5, 0xd4, 0x8d, 0xc2,
+	0xfa, 0x77, 0x6a, 0x2f, 0x2f, 0xa0, 0x16, 0x13, 0x52, 0xdb, 0x14, 0xe8, 0xb6, 0xed, 0xbb, 0x53,
+	0xaa, 0x58, 0x2b, 0x1f, 0x40, 0x21, 0x46, 0x26, 0x25, 0x48, 0x1d, 0xf0, 0xa9, 0x1a, 0x06, 0x7f,
+	0xe2, 0xfc, 0x9e, 0x32, 0x6b, 0xc2, 0xcb, 0x49, 0x41, 0x93, 0x8d, 0x0f, 0x93, 0x77, 0x13, 0xda,
+	0xa7, 0x90, 0xa7, 0xdc, 0x73, 0x26, 0xae, 0xce, 0x3d, 0xf2, 0x0e, 0xe4, 0x6d, 0x66, 0x3b, 0x03,
+	0x7d, 0x3c, 0xf1, 0x04, 0x7b, 0xaa, 0x51, 0x3c, 0x79, 0xb1, 0x96, 0xeb, 0x32, 0xdb, 0x69, 0xf6,
+	0x76, 0x3c, 0x9a, 0xc3, 0xee, 0xe6, 0x78, 0xe2, 0x91, 0xd7, 0xa1, 0x38, 0xe2, 0x23, 0xc7, 0x9d,
+	0x0e, 0x76, 0xa7, 0x3e, 0xf7, 0x84, 0xe0, 0x14, 0x2d, 0x48, 0x5a, 0x03, 0x49, 0xda, 0x1f, 0x26,
+	0xe0, 0x4a, 0x20, 0x9b, 0xf2, 0xdf, 0x9c, 0x98, 0x2e, 0x1f, 0x71, 0xdb, 0xf7, 0xc8, 0xfb, 0x90,
+	0xb5, 0xcc, 0x91, 0xe9, 0xcb, 0x31, 0x0a, 0xeb, 0xaf, 0xcd, 0x5b, 0x73, 0x38, 0x2b, 0xaa, 0xc0,
+	0xa4, 0x0e, 0x45, 0x97, 0x7b, 0xdc, 0x7d, 0x2a, 0x77, 0xa2, 0x9c, 0xfc, 0x2a, 0xcc, 0x33, 0x2c,
+	0xda, 0x7d, 0xc8, 0xf5, 0x2c, 0xe6, 0xef, 0x39, 0xee, 0x88, 0x68, 0x50, 0x64, 0xae, 0xbe, 0x6f,
+	0xfa, 0x5c, 0xf7, 0x27, 0x6e, 0xa0, 0x95, 0x19, 0x1a, 0xb9, 0x0a, 0x49, 0x47, 0x0e, 0x94, 0x6f,
+	0x64, 0x4f, 0x5e, 0xac, 0x25, 0xb7, 0xfa, 0x34, 0xe9, 0x78, 0xda, 0x47, 0x70, 0xa9, 0x67, 0x4d,
+	0x86, 0xa6, 0xdd, 0xe2, 0x9e, 0xee, 0x9a, 0x63, 0x94, 0x8e, 0xea, 0xc5, 0x93, 0x18, 0xa8, 0x17,
+	0x7f, 0x87, 0x2a, 0x4f, 0x46, 0x2a, 0xd7, 0x7e, 0x3f, 0x09, 0x97, 0xda, 0xf6, 0xd0, 0xb4, 0x79,
+	0x9c, 0xfb, 0x2d, 0x58, 0xe6, 0x82, 0x38, 0x78, 0x2a, 0x0f, 0x95, 0x92, 0xb3, 0x24, 0xa9, 0xc1,
+	0x49, 0xeb, 0x9c, 0x3a, 0x2f, 0xef, 0xcd, 0x5b, 0xfe, 0x4b, 0xd2, 0xe7, 0x9d, 0x1a, 0xd2, 0x86,
+	0xc5, 0xb1, 0x58, 0x84, 0x57, 0x4e, 0x09, 0x59, 0x6f, 0xcd, 0x93, 0xf5, 0xd2, 0x3a, 0x1b, 0xe9,
+	0x2f, 0x5f, 0xac, 0x2d, 0xd0, 0x80, 0xf7, 0x97, 0x39, 0x7c, 0xff, 0x9e, 0x80, 0x95, 0xae, 0x63,
+	0xcc, 0xec, 0x43, 0x05, 0x72, 0xfb, 0x8e, 0xe7, 0xc7, 0x2e, 0x4a, 0xd8, 0x26, 0x77, 0x21, 0x37,
+	0x56, 0xea, 0x53, 0xda, 0xbf, 0x3e, 0x7f, 0xca, 0x12, 0x43, 0x43, 0x34, 0xf9, 0x08, 0xf2, 0x6e,
+	0x70, 0x26, 0xca, 0xa9, 0xaf, 0x72, 0x70, 0x22, 0x3c, 0xf9, 0x3e, 0x64, 0xa5, 0x12, 0xca, 0xe9,
+	0x6a, 0xe2, 0xac, 0x7d, 0x7a, 0x69, 0xcf, 0xa9, 0x62, 0xd2, 0x7e, 0x9a, 0x80, 0x12, 0x65, 0x7b,
+	0xfe, 0x23, 0x3e, 0xda, 0xe5, 0x6e, 0xdf, 0x67, 0xfe, 0xc4, 0x23, 0x57, 0x21, 0x6b, 0x71, 0x66,
+	0x70, 0x57, 0x2c, 0x32, 0x47, 0x55, 0x8b, 0xec, 0xe0, 0x21, 0x67, 0xfa, 0x3e, 0xdb, 0x35, 0x2d,
+	0xd3, 0x9f, 0x8a, 0x65, 0x2e, 0xcf, 0xd7, 0xf2, 0x69, 0x99, 0x35, 0x1a, 0x63, 0xa4, 0x33, 0x62,
+	0x48, 0x19, 0x16, 0x47, 0xdc, 0xf3, 0xd8, 0x90, 0x8b, 0xd5, 0xe7, 0x69, 0xd0, 0xd4, 0x3e, 0x82,
+	0x62, 0x9c, 0x8f, 0x14, 0x60, 0x71, 0xa7, 0xfb, 0xb0, 0xbb, 0xf5, 0xa4, 0x5b, 0x5a, 0x20, 0x2b,
+	0x50, 0xd8, 0xe9, 0xd2, 0x76, 0xbd, 0xb9, 0x51, 0x6f, 0x6c, 0xb6, 0x4b, 0x09, 0xb2, 0x04, 0xf9,
+	0xa8, 0x99, 0xd4, 0x7e, 0x9c, 0x00, 0x40, 0x05, 0xaa, 0x45, 0x7d, 0x08, 0x19, 0xcf, 0x67, 0xbe,
+	0x54, 0xdc, 0xf2, 0xfa, 0x9b, 0xf3, 0x66, 0x1d, 0xc1, 0x6b, 0xf8, 0x8f, 0x53, 0xc9, 0x12, 0x9f,
+	0x61, 0x72, 0x66, 0x86, 0x78, 0x87, 0x98, 0x61, 0xb8, 0x6a, 0xe2, 0xe2, 0xb7, 0xf6, 0x11, 0x64,
+	0x04, 0xf7, 0xec, 0x74, 0x73, 0x90, 0x6e, 0xe1, 0xaf, 0x04, 0xc9, 0x43, 0x86, 0xb6, 0xeb, 0xad,
+	0x4f, 0x4b, 0x49, 0x52, 0x82, 0x62, 0xab, 0xd3, 0x6f, 0x6e, 0x75, 0xbb, 0xed, 0xe6, 0x76, 0xbb,
+	0x55, 0x4a, 0x69, 0x6f, 0x41, 0xa6, 0x33, 0x42, 0xc9, 0xd7, 0xf1, 0x54, 0xec, 0x71, 0x97, 0xdb,
+	0x7a, 0x70, 0xd8, 0x22, 0x82, 0xf6, 0x93, 0x3c, 0x64, 0x1e, 0x39, 0x13, 0xdb, 0x27, 0xeb, 0xb1,
+	0x9b, 0xbd, 0xbc, 0xbe, 0x3a, 0x6f, 0x59, 0x02, 0x58, 0xdb, 0x9e, 0x8e, 0xb9, 0xba, 0xf9, 0x57,
+	0x21, 0x2b, 0xcf, 0x8f, 0x5a, 0x8e, 0x6a, 0x21, 0xdd, 0x67, 0xee, 0x90, 0xfb, 0x6a, 0x3d, 0xaa,
+	0x45, 0x6e, 0x40, 0xce, 0xe5, 0xcc, 0x70, 0x6c, 0x6b, 0x2a, 0x8e, 0x59, 0x4e, 0x9a, 0x5e, 0xca,
+	0x99, 0xb1, 0x65, 0x5b, 0x53, 0x1a, 0xf6, 0x92, 0x0d, 0x28, 0xee, 0x9a, 0xb6, 0x31, 0x70, 0xc6,
+	0xd2, 0x0e, 0x66, 0xce, 0x3e, 0x94, 0x72, 0x56, 0x0d, 0xd3, 0x36, 0xb6, 0x24, 0x98, 0x16, 0x76,
+	0xa3, 0x06, 0xe9, 0xc2, 0xf2, 0x53, 0xc7, 0x9a, 0x8c, 0x78, 0x28, 0x2b, 0x2b, 0x64, 0xbd, 0x7d,
+	0xb6, 0xac, 0xc7, 0x02, 0x1f, 0x48, 0x5b, 0x7a, 0x1a, 0x6f, 0x92, 0x87, 0xb0, 0xe4, 0x8f, 0xc6,
+	0x7b, 0x5e, 0x28, 0x6e, 0x51, 0x88, 0xfb, 0xf6, 0x39, 0x1b, 0x86, 0xf0, 0x40, 0x5a, 0xd1, 0x8f,
+	0xb5, 0x2a, 0xbf, 0x9b, 0x82, 0x42, 0x6c, 0xe6, 0xa4, 0x0f, 0x85, 0xb1, 0xeb, 0x8c, 0xd9, 0x50,
+	0xd8, 0xf2, 0x72, 0xe2, 0xec, 0x8b, 0xf1, 0xd2, 0xaa, 0x6b, 0xbd, 0x88, 0x91, 0xc6, 0xa5, 0x68,
+	0xc7, 0x49, 0x28, 0xc4, 0x3a, 0xc9, 0x4d, 0xc8, 0xd1, 0x1e, 0xed, 0x3c, 0xae, 0x6f, 0xb7, 0x4b,
+	0x0b, 0x95, 0xeb, 0x47, 0xc7, 0xd5, 0xb2, 0x90, 0x16, 0x17, 0xd0, 0x73, 0xcd, 0xa7, 0x78, 0xf4,
+	0x6e, 0xc0, 0x62, 0x00, 0x4d, 0x54, 0x5e, 0x3d, 0x3a, 0xae, 0xbe, 0x72, 0x1a, 0x1a, 0x43, 0xd2,
+	0xfe, 0x46, 0x9d, 0xb6, 0x5b, 0xa5, 0xe4, 0x7c, 0x24, 0xed, 0xef, 0x33, 0x97, 0x1b, 0xe4, 0xdb,
+	0x90, 0x55, 0xc0, 0x54, 0xa5, 0x72, 0x74, 0x5c, 0xbd, 0x7a, 0x1a, 0x18, 0xe1, 0x68, 0x7f, 0xb3,
+	0xfe, 0xb8, 0x5d, 0x4a, 0xcf, 0xc7, 0xd1, 0xbe, 0xc5, 0x9e, 0x72, 0xf2, 0x26, 0x64, 0x24, 0x2c,
+	0x53, 0xb9, 0x76, 0x74, 0x5c, 0xfd, 0xd6, 0x4b, 0xe2, 0x10, 0x55, 0x29, 0xff, 0xc1, 0xe7, 0xab,
+	0x0b, 0x7f, 0xfd, 0x17, 0xab, 0xa5, 0xd3, 0xdd, 0x95, 0xff, 0x49, 0xc0, 0xd2, 0x8c, 0xca, 0x89,
+	0x06, 0x59, 0xdb, 0xd1, 0x9d, 0xb1, 0x34, 0xf1, 0xb9, 0x06, 0x9c, 0xbc, 0x58, 0xcb, 0x76, 0x9d,
+	0xa6, 0x33, 0x9e, 0x52, 0xd5, 0x43, 0x1e, 0x9e, 0x7a, 0xa4, 0xee, 0x7c, 0xc5, 0xf3, 0x34, 0xf7,
+	0x99, 0xba, 0x07, 0x4b, 0x86, 0x6b, 0x3e, 0xe5, 0xee, 0x40, 0x77, 0xec, 0x3d, 0x73, 0xa8, 0xcc,
+	0x77, 0x65, 0x9e, 0xcc, 0x96, 0x00, 0xd2, 0xa2, 0x64, 0x68, 0x0a, 0xfc, 0x2f, 0xf1, 0x40, 0x55,
+	0x1e, 0x43, 0x31, 0x7e, 0x42, 0xc9, 0x6b, 0x00, 0x9e, 0xf9, 0x43, 0xae, 0x7c, 0x1e, 0xe1, 0x21,
+	0xd1, 0x3c, 0x52, 0x84, 0xc7, 0x43, 0xde, 0x86, 0xf4, 0xc8, 0x31, 0xa4, 0x9c, 0xa5, 0xc6, 0x65,
+	0x7c, 0x27, 0xff, 0xe5, 0xc5, 0x5a, 0xc1, 0xf1, 0x6a, 0xf7, 0x4d, 0x8b, 0x3f, 0x72, 0x0c, 0x4e,
+	0x05, 0x40, 0x7b, 0x0a, 0x69, 0x34, 0x15, 0xe4, 0x55, 0x48, 0x37, 0x3a, 0xdd, 0x56, 0x69, 0xa1,
+	0x72, 0xe9, 0xe8, 0xb8, 0xba, 0x24, 0xb6, 0x04, 0x3b, 0xf0, 0xec, 0x92, 0x35, 0xc8, 0x3e, 0xde,
+	0xda, 0xdc, 0x79, 0x84, 0xc7, 0xeb, 0xf2, 0xd1, 0x71, 0x75, 0x25, 0xec, 0x96, 0x9b, 0x46, 0x5e,
+	0x83, 0xcc, 0xf6, 0xa3, 0xde, 0xfd, 0x7e, 0x29, 0x59, 0x21, 0x47, 0xc7, 0xd5, 0xe5, 0xb0, 0x5f,
+	0xcc, 0xb9, 0x72, 0x49, 0x69, 0x35, 0x1f, 0xd2, 0xb5, 0x5f, 0x24, 0x61, 0x89, 0xa2, 0xeb, 0xeb,
+	0xfa, 0x3d, 0xc7, 0x32, 0xf5, 0x29, 0xe9, 0x41, 0x5e, 0x77, 0x6c, 0xc3, 0x8c, 0xdd, 0xa9, 0xf5,
+	0x33, 0x1e, 0xc6, 0x88, 0x2b, 0x68, 0x35, 0x03, 0x4e, 0x1a, 0x09, 0x21, 0xef, 0x42, 0xc6, 0xe0,
+	0x16, 0x9b, 0xaa, 0x17, 0xfa, 0x5a, 0x4d, 0x3a, 0xd7, 0xb5, 0xc0, 0xb9, 0xae, 0xb5, 0x94, 0x73,
+	0x4d, 0x25, 0x4e, 0xb8, 0x92, 0xec, 0xd9, 0x80, 0xf9, 0x3e, 0x1f, 0x8d, 0x7d, 0xf9, 0x3c, 0xa7,
+	0x69, 0x61, 0xc4, 0x9e, 0xd5, 0x15, 0x89, 0xbc, 0x07, 0xd9, 0x43, 0xd3, 0x36, 0x9c, 0xc3, 0x72,
+	0xfa, 0x22, 0xa1, 0x0a, 0xa8, 0x1d, 0xe1, 0xab, 0x7b, 0x6a, 0x9a, 0xb8, 0xdf, 0xdd, 0xad, 0x6e,
+	0x3b, 0xd8, 0x6f, 0xd5, 0xbf, 0x65, 0x77, 0x1d, 0x1b, 0xef, 0x0a, 0x6c, 0x75, 0x07, 0xf7, 0xeb,
+	0x9d, 0xcd, 0x1d, 0x8a, 0x7b, 0x7e, 0xe5, 0xe8, 0xb8, 0x5a, 0x0a, 0x21, 0xf7, 0x99, 0x69, 0xa1,
+	0x4b, 0x78, 0x0d, 0x52, 0xf5, 0xee, 0xa7, 0xa5, 0x64, 0xa5, 0x74, 0x74, 0x5c, 0x2d, 0x86, 0xdd,
+	0x75, 0x7b, 0x1a, 0x5d, 0xa3, 0xd3, 0xe3, 0x6a, 0x3f, 0x4b, 0x42, 0x71, 0x67, 0x6c, 0x30, 0x9f,
+	0xcb, 0x33, 0x49, 0xaa, 0x50, 0x18, 0x33, 0x97, 0x59, 0x16, 0xb7, 0x4c, 0x6f, 0xa4, 0xc2, 0x86,
+	0x38, 0x89, 0x7c, 0xf0, 0x55, 0xb7, 0xb1, 0x91, 0xc3, 0x73, 0xf6, 0x27, 0xff, 0xba, 0x96, 0x08,
+	0x36, 0x74, 0x07, 0x96, 0xf7, 0xe4, 0x6c, 0x07, 0x4c, 0x17, 0x8a, 0x4d, 0x09, 0xc5, 0xd6, 0xe6,
+	0x29, 0x36, 0x3e, 0xad, 0x9a, 0x5a, 0x64, 0x5d, 0x70, 0xd1, 0xa5, 0xbd, 0x78, 0x93, 0xdc, 0x81,
+	0xc5, 0x91, 0x63, 0x9b, 0xbe, 0xe3, 0x5e, 0xac, 0x85, 0x00, 0x49, 0x6e, 0xc2, 0x25, 0x54, 0x6e,
+	0x30, 0x1f, 0xd1, 0x2d, 0x5e, 0xac, 0x24, 0x5d, 0x19, 0xb1, 0x67, 0x6a, 0x40, 0x8a, 0x64, 0xed,
+	0x7b, 0xb0, 0x34, 0x33, 0x01, 0x7c, 0xc5, 0x7b, 0xf5, 0x9d, 0x7e, 0xbb, 0xb4, 0x40, 0x8a, 0x90,
+	0x6b, 0x6e, 0x75, 0xb7, 0x3b, 0xdd, 0x1d, 0x74, 0x43, 0x8a, 0x90, 0xa3, 0x5b, 0x9b, 0x9b, 0x8d,
+	0x7a, 0xf3, 0x61, 0x29, 0xa9, 0xfd, 0x67, 0xb8, 0xbb, 0xca, 0x0f, 0x69, 0xcc, 0xfa, 0x21, 0xb7,
+	0xce, 0x5e, 0xb7, 0x64, 0x88, 0x35, 0x42, 0x7f, 0xe4, 0x03, 0x00, 0xa1, 0x44, 0x6e, 0x0c, 0x98,
+	0xaf, 0x94, 0x50, 0x79, 0x69, 0xc1, 0xdb, 0x41, 0x24, 0x49, 0xf3, 0x0a, 0x5d, 0xf7, 0xc9, 0xf7,
+	0xa1, 0xa8, 0x3b, 0xa3, 0xb1, 0xc5, 0x15, 0x73, 0xea, 0x42, 0xe6, 0x42, 0x88, 0xaf, 0xfb, 0x71,
+	0x4f, 0x28, 0x3d, 0xeb, 0xab, 0xfd, 0x5e, 0x02, 0x0a, 0xb1, 0xa9, 0xce, 0x3a, 0x3f, 0x45, 0xc8,
+	0xed, 0xf4, 0x5a, 0xf5, 0xed, 0x4e, 0xf7, 0x41, 0x29, 0x41, 0x00, 0xb2, 0x62, 0xeb, 0x5a, 0xa5,
+	0x24, 0x3a, 0x6d, 0xcd, 0xad, 0x47, 0xbd, 0xcd, 0xb6, 0x70, 0x7f, 0xc8, 0x15, 0x28, 0x05, 0x9b,
+	0x37, 0xe8, 0x6f, 0xd7, 0x29, 0x52, 0xd3, 0xe4, 0x32, 0xac, 0x84, 0x54, 0xc5, 0x99, 0x21, 0x57,
+	0x81, 0x84, 0xc4, 0x48, 0x44, 0x56, 0xfb, 0x6d, 0x58, 0x69, 0x3a, 0xb6, 0xcf, 0x4c, 0x3b, 0x74,
+	0x68, 0xd7, 0x71, 0xd1, 0x8a, 0x34, 0x30, 0x0d, 0x69, 0x5f, 0x1b, 0x2b, 0x27, 0x2f, 0xd6, 0x0a,
+	0x21, 0xb4, 0xd3, 0xc2, 0x95, 0x06, 0x0d, 0x03, 0xef, 0xd2, 0xd8, 0x34, 0xc4, 0xe6, 0x66, 0x1a,
+	0x8b, 0x27, 0x2f, 0xd6, 0x52, 0xbd, 0x4e, 0x8b, 0x22, 0x8d, 0xbc, 0x0a, 0x79, 0xfe, 0xcc, 0xf4,
+	0x07, 0x3a, 0xda, 0x53, 0xdc, 0xc0, 0x0c, 0xcd, 0x21, 0xa1, 0x89, 0xe6, 0xb3, 0x01, 0xd0, 0x73,
+	0x5c, 0x5f, 0x8d, 0xfc, 0x5d, 0xc8, 0x8c, 0x1d, 0x57, 0x44, 0x93, 0xf8, 0xd8, 0xcc, 0x75, 0xcf,
+	0x10, 0x2e, 0xcf, 0x38, 0x95, 0x60, 0xed, 0x6f, 0x93, 0x00, 0xdb, 0xcc, 0x3b, 0x50, 0x42, 0xee,
+	0x42, 0x3e, 0xcc, 0x0a, 0x94, 0x13, 0x17, 0x2a, 0x2c, 0x02, 0x93, 0x3b, 0xc1, 0x61, 0x93, 0xae,
+	0xfa, 0xdc, 0xb0, 0x22, 0x18, 0x68, 0x9e, 0xb7, 0x3b, 0xeb, 0x8f, 0xe3, 0xf3, 0xc4, 0x5d, 0x57,
+	0x69, 0x1e, 0x7f, 0x92, 0x26, 0xe4, 0xc3, 0x4d, 0x53, 0xce, 0xde, 0x1b, 0xf3, 0x06, 0x39, 0xa5,
+	0x91, 0x8d, 0x05, 0x1a, 0xf1, 0x91, 0x7b, 0x50, 0xc0, 0x75, 0x0f, 0x3c, 0xd1, 0xa7, 0xfc, 0xbc,
+	0x33, 0xb7, 0x4a, 0x4a, 0xa0, 0x30, 0x0e, 0x7f, 0x37, 0x4a, 0xb0, 0xec, 0x4e, 0x6c, 0x5c, 0xb6,
+	0x92, 0xa1, 0xfd, 0x38, 0x09, 0xaf, 0x74, 0xb9, 0x7f, 0xe8, 0xb8, 0x07, 0x75, 0xdf, 0x67, 0xfa,
+	0x3e, 0x46, 0xf7, 0xca, 0xbe, 0x45, 0x5e, 0x6e, 0x62, 0xc6, 0xcb, 0x2d, 0xc3, 0x22, 0xb3, 0x4c,
+	0xe6, 0x71, 0xe9, 0x1a, 0xe4, 0x69, 0xd0, 0x44, 0x5f, 0x1c, 0x3d, 0x7b, 0xee, 0x79, 0x5c, 0xc6,
+	0xa3, 0x79, 0x1a, 0x11, 0x08, 0x87, 0x65, 0xcf, 0x72, 0xfc, 0x41, 0x04, 0x49, 0x0b, 0x65, 0x7f,
+	0x3c, 0x37, 0xc4, 0x98, 0x3f, 0xa9, 0x5a, 0xdf, 0x72, 0xfc, 0x7a, 0x20, 0x40, 0x3a, 0x19, 0x4b,
+	0x5e, 0x9c, 0x56, 0x61, 0x40, 0x5e, 0x06, 0xc5, 0x3d, 0x86, 0xb4, 0xf4, 0x18, 0xde, 0x8f, 0x7b,
+	0x0c, 0x85, 0xf5, 0xb5, 0x79, 0xb3, 0x88, 0x09, 0x8a, 0xc7, 0xbc, 0x9b, 0x50, 0x88, 0xf5, 0xcc,
+	0x2e, 0x3b, 0x71, 0x7a, 0xd9, 0x6b, 0x50, 0x18, 0x31, 0x3d, 0x58, 0xb5, 0xf2, 0x4f, 0x60, 0xc4,
+	0x74, 0xc5, 0xae, 0xfd, 0x53, 0x12, 0xa0, 0xd3, 0xab, 0x3f, 0x52, 0xdb, 0xde, 0x82, 0xec, 0x1e,
+	0x1b, 0x99, 0xd6, 0xf4, 0x3c, 0xcb, 0x17, 0xe1, 0x6b, 0x4a, 0xcc, 0x7d, 0xc1, 0x43, 0x15, 0xaf,
+	0x08, 0x5d, 0x26, 0xbb, 0x36, 0xf7, 0xc3, 0xd0, 0x45, 0xb4, 0xd0, 0x4f, 0x72, 0x99, 0x1d, 0x1e,
+	0x59, 0xd9, 0x40, 0x95, 0x0e, 0x99, 0xcf, 0x0f, 0xd9, 0x34, 0x30, 0x57, 0xaa, 0x49, 0x36, 0x20,
+	0x27, 0xb3, 0x2f, 0xdc, 0x28, 0x67, 0x84, 0xba, 0x2e, 0x9a, 0x0f, 0x55, 0x70, 0xa9, 0x9c, 0x90,
+	0xbb, 0xf2, 0x91, 0x70, 0x5b, 0xa2, 0xae, 0xaf, 0x95, 0x65, 0xb8, 0x0d, 0x4b, 0x33, 0xeb, 0x7c,
+	0x29, 0x66, 0xec, 0xf4, 0x1e, 0x7f, 0xb7, 0x94, 0x56, 0xbf, 0xbe, 0x57, 0xca, 0x6a, 0x7f, 0x9f,
+	0x92, 0x06, 0x46, 0xed, 0xea, 0xfc, 0xbc, 0x5d, 0x4e, 0x98, 0x05, 0xdd, 0xb1, 0xd4, 0xc5, 0x7f,
+	0xfb, 0x7c, 0xbb, 0x53, 0xeb, 0x29, 0x38, 0x0d, 0x19, 0x51, 0xbd, 0xf2, 0x5e, 0x0c, 0xf0, 0xa2,
+	0x89, 0x6d, 0x5d, 0xa2, 0x20, 0x49, 0xc8, 0x89, 0x49, 0xa1, 0xf1, 0x64, 0xd7, 0x32, 0xbd, 0x7d,
+	0x6e, 0x48, 0x4c, 0x5a, 0x60, 0x96, 0x42, 0xaa, 0x80, 0x3d, 0x82, 0xa2, 0x22, 0x0c, 0x84, 0xff,
+	0x99, 0x11, 0x13, 0xba, 0x79, 0xd1, 0x84, 0x24, 0x8b, 0x70, 0x4b, 0x0b, 0xe3, 0xa8, 0xa1, 0xfd,
+	0x06, 0xe4, 0x82, 0xc9, 0x92, 0x32, 0xa4, 0xb6, 0x9b, 0xbd, 0xd2, 0x42, 0x65, 0xe5, 0xe8, 0xb8,
+	0x5a, 0x08, 0xc8, 0xdb, 0xcd, 0x1e, 0xf6, 0xec, 0xb4, 0x7a, 0xa5, 0xc4, 0x6c, 0xcf, 0x4e, 0xab,
+	0x47, 0x2a, 0x90, 0xee, 0x37, 0xb7, 0x7b, 0x81, 0x8f, 0x14, 0x74, 0x21, 0xad, 0x92, 0x46, 0x1f,
+	0x49, 0xdb, 0x83, 0x42, 0x6c, 0x74, 0xf2, 0x06, 0x2c, 0x76, 0xba, 0x0f, 0x68, 0xbb, 0xdf, 0x2f,
+	0x2d, 0x54, 0xae, 0x1e, 0x1d, 0x57, 0x49, 0xac, 0xb7, 0x63, 0x0f, 0xc5, 0x4d, 0x79, 0x0d, 0xd2,
+	0x1b, 0x5b, 0xfd, 0xed, 0xc0, 0x19, 0x8e, 0x21, 0x36, 0x1c, 0xcf, 0xaf, 0x5c, 0x56, 0xce, 0x57,
+	0x5c, 0xb0, 0xf6, 0x3b, 0x09, 0xc8, 0x50, 0x67, 0xe2, 0x8b, 0x50, 0x3f, 0x48, 0x23, 0x85, 0xf7,
+	0x2c, 0x24, 0xa0, 0x22, 0xc6, 0xcc, 0xdf, 0x1f, 0x8c, 0x5d, 0xbe, 0x67, 0x3e, 0x0b, 0xee, 0x19,
+	0x92, 0x7a, 0x82, 0x72, 0xb1, 0xa6, 0xca, 0xb0, 0x68, 0x4b, 0xb3, 0x13, 0xdc, 0x02, 0xd5, 0xd4,
+	0xfe, 0x28, 0x09, 0x45, 0x9c, 0x83, 0x69, 0x0f, 0xb7, 0xd9, 0xae, 0xc5, 0xc9, 0x27, 0xb0, 0xc8,
+	0x6d, 0xdf, 0x35, 0x79, 0xf0, 0x62, 0xcd, 0x8d, 0x8f, 0xe3, 0x2c, 0x35, 0x79, 0x1f, 0x02, 0xb6,
+	0xca, 0xdf, 0x24, 0x20, 0x23, 0x48, 0xe4, 0x16, 0x00, 0x5e, 0x0b, 0x53, 0xe7, 0xd1, 0x9b, 0xbb,
+	0x74, 0xf2, 0x62, 0x2d, 0xdf, 0x97, 0xd4, 0x4e, 0x8b, 0xe6, 0x15, 0xa0, 0x63, 0xcc, 0x6e, 0x42,
+	0xf2, 0x82, 0x4d, 0x48, 0xbd, 0xb4, 0x09, 0x15, 0xc8, 0xed, 0x32, 0xfd, 0x80, 0xdb, 0x86, 0x34,
+	0xbf, 0x79, 0x1a, 0xb6, 0x71, 0x22, 0x6a, 0xc1, 0x38, 0x91, 0x4c, 0x34, 0x11, 0x65, 0x8c, 0x71,
+	0x22, 0x0a, 0xd0, 0x31, 0xb4, 0x3f, 0x4d, 0x40, 0x56, 0xc6, 0x6a, 0x73, 0x2f, 0x57, 0x1d, 0x16,
+	0x83, 0x0c, 0x82, 0x0c, 0x20, 0xdf, 0x3e, 0x3b, 0xd8, 0xab, 0xa9, 0xd8, 0x4c, 0x6d, 0x91, 0xe2,
+	0xab, 0x7c, 0x08, 0xc5, 0x78, 0xc7, 0xd7, 0x32, 0x18, 0xbf, 0x05, 0x05, 0xb4, 0x49, 0x8a, 0x9f,
+	0xac, 0x43, 0x56, 0xc6, 0x93, 0xa1, 0x5f, 0x70, 0x76, 0xe4, 0xa9, 0x90, 0xe4, 0x2e, 0x2c, 0xca,
+	0x68, 0x35, 0xc8, 0xad, 0xae, 0x9e, 0x6f, 0xf9, 0x68, 0x00, 0xd7, 0xee, 0x41, 0xba, 0xc7, 0xb9,
+	0x8b, 0x77, 0xc2, 0x76, 0x8c, 0x98, 0x5a, 0x55, 0xa0, 0x6d, 0xa0, 0x4e, 0xb3, 0xd8, 0xd5, 0x31,
+	0xc2, 0xd4, 0x58, 0x32, 0x96, 0x1a, 0xdb, 0x86, 0xe2, 0x13, 0x6e, 0x0e, 0xf7, 0x7d, 0x6e, 0x08,
+	0x41, 0xb7, 0x20, 0x3d, 0xe6, 0xe1, 0xe4, 0xcb, 0x73, 0x8d, 0x02, 0xe7, 0x2e, 0x15, 0x28, 0xb4,
+	0xfd, 0x87, 0x82, 0x5b, 0x65, 0xf4, 0x55, 0x4b, 0xfb, 0xc7, 0x24, 0x2c, 0x77, 0x3c, 0x6f, 0xc2,
+	0x6c, 0x3d, 0xf0, 0xb2, 0x3f, 0x9e, 0xf5, 0xb2, 0x6f, 0xcc, 0x5d, 0xe1, 0x0c, 0xcb, 0x6c, 0xc6,
+	0x4f, 0x79, 0x3a, 0xc9, 0xd0, 0xd3, 0xd1, 0xfe, 0x23, 0x11, 0xa4, 0xf5, 0xde, 0x8a, 0x99, 0xe8,
+	0x4a, 0xf9, 0xe8, 0xb8, 0x7a, 0x25, 0x2e, 0x89, 0xef, 0xd8, 0x07, 0xb6, 0x73, 0x68, 0x93, 0xd7,
+	0x31, 0xcd, 0xd7, 0x6d, 0x3f, 0x29, 0x25, 0xa4, 0xd9, 0x98, 0x01, 0x51, 0x6e, 0xf3, 0x43, 0x94,
+	0xd4, 0x6b, 0x77, 0x5b, 0xe8, 0x15, 0x27, 0xe7, 0x48, 0xea, 0x71, 0xdb, 0x30, 0xed, 0x21, 0x79,
+	0x03, 0xb2, 0x9d, 0x7e, 0x7f, 0x47, 0x24, 0x5e, 0x5e, 0x39, 0x3a, 0xae, 0x5e, 0x9e, 0x41, 0x61,
+	0x83, 0x1b, 0x08, 0xc2, 0xf0, 0x10, 0xfd, 0xe5, 0x39, 0x20, 0x8c, 0x5d, 0x24, 0x88, 0x6e, 0x6d,
+	0x63, 0x56, 0x28, 0x33, 0x07, 0x44, 0x1d, 0xfc, 0xab, 0xcc, 0xe0, 0xcf, 0x92, 0x50, 0xaa, 0xeb,
+	0x3a, 0x1f, 0xfb, 0xd8, 0xaf, 0x22, 0xf2, 0x6d, 0xc8, 0x8d, 0xf1, 0x57, 0x64, 0x1f, 0xee, 0xce,
+	0xad, 0x09, 0x9d, 0xe2, 0xab, 0x51, 0xc7, 0xe2, 0x75, 0x63, 0x64, 0x7a, 0x58, 0x27, 0x90, 0x34,
+	0x1a, 0x4a, 0xaa, 0xfc, 0x57, 0x02, 0x2e, 0xcf, 0x41, 0x90, 0xdb, 0x90, 0x76, 0x1d, 0x2b, 0xd0,
+	0xe1, 0xf5, 0xb3, 0x32, 0xb6, 0xc8, 0x4a, 0x05, 0x92, 0xac, 0x02, 0xb0, 0x89, 0xef, 0x30, 0x31,
+	0xbe, 0xd0, 0x5e, 0x8e, 0xc6, 0x28, 0xe4, 0x09, 0x64, 0x3d, 0xae, 0xbb, 0x3c, 0x88, 0x7b, 0xee,
+	0xfd, 0x7f, 0x67, 0x5f, 0xeb, 0x0b, 0x31, 0x54, 0x89, 0xab, 0xd4, 0x20, 0x2b, 0x29, 0x78, 0xec,
+	0x0d, 0xe6, 0x33, 0x31, 0xe9, 0x22, 0x15, 0xbf, 0xf1, 0x34, 0x31, 0x6b, 0x18, 0x9c, 0x26, 0x66,
+	0x0d, 0xb5, 0x3f, 0x4b, 0x02, 0xb4, 0x9f, 0xf9, 0xdc, 0xb5, 0x99, 0xd5, 0xac, 0x93, 0x76, 0xec,
+	0xc5, 0x96, 0xab, 0x7d, 0x67, 0x6e, 0x1e, 0x3f, 0xe4, 0xa8, 0x35, 0xeb, 0x73, 0xde, 0xec, 0x6b,
+	0x90, 0x9a, 0xb8, 0x96, 0xaa, 0x09, 0x89, 0x98, 0x65, 0x87, 0x6e, 0x52, 0xa4, 0x61, 0x41, 0x25,
+	0x30, 0x5b, 0xa9, 0xb3, 0x8b, 0x79, 0xb1, 0x01, 0xbe, 0x79, 0xd3, 0x75, 0x0b, 0x20, 0x9a, 0x35,
+	0x59, 0x85, 0x4c, 0xf3, 0x7e, 0xbf, 0xbf, 0x59, 0x5a, 0x90, 0x6f, 0x66, 0xd4, 0x25, 0xc8, 0xda,
+	0xe7, 0x09, 0xc8, 0x35, 0xeb, 0xca, 0xcb, 0x69, 0x42, 0x49, 0x18, 0x1c, 0x9d, 0xbb, 0xfe, 0x80,
+	0x3f, 0x1b, 0x9b, 0xee, 0xb4, 0x9c, 0xb8, 0x28, 0xce, 0x5f, 0x46, 0x96, 0x26, 0x77, 0xfd, 0xb6,
+	0x60, 0x20, 0x14, 0x8a, 0x5c, 0xad, 0x6f, 0xa0, 0xb3, 0xc0, 0x7c, 0xaf, 0x9e, 0xbf, 0x0f, 0x32,
+	0x4a, 0x8c, 0xda, 0x1e, 0x2d, 0x04, 0x42, 0x9a, 0xcc, 0xd3, 0x1e, 0xc3, 0xe5, 0x2d, 0x57, 0xdf,
+	0xe7, 0x9e, 0x2f, 0x07, 0x55, 0xf3, 0xbd, 0x07, 0xd7, 0x7d, 0xe6, 0x1d, 0x0c, 0xf6, 0x4d, 0xcf,
+	0xc7, 0x3a, 0xa4, 0xcb, 0x7d, 0x6e, 0x63, 0xff, 0x40, 0xd4, 0x0b, 0x55, 0x76, 0xee, 0x1a, 0x62,
+	0x36, 0x24, 0x84, 0x06, 0x88, 0x4d, 0x04, 0x68, 0x1d, 0x28, 0x62, 0x5c, 0xd6, 0xe2, 0x7b, 0x6c,
+	0x62, 0xf9, 0x1e, 0x46, 0xfc, 0x96, 0x33, 0x1c, 0x7c, 0x65, 0x5b, 0x9f, 0xb7, 0x9c, 0xa1, 0xfc,
+	0xa9, 0xfd, 0x77, 0x02, 0x4a, 0x2d, 0xd3, 0x1b, 0x33, 0x5f, 0xdf, 0x0f, 0xf2, 0x8e, 0xa4, 0x05,
+	0xa5, 0x7d, 0xce, 0x5c, 0x7f, 0x97, 0x33, 0x7f, 0x30, 0xe6, 0xae, 0xe9, 0x18, 0x17, 0x6f, 0xe8,
+	0x4a, 0xc8, 0xd2, 0x13, 0x1c, 0xe4, 0x0e, 0x5c, 0x15, 0xcb, 0x94, 0x41, 0x17, 0x26, 0x50, 0xb8,
+	0x5a, 0xa0, 0xc8, 0x32, 0xd2, 0xcb, 0x7e, 0x18, 0xc4, 0x52, 0xe6, 0x73, 0xb1, 0x34, 0xcc, 0xba,
+	0xc4, 0x99, 0x76, 0x27, 0xae, 0x17, 0x38, 0x2d, 0x2b, 0x11, 0xbe, 0x81, 0x64, 0xf2, 0x2b, 0x50,
+	0xc6, 0x0c, 0xcd, 0x58, 0x1a, 0xc2, 0x81, 0xe0, 0x9b, 0x88, 0x24, 0x83, 0xa7, 0xbc, 0xcd, 0x6f,
+	0x8d, 0xd8, 0x33, 0x65, 0x27, 0x71, 0xc3, 0x64, 0x06, 0xc2, 0xd3, 0x3e, 0x4f, 0x02, 0x60, 0x0d,
+	0x4a, 0x2d, 0xf7, 0x3b, 0x70, 0xc9, 0xb3, 0xd9, 0xd8, 0xdb, 0x77, 0xfc, 0x81, 0x69, 0xfb, 0x58,
+	0x74, 0xb5, 0x54, 0xcc, 0x54, 0x0a, 0x3a, 0x3a, 0x8a, 0x4e, 0x6e, 0x01, 0x39, 0xe0, 0x7c, 0x3c,
+	0x70, 0x2c, 0x63, 0x10, 0x74, 0xca, 0xf8, 0x26, 0x4d, 0x4b, 0xd8, 0xb3, 0x65, 0x19, 0xfd, 0x80,
+	0x4e, 0x1a, 0xb0, 0x8a, 0x9a, 0x51, 0xee, 0xcf, 0x60, 0xcf, 0x71, 0x07, 0x9e, 0xe5, 0x1c, 0x0e,
+	0xf6, 0x1c, 0xcb, 0x72, 0x0e, 0xb9, 0x1b, 0xe4, 0x0c, 0x2b, 0x96, 0x33, 0x6c, 0x4b, 0xd0, 0x7d,
+	0xc7, 0xed, 0x5b, 0xce, 0xe1, 0xfd, 0x00, 0x81, 0xae, 0x74, 0xa4, 0x0d, 0xdf, 0xd4, 0x0f, 0x02,
+	0x57, 0x3a, 0xa4, 0x6e, 0x9b, 0xfa, 0x01, 0x79, 0x03, 0x96, 0xb8, 0xc5, 0x45, 0xfa, 0x49, 0xa2,
+	0x32, 0x02, 0x55, 0x0c, 0x88, 0x02, 0x74, 0x33, 0xb6, 0x54, 0x9c, 0x18, 0x66, 0x80, 0x45, 0x48,
+	0x9d, 0xa6, 0x2b, 0x41, 0xc7, 0xa6, 0x33, 0xec, 0x9b, 0x3f, 0xe4, 0xda, 0x27, 0x50, 0x6a, 0xdb,
+	0xba, 0x3b, 0x1d, 0xc7, 0x8e, 0xee, 0x2d, 0x20, 0x68, 0x30, 0x07, 0x96, 0xa3, 0x1f, 0x0c, 0x46,
+	0xcc, 0x66, 0x43, 0x5c, 0x83, 0x2c, 0x04, 0x96, 0xb0, 0x67, 0xd3, 0xd1, 0x0f, 0x1e, 0x29, 0xba,
+	0x76, 0x07, 0xf2, 0xe8, 0x62, 0x52, 0x11, 0x6d, 0x5d, 0x11, 0x8f, 0xae, 0x2b, 0x8f, 0xf7, 0x12,
+	0x95, 0x0d, 0xf1, 0x94, 0xda, 0x86, 0x3a, 0x11, 0xf8, 0x13, 0x3d, 0xac, 0x2b, 0xc8, 0x55, 0xb7,
+	0x2c, 0x47, 0x8f, 0x5f, 0x9b, 0x06, 0x2c, 0x19, 0x53, 0x9b, 0x8d, 0x4c, 0x7d, 0x20, 0x83, 0xb9,
+	0x73, 0x6a, 0xf0, 0xe1, 0xb0, 0xb4, 0xa8, 0x78, 0xe4, 0x24, 0xee, 0xc5, 0x02, 0x3b, 0x79, 0xc3,
+	0xcf, 0x67, 0x57, 0x25, 0xe3, 0x90, 0x49, 0xa3, 0x70, 0x4d, 0x5d, 0x3b, 0x15, 0x99, 0xf5, 0x1c,
+	0xc7, 0xf2, 0xd4, 0x0c, 0xaf, 0x60, 0x3e, 0xc7, 0xb1, 0x02, 0x37, 0x5d, 0x36, 0xd0, 0x3b, 0x95,
+	0x61, 0xa8, 0xdc, 0x6d, 0xb9, 0x54, 0x90, 0x24, 0xb1, 0xd1, 0x7f, 0x97, 0x80, 0x2b, 0x6a, 0xcf,
+	0xfa, 0x3a, 0xb3, 0x4c, 0x7b, 0xa8, 0xe4, 0xbd, 0x03, 0x25, 0x83, 0x7b, 0xa6, 0xcb, 0x8d, 0xd9,
+	0xbd, 0x5e, 0xa2, 0x2b, 0x8a, 0x1e, 0x6c, 0x35, 0x96, 0x89, 0xc7, 0x16, 0xd3, 0xc5, 0x77, 0x0a,
+	0xe7, 0x7d, 0x5f, 0xd0, 0x0b, 0x40, 0x34, 0xc2, 0x93, 0x36, 0x5c, 0x32, 0x9c, 0x43, 0x7b, 0x30,
+	0x74, 0x99, 0xce, 0x83, 0x0b, 0x9f, 0xba, 0xf0, 0xc2, 0x23, 0xcf, 0x03, 0x64, 0x91, 0x17, 0x5e,
+	0xfb, 0x00, 0xa0, 0x3f, 0xc6, 0x62, 0xdf, 0x16, 0x3a, 0x92, 0x78, 0xab, 0x44, 0x6b, 0x60, 0xa8,
+	0xd2, 0xb2, 0xe3, 0xaa, 0xa7, 0xa0, 0x24, 0x3b, 0x5a, 0x21, 0x5d, 0xfb, 0x35, 0xb8, 0x1c, 0xce,
+	0xac, 0x17, 0x16, 0x32, 0xc9, 0x5d, 0xc8, 0x4a, 0xa8, 0xd2, 0xf5, 0x5c, 0x73, 0x1c, 0x8d, 0xb9,
+	0xb1, 0x40, 0x15, 0xbe, 0x51, 0x04, 0x88, 0xe4, 0x68, 0xcf, 0x20, 0x1f, 0x8a, 0xc7, 0x0c, 0xb6,
+	0xee, 0xd8, 0x68, 0x93, 0x4d, 0xdb, 0x0f, 0x74, 0x15, 0x27, 0x91, 0x0e, 0x16, 0xec, 0x02, 0xe6,
+	0x73, 0x3d, 0xf9, 0x39, 0x93, 0xa6, 0x71, 0x5e, 0xed, 0x63, 0x80, 0x1f, 0x38, 0xa6, 0xbd, 0xed,
+	0x1c, 0x70, 0x5b, 0xd4, 0xce, 0x31, 0x8e, 0xe0, 0xc1, 0x46, 0xa8, 0x96, 0x48, 0xaa, 0x49, 0x4d,
+	0x86, 0x25, 0x64, 0xd9, 0xd4, 0xbe, 0x4c, 0x40, 0x96, 0x3a, 0x8e, 0xdf, 0xac, 0x93, 0x2a, 0x64,
+	0x75, 0x36, 0x08, 0x1e, 0xd4, 0x62, 0x23, 0x7f, 0xf2, 0x62, 0x2d, 0xd3, 0xac, 0x3f, 0xe4, 0x53,
+	0x9a, 0xd1, 0xd9, 0x43, 0x3e, 0x45, 0xcf, 0x5b, 0x67, 0xe2, 0x19, 0x14, 0x62, 0x8a, 0xd2, 0xf3,
+	0x6e, 0xd6, 0xf1, 0x99, 0xa3, 0x59, 0x9d, 0xe1, 0x7f, 0x72, 0x1b, 0x8a, 0x0a, 0x34, 0xd8, 0x67,
+	0xde, 0xbe, 0x8c, 0x96, 0x1a, 0xcb, 0x27, 0x2f, 0xd6, 0x40, 0x22, 0x37, 0x98, 0xb7, 0x4f, 0x41,
+	0x67, 0xc1, 0x6f, 0xd2, 0x86, 0xc2, 0x67, 0x8e, 0x69, 0x0f, 0x7c, 0xb1, 0x88, 0x72, 0xfa, 0x6c,
+	0x55, 0x44, 0x4b, 0x55, 0x17, 0x07, 0x3e, 0x0b, 0x29, 0xda, 0x3f, 0x27, 0xa0, 0x80, 0x32, 0xcd,
+	0x3d, 0x53, 0x47, 0x4f, 0xf9, 0xeb, 0x3b, 0x70, 0xd7, 0x20, 0xa5, 0x7b, 0xae, 0x5a, 0x9b, 0xf0,
+	0x60, 0x9a, 0x7d, 0x4a, 0x91, 0x46, 0x3e, 0x81, 0xac, 0x4a, 0x10, 0xca, 0x73, 0xab, 0x5d, 0xec,
+	0xd3, 0xab, 0x29, 0x2a, 0x3e, 0x71, 0x2c, 0xa2, 0xd9, 0x89, 0x55, 0x16, 0x69, 0x9c, 0x84, 0xdf,
+	0xd4, 0xe8, 0x76, 0x39, 0x13, 0x7d, 0x53, 0xd3, 0xec, 0xd2, 0xa4, 0x6e, 0x6b, 0xff, 0x90, 0x80,
+	0xa5, 0xc8, 0x52, 0xa2, 0x22, 0xae, 0x43, 0xde, 0x9b, 0xec, 0x7a, 0x53, 0xcf, 0xe7, 0xa3, 0xa0,
+	0x3c, 0x1f, 0x12, 0x48, 0x07, 0xf2, 0xcc, 0x1a, 0x3a, 0xae, 0xe9, 0xef, 0x8f, 0x54, 0x0a, 0x66,
+	0xbe, 0xbf, 0x15, 0x97, 0x59, 0xab, 0x07, 0x2c, 0x34, 0xe2, 0x0e, 0x3c, 0xac, 0x94, 0x98, 0x2c,
+	0xfe, 0xc4, 0x9a, 0x94, 0xc5, 0x46, 0x22, 0x63, 0x8a, 0x29, 0x4f, 0xb1, 0x8e, 0x34, 0x2d, 0x28,
+	0x1a, 0xe6, 0x81, 0x35, 0x0d, 0xf2, 0xa1, 0x30, 0xfc, 0x50, 0xa2, 0xde, 0xee, 0x0f, 0xde, 0x5b,
+	0xbf, 0x3b, 0x78, 0xd0, 0x7c, 0x54, 0x5a, 0x50, 0x0e, 0xfe, 0x5f, 0x25, 0x60, 0x29, 0xb0, 0x49,
+	0x72, 0x7f, 0xde, 0x80, 0x45, 0x97, 0xed, 0xf9, 0x41, 0x58, 0x97, 0x96, 0x87, 0x0b, 0x9f, 0x51,
+	0x0c, 0xeb, 0xb0, 0x6b, 0x7e, 0x58, 0x17, 0xfb, 0x60, 0x24, 0x75, 0xee, 0x07, 0x23, 0xe9, 0x6f,
+	0xe4, 0x83, 0x11, 0xed, 0x8f, 0x53, 0xb0, 0xa2, 0xfc, 0xef, 0xd0, 0x8e, 0xbc, 0x03, 0x79, 0xe9,
+	0x8a, 0x47, 0x41, 0xa9, 0xf8, 0x46, 0x41, 0xe2, 0x3a, 0x2d, 0x9a, 0x93, 0xdd, 0x1d, 0x43, 0x58,
+	0x6b, 0x09, 0x8d, 0x7d, 0xfe, 0x04, 0x92, 0xd4, 0xc5, 0x10, 0xbf, 0x05, 0xe9, 0x3d, 0xd3, 0xe2,
+	0xea, 0x9c, 0xcd, 0xad, 0x4c, 0x9d, 0x1a, 0x5e, 0xd4, 0x50, 0xb7, 0x45, 0xc6, 0x65, 0x63, 0x81,
+	0x0a, 0x6e, 0x7c, 0xd4, 0xd5, 0x30, 0xc1, 0x47, 0x53, 0x52, 0x51, 0x4b, 0x92, 0x1a, 0x7c, 0x34,
+	0xf5, 0x3a, 0x14, 0x7d, 0x97, 0xe9, 0x07, 0x03, 0x0b, 0x1d, 0x17, 0x5f, 0x1c, 0xbe, 0x1c, 0x2d,
+	0x08, 0xda, 0xa6, 0x20, 0xe1, 0x59, 0xc3, 0x5a, 0x23, 0x02, 0x0c, 0xf1, 0x94, 0xe7, 0x68, 0x44,
+	0xa8, 0xfc, 0x08, 0x20, 0x1a, 0x7d, 0x6e, 0xca, 0x02, 0xc3, 0x02, 0xd3, 0x98, 0x09, 0x0b, 0xb0,
+	0x94, 0x31, 0x31, 0x45, 0x95, 0x63, 0x68, 0x1a, 0xe5, 0x54, 0xd4, 0xf5, 0x00, 0xbb, 0x86, 0xa6,
+	0x11, 0x16, 0x8c, 0xd3, 0x17, 0x14, 0x8c, 0x1b, 0xb9, 0x20, 0x9f, 0xae, 0x6d, 0xc2, 0xd5, 0x86,
+	0xc5, 0xf4, 0x03, 0xcb, 0xf4, 0x7c, 0x6e, 0xc4, 0x2d, 0xc1, 0x3a, 0x64, 0x67, 0xdc, 0xf6, 0xf3,
+	0xea, 0x17, 0x0a, 0xa9, 0xfd, 0x65, 0x02, 0x8a, 0x1b, 0x9c, 0x59, 0xfe, 0x7e, 0x94, 0xeb, 0x14,
+	0x5b, 0x24, 0xed, 0xb9, 0xf8, 0x4d, 0xde, 0x87, 0x5c, 0xe8, 0xd0, 0x5d, 0x58, 0xd4, 0x0d, 0xa1,
+	0x58, 0x2f, 0xc4, 0xbb, 0xe3, 0x4c, 0xfc, 0x8b, 0x5f, 0xc1, 0x00, 0x89, 0x36, 0xdc, 0xe5, 0x32,
+	0x39, 0x96, 0x16, 0x55, 0x9f, 0xa0, 0xa9, 0xfd, 0xaf, 0x78, 0xdf, 0xa7, 0xbb, 0x5c, 0x5d, 0x68,
+	0x6e, 0x50, 0xae, 0x3b, 0xae, 0x81, 0x25, 0xec, 0xc8, 0x10, 0x9c, 0x53, 0xc2, 0x9e, 0xc7, 0x3c,
+	0xdf, 0x1e, 0x04, 0xf1, 0x65, 0x32, 0x16, 0x5f, 0x5e, 0x81, 0x8c, 0xed, 0xe0, 0x77, 0x42, 0xd2,
+	0x4a, 0xc8, 0x86, 0x66, 0xc6, 0x8d, 0x40, 0x25, 0xac, 0x2e, 0x8b, 0xbc, 0x67, 0xd7, 0xf1, 0xc3,
+	0xd1, 0xc8, 0x27, 0x50, 0xe9, 0xb7, 0x9b, 0xb4, 0xbd, 0xdd, 0xd8, 0xfa, 0xd5, 0x41, 0xbf, 0xbe,
+	0xd9, 0xaf, 0xaf, 0xdf, 0x1e, 0xf4, 0xb6, 0x36, 0x3f, 0x7d, 0xef, 0xce, 0xed, 0xf7, 0x4b, 0x89,
+	0x4a, 0xf5, 0xe8, 0xb8, 0x7a, 0xbd, 0x5b, 0x6f, 0x6e, 0xca, 0x53, 0xbf, 0xeb, 0x3c, 0xeb, 0x33,
+	0xcb, 0x63, 0xeb, 0xb7, 0x7b, 0x8e, 0x35, 0x45, 0x0c, 0x7e, 0x4d, 0x9a, 0x13, 0x56, 0x7c, 0x62,
+	0xa9, 0xfa, 0x91, 0xbf, 0xef, 0x18, 0xc1, 0xcb, 0x1b, 0x34, 0xd1, 0x84, 0xcf, 0x7c, 0x7b, 0x71,
+	0x63, 0x7e, 0x72, 0x51, 0xca, 0xf9, 0xa6, 0xbf, 0x26, 0xfd, 0x11, 0x14, 0xea, 0x13, 0xc3, 0xf4,
+	0x9b, 0x58, 0xc6, 0x46, 0x9b, 0x95, 0x0c, 0x2d, 0x85, 0x30, 0xf5, 0x9d, 0x16, 0x4d, 0x9a, 0x06,
+	0x0a, 0xc0, 0x97, 0x28, 0xc8, 0x41, 0xca, 0x06, 0xde, 0xd2, 0x3d, 0xc7, 0x3d, 0x64, 0xae, 0xc1,
+	0x8d, 0xc1, 0xee, 0x54, 0x25, 0x20, 0x0b, 0x21, 0xad, 0x31, 0x45, 0xb3, 0xe2, 0xf2, 0x91, 0xe3,
+	0x73, 0x51, 0x12, 0x51, 0x99, 0x56, 0x90, 0x24, 0xf4, 0x24, 0x6f, 0xfe, 0x22, 0x05, 0xf9, 0xb0,
+	0xd8, 0x86, 0x37, 0x0f, 0x93, 0x43, 0x4a, 0x1f, 0x21, 0xbd, 0xcb, 0x0f, 0xc9, 0xeb, 0x51, 0x5a,
+	0xe8, 0x13, 0x59, 0xe9, 0x0f, 0xbb, 0x83, 0x94, 0xd0, 0x9b, 0x90, 0xab, 0xf7, 0xfb, 0x9d, 0x07,
+	0xdd, 0x76, 0xab, 0xf4, 0x45, 0xa2, 0xf2, 0xad, 0xa3, 0xe3, 0xea, 0xa5, 0x10, 0x54, 0xf7, 0x3c,
+	0x73, 0x68, 0x73, 0x43, 0xa0, 0x9a, 0xcd, 0x76, 0x0f, 0x0b, 0xa3, 0xcf, 0x93, 0xa7, 0x51, 0x22,
+	0xcd, 0x21, 0xbe, 0xd7, 0xc9, 0xf7, 0x68, 0xbb, 0x57, 0xa7, 0x38, 0xe0, 0x17, 0x49, 0x99, 0xad,
+	0x8a, 0x46, 0x74, 0xf9, 0x98, 0xb9, 0x38, 0xe6, 0x6a, 0xf0, 0xdd, 0xda, 0xf3, 0x94, 0xfc, 0xa6,
+	0x23, 0xc4, 0xe0, 0x87, 0x60, 0x53, 0x1c, 0x4d, 0x94, 0x6c, 0x85, 0x98, 0xd4, 0xa9, 0xd1, 0xfa,
+	0x3e, 0x73, 0x31, 0x67, 0x4c, 0x34, 0x58, 0xa4, 0x3b, 0xdd, 0x2e, 0x82, 0x9e, 0xa7, 0x4f, 0xad,
+	0x8e, 0x4e, 0x6c, 0x1b, 0x31, 0x6f, 0x41, 0x2e, 0xa8, 0xe8, 0x96, 0xbe, 0x48, 0x9f, 0x9a, 0x50,
+	0x33, 0x28, 0x47, 0x8b, 0x01, 0x37, 0x76, 0xb6, 0xc5, 0x67, 0x75, 0xcf, 0x33, 0xa7, 0x07, 0xdc,
+	0x9f, 0xf8, 0xe8, 0xbe, 0x92, 0x6a, 0x98, 0x18, 0xfb, 0x22, 0x23, 0x53, 0x0d, 0x21, 0x46, 0x65,
+	0xc5, 0xde, 0x84, 0x1c, 0x6d, 0xff, 0x40, 0x7e, 0x81, 0xf7, 0x3c, 0x7b, 0x4a, 0x0e, 0xe5, 0x9f,
+	0x71, 0x5d, 0x8d, 0xb6, 0x45, 0x7b, 0x1b, 0x75, 0xb1, 0xe5, 0xa7, 0x51, 0x5b, 0xee, 0x78, 0x9f,
+	0xd9, 0xdc, 0x88, 0x3e, 0x6c, 0x09, 0xbb, 0x6e, 0xfe, 0x3a, 0xe4, 0x02, 0x2f, 0x87, 0xac, 0x42,
+	0xf6, 0xc9, 0x16, 0x7d, 0xd8, 0xa6, 0xa5, 0x05, 0xb9, 0x87, 0x41, 0xcf, 0x13, 0xe9, 0x26, 0x56,
+	0x61, 0xf1, 0x51, 0xbd, 0x5b, 0x7f, 0xd0, 0xa6, 0x41, 0x2d, 0x21, 0x00, 0xa8, 0xa7, 0xba, 0x52,
+	0x52, 0x03, 0x84, 0x32, 0x1b, 0xe5, 0x2f, 0x7f, 0xbe, 0xba, 0xf0, 0xd3, 0x9f, 0xaf, 0x2e, 0x3c,
+	0x3f, 0x59, 0x4d, 0x7c, 0x79, 0xb2, 0x9a, 0xf8, 0xc9, 0xc9, 0x6a, 0xe2, 0xdf, 0x4e, 0x56, 0x13,
+	0xbb, 0x59, 0x61, 0xcc, 0xee, 0xfc, 0xdf, 0x00, 0x1b, 0xe5, 0xac, 0x42, 0x86, 0x2e, 0x00, 0x00,
 }
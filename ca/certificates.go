@@ -323,6 +323,16 @@ func (rca *RootCA) ParseValidateAndSignCSR(csrBytes []byte, cn, ou, org string)
 	return cert, nil
 }
 
+// IssueUserCertificate returns a client certificate signed by the rootCA for
+// the user with the given name, who is then subject to role-based access
+// control when calling the Control API.
+func (rca *RootCA) IssueUserCertificate(csrBytes []byte, name, org string) ([]byte, error) {
+	if name == "" {
+		return nil, errors.New("user certificates need a name")
+	}
+	return rca.ParseValidateAndSignCSR(csrBytes, name, UserRole, org)
+}
+
 // NewRootCA creates a new RootCA object from unparsed PEM cert bundle and key byte
 // slices. key may be nil, and in this case NewRootCA will return a RootCA
 // without a signer.
@@ -213,6 +213,27 @@ func TestParseValidateAndSignCSR(t *testing.T) {
 	checkSingleCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
 }
 
+func TestIssueUserCertificate(t *testing.T) {
+	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempBaseDir)
+
+	paths := ca.NewConfigPaths(tempBaseDir)
+
+	rootCA, err := ca.CreateRootCA("rootCN", paths.RootCA)
+	assert.NoError(t, err)
+
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+
+	signedCert, err := rootCA.IssueUserCertificate(csr, "alice", "ORG")
+	assert.NoError(t, err)
+	checkSingleCert(t, signedCert, "rootCN", "alice", ca.UserRole, "ORG")
+
+	_, err = rootCA.IssueUserCertificate(csr, "", "ORG")
+	assert.Error(t, err)
+}
+
 func TestParseValidateAndSignMaliciousCSR(t *testing.T) {
 	tempBaseDir, err := ioutil.TempDir("", "swarm-ca-test-")
 	assert.NoError(t, err)
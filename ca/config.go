@@ -42,6 +42,8 @@ const (
 	WorkerRole = "swarm-worker"
 	// CARole represents the CA node type, and is used for clients attempting to get new certificates issued
 	CARole = "swarm-ca"
+	// UserRole represents a user of the Control API, whose permissions are defined by role-based access control
+	UserRole = "swarm-user"
 
 	generatedSecretEntropyBytes = 16
 	joinTokenBase               = 36
@@ -0,0 +1,14 @@
+package ca
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var issuedCertificatesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "swarm",
+	Subsystem: "ca",
+	Name:      "node_certificates_total",
+	Help:      "Number of node certificate signing attempts, by role and result.",
+}, []string{"role", "result"})
+
+func init() {
+	prometheus.MustRegister(issuedCertificatesCounter)
+}
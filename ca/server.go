@@ -641,6 +641,7 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 			"node.id": node.ID,
 			"method":  "(*Server).signNodeCert",
 		}).WithError(err).Errorf("failed to sign CSR")
+		issuedCertificatesCounter.WithLabelValues(role, "failed").Inc()
 
 		// If the current state is already Failed, no need to change it
 		if node.Certificate.Status.State == api.IssuanceStateFailed {
@@ -702,6 +703,7 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 				"node.role": node.Certificate.Role,
 				"method":    "(*Server).signNodeCert",
 			}).Debugf("certificate issued")
+			issuedCertificatesCounter.WithLabelValues(role, "issued").Inc()
 			delete(s.pending, node.ID)
 			break
 		}
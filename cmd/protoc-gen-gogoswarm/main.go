@@ -4,6 +4,7 @@ import (
 	_ "github.com/docker/swarmkit/protobuf/plugin/authenticatedwrapper"
 	_ "github.com/docker/swarmkit/protobuf/plugin/deepcopy"
 	_ "github.com/docker/swarmkit/protobuf/plugin/raftproxy"
+	_ "github.com/docker/swarmkit/protobuf/plugin/rbacwrapper"
 	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"github.com/gogo/protobuf/vanity"
 	"github.com/gogo/protobuf/vanity/command"
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	mainCmd = &cobra.Command{
+		Use:   os.Args[0],
+		Short: "Tool to inspect and repair the task database of a swarm agent",
+	}
+
+	listCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the tasks stored by the agent, with their status and assignment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return errors.New("list subcommand does not take any arguments")
+			}
+
+			stateDir, err := cmd.Flags().GetString("state-dir")
+			if err != nil {
+				return err
+			}
+
+			return listTasks(stateDir, os.Stdout)
+		},
+	}
+
+	exportCmd = &cobra.Command{
+		Use:   "export",
+		Short: "Export the agent's task database as JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return errors.New("export subcommand does not take any arguments")
+			}
+
+			stateDir, err := cmd.Flags().GetString("state-dir")
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+
+			if output == "" || output == "-" {
+				return exportTasks(stateDir, os.Stdout)
+			}
+
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			if err := exportTasks(stateDir, f); err != nil {
+				f.Close()
+				return err
+			}
+			return f.Close()
+		},
+	}
+
+	deleteOrphansCmd = &cobra.Command{
+		Use:   "delete-orphans",
+		Short: "Delete the task buckets which hold no task or an unassigned task",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return errors.New("delete-orphans subcommand does not take any arguments")
+			}
+
+			stateDir, err := cmd.Flags().GetString("state-dir")
+			if err != nil {
+				return err
+			}
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+
+			return deleteOrphans(stateDir, dryRun, os.Stdout)
+		},
+	}
+)
+
+func init() {
+	mainCmd.PersistentFlags().StringP("state-dir", "d", "./swarmkitstate", "State directory")
+	mainCmd.AddCommand(
+		listCmd,
+		exportCmd,
+		deleteOrphansCmd,
+	)
+
+	exportCmd.Flags().StringP("output", "o", "", "Output file (defaults to stdout)")
+
+	deleteOrphansCmd.Flags().Bool("dry-run", false, "Only print the task buckets which would be deleted")
+}
+
+func main() {
+	if _, err := mainCmd.ExecuteC(); err != nil {
+		os.Exit(-1)
+	}
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/docker/swarmkit/agent"
+	"github.com/docker/swarmkit/api"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// errDBInUse is returned when the node holds the lock on the task database.
+var errDBInUse = errors.New("the task database is in use, stop the node first")
+
+// taskRecord is the content of a task bucket of the agent's database.
+type taskRecord struct {
+	ID string
+	// Task is nil if the bucket holds no task.
+	Task     *api.Task
+	Status   *api.TaskStatus
+	Assigned bool
+}
+
+// orphaned returns true if the worker can't use the task bucket anymore,
+// because it holds no task or the task is no longer assigned to the node.
+// The worker only removes unassigned tasks when it starts.
+func (r taskRecord) orphaned() bool {
+	return r.Task == nil || !r.Assigned
+}
+
+func taskDBPath(stateDir string) string {
+	return filepath.Join(stateDir, "worker", "tasks.db")
+}
+
+func openTaskDB(stateDir string, readOnly bool) (*bolt.DB, error) {
+	path := taskDBPath(stateDir)
+	// bolt would create a missing database
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{ReadOnly: readOnly, Timeout: time.Second})
+	if err == bolt.ErrTimeout {
+		return nil, errDBInUse
+	}
+	return db, err
+}
+
+// readTasks returns the task buckets of the database, sorted by ID.
+func readTasks(tx *bolt.Tx) ([]taskRecord, error) {
+	tasks := make(map[string]*api.Task)
+	if err := agent.WalkTasks(tx, func(task *api.Task) error {
+		// A bucket without task data decodes to an empty task
+		if task.ID != "" {
+			tasks[task.ID] = task
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var records []taskRecord
+	if err := agent.WalkTaskStatus(tx, func(id string, status *api.TaskStatus) error {
+		records = append(records, taskRecord{
+			ID:       id,
+			Task:     tasks[id],
+			Status:   status,
+			Assigned: agent.TaskAssigned(tx, id),
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	sort.Sort(recordsByID(records))
+	return records, nil
+}
+
+func listTasks(stateDir string, out io.Writer) error {
+	db, err := openTaskDB(stateDir, true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var records []taskRecord
+	if err := db.View(func(tx *bolt.Tx) error {
+		records, err = readTasks(tx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tService\tDesired State\tState\tAssigned\tOrphaned")
+	for _, r := range records {
+		service, desiredState := "-", "-"
+		if r.Task != nil {
+			service = fmt.Sprintf("%s.%d", r.Task.ServiceID, r.Task.Slot)
+			desiredState = r.Task.DesiredState.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%t\n",
+			r.ID,
+			service,
+			desiredState,
+			r.Status.State.String(),
+			r.Assigned,
+			r.orphaned(),
+		)
+	}
+	return w.Flush()
+}
+
+// exportedTask is the JSON representation of a task bucket.
+type exportedTask struct {
+	ID       string          `json:"id"`
+	Assigned bool            `json:"assigned"`
+	Orphaned bool            `json:"orphaned"`
+	Task     json.RawMessage `json:"task,omitempty"`
+	Status   json.RawMessage `json:"status"`
+}
+
+func exportTasks(stateDir string, out io.Writer) error {
+	db, err := openTaskDB(stateDir, true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var records []taskRecord
+	if err := db.View(func(tx *bolt.Tx) error {
+		records, err = readTasks(tx)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	exported := make([]exportedTask, 0, len(records))
+	for _, r := range records {
+		e := exportedTask{
+			ID:       r.ID,
+			Assigned: r.Assigned,
+			Orphaned: r.orphaned(),
+		}
+		if r.Task != nil {
+			if e.Task, err = marshalJSON(r.Task); err != nil {
+				return err
+			}
+		}
+		if e.Status, err = marshalJSON(r.Status); err != nil {
+			return err
+		}
+		exported = append(exported, e)
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(exported)
+}
+
+func marshalJSON(m proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// deleteOrphans removes the orphaned task buckets from the database. With
+// dryRun, they are only listed.
+func deleteOrphans(stateDir string, dryRun bool, out io.Writer) error {
+	db, err := openTaskDB(stateDir, dryRun)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	update := db.Update
+	if dryRun {
+		update = db.View
+	}
+	return update(func(tx *bolt.Tx) error {
+		records, err := readTasks(tx)
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if !r.orphaned() {
+				continue
+			}
+			if !dryRun {
+				if err := agent.DeleteTask(tx, r.ID); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(out, "%s\n", r.ID)
+		}
+		return nil
+	})
+}
+
+type recordsByID []taskRecord
+
+func (r recordsByID) Len() int           { return len(r) }
+func (r recordsByID) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r recordsByID) Less(i, j int) bool { return r[i].ID < r[j].ID }
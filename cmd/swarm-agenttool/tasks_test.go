@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/docker/swarmkit/agent"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTaskDB creates a task database with an assigned task, an unassigned
+// task and a bucket holding only a status.
+func createTaskDB(t *testing.T, stateDir string) {
+	require.NoError(t, os.MkdirAll(filepath.Join(stateDir, "worker"), 0700))
+	db, err := bolt.Open(taskDBPath(stateDir), 0600, nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, agent.InitDB(db))
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		for _, task := range []*api.Task{
+			{ID: "assigned", ServiceID: "service", Slot: 1, DesiredState: api.TaskStateRunning},
+			{ID: "unassigned", ServiceID: "service", Slot: 2, DesiredState: api.TaskStateShutdown},
+		} {
+			require.NoError(t, agent.PutTask(tx, task))
+			require.NoError(t, agent.PutTaskStatus(tx, task.ID, &api.TaskStatus{
+				State:     api.TaskStateRunning,
+				Timestamp: ptypes.MustTimestampProto(time.Unix(1000, 0)),
+			}))
+			require.NoError(t, agent.SetTaskAssignment(tx, task.ID, task.ID == "assigned"))
+		}
+		return agent.PutTaskStatus(tx, "statusonly", &api.TaskStatus{State: api.TaskStateFailed})
+	}))
+}
+
+func TestAgentTool(t *testing.T) {
+	stateDir, err := ioutil.TempDir("", "agenttool")
+	require.NoError(t, err)
+	defer os.RemoveAll(stateDir)
+
+	require.Error(t, listTasks(stateDir, ioutil.Discard))
+	_, err = os.Stat(taskDBPath(stateDir))
+	require.True(t, os.IsNotExist(err))
+
+	createTaskDB(t, stateDir)
+
+	var out bytes.Buffer
+	require.NoError(t, listTasks(stateDir, &out))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Equal(t, []string{"assigned", "service.1", "RUNNING", "RUNNING", "true", "false"}, strings.Fields(lines[1]))
+	assert.Equal(t, []string{"statusonly", "-", "-", "FAILED", "false", "true"}, strings.Fields(lines[2]))
+	assert.Equal(t, []string{"unassigned", "service.2", "SHUTDOWN", "RUNNING", "false", "true"}, strings.Fields(lines[3]))
+
+	out.Reset()
+	require.NoError(t, exportTasks(stateDir, &out))
+	var exported []struct {
+		ID       string
+		Assigned bool
+		Orphaned bool
+		Task     *struct {
+			ID        string
+			ServiceID string
+		}
+		Status struct {
+			State     string
+			Timestamp string
+		}
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &exported))
+	require.Len(t, exported, 3)
+	assert.Equal(t, "assigned", exported[0].ID)
+	assert.True(t, exported[0].Assigned)
+	assert.Equal(t, "service", exported[0].Task.ServiceID)
+	assert.Equal(t, "RUNNING", exported[0].Status.State)
+	assert.Equal(t, "1970-01-01T00:16:40.000Z", exported[0].Status.Timestamp)
+	assert.Nil(t, exported[1].Task)
+	assert.Equal(t, "FAILED", exported[1].Status.State)
+	assert.True(t, exported[2].Orphaned)
+
+	// a dry run doesn't change anything
+	out.Reset()
+	require.NoError(t, deleteOrphans(stateDir, true, &out))
+	assert.Equal(t, "statusonly\nunassigned\n", out.String())
+
+	out.Reset()
+	require.NoError(t, deleteOrphans(stateDir, false, &out))
+	assert.Equal(t, "statusonly\nunassigned\n", out.String())
+
+	out.Reset()
+	require.NoError(t, listTasks(stateDir, &out))
+	lines = strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "assigned", strings.Fields(lines[1])[0])
+}
@@ -128,6 +128,33 @@ var (
 			return dumpObject(stateDir, unlockKey, args[0], selector)
 		},
 	}
+
+	verifyCmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Check the integrity of a swarm manager's raft logs and snapshots",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return errors.New("verify subcommand does not take any arguments")
+			}
+
+			stateDir, err := cmd.Flags().GetString("state-dir")
+			if err != nil {
+				return err
+			}
+
+			unlockKey, err := cmd.Flags().GetString("unlock-key")
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			return verifyRaftData(stateDir, unlockKey, format, os.Stdout)
+		},
+	}
 )
 
 func init() {
@@ -139,6 +166,7 @@ func init() {
 		dumpWALCmd,
 		dumpSnapshotCmd,
 		dumpObjectCmd,
+		verifyCmd,
 	)
 
 	dumpWALCmd.Flags().Uint64("start", 0, "Start of index range to dump")
@@ -146,6 +174,8 @@ func init() {
 
 	dumpObjectCmd.Flags().String("id", "", "Look up object by ID")
 	dumpObjectCmd.Flags().String("name", "", "Look up object by name")
+
+	verifyCmd.Flags().String("format", "text", "Output format (text or json)")
 }
 
 func main() {
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/swarmkit/manager/state/raft/storage"
+)
+
+// errVerifyFailed is returned when problems were found in the raft data, so
+// that the command exits with a non-zero status.
+var errVerifyFailed = errors.New("raft data failed verification")
+
+func verifyRaftData(swarmdir, unlockKey, format string, out io.Writer) error {
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unknown output format %q", format)
+	}
+
+	logger := storage.EncryptedRaftLogger{
+		StateDir: filepath.Join(swarmdir, "raft"),
+	}
+	var oldKeys [][]byte
+
+	// The key is only needed if the encrypted WAL is present
+	if _, err := os.Stat(filepath.Join(swarmdir, "raft", "wal-v3-encrypted")); err == nil {
+		krw, err := getKRW(swarmdir, unlockKey)
+		if err != nil {
+			return err
+		}
+		deks, err := getDEKData(krw)
+		if err != nil {
+			return err
+		}
+		logger.EncryptionKey = deks.CurrentDEK
+		if deks.PendingDEK != nil {
+			oldKeys = append(oldKeys, deks.PendingDEK)
+		}
+	}
+
+	report, err := logger.Verify(context.Background(), oldKeys...)
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(out, "WAL directory: %s\n", report.WALDir)
+		fmt.Fprintf(out, "Snapshot directory: %s\n", report.SnapDir)
+		for _, s := range report.Snapshots {
+			status := "ok"
+			if s.Error != "" {
+				status = s.Error
+			}
+			fmt.Fprintf(out, "Snapshot %s (Index=%d, Term=%d): %s\n", s.Name, s.Index, s.Term, status)
+		}
+		fmt.Fprintf(out, "Entries: %d (Index %d to %d, Commit=%d)\n", report.Entries, report.FirstIndex, report.LastIndex, report.CommitIndex)
+		for _, p := range report.Problems {
+			fmt.Fprintf(out, "Problem: %s\n", p)
+		}
+	}
+
+	if !report.OK() {
+		return errVerifyFailed
+	}
+	return nil
+}
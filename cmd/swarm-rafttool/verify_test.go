@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/ca/testutils"
+	"github.com/docker/swarmkit/manager"
+	"github.com/docker/swarmkit/manager/encryption"
+	"github.com/docker/swarmkit/manager/state/raft"
+	"github.com/docker/swarmkit/manager/state/raft/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "rafttool")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	kek := []byte("kek")
+	dek := []byte("dek")
+	unlockKey := encryption.HumanReadableKey(kek)
+
+	paths := certPaths(tempdir)
+	krw := ca.NewKeyReadWriter(paths.Node, kek,
+		manager.RaftDEKData{EncryptionKeys: raft.EncryptionKeys{CurrentDEK: dek}})
+	cert, key, err := testutils.CreateRootCertAndKey("not really a root, just need cert and key")
+	require.NoError(t, err)
+	require.NoError(t, krw.Write(cert, key, nil))
+
+	// the fake entries are not raft requests, so verification finds problems
+	snapshot := raftpb.Snapshot{
+		Metadata: raftpb.SnapshotMetadata{
+			Index: 1,
+			Term:  1,
+		},
+		Data: []byte{},
+	}
+	e, d := encryption.Defaults(dek)
+	writeFakeRaftData(t, tempdir, &snapshot, storage.NewWALFactory(e, d), storage.NewSnapFactory(e, d))
+
+	// the wrong unlock key can't read the DEK
+	err = verifyRaftData(tempdir, "", "json", ioutil.Discard)
+	require.IsType(t, ca.ErrInvalidKEK{}, err)
+
+	require.Error(t, verifyRaftData(tempdir, unlockKey, "yaml", ioutil.Discard))
+
+	var out bytes.Buffer
+	require.Equal(t, errVerifyFailed, verifyRaftData(tempdir, unlockKey, "json", &out))
+
+	var report storage.VerifyReport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	require.Len(t, report.Snapshots, 1)
+	require.Empty(t, report.Snapshots[0].Error)
+	require.EqualValues(t, 1, report.SnapshotIndex)
+	require.Equal(t, 5, report.Entries)
+	require.Len(t, report.Problems, 5)
+
+	out.Reset()
+	require.Equal(t, errVerifyFailed, verifyRaftData(tempdir, unlockKey, "text", &out))
+	require.Contains(t, out.String(), "Entries: 5 (Index 2 to 6, Commit=0)")
+}
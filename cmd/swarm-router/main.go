@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/swarmkit/agent/exec/router"
+	"github.com/spf13/cobra"
+)
+
+// reloadInterval is how often the routing table file is checked for changes.
+const reloadInterval = time.Second
+
+var mainCmd = &cobra.Command{
+	Use:   os.Args[0],
+	Short: "Built-in HTTP router, run in the router task containers",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			return errors.New("swarm-router does not take any arguments")
+		}
+
+		listenAddr, err := cmd.Flags().GetString("listen-addr")
+		if err != nil {
+			return err
+		}
+
+		table, err := cmd.Flags().GetString("routing-table")
+		if err != nil {
+			return err
+		}
+
+		r := router.New()
+		go watchTable(r, table)
+
+		return http.ListenAndServe(listenAddr, r)
+	},
+}
+
+// watchTable loads the routing table file into r whenever it is replaced.
+// Until the agent writes it, the router has no routes.
+func watchTable(r *router.Router, path string) {
+	var modTime time.Time
+	for {
+		if fi, err := os.Stat(path); err == nil && !fi.ModTime().Equal(modTime) {
+			if err := r.Load(path); err != nil {
+				logrus.WithError(err).Error("loading routing table failed")
+			} else {
+				modTime = fi.ModTime()
+			}
+		}
+		time.Sleep(reloadInterval)
+	}
+}
+
+func init() {
+	mainCmd.Flags().String("listen-addr", ":80", "Address to accept requests on")
+	mainCmd.Flags().String("routing-table", "/run/swarm-router/routing-table", "Routing table file written by the agent")
+}
+
+func main() {
+	if _, err := mainCmd.ExecuteC(); err != nil {
+		os.Exit(-1)
+	}
+}
@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/dustin/go-humanize"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Cmd exposes the top-level audit command.
+	Cmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Show the audit log of changes made through the Control API",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("audit command takes no arguments")
+			}
+
+			flags := cmd.Flags()
+			objects, err := flags.GetStringSlice("object")
+			if err != nil {
+				return err
+			}
+			methods, err := flags.GetStringSlice("method")
+			if err != nil {
+				return err
+			}
+			callers, err := flags.GetStringSlice("caller")
+			if err != nil {
+				return err
+			}
+			limit, err := flags.GetUint32("limit")
+			if err != nil {
+				return err
+			}
+			diff, err := flags.GetBool("diff")
+			if err != nil {
+				return err
+			}
+
+			client, err := common.Dial(cmd)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListAuditEvents(common.Context(cmd), &api.ListAuditEventsRequest{
+				Filters: &api.ListAuditEventsRequest_Filters{
+					ObjectIDs: objects,
+					Methods:   methods,
+					CallerIDs: callers,
+				},
+				Limit: limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			if diff {
+				for _, e := range resp.Events {
+					printEvent(e)
+				}
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+			defer func() {
+				// Ignore flushing errors - there's nothing we can do.
+				_ = w.Flush()
+			}()
+			common.PrintHeader(w, "Time", "Caller", "Method", "Object", "Result")
+			for _, e := range resp.Events {
+				created, err := gogotypes.TimestampFromProto(e.Meta.CreatedAt)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					humanize.Time(created),
+					e.Caller.ID,
+					e.Method,
+					e.ObjectID,
+					result(e),
+				)
+			}
+			return nil
+		},
+	}
+)
+
+func result(e *api.AuditEvent) string {
+	if e.Error != "" {
+		return "failed"
+	}
+	return "ok"
+}
+
+func printEvent(e *api.AuditEvent) {
+	fmt.Printf("Event %s\n", e.ID)
+	fmt.Printf("Time:    %s\n", gogotypes.TimestampString(e.Meta.CreatedAt))
+	fmt.Printf("Caller:  %s (%s)\n", e.Caller.ID, strings.Join(e.Caller.Roles, ","))
+	if e.Caller.RemoteAddr != "" {
+		fmt.Printf("Address: %s\n", e.Caller.RemoteAddr)
+	}
+	if e.Caller.ForwardedBy != "" {
+		fmt.Printf("Via:     %s\n", e.Caller.ForwardedBy)
+	}
+	fmt.Printf("Method:  %s\n", e.Method)
+	fmt.Printf("Object:  %s\n", e.ObjectID)
+	if e.Error != "" {
+		fmt.Printf("Error:   %s\n", e.Error)
+	}
+	if e.SpecDiff != "" {
+		fmt.Printf("\n%s", e.SpecDiff)
+	}
+	fmt.Println()
+}
+
+func init() {
+	Cmd.Flags().StringSlice("object", nil, "Only display events for the given object IDs")
+	Cmd.Flags().StringSlice("method", nil, "Only display events for the given Control API methods")
+	Cmd.Flags().StringSlice("caller", nil, "Only display events made by the given nodes or users")
+	Cmd.Flags().Uint32("limit", 0, "Maximum number of events to display")
+	Cmd.Flags().Bool("diff", false, "Display the full event, including the spec diff")
+}
@@ -16,5 +16,7 @@ func init() {
 		listCmd,
 		updateCmd,
 		unlockKeyCmd,
+		portsCmd,
+		quorumCmd,
 	)
 }
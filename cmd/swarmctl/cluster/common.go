@@ -2,6 +2,8 @@ package cluster
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"golang.org/x/net/context"
 
@@ -34,3 +36,27 @@ func getCluster(ctx context.Context, c api.ControlClient, input string) (*api.Cl
 
 	return rl.Clusters[0], nil
 }
+
+// parsePortRange parses a port range in the format START[-END].
+func parsePortRange(s string) (api.PortRange, error) {
+	parts := strings.SplitN(s, "-", 2)
+	start, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return api.PortRange{}, fmt.Errorf("invalid port range %s", s)
+	}
+	end := start
+	if len(parts) > 1 {
+		end, err = strconv.ParseUint(parts[1], 10, 16)
+		if err != nil {
+			return api.PortRange{}, fmt.Errorf("invalid port range %s", s)
+		}
+	}
+	return api.PortRange{Start: uint32(start), End: uint32(end)}, nil
+}
+
+func formatPortRange(r api.PortRange) string {
+	if r.Start == r.End {
+		return strconv.FormatUint(uint64(r.Start), 10)
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
@@ -22,11 +22,58 @@ func printClusterSummary(cluster *api.Cluster) {
 	fmt.Fprintln(w, "Orchestration settings:")
 	fmt.Fprintf(w, "  Task history entries: %d\n", cluster.Spec.Orchestration.TaskHistoryRetentionLimit)
 
+	fmt.Fprintln(w, "Dispatcher settings:")
 	heartbeatPeriod, err := gogotypes.DurationFromProto(cluster.Spec.Dispatcher.HeartbeatPeriod)
 	if err == nil {
-		fmt.Fprintln(w, "Dispatcher settings:")
 		fmt.Fprintf(w, "  Dispatcher heartbeat period: %s\n", heartbeatPeriod.String())
 	}
+	if cluster.Spec.Dispatcher.TaskStatusRateLimit != 0 {
+		fmt.Fprintf(w, "  Task status rate limit: %d/s (burst %d)\n", cluster.Spec.Dispatcher.TaskStatusRateLimit, cluster.Spec.Dispatcher.TaskStatusBurst)
+	}
+	if cluster.Spec.Dispatcher.MaxPendingTaskUpdates != 0 {
+		fmt.Fprintf(w, "  Max pending task updates: %d\n", cluster.Spec.Dispatcher.MaxPendingTaskUpdates)
+	}
+
+	fmt.Fprintln(w, "Published port settings:")
+	if cluster.Spec.PortAllocation.DynamicRange != nil {
+		fmt.Fprintf(w, "  Dynamic range: %s\n", formatPortRange(*cluster.Spec.PortAllocation.DynamicRange))
+	} else {
+		fmt.Fprintln(w, "  Dynamic range: default")
+	}
+	for _, r := range cluster.Spec.PortAllocation.Reserved {
+		fmt.Fprintf(w, "  Reserved: %s\n", formatPortRange(r))
+	}
+
+	if len(cluster.Spec.DefaultAddressPools.Pools) > 0 {
+		fmt.Fprintln(w, "Default address pools:")
+		for _, pool := range cluster.Spec.DefaultAddressPools.Pools {
+			fmt.Fprintf(w, "  %s\n", pool)
+		}
+		if cluster.Spec.DefaultAddressPools.SubnetSize != 0 {
+			fmt.Fprintf(w, "  Subnet size: /%d\n", cluster.Spec.DefaultAddressPools.SubnetSize)
+		}
+	}
+
+	if scaling := cluster.Spec.ManagerScaling; scaling.DesiredManagers != 0 {
+		fmt.Fprintln(w, "Manager scaling:")
+		fmt.Fprintf(w, "  Desired managers: %d\n", scaling.DesiredManagers)
+		if scaling.Placement != nil {
+			for _, c := range scaling.Placement.Constraints {
+				fmt.Fprintf(w, "  Constraint: %s\n", c)
+			}
+			for _, pref := range scaling.Placement.Preferences {
+				if spread := pref.GetSpread(); spread != nil {
+					fmt.Fprintf(w, "  Spread over: %s\n", spread.SpreadDescriptor)
+				}
+			}
+		}
+		if scaling.DownGracePeriod != nil {
+			gracePeriod, err := gogotypes.DurationFromProto(scaling.DownGracePeriod)
+			if err == nil {
+				fmt.Fprintf(w, "  Down grace period: %s\n", gracePeriod.String())
+			}
+		}
+	}
 
 	fmt.Fprintln(w, "Certificate Authority settings:")
 	if cluster.Spec.CAConfig.NodeCertExpiry != nil {
@@ -0,0 +1,65 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	portsCmd = &cobra.Command{
+		Use:   "ports",
+		Short: "List published ports by port range",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("ports command takes no arguments")
+			}
+
+			c, err := common.Dial(cmd)
+			if err != nil {
+				return err
+			}
+
+			r, err := c.ListPublishedPorts(common.Context(cmd), &api.ListPublishedPortsRequest{})
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			defer func() {
+				// Ignore flushing errors - there's nothing we can do.
+				_ = w.Flush()
+			}()
+			common.PrintHeader(w, "Range", "Kind", "Port", "Protocol", "Mode", "Service", "Target")
+			for _, usage := range r.Ranges {
+				portRange := "-"
+				if usage.Range != nil {
+					portRange = formatPortRange(*usage.Range)
+				}
+				kind := strings.ToLower(usage.Kind.String())
+				if len(usage.Ports) == 0 {
+					fmt.Fprintf(w, "%s\t%s\t-\t-\t-\t-\t-\n", portRange, kind)
+					continue
+				}
+				for _, p := range usage.Ports {
+					fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%d\n",
+						portRange,
+						kind,
+						p.Port,
+						strings.ToLower(p.Protocol.String()),
+						strings.ToLower(p.PublishMode.String()),
+						p.ServiceID,
+						p.TargetPort,
+					)
+				}
+			}
+			return nil
+		},
+	}
+)
@@ -0,0 +1,53 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quorumCmd = &cobra.Command{
+		Use:   "quorum",
+		Short: "Show how many manager failures the cluster can tolerate",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("quorum command takes no arguments")
+			}
+
+			c, err := common.Dial(cmd)
+			if err != nil {
+				return err
+			}
+
+			r, err := c.GetClusterQuorum(common.Context(cmd), &api.GetClusterQuorumRequest{})
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 8, 8, 8, ' ', 0)
+			defer func() {
+				// Ignore flushing errors - there's nothing we can do.
+				_ = w.Flush()
+			}()
+			fmt.Fprintf(w, "Managers\t: %d\n", r.Managers)
+			fmt.Fprintf(w, "Reachable\t: %d\n", r.Reachable)
+			fmt.Fprintf(w, "Quorum\t: %d\n", r.Quorum)
+			if r.FaultTolerance < 0 {
+				fmt.Fprintln(w, "Fault tolerance\t: none, quorum is lost")
+			} else {
+				fmt.Fprintf(w, "Fault tolerance\t: %d\n", r.FaultTolerance)
+			}
+			if len(r.UnreachableNodeIDs) > 0 {
+				fmt.Fprintf(w, "Unreachable\t: %s\n", strings.Join(r.UnreachableNodeIDs, ", "))
+			}
+			return nil
+		},
+	}
+)
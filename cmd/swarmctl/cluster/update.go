@@ -67,6 +67,27 @@ var (
 				}
 				spec.Dispatcher.HeartbeatPeriod = gogotypes.DurationProto(hbPeriod)
 			}
+			if flags.Changed("task-status-rate-limit") {
+				rateLimit, err := flags.GetUint32("task-status-rate-limit")
+				if err != nil {
+					return err
+				}
+				spec.Dispatcher.TaskStatusRateLimit = rateLimit
+			}
+			if flags.Changed("task-status-burst") {
+				burst, err := flags.GetUint32("task-status-burst")
+				if err != nil {
+					return err
+				}
+				spec.Dispatcher.TaskStatusBurst = burst
+			}
+			if flags.Changed("max-pending-task-updates") {
+				maxPending, err := flags.GetUint32("max-pending-task-updates")
+				if err != nil {
+					return err
+				}
+				spec.Dispatcher.MaxPendingTaskUpdates = maxPending
+			}
 			if flags.Changed("rotate-join-token") {
 				rotateJoinToken, err := flags.GetString("rotate-join-token")
 				if err != nil {
@@ -89,6 +110,85 @@ var (
 					return err
 				}
 			}
+			if flags.Changed("dynamic-ports") {
+				dynamicPorts, err := flags.GetString("dynamic-ports")
+				if err != nil {
+					return err
+				}
+				if dynamicPorts == "" {
+					spec.PortAllocation.DynamicRange = nil
+				} else {
+					dynamicRange, err := parsePortRange(dynamicPorts)
+					if err != nil {
+						return err
+					}
+					spec.PortAllocation.DynamicRange = &dynamicRange
+				}
+			}
+			if flags.Changed("reserved-ports") {
+				reservedPorts, err := flags.GetStringSlice("reserved-ports")
+				if err != nil {
+					return err
+				}
+				spec.PortAllocation.Reserved = nil
+				for _, ports := range reservedPorts {
+					reserved, err := parsePortRange(ports)
+					if err != nil {
+						return err
+					}
+					spec.PortAllocation.Reserved = append(spec.PortAllocation.Reserved, reserved)
+				}
+			}
+			if flags.Changed("default-addr-pool") {
+				spec.DefaultAddressPools.Pools, err = flags.GetStringSlice("default-addr-pool")
+				if err != nil {
+					return err
+				}
+			}
+			if flags.Changed("default-addr-pool-mask-length") {
+				spec.DefaultAddressPools.SubnetSize, err = flags.GetUint32("default-addr-pool-mask-length")
+				if err != nil {
+					return err
+				}
+			}
+			if flags.Changed("managers") {
+				spec.ManagerScaling.DesiredManagers, err = flags.GetUint32("managers")
+				if err != nil {
+					return err
+				}
+			}
+			if flags.Changed("manager-constraint") || flags.Changed("manager-spread") {
+				if spec.ManagerScaling.Placement == nil {
+					spec.ManagerScaling.Placement = &api.Placement{}
+				}
+			}
+			if flags.Changed("manager-constraint") {
+				spec.ManagerScaling.Placement.Constraints, err = flags.GetStringSlice("manager-constraint")
+				if err != nil {
+					return err
+				}
+			}
+			if flags.Changed("manager-spread") {
+				descriptors, err := flags.GetStringSlice("manager-spread")
+				if err != nil {
+					return err
+				}
+				spec.ManagerScaling.Placement.Preferences = nil
+				for _, descriptor := range descriptors {
+					spec.ManagerScaling.Placement.Preferences = append(spec.ManagerScaling.Placement.Preferences, &api.PlacementPreference{
+						Preference: &api.PlacementPreference_Spread{
+							Spread: &api.SpreadOver{SpreadDescriptor: descriptor},
+						},
+					})
+				}
+			}
+			if flags.Changed("manager-down-grace-period") {
+				gracePeriod, err := flags.GetDuration("manager-down-grace-period")
+				if err != nil {
+					return err
+				}
+				spec.ManagerScaling.DownGracePeriod = gogotypes.DurationProto(gracePeriod)
+			}
 			rotateUnlockKey, err := flags.GetBool("rotate-unlock-key")
 			if err != nil {
 				return err
@@ -125,10 +225,21 @@ func init() {
 	updateCmd.Flags().Duration("certexpiry", 24*30*3*time.Hour, "Duration node certificates will be valid for")
 	updateCmd.Flags().Var(&externalCAOpt, "external-ca", "Specifications of one or more certificate signing endpoints")
 	updateCmd.Flags().Duration("heartbeatperiod", 0, "Period when heartbeat is expected to receive from agent")
+	updateCmd.Flags().Uint32("task-status-rate-limit", 0, "Task status updates per second each node may report (0 = unlimited)")
+	updateCmd.Flags().Uint32("task-status-burst", 0, "Task status updates a node may report at once (0 = the rate limit)")
+	updateCmd.Flags().Uint32("max-pending-task-updates", 0, "Task status updates held by the dispatcher before rejecting new ones (0 = default)")
 
 	updateCmd.Flags().String("log-driver", "", "Set default log driver for cluster")
 	updateCmd.Flags().StringSlice("log-opt", nil, "Set options for default log driver")
 	updateCmd.Flags().String("rotate-join-token", "", "Rotate join token for worker or manager")
 	updateCmd.Flags().Bool("rotate-unlock-key", false, "Rotate manager unlock key")
+	updateCmd.Flags().String("dynamic-ports", "", "Range published ports are allocated from, as start-end (empty for the default range)")
+	updateCmd.Flags().StringSlice("reserved-ports", nil, "Ports which can't be published, as port or start-end")
+	updateCmd.Flags().StringSlice("default-addr-pool", nil, "Address pools, in CIDR format, network subnets are allocated from")
+	updateCmd.Flags().Uint32("default-addr-pool-mask-length", 0, "Prefix length of the subnets allocated from the default address pools")
+	updateCmd.Flags().Uint32("managers", 0, "Number of managers to maintain by promoting and demoting nodes (0 = disabled)")
+	updateCmd.Flags().StringSlice("manager-constraint", nil, "Placement constraints nodes must meet to be promoted to manager")
+	updateCmd.Flags().StringSlice("manager-spread", nil, "Node attributes to spread managers over, such as node.labels.zone")
+	updateCmd.Flags().Duration("manager-down-grace-period", time.Minute, "How long a manager must be down before it is replaced")
 	updateCmd.Flags().Bool("autolock", false, "Enable or disable manager autolocking (requiring an unlock key to start a stopped manager)")
 }
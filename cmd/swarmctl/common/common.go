@@ -2,11 +2,18 @@ package common
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"net"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/manager/readproxy"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"golang.org/x/net/context"
@@ -25,8 +32,24 @@ func Dial(cmd *cobra.Command) (api.ControlClient, error) {
 	return api.NewControlClient(conn), nil
 }
 
+// Names of the files written by `swarmctl role issue-cert`, and read from
+// the directory given by --cert-dir.
+const (
+	UserKeyFile  = "key.pem"
+	UserCertFile = "cert.pem"
+	RootCAFile   = "ca.pem"
+)
+
 // DialConn establishes a connection to SwarmKit.
 func DialConn(cmd *cobra.Command) (*grpc.ClientConn, error) {
+	host, err := cmd.Flags().GetString("host")
+	if err != nil {
+		return nil, err
+	}
+	if host != "" {
+		return dialRemote(cmd, host)
+	}
+
 	addr, err := cmd.Flags().GetString("socket")
 	if err != nil {
 		return nil, err
@@ -47,10 +70,47 @@ func DialConn(cmd *cobra.Command) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
+// dialRemote connects to a manager over TLS, authenticating with the user
+// certificate found in the directory given by --cert-dir.
+func dialRemote(cmd *cobra.Command, host string) (*grpc.ClientConn, error) {
+	certDir, err := cmd.Flags().GetString("cert-dir")
+	if err != nil {
+		return nil, err
+	}
+	if certDir == "" {
+		return nil, errors.New("--cert-dir is required to connect to a remote manager")
+	}
+
+	cert, err := tls.LoadX509KeyPair(filepath.Join(certDir, UserCertFile), filepath.Join(certDir, UserKeyFile))
+	if err != nil {
+		return nil, err
+	}
+	rootCA, err := ioutil.ReadFile(filepath.Join(certDir, RootCAFile))
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(rootCA) {
+		return nil, fmt.Errorf("no valid root CA certificate in %s", filepath.Join(certDir, RootCAFile))
+	}
+
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		// Manager certificates have the manager role as a DNS name
+		ServerName: ca.ManagerRole,
+	})
+	return grpc.Dial(host, grpc.WithTransportCredentials(creds))
+}
+
 // Context returns a request context based on CLI arguments.
 func Context(cmd *cobra.Command) context.Context {
 	// TODO(aluzzardi): Actually create a context.
-	return context.TODO()
+	ctx := context.TODO()
+	if stale, err := cmd.Flags().GetBool("stale-reads"); err == nil && stale {
+		ctx = readproxy.WithStaleReads(ctx)
+	}
+	return ctx
 }
 
 // ParseLogDriverFlags parses a silly string format for log driver and options.
@@ -3,9 +3,11 @@ package main
 import (
 	"os"
 
+	"github.com/docker/swarmkit/cmd/swarmctl/audit"
 	"github.com/docker/swarmkit/cmd/swarmctl/cluster"
 	"github.com/docker/swarmkit/cmd/swarmctl/network"
 	"github.com/docker/swarmkit/cmd/swarmctl/node"
+	"github.com/docker/swarmkit/cmd/swarmctl/role"
 	"github.com/docker/swarmkit/cmd/swarmctl/secret"
 	"github.com/docker/swarmkit/cmd/swarmctl/service"
 	"github.com/docker/swarmkit/cmd/swarmctl/task"
@@ -46,7 +48,10 @@ func defaultSocket() string {
 
 func init() {
 	mainCmd.PersistentFlags().StringP("socket", "s", defaultSocket(), "Socket to connect to the Swarm manager")
+	mainCmd.PersistentFlags().String("host", "", "Address of a remote manager to connect to as a user, instead of the socket")
+	mainCmd.PersistentFlags().String("cert-dir", "", "Directory holding the user certificate to connect to a remote manager with")
 	mainCmd.PersistentFlags().BoolP("no-resolve", "n", false, "Do not try to map IDs to Names when displaying them")
+	mainCmd.PersistentFlags().Bool("stale-reads", false, "Allow a follower manager to answer reads without catching up with the leader")
 
 	mainCmd.AddCommand(
 		node.Cmd,
@@ -56,5 +61,7 @@ func init() {
 		network.Cmd,
 		cluster.Cmd,
 		secret.Cmd,
+		role.Cmd,
+		audit.Cmd,
 	)
 }
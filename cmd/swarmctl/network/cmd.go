@@ -15,6 +15,7 @@ func init() {
 		inspectCmd,
 		listCmd,
 		createCmd,
+		updateCmd,
 		removeCmd,
 	)
 }
@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
+	"github.com/docker/libnetwork/ipamapi"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/docker/swarmkit/manager/allocator/networkallocator"
 	"github.com/spf13/cobra"
 )
 
@@ -101,6 +104,25 @@ func processIPAMOptions(cmd *cobra.Command) (*api.IPAMOptions, error) {
 		}
 	}
 
+	if flags.Changed("subnet-size") {
+		subnetSize, err := cmd.Flags().GetUint32("subnet-size")
+		if err != nil {
+			return nil, err
+		}
+
+		if ipamOpts == nil {
+			ipamOpts = &api.IPAMOptions{
+				Driver: &api.Driver{
+					Name: ipamapi.DefaultIPAM,
+				},
+			}
+		}
+		if ipamOpts.Driver.Options == nil {
+			ipamOpts.Driver.Options = make(map[string]string)
+		}
+		ipamOpts.Driver.Options[networkallocator.DefaultPoolSubnetSizeOption] = strconv.FormatUint(uint64(subnetSize), 10)
+	}
+
 	if !flags.Changed("subnet") {
 		return ipamOpts, nil
 	}
@@ -176,6 +198,7 @@ func init() {
 	createCmd.Flags().String("driver", "", "Network driver")
 	createCmd.Flags().String("ipam-driver", "", "IPAM driver")
 	createCmd.Flags().StringSlice("subnet", []string{}, "Subnets in CIDR format that represents a network segments")
+	createCmd.Flags().Uint32("subnet-size", 0, "Prefix length of the subnet allocated from the default address pools")
 	createCmd.Flags().StringSlice("gateway", []string{}, "Gateway IP addresses for network segments")
 	createCmd.Flags().StringSlice("ip-range", []string{}, "IP ranges to allocate from within the subnets")
 	createCmd.Flags().StringSlice("opts", []string{}, "Network driver options")
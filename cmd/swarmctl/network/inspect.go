@@ -3,7 +3,9 @@ package network
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/docker/swarmkit/api"
@@ -35,11 +37,87 @@ var (
 
 			printNetworkSummary(network)
 
+			allocation, err := cmd.Flags().GetBool("allocation")
+			if err != nil {
+				return err
+			}
+			if allocation {
+				r, err := c.InspectAllocator(common.Context(cmd), &api.InspectAllocatorRequest{})
+				if err != nil {
+					return err
+				}
+				printNetworkAllocation(network, r)
+			}
+
 			return nil
 		},
 	}
 )
 
+func init() {
+	inspectCmd.Flags().Bool("allocation", false, "Show the allocator state of the network")
+}
+
+func printNetworkAllocation(network *api.Network, r *api.InspectAllocatorResponse) {
+	w := tabwriter.NewWriter(os.Stdout, 8, 8, 8, ' ', 0)
+	defer func() {
+		// Ignore flushing errors - there's nothing we can do.
+		_ = w.Flush()
+	}()
+
+	fmt.Fprintln(w, "Allocation:\t")
+	for _, n := range r.Networks {
+		if n.ID != network.ID {
+			continue
+		}
+		for _, pool := range n.Pools {
+			fmt.Fprintf(w, "  Pool %s\t: %d/%d allocated, %d reserved\n", pool.Subnet, pool.Allocated, pool.Capacity, pool.Reserved)
+		}
+	}
+
+	for _, s := range r.Services {
+		for _, vip := range s.VirtualIPs {
+			if vip.NetworkID == network.ID {
+				fmt.Fprintf(w, "  VIP %s\t: service %s (%s)\n", vip.Addr, s.Name, s.ID)
+			}
+		}
+	}
+
+	for _, node := range r.Nodes {
+		if node.NetworkID == network.ID {
+			fmt.Fprintf(w, "  Node %s\t: %s\n", node.ID, strings.Join(node.Addresses, ", "))
+		}
+	}
+
+	printUnallocated(w, "Unallocated services", network.ID, r.UnallocatedServices)
+	printUnallocated(w, "Unallocated tasks", network.ID, r.UnallocatedTasks)
+	for _, n := range r.UnallocatedNetworks {
+		if n.ID == network.ID {
+			fmt.Fprintln(w, "  Network is waiting for allocation\t")
+		}
+	}
+}
+
+func printUnallocated(w io.Writer, title, networkID string, unallocated []*api.InspectAllocatorResponse_Unallocated) {
+	var names []string
+	for _, u := range unallocated {
+		for _, id := range u.NetworkIDs {
+			if id == networkID {
+				names = append(names, fmt.Sprintf("%s (%s)", u.Name, u.ID))
+				break
+			}
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "  %s:\t\n", title)
+	for _, name := range names {
+		fmt.Fprintf(w, "    %s\n", name)
+	}
+}
+
 func printNetworkSummary(network *api.Network) {
 	w := tabwriter.NewWriter(os.Stdout, 8, 8, 8, ' ', 0)
 	defer func() {
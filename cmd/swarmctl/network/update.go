@@ -0,0 +1,102 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	updateCmd = &cobra.Command{
+		Use:   "update <network ID>",
+		Short: "Update a network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("update command takes exactly 1 argument")
+			}
+
+			c, err := common.Dial(cmd)
+			if err != nil {
+				return err
+			}
+
+			network, err := GetNetwork(common.Context(cmd), c, args[0])
+			if err != nil {
+				return err
+			}
+			spec := network.Spec.Copy()
+
+			flags := cmd.Flags()
+			if flags.Changed("name") {
+				spec.Annotations.Name, err = flags.GetString("name")
+				if err != nil {
+					return err
+				}
+			}
+
+			if flags.Changed("label") {
+				labels, err := flags.GetStringSlice("label")
+				if err != nil {
+					return err
+				}
+				// overwrite existing labels
+				spec.Annotations.Labels = map[string]string{}
+				for _, l := range labels {
+					parts := strings.SplitN(l, "=", 2)
+					if len(parts) != 2 {
+						return fmt.Errorf("malformed label for network %s", l)
+					}
+					spec.Annotations.Labels[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+				}
+			}
+
+			if flags.Changed("attachable") {
+				spec.Attachable, err = flags.GetBool("attachable")
+				if err != nil {
+					return err
+				}
+			}
+
+			// New subnets are appended to the IPAM configs of the network.
+			ipamOpts, err := processIPAMOptions(cmd)
+			if err != nil {
+				return err
+			}
+			if ipamOpts != nil {
+				if spec.IPAM == nil {
+					spec.IPAM = &api.IPAMOptions{}
+				}
+				spec.IPAM.Configs = append(spec.IPAM.Configs, ipamOpts.Configs...)
+			}
+
+			if reflect.DeepEqual(spec, &network.Spec) {
+				return fmt.Errorf("No change for network %s", args[0])
+			}
+
+			r, err := c.UpdateNetwork(common.Context(cmd), &api.UpdateNetworkRequest{
+				NetworkID:      network.ID,
+				NetworkVersion: &network.Meta.Version,
+				Spec:           spec,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Println(r.Network.ID)
+			return nil
+		},
+	}
+)
+
+func init() {
+	updateCmd.Flags().String("name", "", "Network name")
+	updateCmd.Flags().StringSlice("label", nil, "Network label (key=value)")
+	updateCmd.Flags().Bool("attachable", false, "Allow standalone containers to attach to the network")
+	updateCmd.Flags().StringSlice("subnet", []string{}, "Subnets in CIDR format to add to the network")
+	updateCmd.Flags().StringSlice("gateway", []string{}, "Gateway IP addresses for the added subnets")
+	updateCmd.Flags().StringSlice("ip-range", []string{}, "IP ranges to allocate from within the added subnets")
+}
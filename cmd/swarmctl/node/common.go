@@ -94,7 +94,7 @@ func changeNodeMembership(cmd *cobra.Command, args []string, membership api.Node
 	return nil
 }
 
-func changeNodeRole(cmd *cobra.Command, args []string, role api.NodeRole) error {
+func changeNodeRole(cmd *cobra.Command, args []string, role api.NodeRole, force bool) error {
 	if len(args) == 0 {
 		return errors.New("missing node ID")
 	}
@@ -123,6 +123,7 @@ func changeNodeRole(cmd *cobra.Command, args []string, role api.NodeRole) error
 		NodeID:      node.ID,
 		NodeVersion: &node.Meta.Version,
 		Spec:        spec,
+		Force:       force,
 	})
 
 	if err != nil {
@@ -12,7 +12,11 @@ var (
 		Use:   "demote <node ID>",
 		Short: "Demote a node from a manager to a worker",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := changeNodeRole(cmd, args, api.NodeRoleWorker); err != nil {
+			force, err := cmd.Flags().GetBool("force")
+			if err != nil {
+				return err
+			}
+			if err := changeNodeRole(cmd, args, api.NodeRoleWorker, force); err != nil {
 				if err == errNoChange {
 					return fmt.Errorf("Node %s is already a worker", args[0])
 				}
@@ -22,3 +26,7 @@ var (
 		},
 	}
 )
+
+func init() {
+	demoteCmd.Flags().BoolP("force", "f", false, "Demote the manager even if the cluster currently can't afford to lose it")
+}
@@ -0,0 +1,84 @@
+package role
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bindCmd = &cobra.Command{
+		Use:   "bind <role ID or name> <user>...",
+		Short: "Bind a role to users",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("bind command takes a role ID or name and at least one user")
+			}
+			return updateSubjects(cmd, args[0], func(subjects []string) []string {
+				for _, user := range args[1:] {
+					if !contains(subjects, user) {
+						subjects = append(subjects, user)
+					}
+				}
+				return subjects
+			})
+		},
+	}
+
+	unbindCmd = &cobra.Command{
+		Use:   "unbind <role ID or name> <user>...",
+		Short: "Unbind a role from users",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("unbind command takes a role ID or name and at least one user")
+			}
+			return updateSubjects(cmd, args[0], func(subjects []string) []string {
+				var remaining []string
+				for _, subject := range subjects {
+					if !contains(args[1:], subject) {
+						remaining = append(remaining, subject)
+					}
+				}
+				return remaining
+			})
+		},
+	}
+)
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func updateSubjects(cmd *cobra.Command, input string, update func([]string) []string) error {
+	client, err := common.Dial(cmd)
+	if err != nil {
+		return err
+	}
+
+	role, err := getRole(common.Context(cmd), client, input)
+	if err != nil {
+		return err
+	}
+
+	spec := role.Spec.Copy()
+	spec.Subjects = update(spec.Subjects)
+
+	_, err = client.UpdateRole(common.Context(cmd), &api.UpdateRoleRequest{
+		RoleID:      role.ID,
+		RoleVersion: &role.Meta.Version,
+		Spec:        spec,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Println(role.ID)
+	return nil
+}
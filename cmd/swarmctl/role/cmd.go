@@ -0,0 +1,24 @@
+package role
+
+import "github.com/spf13/cobra"
+
+var (
+	// Cmd exposes the top-level role command.
+	Cmd = &cobra.Command{
+		Use:     "role",
+		Aliases: nil,
+		Short:   "Role-based access control management",
+	}
+)
+
+func init() {
+	Cmd.AddCommand(
+		inspectCmd,
+		listCmd,
+		createCmd,
+		removeCmd,
+		bindCmd,
+		unbindCmd,
+		issueCertCmd,
+	)
+}
@@ -0,0 +1,88 @@
+package role
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/swarmkit/api"
+	"golang.org/x/net/context"
+)
+
+func getRole(ctx context.Context, c api.ControlClient, input string) (*api.Role, error) {
+	// not sure what it is, match by name or id prefix
+	resp, err := c.ListRoles(ctx,
+		&api.ListRolesRequest{
+			Filters: &api.ListRolesRequest_Filters{
+				Names:      []string{input},
+				IDPrefixes: []string{input},
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(resp.Roles) {
+	case 0:
+		return nil, fmt.Errorf("role %s not found", input)
+	case 1:
+		return resp.Roles[0], nil
+	default:
+		// ok, multiple matches.  Prefer exact ID over exact name.  If no exact matches, return an error
+		for _, r := range resp.Roles {
+			if r.ID == input {
+				return r, nil
+			}
+		}
+		for _, r := range resp.Roles {
+			if r.Spec.Annotations.Name == input {
+				return r, nil
+			}
+		}
+		return nil, fmt.Errorf("role %s is ambiguous (%d matches found)", input, len(resp.Roles))
+	}
+}
+
+// parseRule parses a rule in the form "method[,method...][:key=value[,key=value...]]".
+func parseRule(input string) (api.RoleRule, error) {
+	var rule api.RoleRule
+
+	parts := strings.SplitN(input, ":", 2)
+	for _, method := range strings.Split(parts[0], ",") {
+		if method != "" {
+			rule.Methods = append(rule.Methods, method)
+		}
+	}
+	if len(rule.Methods) == 0 {
+		return rule, fmt.Errorf("rule %q does not list any methods", input)
+	}
+
+	if len(parts) == 2 {
+		rule.Labels = make(map[string]string)
+		for _, label := range strings.Split(parts[1], ",") {
+			kv := strings.SplitN(label, "=", 2)
+			if kv[0] == "" {
+				return rule, fmt.Errorf("malformed label %q in rule %q", label, input)
+			}
+			if len(kv) == 2 {
+				rule.Labels[kv[0]] = kv[1]
+			} else {
+				rule.Labels[kv[0]] = ""
+			}
+		}
+	}
+
+	return rule, nil
+}
+
+func formatRule(rule api.RoleRule) string {
+	s := strings.Join(rule.Methods, ",")
+	if len(rule.Labels) == 0 {
+		return s
+	}
+	labels := make([]string, 0, len(rule.Labels))
+	for k, v := range rule.Labels {
+		labels = append(labels, k+"="+v)
+	}
+	return s + ":" + strings.Join(labels, ",")
+}
@@ -0,0 +1,59 @@
+package role
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create <role name>",
+	Short: "Create a role",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("create command takes a unique role name as an argument")
+		}
+
+		flags := cmd.Flags()
+		rawRules, err := flags.GetStringSlice("rule")
+		if err != nil {
+			return err
+		}
+		subjects, err := flags.GetStringSlice("subject")
+		if err != nil {
+			return err
+		}
+
+		spec := &api.RoleSpec{
+			Annotations: api.Annotations{Name: args[0]},
+			Subjects:    subjects,
+		}
+		for _, rawRule := range rawRules {
+			rule, err := parseRule(rawRule)
+			if err != nil {
+				return err
+			}
+			spec.Rules = append(spec.Rules, rule)
+		}
+
+		client, err := common.Dial(cmd)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.CreateRole(common.Context(cmd), &api.CreateRoleRequest{Spec: spec})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.Role.ID)
+		return nil
+	},
+}
+
+func init() {
+	createCmd.Flags().StringSlice("rule", nil, "Grant access to methods, optionally scoped by labels (method[,method...][:key=value[,key=value...]])")
+	createCmd.Flags().StringSlice("subject", nil, "Bind the role to the given user")
+}
@@ -0,0 +1,62 @@
+package role
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+)
+
+func printRoleSummary(role *api.Role) {
+	w := tabwriter.NewWriter(os.Stdout, 8, 8, 8, ' ', 0)
+	defer w.Flush()
+
+	common.FprintfIfNotEmpty(w, "ID\t: %s\n", role.ID)
+	common.FprintfIfNotEmpty(w, "Name\t: %s\n", role.Spec.Annotations.Name)
+	if len(role.Spec.Annotations.Labels) > 0 {
+		fmt.Fprintln(w, "Labels\t")
+		for k, v := range role.Spec.Annotations.Labels {
+			fmt.Fprintf(w, "  %s\t: %s\n", k, v)
+		}
+	}
+	if len(role.Spec.Rules) > 0 {
+		fmt.Fprintln(w, "Rules\t")
+		for _, rule := range role.Spec.Rules {
+			fmt.Fprintf(w, "  %s\n", formatRule(rule))
+		}
+	}
+	common.FprintfIfNotEmpty(w, "Subjects\t: %s\n", strings.Join(role.Spec.Subjects, ", "))
+
+	common.FprintfIfNotEmpty(w, "Created\t: %s\n", gogotypes.TimestampString(role.Meta.CreatedAt))
+}
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:   "inspect <role ID or name>",
+		Short: "Inspect a role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("inspect command takes a single role ID or name")
+			}
+
+			client, err := common.Dial(cmd)
+			if err != nil {
+				return err
+			}
+
+			role, err := getRole(common.Context(cmd), client, args[0])
+			if err != nil {
+				return err
+			}
+
+			printRoleSummary(role)
+			return nil
+		},
+	}
+)
@@ -0,0 +1,68 @@
+package role
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var issueCertCmd = &cobra.Command{
+	Use:   "issue-cert <user name>",
+	Short: "Issue a client certificate for a user",
+	Long: "Issue a client certificate for a user. The user can then connect to a manager with " +
+		"--host and --cert-dir, and call the methods granted by the roles bound to its name.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New("issue-cert command takes a unique user name as an argument")
+		}
+
+		outDir, err := cmd.Flags().GetString("output-dir")
+		if err != nil {
+			return err
+		}
+
+		csr, key, err := ca.GenerateNewCSR()
+		if err != nil {
+			return err
+		}
+
+		client, err := common.Dial(cmd)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.IssueUserCertificate(common.Context(cmd), &api.IssueUserCertificateRequest{
+			Name: args[0],
+			CSR:  csr,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(outDir, 0700); err != nil {
+			return err
+		}
+		for name, data := range map[string][]byte{
+			common.UserKeyFile:  key,
+			common.UserCertFile: resp.Certificate,
+			common.RootCAFile:   resp.RootCACertificate,
+		} {
+			if err := ioutil.WriteFile(filepath.Join(outDir, name), data, 0600); err != nil {
+				return err
+			}
+		}
+		fmt.Println(outDir)
+		return nil
+	},
+}
+
+func init() {
+	issueCertCmd.Flags().StringP("output-dir", "o", ".", "Directory to write the key and certificates to")
+}
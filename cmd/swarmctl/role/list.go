@@ -0,0 +1,90 @@
+package role
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+type roleSorter []*api.Role
+
+func (k roleSorter) Len() int      { return len(k) }
+func (k roleSorter) Swap(i, j int) { k[i], k[j] = k[j], k[i] }
+func (k roleSorter) Less(i, j int) bool {
+	return k[i].Spec.Annotations.Name < k[j].Spec.Annotations.Name
+}
+
+var (
+	listCmd = &cobra.Command{
+		Use:   "ls",
+		Short: "List roles",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("ls command takes no arguments")
+			}
+
+			flags := cmd.Flags()
+			quiet, err := flags.GetBool("quiet")
+			if err != nil {
+				return err
+			}
+			subjects, err := flags.GetStringSlice("subject")
+			if err != nil {
+				return err
+			}
+
+			client, err := common.Dial(cmd)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListRoles(common.Context(cmd), &api.ListRolesRequest{
+				Filters: &api.ListRolesRequest_Filters{Subjects: subjects},
+			})
+			if err != nil {
+				return err
+			}
+
+			var output func(*api.Role)
+
+			if !quiet {
+				w := tabwriter.NewWriter(os.Stdout, 0, 4, 4, ' ', 0)
+				defer func() {
+					// Ignore flushing errors - there's nothing we can do.
+					_ = w.Flush()
+				}()
+				common.PrintHeader(w, "ID", "Name", "Rules", "Subjects")
+				output = func(r *api.Role) {
+					fmt.Fprintf(w, "%s\t%s\t%d\t%s\n",
+						r.ID,
+						r.Spec.Annotations.Name,
+						len(r.Spec.Rules),
+						strings.Join(r.Spec.Subjects, ","),
+					)
+				}
+
+			} else {
+				output = func(r *api.Role) { fmt.Println(r.ID) }
+			}
+
+			sorted := roleSorter(resp.Roles)
+			sort.Sort(sorted)
+			for _, r := range sorted {
+				output(r)
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	listCmd.Flags().BoolP("quiet", "q", false, "Only display role IDs")
+	listCmd.Flags().StringSlice("subject", nil, "Only display roles bound to the given users")
+}
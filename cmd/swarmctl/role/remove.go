@@ -0,0 +1,38 @@
+package role
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var removeCmd = &cobra.Command{
+	Use:     "remove <role ID or name>",
+	Short:   "Remove a role",
+	Aliases: []string{"rm"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errors.New("remove command takes a single role ID or name")
+		}
+
+		client, err := common.Dial(cmd)
+		if err != nil {
+			return err
+		}
+
+		role, err := getRole(common.Context(cmd), client, args[0])
+		if err != nil {
+			return err
+		}
+
+		_, err = client.RemoveRole(common.Context(cmd), &api.RemoveRoleRequest{RoleID: role.ID})
+		if err != nil {
+			return err
+		}
+		fmt.Println(role.ID)
+		return nil
+	},
+}
@@ -16,6 +16,7 @@ func init() {
 		inspectCmd,
 		listCmd,
 		createCmd,
+		rotateCmd,
 		removeCmd,
 	)
 }
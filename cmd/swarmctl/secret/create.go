@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/cmd/swarmctl/common"
@@ -23,10 +24,33 @@ var createCmd = &cobra.Command{
 		flags := cmd.Flags()
 		var (
 			secretData []byte
+			driver     *api.Driver
 			err        error
 		)
 
-		if flags.Changed("file") {
+		if flags.Changed("driver") {
+			// the secret's data is provided by the driver
+			driver = new(api.Driver)
+
+			driver.Name, err = flags.GetString("driver")
+			if err != nil {
+				return err
+			}
+
+			opts, err := flags.GetStringSlice("opts")
+			if err != nil {
+				return err
+			}
+
+			driver.Options = map[string]string{}
+			for _, opt := range opts {
+				optPair := strings.Split(opt, "=")
+				if len(optPair) != 2 {
+					return fmt.Errorf("Malformed opts: %s", opt)
+				}
+				driver.Options[optPair[0]] = optPair[1]
+			}
+		} else if flags.Changed("file") {
 			filename, err := flags.GetString("file")
 			if err != nil {
 				return err
@@ -50,6 +74,7 @@ var createCmd = &cobra.Command{
 		spec := &api.SecretSpec{
 			Annotations: api.Annotations{Name: args[0]},
 			Data:        secretData,
+			Driver:      driver,
 		}
 
 		resp, err := client.CreateSecret(common.Context(cmd), &api.CreateSecretRequest{Spec: spec})
@@ -63,4 +88,6 @@ var createCmd = &cobra.Command{
 
 func init() {
 	createCmd.Flags().StringP("file", "f", "", "Rather than read the secret from STDIN, read from the given file")
+	createCmd.Flags().String("driver", "", "Rather than store the secret, fetch it from the given secrets driver")
+	createCmd.Flags().StringSlice("opts", []string{}, "Secrets driver options")
 }
@@ -18,6 +18,12 @@ func printSecretSummary(secret *api.Secret) {
 
 	common.FprintfIfNotEmpty(w, "ID\t: %s\n", secret.ID)
 	common.FprintfIfNotEmpty(w, "Name\t: %s\n", secret.Spec.Annotations.Name)
+	if secret.LatestVersion != 0 {
+		fmt.Fprintf(w, "Version\t: %d\n", secret.LatestVersion)
+	}
+	if secret.Spec.Driver != nil {
+		common.FprintfIfNotEmpty(w, "Driver\t: %s\n", secret.Spec.Driver.Name)
+	}
 	if len(secret.Spec.Annotations.Labels) > 0 {
 		fmt.Fprintln(w, "Labels\t")
 		for k, v := range secret.Spec.Annotations.Labels {
@@ -0,0 +1,73 @@
+package secret
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/cmd/swarmctl/common"
+	"github.com/spf13/cobra"
+)
+
+var rotateCmd = &cobra.Command{
+	Use:   "rotate <secret ID or name>",
+	Short: "Store new data as the latest version of a secret",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errors.New(
+				"rotate command takes a single secret ID or name as an argument, and accepts secret data via stdin or via a file")
+		}
+
+		flags := cmd.Flags()
+		var (
+			secretData []byte
+			err        error
+		)
+
+		if flags.Changed("file") {
+			filename, err := flags.GetString("file")
+			if err != nil {
+				return err
+			}
+			secretData, err = ioutil.ReadFile(filename)
+			if err != nil {
+				return fmt.Errorf("Error reading from file '%s': %s", filename, err.Error())
+			}
+		} else {
+			secretData, err = ioutil.ReadAll(os.Stdin)
+			if err != nil {
+				return fmt.Errorf("Error reading content from STDIN: %s", err.Error())
+			}
+		}
+
+		client, err := common.Dial(cmd)
+		if err != nil {
+			return err
+		}
+
+		secret, err := getSecret(common.Context(cmd), client, args[0])
+		if err != nil {
+			return err
+		}
+
+		spec := secret.Spec.Copy()
+		spec.Data = secretData
+
+		resp, err := client.UpdateSecret(common.Context(cmd), &api.UpdateSecretRequest{
+			SecretID:      secret.ID,
+			SecretVersion: &secret.Meta.Version,
+			Spec:          spec,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.Secret.LatestVersion)
+		return nil
+	},
+}
+
+func init() {
+	rotateCmd.Flags().StringP("file", "f", "", "Rather than read the secret from STDIN, read from the given file")
+}
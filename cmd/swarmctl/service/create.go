@@ -63,5 +63,5 @@ func init() {
 	flags := createCmd.Flags()
 	flagparser.AddServiceFlags(flags)
 	flags.String("mode", "replicated", "one of replicated, global")
-	flags.StringSlice("secret", nil, "add a secret from swarm")
+	flags.StringSlice("secret", nil, "add a secret from swarm, as name[@version|@latest][:target[:template]]")
 }
@@ -17,7 +17,7 @@ func AddServiceFlags(flags *pflag.FlagSet) {
 
 	flags.Uint64("replicas", 1, "number of replicas for the service (only works in replicated service mode)")
 
-	flags.String("runtime", "container", "task runtime")
+	flags.String("runtime", "container", "task runtime (container|plugin|router)")
 	flags.String("image", "", "container image")
 
 	// Container runtime specific flags
@@ -32,8 +32,13 @@ func AddServiceFlags(flags *pflag.FlagSet) {
 	flags.StringSlice("volume", nil, "define a volume mount")
 	flags.StringSlice("tmpfs", nil, "define a tmpfs mount")
 
-	flags.StringSlice("ports", nil, "ports")
+	// Router runtime specific flags
+	flags.Uint32("listen-port", 80, "port the router listens on")
+
+	flags.StringSlice("ports", nil, "ports (name:port[/tcp|udp|sctp][:published[/tcp|udp|sctp]])")
 	flags.String("network", "", "network name")
+	flags.StringSlice("slot-address", nil, "static address of a slot on the network (slot=ip[/mac])")
+	flags.StringSlice("route", nil, "HTTP route through the built-in router ([hostname][/path-prefix]=port)")
 
 	flags.String("memory-reservation", "", "amount of reserved memory (e.g. 512m)")
 	flags.String("memory-limit", "", "memory limit (e.g. 512m)")
@@ -112,6 +117,10 @@ func Merge(cmd *cobra.Command, spec *api.ServiceSpec, c api.ControlClient) error
 		return err
 	}
 
+	if err := parseRoutes(flags, spec); err != nil {
+		return err
+	}
+
 	if err := parseNetworks(cmd, spec, c); err != nil {
 		return err
 	}
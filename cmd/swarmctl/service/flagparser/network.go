@@ -1,6 +1,10 @@
 package flagparser
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/cmd/swarmctl/common"
 	"github.com/docker/swarmkit/cmd/swarmctl/network"
@@ -11,6 +15,9 @@ func parseNetworks(cmd *cobra.Command, spec *api.ServiceSpec, c api.ControlClien
 	flags := cmd.Flags()
 
 	if !flags.Changed("network") {
+		if flags.Changed("slot-address") {
+			return fmt.Errorf("--slot-address requires --network")
+		}
 		return nil
 	}
 	input, err := flags.GetString("network")
@@ -23,10 +30,50 @@ func parseNetworks(cmd *cobra.Command, spec *api.ServiceSpec, c api.ControlClien
 		return err
 	}
 
-	spec.Task.Networks = []*api.NetworkAttachmentConfig{
-		{
-			Target: n.ID,
-		},
+	attachment := &api.NetworkAttachmentConfig{
+		Target: n.ID,
+	}
+
+	if flags.Changed("slot-address") {
+		slotAddresses, err := flags.GetStringSlice("slot-address")
+		if err != nil {
+			return err
+		}
+
+		attachment.SlotAddresses = make(map[uint64]*api.SlotAddress)
+		for _, slotAddress := range slotAddresses {
+			slot, address, err := parseSlotAddress(slotAddress)
+			if err != nil {
+				return err
+			}
+			attachment.SlotAddresses[slot] = address
+		}
 	}
+
+	spec.Task.Networks = []*api.NetworkAttachmentConfig{attachment}
 	return nil
 }
+
+// parseSlotAddress parses a slot address in the slot=ip[/mac] format.
+func parseSlotAddress(slotAddress string) (uint64, *api.SlotAddress, error) {
+	parts := strings.SplitN(slotAddress, "=", 2)
+	if len(parts) != 2 {
+		return 0, nil, fmt.Errorf("invalid slot address %s: expected slot=ip[/mac]", slotAddress)
+	}
+
+	slot, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid slot in slot address %s: %v", slotAddress, err)
+	}
+
+	address := parts[1]
+	var mac string
+	if i := strings.LastIndex(address, "/"); i != -1 && strings.Contains(address[i+1:], ":") {
+		address, mac = address[:i], address[i+1:]
+	}
+
+	return slot, &api.SlotAddress{
+		Addresses:  []string{address},
+		MacAddress: mac,
+	}, nil
+}
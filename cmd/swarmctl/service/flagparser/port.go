@@ -36,9 +36,10 @@ func parsePorts(flags *pflag.FlagSet, spec *api.ServiceSpec) error {
 		})
 	}
 
-	spec.Endpoint = &api.EndpointSpec{
-		Ports: ports,
+	if spec.Endpoint == nil {
+		spec.Endpoint = &api.EndpointSpec{}
 	}
+	spec.Endpoint.Ports = ports
 
 	return nil
 }
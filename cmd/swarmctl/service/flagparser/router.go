@@ -0,0 +1,82 @@
+package flagparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/spf13/pflag"
+)
+
+func parseRouter(flags *pflag.FlagSet, spec *api.ServiceSpec) error {
+	router := spec.Task.GetRouter()
+
+	if flags.Changed("listen-port") || router.ListenPort == 0 {
+		port, err := flags.GetUint32("listen-port")
+		if err != nil {
+			return err
+		}
+		router.ListenPort = port
+	}
+
+	if flags.Changed("image") {
+		image, err := flags.GetString("image")
+		if err != nil {
+			return err
+		}
+		router.Image = image
+	}
+
+	return nil
+}
+
+func parseRoutes(flags *pflag.FlagSet, spec *api.ServiceSpec) error {
+	if !flags.Changed("route") {
+		return nil
+	}
+	input, err := flags.GetStringSlice("route")
+	if err != nil {
+		return err
+	}
+
+	routes := []*api.Route{}
+	for _, r := range input {
+		route, err := parseRoute(r)
+		if err != nil {
+			return err
+		}
+		routes = append(routes, route)
+	}
+
+	if spec.Endpoint == nil {
+		spec.Endpoint = &api.EndpointSpec{}
+	}
+	spec.Endpoint.Routes = routes
+
+	return nil
+}
+
+// parseRoute parses a route of the form [hostname][/path-prefix]=port.
+func parseRoute(input string) (*api.Route, error) {
+	i := strings.LastIndex(input, "=")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid route %s: missing target port", input)
+	}
+	port, err := strconv.ParseUint(input[i+1:], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route %s: %v", input, err)
+	}
+
+	route := &api.Route{TargetPort: uint32(port)}
+	target := input[:i]
+	if j := strings.Index(target, "/"); j >= 0 {
+		route.PathPrefix = target[j:]
+		target = target[:j]
+	}
+	if target != "" {
+		route.Hostnames = []string{target}
+	}
+
+	return route, nil
+}
@@ -35,6 +35,13 @@ func parseRuntime(flags *pflag.FlagSet, spec *api.ServiceSpec) error {
 			}
 		}
 		return parsePlugin(flags, spec)
+	case "router":
+		if spec.Task.GetRouter() == nil {
+			spec.Task.Runtime = &api.TaskSpec_Router{
+				Router: &api.RouterSpec{},
+			}
+		}
+		return parseRouter(flags, spec)
 	default:
 		return fmt.Errorf("invalid runtime: %q", runtime)
 	}
@@ -2,6 +2,7 @@ package flagparser
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/docker/swarmkit/api"
@@ -9,11 +10,22 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// expects secrets in the format SECRET_NAME:TARGET_NAME
-func parseSecretString(secretString string) (secretName, presentName string, err error) {
+// expects secrets in the format SECRET_NAME[@VERSION]:TARGET_NAME[:template],
+// where VERSION is a version number or "latest"
+func parseSecretString(secretString string) (secretName, presentName string, version uint64, trackLatest, templated bool, err error) {
 	tokens := strings.Split(secretString, ":")
 
 	secretName = strings.TrimSpace(tokens[0])
+	if i := strings.Index(secretName, "@"); i != -1 {
+		v := secretName[i+1:]
+		secretName = secretName[:i]
+		if v == "latest" {
+			trackLatest = true
+		} else if version, err = strconv.ParseUint(v, 10, 64); err != nil || version == 0 {
+			err = fmt.Errorf("invalid secret version provided: %s", v)
+			return
+		}
+	}
 
 	if secretName == "" {
 		err = fmt.Errorf("invalid secret name provided")
@@ -29,6 +41,14 @@ func parseSecretString(secretString string) (secretName, presentName string, err
 	} else {
 		presentName = secretName
 	}
+
+	if len(tokens) > 2 {
+		if strings.TrimSpace(tokens[2]) != "template" || len(tokens) > 3 {
+			err = fmt.Errorf("invalid secret options provided: %s", strings.Join(tokens[2:], ":"))
+			return
+		}
+		templated = true
+	}
 	return
 }
 
@@ -53,14 +73,17 @@ func ParseAddSecret(cmd *cobra.Command, spec *api.ServiceSpec, flagName string)
 		var needSecrets []*api.SecretReference
 
 		for _, secret := range secrets {
-			n, p, err := parseSecretString(secret)
+			n, p, v, latest, templated, err := parseSecretString(secret)
 			if err != nil {
 				return err
 			}
 
 			// TODO(diogo): defaults to File targets, but in the future will take different types
 			secretRef := &api.SecretReference{
-				SecretName: n,
+				SecretName:    n,
+				SecretVersion: v,
+				TrackLatest:   latest,
+				Templated:     templated,
 				Target: &api.SecretReference_File{
 					File: &api.SecretReference_FileTarget{
 						Name: p,
@@ -121,7 +144,7 @@ func ParseRemoveSecret(cmd *cobra.Command, spec *api.ServiceSpec, flagName strin
 		wantToDelete := make(map[string]struct{})
 
 		for _, secret := range secrets {
-			n, _, err := parseSecretString(secret)
+			n, _, _, _, _, err := parseSecretString(secret)
 			if err != nil {
 				return err
 			}
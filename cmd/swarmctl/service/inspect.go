@@ -49,8 +49,14 @@ func printServiceSummary(service *api.Service, running int) {
 	}
 
 	fmt.Fprintln(w, "Template\t")
-	fmt.Fprintln(w, " Container\t")
 	ctr := service.Spec.Task.GetContainer()
+	if router := service.Spec.Task.GetRouter(); router != nil {
+		fmt.Fprintln(w, " Router\t")
+		fmt.Fprintf(w, "  ListenPort\t: %d\n", router.ListenPort)
+		ctr = &api.ContainerSpec{Image: router.Image}
+	} else {
+		fmt.Fprintln(w, " Container\t")
+	}
 	common.FprintfIfNotEmpty(w, "  Image\t: %s\n", ctr.Image)
 	common.FprintfIfNotEmpty(w, "  Command\t: %q\n", strings.Join(ctr.Command, " "))
 	common.FprintfIfNotEmpty(w, "  Args\t: [%s]\n", strings.Join(ctr.Args, ", "))
@@ -96,6 +102,15 @@ func printServiceSummary(service *api.Service, running int) {
 		}
 	}
 
+	if service.Spec.Endpoint != nil && len(service.Spec.Endpoint.Routes) > 0 {
+		fmt.Fprintln(w, "\nRoutes:")
+		for _, route := range service.Spec.Endpoint.Routes {
+			fmt.Fprintf(w, "    - Hostnames\t= %s\n", strings.Join(route.Hostnames, ", "))
+			fmt.Fprintf(w, "      PathPrefix\t= %s\n", route.PathPrefix)
+			fmt.Fprintf(w, "      Port\t= %d\n", route.TargetPort)
+		}
+	}
+
 	if len(ctr.Mounts) > 0 {
 		fmt.Fprintln(w, "  Mounts:")
 		for _, v := range ctr.Mounts {
@@ -66,7 +66,7 @@ var (
 )
 
 func init() {
-	updateCmd.Flags().StringSlice("add-secret", nil, "add a new secret from swarm")
+	updateCmd.Flags().StringSlice("add-secret", nil, "add a new secret from swarm, as name[@version|@latest][:target[:template]]")
 	updateCmd.Flags().StringSlice("rm-secret", nil, "removes a secret from the service")
 	updateCmd.Flags().Bool("force", false, "force tasks to restart even if nothing has changed")
 	flagparser.AddServiceFlags(updateCmd.Flags())
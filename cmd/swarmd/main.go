@@ -8,6 +8,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
 
 	"github.com/Sirupsen/logrus"
 	engineapi "github.com/docker/docker/client"
@@ -80,6 +81,11 @@ var (
 				return err
 			}
 
+			discoverySocket, err := cmd.Flags().GetString("listen-discovery")
+			if err != nil {
+				return err
+			}
+
 			metricsAddr, err := cmd.Flags().GetString("listen-metrics")
 			if err != nil {
 				return err
@@ -151,7 +157,14 @@ var (
 				return err
 			}
 
-			executor := dockerapi.NewExecutor(client)
+			// The routing table directory is bind mounted into router
+			// containers, so its path must be absolute.
+			routerDir, err := filepath.Abs(filepath.Join(stateDir, "router"))
+			if err != nil {
+				return err
+			}
+
+			executor := dockerapi.NewExecutor(client, routerDir)
 
 			if debugAddr != "" {
 				go func() {
@@ -185,6 +198,7 @@ var (
 				ForceNewCluster:  forceNewCluster,
 				ListenControlAPI: unix,
 				ListenRemoteAPI:  addr,
+				DiscoverySocket:  discoverySocket,
 				JoinAddr:         managerAddr,
 				StateDir:         stateDir,
 				JoinToken:        joinToken,
@@ -234,6 +248,7 @@ func init() {
 	mainCmd.Flags().String("hostname", "", "Override reported agent hostname")
 	mainCmd.Flags().String("listen-remote-api", "0.0.0.0:4242", "Listen address for remote API")
 	mainCmd.Flags().String("listen-control-api", "./swarmkitstate/swarmd.sock", "Listen socket for control API")
+	mainCmd.Flags().String("listen-discovery", "", "Listen socket for the service discovery records")
 	mainCmd.Flags().String("listen-debug", "", "Bind the Go debug server on the provided address")
 	mainCmd.Flags().String("listen-metrics", "", "Listen address for metrics")
 	mainCmd.Flags().String("join-addr", "", "Join cluster with a node at this address")
@@ -51,3 +51,9 @@ func NewID() string {
 	p[0] |= 0x80 // set high bit to avoid the need for padding
 	return (&big.Int{}).SetBytes(p[:]).Text(randomIDBase)[1 : maxRandomIDLength+1]
 }
+
+// CombineTwoIDs returns an identifier for the pair of the given identifiers,
+// such as the copy of a secret sent for a single task.
+func CombineTwoIDs(id1, id2 string) string {
+	return id1 + "." + id2
+}
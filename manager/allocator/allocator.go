@@ -5,6 +5,7 @@ import (
 
 	"github.com/docker/docker/pkg/plugingetter"
 	"github.com/docker/go-events"
+	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/manager/state"
 	"github.com/docker/swarmkit/manager/state/store"
 	"golang.org/x/net/context"
@@ -31,6 +32,10 @@ type Allocator struct {
 
 	// pluginGetter provides access to docker's plugin inventory.
 	pluginGetter plugingetter.PluginGetter
+
+	// inspectChan passes inspection requests to the allocator
+	// goroutines.
+	inspectChan chan *inspectRequest
 }
 
 // taskBallot controls how the voting for task allocation is
@@ -67,6 +72,10 @@ type allocActor struct {
 	// Init routine which is called during the initialization of
 	// the allocator.
 	init func(ctx context.Context) error
+
+	// Inspect routine which fills in the allocator state of an
+	// inspection response.
+	inspect func(*api.InspectAllocatorResponse) error
 }
 
 // New returns a new instance of Allocator for use during allocation
@@ -80,6 +89,7 @@ func New(store *store.MemoryStore, pg plugingetter.PluginGetter) (*Allocator, er
 		stopChan:     make(chan struct{}),
 		doneChan:     make(chan struct{}),
 		pluginGetter: pg,
+		inspectChan:  make(chan *inspectRequest),
 	}
 
 	return a, nil
@@ -100,6 +110,7 @@ func (a *Allocator) Run(ctx context.Context) error {
 	var actors []func() error
 	watch, watchCancel := state.Watch(a.store.WatchQueue(),
 		state.EventCreateNetwork{},
+		state.EventUpdateNetwork{},
 		state.EventDeleteNetwork{},
 		state.EventCreateService{},
 		state.EventUpdateService{},
@@ -110,6 +121,7 @@ func (a *Allocator) Run(ctx context.Context) error {
 		state.EventCreateNode{},
 		state.EventUpdateNode{},
 		state.EventDeleteNode{},
+		state.EventUpdateCluster{},
 		state.EventCommit{},
 	)
 
@@ -120,6 +132,7 @@ func (a *Allocator) Run(ctx context.Context) error {
 			taskVoter: networkVoter,
 			init:      a.doNetworkInit,
 			action:    a.doNetworkAlloc,
+			inspect:   a.inspectNetwork,
 		},
 	} {
 		if aa.taskVoter != "" {
@@ -180,6 +193,11 @@ func (a *Allocator) run(ctx context.Context, aa allocActor) {
 			}
 
 			aa.action(ctx, ev)
+		case req := <-a.inspectChan:
+			if aa.inspect != nil {
+				req.err = aa.inspect(req.resp)
+			}
+			close(req.done)
 		case <-ctx.Done():
 			return
 		}
@@ -571,3 +571,73 @@ func watchTask(t *testing.T, s *store.MemoryStore, watch chan events.Event, expe
 		}
 	}
 }
+
+func TestAllocatorRetriesNetworkUpdate(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	a, err := New(s, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, a)
+
+	newNetwork := func(id, subnet string) *api.Network {
+		return &api.Network{
+			ID: id,
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{
+					Name: id,
+				},
+				IPAM: &api.IPAMOptions{
+					Configs: []*api.IPAMConfig{{Subnet: subnet}},
+				},
+			},
+		}
+	}
+	assert.NoError(t, s.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateNetwork(tx, newNetwork("testID1", "10.20.0.0/24")))
+		assert.NoError(t, store.CreateNetwork(tx, newNetwork("testID2", "10.30.0.0/24")))
+		return nil
+	}))
+
+	netWatch, cancel := state.Watch(s.WatchQueue(), state.EventUpdateNetwork{})
+	defer cancel()
+
+	go func() {
+		assert.NoError(t, a.Run(context.Background()))
+	}()
+	defer a.Stop()
+
+	watchNetwork(t, netWatch, false, isValidNetwork)
+	watchNetwork(t, netWatch, false, isValidNetwork)
+
+	// The gateway of the added subnet is held by the other network, so
+	// its allocation fails.
+	assert.NoError(t, s.Update(func(tx store.Tx) error {
+		n := store.GetNetwork(tx, "testID1")
+		n.Spec.IPAM.Configs = append(n.Spec.IPAM.Configs, &api.IPAMConfig{
+			Subnet:  "10.30.0.0/24",
+			Gateway: "10.30.0.1",
+		})
+		return store.UpdateNetwork(tx, n)
+	}))
+	hasTwoSubnets := func(t assert.TestingT, n *api.Network) bool {
+		return assert.Equal(t, "testID1", n.ID) && assert.Len(t, n.IPAM.Configs, 2)
+	}
+	watchNetwork(t, netWatch, true, hasTwoSubnets)
+	s.View(func(tx store.ReadTx) {
+		assert.Len(t, store.GetNetwork(tx, "testID1").IPAM.Configs, 1)
+	})
+
+	// Once the other network is gone, the update is retried.
+	assert.NoError(t, s.Update(func(tx store.Tx) error {
+		return store.DeleteNetwork(tx, "testID2")
+	}))
+	watchNetwork(t, netWatch, false, hasTwoSubnets)
+
+	s.View(func(tx store.ReadTx) {
+		n := store.GetNetwork(tx, "testID1")
+		assert.Equal(t, "10.30.0.0/24", n.IPAM.Configs[1].Subnet)
+		assert.Equal(t, "10.30.0.1", n.IPAM.Configs[1].Gateway)
+	})
+}
@@ -0,0 +1,144 @@
+package allocator
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/api/naming"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+)
+
+// ErrStopped is returned when inspecting an allocator which is not running.
+var ErrStopped = errors.New("allocator is not running")
+
+// inspectRequest is a request for the state of the allocator goroutines.
+type inspectRequest struct {
+	resp *api.InspectAllocatorResponse
+	err  error
+	done chan struct{}
+}
+
+// Inspect returns a snapshot of the allocation state. The snapshot is taken
+// by the allocator goroutine between two events, so it is consistent with
+// the allocations made so far.
+func (a *Allocator) Inspect(ctx context.Context) (*api.InspectAllocatorResponse, error) {
+	req := &inspectRequest{
+		resp: &api.InspectAllocatorResponse{},
+		done: make(chan struct{}),
+	}
+
+	select {
+	case a.inspectChan <- req:
+	case <-a.doneChan:
+		return nil, ErrStopped
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case <-req.done:
+		return req.resp, req.err
+	case <-a.doneChan:
+		return nil, ErrStopped
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// inspectNetwork fills in the state of the network allocator.
+func (a *Allocator) inspectNetwork(resp *api.InspectAllocatorResponse) error {
+	nc := a.netCtx
+
+	resp.Networks = nc.nwkAllocator.InspectNetworks()
+	resp.PortSpaces = nc.nwkAllocator.InspectPorts()
+
+	var err error
+	a.store.View(func(tx store.ReadTx) {
+		var services []*api.Service
+		services, err = store.FindServices(tx, store.All)
+		if err != nil {
+			return
+		}
+		for _, s := range services {
+			if _, ok := nc.unallocatedServices[s.ID]; ok {
+				continue
+			}
+			if s.Endpoint == nil || len(s.Endpoint.VirtualIPs) == 0 {
+				continue
+			}
+			resp.Services = append(resp.Services, &api.InspectAllocatorResponse_Service{
+				ID:         s.ID,
+				Name:       s.Spec.Annotations.Name,
+				VirtualIPs: s.Endpoint.VirtualIPs,
+			})
+		}
+
+		var nodes []*api.Node
+		nodes, err = store.FindNodes(tx, store.All)
+		if err != nil {
+			return
+		}
+		for _, node := range nodes {
+			if node.Attachment == nil || node.Attachment.Network == nil || len(node.Attachment.Addresses) == 0 {
+				continue
+			}
+			resp.Nodes = append(resp.Nodes, &api.InspectAllocatorResponse_Node{
+				ID:        node.ID,
+				NetworkID: node.Attachment.Network.ID,
+				Addresses: node.Attachment.Addresses,
+			})
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, n := range nc.unallocatedNetworks {
+		resp.UnallocatedNetworks = append(resp.UnallocatedNetworks, &api.InspectAllocatorResponse_Unallocated{
+			ID:   n.ID,
+			Name: n.Spec.Annotations.Name,
+		})
+	}
+
+	for _, s := range nc.unallocatedServices {
+		specNetworks := s.Spec.Task.Networks
+		if len(specNetworks) == 0 {
+			specNetworks = s.Spec.Networks
+		}
+		unallocated := &api.InspectAllocatorResponse_Unallocated{
+			ID:   s.ID,
+			Name: s.Spec.Annotations.Name,
+		}
+		for _, na := range specNetworks {
+			unallocated.NetworkIDs = append(unallocated.NetworkIDs, na.Target)
+		}
+		resp.UnallocatedServices = append(resp.UnallocatedServices, unallocated)
+	}
+
+	for _, t := range nc.unallocatedTasks {
+		unallocated := &api.InspectAllocatorResponse_Unallocated{
+			ID:   t.ID,
+			Name: naming.Task(t),
+		}
+		for _, na := range t.Networks {
+			if na.Network != nil {
+				unallocated.NetworkIDs = append(unallocated.NetworkIDs, na.Network.ID)
+			}
+		}
+		resp.UnallocatedTasks = append(resp.UnallocatedTasks, unallocated)
+	}
+
+	sort.Sort(unallocatedByName(resp.UnallocatedNetworks))
+	sort.Sort(unallocatedByName(resp.UnallocatedServices))
+	sort.Sort(unallocatedByName(resp.UnallocatedTasks))
+
+	return nil
+}
+
+type unallocatedByName []*api.InspectAllocatorResponse_Unallocated
+
+func (u unallocatedByName) Len() int           { return len(u) }
+func (u unallocatedByName) Swap(i, j int)      { u[i], u[j] = u[j], u[i] }
+func (u unallocatedByName) Less(i, j int) bool { return u[i].Name < u[j].Name }
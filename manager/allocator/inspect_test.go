@@ -0,0 +1,141 @@
+package allocator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestInspect(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	a, err := New(s, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateNetwork(tx, &api.Network{
+			ID: "testID1",
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{
+					Name: "test1",
+				},
+				IPAM: &api.IPAMOptions{
+					Configs: []*api.IPAMConfig{
+						{
+							Subnet:  "192.168.20.0/24",
+							Gateway: "192.168.20.1",
+						},
+					},
+				},
+			},
+		}))
+		assert.NoError(t, store.CreateNetwork(tx, &api.Network{
+			ID: "testID2",
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{
+					Name: "test2",
+				},
+				IPAM: &api.IPAMOptions{
+					Driver: &api.Driver{
+						Name: "invalidipam",
+					},
+				},
+			},
+		}))
+		assert.NoError(t, store.CreateService(tx, &api.Service{
+			ID: "testServiceID1",
+			Spec: api.ServiceSpec{
+				Annotations: api.Annotations{
+					Name: "service1",
+				},
+				Task: api.TaskSpec{
+					Networks: []*api.NetworkAttachmentConfig{
+						{
+							Target: "testID1",
+						},
+					},
+				},
+				Endpoint: &api.EndpointSpec{
+					Mode: api.ResolutionModeVirtualIP,
+					Ports: []*api.PortConfig{
+						{
+							Protocol:      api.ProtocolUDP,
+							TargetPort:    53,
+							PublishedPort: 5353,
+						},
+					},
+				},
+			},
+		}))
+		return nil
+	}))
+
+	serviceWatch, cancel := state.Watch(s.WatchQueue(), state.EventUpdateService{})
+	defer cancel()
+
+	go func() {
+		assert.NoError(t, a.Run(context.Background()))
+	}()
+	defer a.Stop()
+
+	watchService(t, serviceWatch, false, nil)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+	resp, err := a.Inspect(ctx)
+	require.NoError(t, err)
+
+	var network *api.InspectAllocatorResponse_Network
+	for _, n := range resp.Networks {
+		if n.ID == "testID1" {
+			network = n
+		}
+	}
+	require.NotNil(t, network)
+	require.Len(t, network.Pools, 1)
+	assert.Equal(t, "192.168.20.0/24", network.Pools[0].Subnet)
+	assert.Equal(t, uint64(253), network.Pools[0].Capacity)
+	assert.Equal(t, uint64(1), network.Pools[0].Allocated)
+
+	require.Len(t, resp.Services, 1)
+	assert.Equal(t, "testServiceID1", resp.Services[0].ID)
+	// The published port attaches the service to the ingress network too.
+	require.Len(t, resp.Services[0].VirtualIPs, 2)
+	assert.Equal(t, "testID1", resp.Services[0].VirtualIPs[1].NetworkID)
+
+	for _, ps := range resp.PortSpaces {
+		if ps.Protocol == api.ProtocolUDP {
+			assert.Equal(t, []uint32{5353}, ps.Ports)
+		} else {
+			assert.Empty(t, ps.Ports)
+		}
+	}
+
+	require.Len(t, resp.UnallocatedNetworks, 1)
+	assert.Equal(t, "testID2", resp.UnallocatedNetworks[0].ID)
+}
+
+func TestInspectStopped(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	a, err := New(s, nil)
+	assert.NoError(t, err)
+
+	go func() {
+		assert.NoError(t, a.Run(context.Background()))
+	}()
+	a.Stop()
+
+	_, err = a.Inspect(context.Background())
+	assert.Equal(t, ErrStopped, err)
+}
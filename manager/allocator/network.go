@@ -78,6 +78,25 @@ func (a *Allocator) doNetworkInit(ctx context.Context) (err error) {
 		return err
 	}
 
+	// Published ports and subnets must be allocated from the ranges
+	// configured for the cluster, so set them up before allocating any
+	// network or service.
+	var clusters []*api.Cluster
+	a.store.View(func(tx store.ReadTx) {
+		clusters, err = store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to find cluster during init")
+	}
+	if len(clusters) == 1 {
+		if err := na.UpdatePortAllocation(&clusters[0].Spec.PortAllocation); err != nil {
+			return errors.Wrap(err, "failed to configure published port ranges")
+		}
+		if err := na.UpdateDefaultAddressPools(&clusters[0].Spec.DefaultAddressPools); err != nil {
+			return errors.Wrap(err, "failed to configure default address pools")
+		}
+	}
+
 	nc := &networkContext{
 		nwkAllocator:        na,
 		unallocatedTasks:    make(map[string]*api.Task),
@@ -325,6 +344,16 @@ func (a *Allocator) doNetworkAlloc(ctx context.Context, ev events.Event) {
 	nc := a.netCtx
 
 	switch v := ev.(type) {
+	case state.EventUpdateCluster:
+		if v.Cluster.Spec.Annotations.Name != store.DefaultClusterName {
+			break
+		}
+		if err := nc.nwkAllocator.UpdatePortAllocation(&v.Cluster.Spec.PortAllocation); err != nil {
+			log.G(ctx).WithError(err).Error("Failed to update published port ranges")
+		}
+		if err := nc.nwkAllocator.UpdateDefaultAddressPools(&v.Cluster.Spec.DefaultAddressPools); err != nil {
+			log.G(ctx).WithError(err).Error("Failed to update default address pools")
+		}
 	case state.EventCreateNetwork:
 		n := v.Network.Copy()
 		if nc.nwkAllocator.IsAllocated(n) {
@@ -341,6 +370,33 @@ func (a *Allocator) doNetworkAlloc(ctx context.Context, ev events.Event) {
 		}); err != nil {
 			log.G(ctx).WithError(err).Errorf("Failed to commit allocation for network %s", n.ID)
 		}
+	case state.EventUpdateNetwork:
+		n := v.Network.Copy()
+
+		// Networks waiting for allocation are retried with their
+		// latest spec.
+		if _, ok := nc.unallocatedNetworks[n.ID]; ok {
+			nc.unallocatedNetworks[n.ID] = n
+			break
+		}
+		if !nc.nwkAllocator.IsAllocated(n) {
+			break
+		}
+
+		updated, err := a.updateNetwork(ctx, n)
+		if err != nil {
+			log.G(ctx).WithError(err).Errorf("Failed allocation during update of network %s", n.ID)
+			break
+		}
+		if !updated {
+			break
+		}
+
+		if _, err := a.store.Batch(func(batch *store.Batch) error {
+			return a.commitUpdatedNetwork(ctx, batch, n)
+		}); err != nil {
+			log.G(ctx).WithError(err).Errorf("Failed to commit allocation during update of network %s", n.ID)
+		}
 	case state.EventDeleteNetwork:
 		n := v.Network.Copy()
 
@@ -542,7 +598,12 @@ func (a *Allocator) taskCreateNetworkAttachments(t *api.Task, s *api.Service) {
 
 			attachment := api.NetworkAttachment{Network: n}
 			attachment.Aliases = append(attachment.Aliases, na.Aliases...)
-			attachment.Addresses = append(attachment.Addresses, na.Addresses...)
+			if slotAddress, ok := na.SlotAddresses[t.Slot]; ok && t.Slot != 0 {
+				attachment.Addresses = append(attachment.Addresses, slotAddress.Addresses...)
+				attachment.MacAddress = slotAddress.MacAddress
+			} else {
+				attachment.Addresses = append(attachment.Addresses, na.Addresses...)
+			}
 
 			networks = append(networks, &attachment)
 		}
@@ -768,6 +829,21 @@ func (a *Allocator) allocateNetwork(ctx context.Context, n *api.Network) error {
 	return nil
 }
 
+// updateNetwork allocates the resources of the IPAM configs added to an
+// allocated network. If it fails, the network is retried with the
+// unallocated ones.
+func (a *Allocator) updateNetwork(ctx context.Context, n *api.Network) (bool, error) {
+	nc := a.netCtx
+
+	updated, err := nc.nwkAllocator.Update(n)
+	if err != nil {
+		nc.unallocatedNetworks[n.ID] = n
+		return false, errors.Wrapf(err, "failed during update of network %s", n.ID)
+	}
+
+	return updated, nil
+}
+
 func (a *Allocator) commitAllocatedNetwork(ctx context.Context, batch *store.Batch, n *api.Network) error {
 	if err := batch.Update(func(tx store.Tx) error {
 		if err := store.UpdateNetwork(tx, n); err != nil {
@@ -785,6 +861,24 @@ func (a *Allocator) commitAllocatedNetwork(ctx context.Context, batch *store.Bat
 	return nil
 }
 
+// commitUpdatedNetwork records the IPAM state of an updated network in the
+// store. The network is read again in the transaction so that spec changes
+// made since the update event are preserved.
+func (a *Allocator) commitUpdatedNetwork(ctx context.Context, batch *store.Batch, n *api.Network) error {
+	return batch.Update(func(tx store.Tx) error {
+		storeNetwork := store.GetNetwork(tx, n.ID)
+		if storeNetwork == nil {
+			return fmt.Errorf("could not find network %s", n.ID)
+		}
+
+		storeNetwork.IPAM = n.IPAM
+		if err := store.UpdateNetwork(tx, storeNetwork); err != nil {
+			return errors.Wrapf(err, "failed updating state in store transaction for network %s", n.ID)
+		}
+		return nil
+	})
+}
+
 func (a *Allocator) allocateTask(ctx context.Context, t *api.Task) (err error) {
 	taskUpdated := false
 	nc := a.netCtx
@@ -878,7 +972,7 @@ func (a *Allocator) commitAllocatedTask(ctx context.Context, batch *store.Batch,
 
 func (a *Allocator) procUnallocatedNetworks(ctx context.Context) {
 	nc := a.netCtx
-	var allocatedNetworks []*api.Network
+	var allocatedNetworks, updatedNetworks []*api.Network
 	for _, n := range nc.unallocatedNetworks {
 		if !nc.nwkAllocator.IsAllocated(n) {
 			if err := a.allocateNetwork(ctx, n); err != nil {
@@ -886,6 +980,40 @@ func (a *Allocator) procUnallocatedNetworks(ctx context.Context) {
 				continue
 			}
 			allocatedNetworks = append(allocatedNetworks, n)
+			continue
+		}
+
+		// The network is allocated, but allocating its last update
+		// failed.
+		updated, err := a.updateNetwork(ctx, n)
+		if err != nil {
+			log.G(ctx).WithError(err).Debugf("Failed allocation of update of network %s", n.ID)
+			continue
+		}
+		if !updated {
+			delete(nc.unallocatedNetworks, n.ID)
+			continue
+		}
+		updatedNetworks = append(updatedNetworks, n)
+	}
+
+	if len(updatedNetworks) != 0 {
+		committed, err := a.store.Batch(func(batch *store.Batch) error {
+			for _, n := range updatedNetworks {
+				if err := a.commitUpdatedNetwork(ctx, batch, n); err != nil {
+					log.G(ctx).WithError(err).Debugf("Failed to commit allocation of update of network %s", n.ID)
+					continue
+				}
+			}
+			return nil
+		})
+
+		if err != nil {
+			log.G(ctx).WithError(err).Error("Failed to commit allocation of network updates")
+		}
+
+		for _, n := range updatedNetworks[:committed] {
+			delete(nc.unallocatedNetworks, n.ID)
 		}
 	}
 
@@ -0,0 +1,162 @@
+package networkallocator
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/docker/swarmkit/api"
+)
+
+const (
+	// defaultSubnetSize is the prefix length of the subnets allocated
+	// from the default address pools when the cluster doesn't set one.
+	defaultSubnetSize = 24
+
+	// maxSubnetSize is the longest prefix length of the subnets
+	// allocated from the default address pools, which leaves room for
+	// the network, gateway and broadcast addresses and one endpoint.
+	maxSubnetSize = 29
+
+	// maxDefaultPoolCandidates is the number of subnets of the default
+	// address pools tried at most for a network.
+	maxDefaultPoolCandidates = 1 << 16
+
+	// DefaultPoolSubnetSizeOption is the option of the default IPAM driver
+	// setting the prefix length of the subnet allocated to a network from
+	// the default address pools, instead of the cluster-wide one.
+	DefaultPoolSubnetSizeOption = "com.docker.network.ipam.subnet_size"
+)
+
+// addressPools are the pools the subnets of networks which don't specify one
+// are allocated from.
+type addressPools struct {
+	pools      []*net.IPNet
+	subnetSize int
+}
+
+// parseDefaultAddressPools validates config and returns the address pools it
+// defines.
+func parseDefaultAddressPools(config *api.DefaultAddressPoolsConfig) (*addressPools, error) {
+	subnetSize := int(config.SubnetSize)
+	if subnetSize == 0 {
+		subnetSize = defaultSubnetSize
+	}
+	if err := validateSubnetSize(subnetSize); err != nil {
+		return nil, err
+	}
+
+	ap := &addressPools{subnetSize: subnetSize}
+	for _, pool := range config.Pools {
+		_, ipNet, err := net.ParseCIDR(pool)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address pool %s: %v", pool, err)
+		}
+		if ipNet.IP.To4() == nil {
+			return nil, fmt.Errorf("invalid address pool %s: only IPv4 pools are supported", pool)
+		}
+		if ones, _ := ipNet.Mask.Size(); ones > subnetSize {
+			return nil, fmt.Errorf("address pool %s is smaller than the subnet size /%d", pool, subnetSize)
+		}
+		for _, other := range ap.pools {
+			if subnetsOverlap(ipNet, other) {
+				return nil, fmt.Errorf("address pools %s and %s overlap", ipNet, other)
+			}
+		}
+		ap.pools = append(ap.pools, ipNet)
+	}
+
+	return ap, nil
+}
+
+// ValidateDefaultAddressPools checks that the address pools of config are
+// valid.
+func ValidateDefaultAddressPools(config *api.DefaultAddressPoolsConfig) error {
+	_, err := parseDefaultAddressPools(config)
+	return err
+}
+
+// SubnetInDefaultAddressPools returns true if the subnet, in CIDR format, is
+// contained in one of the address pools of config. If config has no pools,
+// every subnet is considered contained.
+func SubnetInDefaultAddressPools(config *api.DefaultAddressPoolsConfig, subnet string) (bool, error) {
+	ap, err := parseDefaultAddressPools(config)
+	if err != nil {
+		return false, err
+	}
+	if len(ap.pools) == 0 {
+		return true, nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return false, err
+	}
+	for _, pool := range ap.pools {
+		poolOnes, _ := pool.Mask.Size()
+		ones, _ := ipNet.Mask.Size()
+		if ones >= poolOnes && pool.Contains(ipNet.IP) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func validateSubnetSize(subnetSize int) error {
+	if subnetSize < 1 || subnetSize > maxSubnetSize {
+		return fmt.Errorf("subnet size must be between 1 and %d", maxSubnetSize)
+	}
+	return nil
+}
+
+// ValidateDefaultPoolOptions checks the options of the default IPAM driver
+// which apply to the default address pools.
+func ValidateDefaultPoolOptions(options map[string]string) error {
+	_, err := defaultPoolSubnetSize(options, defaultSubnetSize)
+	return err
+}
+
+// defaultPoolSubnetSize returns the prefix length of the subnet to allocate
+// from the default address pools for a network with the given IPAM driver
+// options, or subnetSize if they don't set one.
+func defaultPoolSubnetSize(options map[string]string, subnetSize int) (int, error) {
+	value, ok := options[DefaultPoolSubnetSizeOption]
+	if !ok {
+		return subnetSize, nil
+	}
+	size, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s option %q", DefaultPoolSubnetSizeOption, value)
+	}
+	if err := validateSubnetSize(size); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// forEachSubnet calls fn for the subnets of the address pools with the given
+// prefix length, in order, until fn returns false. Pools smaller than the
+// subnets are skipped.
+func (ap *addressPools) forEachSubnet(subnetSize int, fn func(*net.IPNet) bool) {
+	mask := net.CIDRMask(subnetSize, 32)
+	for _, pool := range ap.pools {
+		ones, _ := pool.Mask.Size()
+		if ones > subnetSize {
+			continue
+		}
+		base := binary.BigEndian.Uint32(pool.IP.To4())
+		count := uint64(1) << uint(subnetSize-ones)
+		for i := uint64(0); i < count; i++ {
+			ip := make(net.IP, 4)
+			binary.BigEndian.PutUint32(ip, base+uint32(i<<uint(32-subnetSize)))
+			if !fn(&net.IPNet{IP: ip, Mask: mask}) {
+				return
+			}
+		}
+	}
+}
+
+func subnetsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
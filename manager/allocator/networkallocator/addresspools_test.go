@@ -0,0 +1,168 @@
+package networkallocator
+
+import (
+	"net"
+	"testing"
+
+	"github.com/docker/libnetwork/ipamapi"
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDefaultAddressPools(t *testing.T) {
+	for _, config := range []api.DefaultAddressPoolsConfig{
+		{Pools: []string{"10.10.0.0/16"}, SubnetSize: 30},
+		{Pools: []string{"invalid"}},
+		{Pools: []string{"fd00::/48"}},
+		{Pools: []string{"10.10.0.0/25"}},
+		{Pools: []string{"10.10.0.0/16", "10.10.128.0/17"}},
+	} {
+		assert.Error(t, ValidateDefaultAddressPools(&config), "%v", config)
+	}
+
+	for _, config := range []api.DefaultAddressPoolsConfig{
+		{},
+		{Pools: []string{"10.10.0.0/16"}},
+		{Pools: []string{"10.10.0.0/16", "10.11.0.0/16"}, SubnetSize: 26},
+	} {
+		assert.NoError(t, ValidateDefaultAddressPools(&config), "%v", config)
+	}
+}
+
+func TestSubnetInDefaultAddressPools(t *testing.T) {
+	in, err := SubnetInDefaultAddressPools(&api.DefaultAddressPoolsConfig{}, "172.17.0.0/16")
+	assert.NoError(t, err)
+	assert.True(t, in)
+
+	config := &api.DefaultAddressPoolsConfig{Pools: []string{"10.10.0.0/16"}}
+	in, err = SubnetInDefaultAddressPools(config, "10.10.3.0/24")
+	assert.NoError(t, err)
+	assert.True(t, in)
+	in, err = SubnetInDefaultAddressPools(config, "10.0.0.0/8")
+	assert.NoError(t, err)
+	assert.False(t, in)
+	in, err = SubnetInDefaultAddressPools(config, "10.11.0.0/24")
+	assert.NoError(t, err)
+	assert.False(t, in)
+}
+
+func TestAddressPoolsForEachSubnet(t *testing.T) {
+	ap, err := parseDefaultAddressPools(&api.DefaultAddressPoolsConfig{
+		Pools:      []string{"10.10.0.0/25", "10.20.0.0/26"},
+		SubnetSize: 26,
+	})
+	assert.NoError(t, err)
+
+	var subnets []string
+	ap.forEachSubnet(ap.subnetSize, func(subnet *net.IPNet) bool {
+		subnets = append(subnets, subnet.String())
+		return true
+	})
+	assert.Equal(t, []string{"10.10.0.0/26", "10.10.0.64/26", "10.20.0.0/26"}, subnets)
+
+	subnets = nil
+	ap.forEachSubnet(ap.subnetSize, func(subnet *net.IPNet) bool {
+		subnets = append(subnets, subnet.String())
+		return false
+	})
+	assert.Equal(t, []string{"10.10.0.0/26"}, subnets)
+}
+
+func TestAllocateFromDefaultAddressPools(t *testing.T) {
+	na := newNetworkAllocator(t)
+	assert.NoError(t, na.UpdateDefaultAddressPools(&api.DefaultAddressPoolsConfig{
+		Pools: []string{"10.210.0.0/23"},
+	}))
+
+	var networks []*api.Network
+	for _, id := range []string{"testID1", "testID2", "testID3"} {
+		networks = append(networks, &api.Network{
+			ID: id,
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{
+					Name: id,
+				},
+			},
+		})
+	}
+
+	assert.NoError(t, na.Allocate(networks[0]))
+	assert.Equal(t, "10.210.0.0/24", networks[0].IPAM.Configs[0].Subnet)
+	assert.NoError(t, na.Allocate(networks[1]))
+	assert.Equal(t, "10.210.1.0/24", networks[1].IPAM.Configs[0].Subnet)
+
+	// The pools are exhausted.
+	assert.Error(t, na.Allocate(networks[2]))
+
+	// A freed subnet is handed out again.
+	assert.NoError(t, na.Deallocate(networks[0]))
+	assert.NoError(t, na.Allocate(networks[2]))
+	assert.Equal(t, "10.210.0.0/24", networks[2].IPAM.Configs[0].Subnet)
+
+	assert.NoError(t, na.Deallocate(networks[1]))
+	assert.NoError(t, na.Deallocate(networks[2]))
+
+	// A network can set its own subnet size.
+	n := &api.Network{
+		ID: "testID4",
+		Spec: api.NetworkSpec{
+			Annotations: api.Annotations{
+				Name: "testID4",
+			},
+			IPAM: &api.IPAMOptions{
+				Driver: &api.Driver{
+					Name:    ipamapi.DefaultIPAM,
+					Options: map[string]string{DefaultPoolSubnetSizeOption: "25"},
+				},
+			},
+		},
+	}
+	assert.NoError(t, na.Allocate(n))
+	assert.Equal(t, "10.210.0.0/25", n.IPAM.Configs[0].Subnet)
+	assert.NoError(t, na.Deallocate(n))
+}
+
+func TestDefaultPoolCandidatesBound(t *testing.T) {
+	na := newNetworkAllocator(t)
+	assert.NoError(t, na.UpdateDefaultAddressPools(&api.DefaultAddressPoolsConfig{
+		Pools:      []string{"10.0.0.0/8"},
+		SubnetSize: 29,
+	}))
+
+	// The first half of the pool is taken by a network of another IPAM
+	// driver, which is more than the subnets tried for a network.
+	_, taken, err := net.ParseCIDR("10.0.0.0/9")
+	assert.NoError(t, err)
+	na.defaultIPAM.allocated = func() []*net.IPNet { return []*net.IPNet{taken} }
+
+	n := &api.Network{
+		ID: "testID",
+		Spec: api.NetworkSpec{
+			Annotations: api.Annotations{
+				Name: "test",
+			},
+		},
+	}
+	err = na.Allocate(n)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no subnets available in the first")
+
+	// With larger subnets, a free one is found within the bound.
+	n.IPAM = nil
+	n.Spec.IPAM = &api.IPAMOptions{
+		Driver: &api.Driver{
+			Name:    ipamapi.DefaultIPAM,
+			Options: map[string]string{DefaultPoolSubnetSizeOption: "16"},
+		},
+	}
+	assert.NoError(t, na.Allocate(n))
+	assert.Equal(t, "10.128.0.0/16", n.IPAM.Configs[0].Subnet)
+}
+
+func TestValidateDefaultPoolOptions(t *testing.T) {
+	assert.NoError(t, ValidateDefaultPoolOptions(nil))
+	assert.NoError(t, ValidateDefaultPoolOptions(map[string]string{DefaultPoolSubnetSizeOption: "26"}))
+	for _, size := range []string{"invalid", "0", "30"} {
+		assert.Error(t, ValidateDefaultPoolOptions(map[string]string{DefaultPoolSubnetSizeOption: size}))
+	}
+}
@@ -1,6 +1,9 @@
 package networkallocator
 
 import (
+	"fmt"
+	"net"
+
 	"github.com/docker/libnetwork/drvregistry"
 	"github.com/docker/libnetwork/ipamapi"
 	builtinIpam "github.com/docker/libnetwork/ipams/builtin"
@@ -8,16 +11,118 @@ import (
 	remoteIpam "github.com/docker/libnetwork/ipams/remote"
 )
 
-func initIPAMDrivers(r *drvregistry.DrvRegistry) error {
+// initIPAMDrivers registers the IPAM drivers with r. The default driver is
+// wrapped by the returned defaultPoolIPAM.
+func initIPAMDrivers(r *drvregistry.DrvRegistry, allocated func() []*net.IPNet) (*defaultPoolIPAM, error) {
+	cb := &defaultPoolCallback{
+		DrvRegistry: r,
+		defaultIPAM: &defaultPoolIPAM{allocated: allocated},
+	}
 	for _, fn := range [](func(ipamapi.Callback, interface{}, interface{}) error){
 		builtinIpam.Init,
 		remoteIpam.Init,
 		nullIpam.Init,
 	} {
-		if err := fn(r, nil, nil); err != nil {
-			return err
+		if err := fn(cb, nil, nil); err != nil {
+			return nil, err
 		}
 	}
+	if cb.defaultIPAM.Ipam == nil {
+		return nil, fmt.Errorf("IPAM driver %s was not registered", ipamapi.DefaultIPAM)
+	}
+
+	return cb.defaultIPAM, nil
+}
+
+// defaultPoolCallback registers IPAM drivers with the registry, replacing
+// the default driver with a defaultPoolIPAM wrapping it.
+type defaultPoolCallback struct {
+	*drvregistry.DrvRegistry
+	defaultIPAM *defaultPoolIPAM
+}
+
+func (cb *defaultPoolCallback) RegisterIpamDriver(name string, driver ipamapi.Ipam) error {
+	return cb.DrvRegistry.RegisterIpamDriver(name, cb.wrap(name, driver))
+}
+
+func (cb *defaultPoolCallback) RegisterIpamDriverWithCapabilities(name string, driver ipamapi.Ipam, caps *ipamapi.Capability) error {
+	return cb.DrvRegistry.RegisterIpamDriverWithCapabilities(name, cb.wrap(name, driver), caps)
+}
+
+func (cb *defaultPoolCallback) wrap(name string, driver ipamapi.Ipam) ipamapi.Ipam {
+	if name != ipamapi.DefaultIPAM {
+		return driver
+	}
+	cb.defaultIPAM.Ipam = driver
+	return cb.defaultIPAM
+}
+
+// defaultPoolIPAM is the default IPAM driver. When the cluster configures
+// default address pools, the IPv4 subnets of networks which don't specify
+// one are allocated from them. Otherwise it behaves as the driver it wraps.
+type defaultPoolIPAM struct {
+	ipamapi.Ipam
+
+	// pools are the default address pools, nil if the cluster
+	// configures none
+	pools *addressPools
+
+	// allocated returns the subnets of the networks allocated so far,
+	// including those allocated by other IPAM drivers
+	allocated func() []*net.IPNet
+}
+
+// RequestPool requests the first subnet of the default address pools which
+// doesn't overlap with the subnet of another network, trying at most
+// maxDefaultPoolCandidates subnets. The subnets are as large as the
+// DefaultPoolSubnetSizeOption option sets, or the cluster's subnet size.
+func (d *defaultPoolIPAM) RequestPool(addressSpace, pool, subPool string, options map[string]string, v6 bool) (string, *net.IPNet, map[string]string, error) {
+	if pool != "" || v6 || d.pools == nil || len(d.pools.pools) == 0 {
+		return d.Ipam.RequestPool(addressSpace, pool, subPool, options, v6)
+	}
+
+	subnetSize, err := defaultPoolSubnetSize(options, d.pools.subnetSize)
+	if err != nil {
+		return "", nil, nil, err
+	}
 
-	return nil
+	var allocated []*net.IPNet
+	if d.allocated != nil {
+		allocated = d.allocated()
+	}
+
+	var (
+		poolID     string
+		poolIP     *net.IPNet
+		data       map[string]string
+		candidates int
+	)
+	err = fmt.Errorf("no subnets available in the default address pools")
+	d.pools.forEachSubnet(subnetSize, func(subnet *net.IPNet) bool {
+		candidates++
+		if candidates > maxDefaultPoolCandidates {
+			err = fmt.Errorf("no subnets available in the first %d subnets of the default address pools", maxDefaultPoolCandidates)
+			return false
+		}
+
+		for _, ipNet := range allocated {
+			if subnetsOverlap(subnet, ipNet) {
+				return true
+			}
+		}
+
+		// The wrapped driver also checks for overlaps with the pools
+		// it allocated for other users of the address space.
+		var requestErr error
+		poolID, poolIP, data, requestErr = d.Ipam.RequestPool(addressSpace, subnet.String(), subPool, options, false)
+		if requestErr == ipamapi.ErrPoolOverlap {
+			return true
+		}
+		err = requestErr
+		return false
+	})
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return poolID, poolIP, data, nil
 }
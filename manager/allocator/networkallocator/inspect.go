@@ -0,0 +1,107 @@
+package networkallocator
+
+import (
+	"math"
+	"net"
+	"sort"
+
+	"github.com/docker/swarmkit/api"
+)
+
+// InspectNetworks returns the usage of the address pools of the allocated
+// networks.
+func (na *NetworkAllocator) InspectNetworks() []*api.InspectAllocatorResponse_Network {
+	var networks []*api.InspectAllocatorResponse_Network
+	for _, localNet := range na.networks {
+		network := &api.InspectAllocatorResponse_Network{
+			ID:   localNet.nw.ID,
+			Name: localNet.nw.Spec.Annotations.Name,
+		}
+
+		allocated := make(map[string]uint64)
+		for _, poolID := range localNet.endpoints {
+			allocated[poolID]++
+		}
+		reserved := make(map[string]uint64)
+		for _, r := range localNet.reserved {
+			if _, ok := localNet.endpoints[r.addr]; !ok {
+				reserved[r.poolID]++
+			}
+		}
+
+		var configs []*api.IPAMConfig
+		if localNet.nw.IPAM != nil {
+			configs = localNet.nw.IPAM.Configs
+		}
+		for _, ic := range configs {
+			poolID, ok := localNet.pools[ic.Subnet]
+			if !ok {
+				continue
+			}
+			network.Pools = append(network.Pools, &api.InspectAllocatorResponse_Pool{
+				Subnet:    ic.Subnet,
+				Gateway:   ic.Gateway,
+				Capacity:  poolCapacity(ic.Subnet),
+				Allocated: allocated[poolID],
+				Reserved:  reserved[poolID],
+			})
+		}
+
+		networks = append(networks, network)
+	}
+
+	sort.Sort(networksByName(networks))
+	return networks
+}
+
+type networksByName []*api.InspectAllocatorResponse_Network
+
+func (n networksByName) Len() int           { return len(n) }
+func (n networksByName) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n networksByName) Less(i, j int) bool { return n[i].Name < n[j].Name }
+
+// InspectPorts returns the published ports allocated for each protocol.
+func (na *NetworkAllocator) InspectPorts() []*api.InspectAllocatorResponse_PortSpace {
+	var portSpaces []*api.InspectAllocatorResponse_PortSpace
+	for _, protocol := range []api.PortConfig_Protocol{api.ProtocolTCP, api.ProtocolUDP, api.ProtocolSCTP} {
+		ps, ok := na.portAllocator.portSpaces[protocol]
+		if !ok {
+			continue
+		}
+
+		dynamicRange := ps.dynamicRange
+		portSpace := &api.InspectAllocatorResponse_PortSpace{
+			Protocol:     protocol,
+			DynamicRange: &dynamicRange,
+		}
+		for port := uint32(masterPortStart); port <= masterPortEnd; port++ {
+			if ps.isAllocated(port) {
+				portSpace.Ports = append(portSpace.Ports, port)
+			}
+		}
+
+		portSpaces = append(portSpaces, portSpace)
+	}
+
+	return portSpaces
+}
+
+// poolCapacity returns the number of addresses of subnet which can be
+// allocated to endpoints, leaving out the network and broadcast addresses
+// and the gateway.
+func poolCapacity(subnet string) uint64 {
+	_, ipNet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return 0
+	}
+
+	ones, bits := ipNet.Mask.Size()
+	if bits-ones >= 64 {
+		return math.MaxUint64
+	}
+	size := uint64(1) << uint(bits-ones)
+	if size <= 3 {
+		return 0
+	}
+	return size - 3
+}
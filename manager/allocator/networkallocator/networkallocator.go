@@ -33,6 +33,10 @@ type NetworkAllocator struct {
 	// The port allocator instance for allocating node ports
 	portAllocator *portAllocator
 
+	// The default IPAM driver, which allocates the subnets of
+	// networks which don't specify one from the default address pools.
+	defaultIPAM *defaultPoolIPAM
+
 	// Local network state used by NetworkAllocator to do network management.
 	networks map[string]*network
 
@@ -61,6 +65,28 @@ type network struct {
 	// endpoints is a map of endpoint IP to the poolID from which it
 	// was allocated.
 	endpoints map[string]string
+
+	// endpointServices is a map of endpoint IP to the service of the
+	// task holding it. VIPs and node attachments are not recorded.
+	endpointServices map[string]string
+
+	// reserved is a map of IP to the slot address reservation of a
+	// service which holds it.
+	reserved map[string]*reservation
+}
+
+// reservation is an address reserved in IPAM for the tasks of a service
+// slot. It is held across tasks, and only released when the service is
+// deallocated.
+type reservation struct {
+	// addr is the address, in CIDR format, as allocated by IPAM.
+	addr string
+
+	// poolID is the pool the address was allocated from.
+	poolID string
+
+	// serviceID is the service holding the reservation.
+	serviceID string
 }
 
 type initializer struct {
@@ -88,7 +114,7 @@ func New(pg plugingetter.PluginGetter) (*NetworkAllocator, error) {
 		return nil, err
 	}
 
-	if err = initIPAMDrivers(reg); err != nil {
+	if na.defaultIPAM, err = initIPAMDrivers(reg, na.allocatedSubnets); err != nil {
 		return nil, err
 	}
 
@@ -120,14 +146,94 @@ func (na *NetworkAllocator) Allocate(n *api.Network) error {
 	}
 
 	na.networks[n.ID] = &network{
-		nw:        n,
-		pools:     pools,
-		endpoints: make(map[string]string),
+		nw:               n,
+		pools:            pools,
+		endpoints:        make(map[string]string),
+		endpointServices: make(map[string]string),
+		reserved:         make(map[string]*reservation),
 	}
 
 	return nil
 }
 
+// Update refreshes the allocator state of an allocated network after its
+// spec was updated, and allocates the pools of the IPAM configs appended to
+// the spec. It returns true if the IPAM state of n was changed.
+func (na *NetworkAllocator) Update(n *api.Network) (bool, error) {
+	localNet := na.getNetwork(n.ID)
+	if localNet == nil {
+		return false, fmt.Errorf("network %s is not allocated", n.ID)
+	}
+
+	if n.Spec.IPAM == nil || n.IPAM == nil {
+		localNet.nw = n
+		return false, nil
+	}
+
+	allocated := make(map[string]struct{})
+	for _, ic := range n.IPAM.Configs {
+		if _, subnet, err := net.ParseCIDR(ic.Subnet); err == nil {
+			allocated[subnet.String()] = struct{}{}
+		}
+	}
+
+	var added []*api.IPAMConfig
+	for _, ic := range n.Spec.IPAM.Configs {
+		_, subnet, err := net.ParseCIDR(ic.Subnet)
+		if err != nil {
+			continue
+		}
+		if _, ok := allocated[subnet.String()]; !ok {
+			added = append(added, ic.Copy())
+		}
+	}
+	if len(added) == 0 {
+		localNet.nw = n
+		return false, nil
+	}
+
+	ipam, dName, dOptions, err := na.resolveIPAM(n)
+	if err != nil {
+		return false, err
+	}
+
+	_, asName, err := na.drvRegistry.IPAMDefaultAddressSpaces(dName)
+	if err != nil {
+		return false, err
+	}
+
+	pools := make(map[string]string)
+	for i, ic := range added {
+		poolID, poolIP, _, err := ipam.RequestPool(asName, ic.Subnet, ic.Range, dOptions, false)
+		if err != nil {
+			releasePools(ipam, added[:i], pools)
+			return false, errors.Wrapf(err, "failed allocating pool %s for network %s", ic.Subnet, n.ID)
+		}
+		pools[poolIP.String()] = poolID
+
+		gwIP, _, err := ipam.RequestAddress(poolID, net.ParseIP(ic.Gateway), nil)
+		if err != nil {
+			releasePools(ipam, added[:i], pools)
+			return false, errors.Wrapf(err, "failed allocating gateway of pool %s for network %s", ic.Subnet, n.ID)
+		}
+
+		ic.Subnet = poolIP.String()
+		if ic.Gateway == "" {
+			ic.Gateway = gwIP.IP.String()
+		}
+	}
+
+	for subnet, poolID := range pools {
+		localNet.pools[subnet] = poolID
+	}
+
+	n.IPAM = n.IPAM.Copy()
+	n.IPAM.Configs = append(n.IPAM.Configs, added...)
+	localNet.nw = n
+
+	return true, nil
+}
+
 func (na *NetworkAllocator) getNetwork(id string) *network {
 	return na.networks[id]
 }
@@ -160,6 +266,10 @@ func (na *NetworkAllocator) ServiceAllocate(s *api.Service) (err error) {
 		}
 	}()
 
+	if err = na.reserveSlotAddresses(s); err != nil {
+		return
+	}
+
 	if s.Endpoint == nil {
 		s.Endpoint = &api.Endpoint{}
 	}
@@ -221,6 +331,8 @@ outer:
 // ServiceDeallocate de-allocates all the network resources such as
 // virtual IP and ports associated with the service.
 func (na *NetworkAllocator) ServiceDeallocate(s *api.Service) error {
+	na.releaseSlotAddresses(s.ID)
+
 	if s.Endpoint == nil {
 		return nil
 	}
@@ -299,6 +411,24 @@ func OnInit(options *ServiceAllocationOpts) {
 	options.OnInit = true
 }
 
+// UpdateDefaultAddressPools updates the address pools subnets are allocated
+// from for networks which use the default IPAM driver and don't specify a
+// subnet. Networks which are already allocated keep their subnets.
+func (na *NetworkAllocator) UpdateDefaultAddressPools(config *api.DefaultAddressPoolsConfig) error {
+	ap, err := parseDefaultAddressPools(config)
+	if err != nil {
+		return err
+	}
+	na.defaultIPAM.pools = ap
+	return nil
+}
+
+// UpdatePortAllocation updates the ranges published ports are allocated from.
+// Ports which are already allocated stay allocated.
+func (na *NetworkAllocator) UpdatePortAllocation(config *api.PortAllocationConfig) error {
+	return na.portAllocator.updateConfig(config)
+}
+
 // IsServiceAllocated returns if the passed service has its network resources allocated or not.
 // init bool indicates if the func is called during allocator initialization stage.
 func (na *NetworkAllocator) IsServiceAllocated(s *api.Service, flags ...func(*ServiceAllocationOpts)) bool {
@@ -308,6 +438,10 @@ func (na *NetworkAllocator) IsServiceAllocated(s *api.Service, flags ...func(*Se
 		flag(&options)
 	}
 
+	if !na.slotAddressesReserved(s) {
+		return false
+	}
+
 	// If endpoint mode is VIP and allocator does not have the
 	// service in VIP allocated set then it is not allocated.
 	if (len(s.Spec.Task.Networks) != 0 || len(s.Spec.Networks) != 0) &&
@@ -371,7 +505,7 @@ func (na *NetworkAllocator) IsNodeAllocated(node *api.Node) bool {
 // AllocateNode allocates the IP addresses for the network to which
 // the node is attached.
 func (na *NetworkAllocator) AllocateNode(node *api.Node) error {
-	if err := na.allocateNetworkIPs(node.Attachment); err != nil {
+	if err := na.allocateNetworkIPs(node.Attachment, ""); err != nil {
 		return err
 	}
 
@@ -390,7 +524,7 @@ func (na *NetworkAllocator) DeallocateNode(node *api.Node) error {
 // networks that a task is attached to.
 func (na *NetworkAllocator) AllocateTask(t *api.Task) error {
 	for i, nAttach := range t.Networks {
-		if err := na.allocateNetworkIPs(nAttach); err != nil {
+		if err := na.allocateNetworkIPs(nAttach, t.ServiceID); err != nil {
 			if err := na.releaseEndpoints(t.Networks[:i]); err != nil {
 				log.G(context.TODO()).WithError(err).Errorf("Failed to release IP addresses while rolling back allocation for task %s network %s", t.ID, nAttach.Network.ID)
 			}
@@ -430,6 +564,7 @@ func (na *NetworkAllocator) releaseEndpoints(networks []*api.NetworkAttachment)
 			// out the mapping.
 			poolID := localNet.endpoints[addr]
 			delete(localNet.endpoints, addr)
+			delete(localNet.endpointServices, addr)
 
 			ip, _, err := net.ParseCIDR(addr)
 			if err != nil {
@@ -437,6 +572,12 @@ func (na *NetworkAllocator) releaseEndpoints(networks []*api.NetworkAttachment)
 				continue
 			}
 
+			// Reserved addresses stay allocated in IPAM for
+			// the next task of the slot.
+			if _, ok := localNet.reserved[ip.String()]; ok {
+				continue
+			}
+
 			if err := ipam.ReleaseAddress(poolID, ip); err != nil {
 				log.G(context.TODO()).WithError(err).Errorf("IPAM failure while releasing IP address %s", addr)
 			}
@@ -518,6 +659,11 @@ func (na *NetworkAllocator) deallocateVIP(vip *api.Endpoint_VirtualIP) error {
 		return err
 	}
 
+	// A reserved address stays allocated in IPAM for its reservation.
+	if _, ok := localNet.reserved[ip.String()]; ok {
+		return nil
+	}
+
 	if err := ipam.ReleaseAddress(poolID, ip); err != nil {
 		log.G(context.TODO()).WithError(err).Errorf("IPAM failure while releasing VIP address %s", vip.Addr)
 		return err
@@ -527,7 +673,9 @@ func (na *NetworkAllocator) deallocateVIP(vip *api.Endpoint_VirtualIP) error {
 }
 
 // allocate the IP addresses for a single network attachment of the task.
-func (na *NetworkAllocator) allocateNetworkIPs(nAttach *api.NetworkAttachment) error {
+// serviceID is the service of the task, if any, which may use the addresses
+// reserved for it.
+func (na *NetworkAllocator) allocateNetworkIPs(nAttach *api.NetworkAttachment, serviceID string) error {
 	var ip *net.IPNet
 
 	ipam, _, _, err := na.resolveIPAM(nAttach.Network)
@@ -549,13 +697,23 @@ func (na *NetworkAllocator) allocateNetworkIPs(nAttach *api.NetworkAttachment) e
 		var addr net.IP
 		if rawAddr != "" {
 			var err error
-			addr, _, err = net.ParseCIDR(rawAddr)
+			addr, err = parseAddress(rawAddr)
 			if err != nil {
-				addr = net.ParseIP(rawAddr)
+				return err
+			}
 
-				if addr == nil {
-					return errors.Wrapf(err, "could not parse address string %s", rawAddr)
+			if r, ok := localNet.reserved[addr.String()]; ok {
+				if r.serviceID != serviceID {
+					return fmt.Errorf("address %s is reserved by service %s", addr, r.serviceID)
+				}
+				if _, ok := localNet.endpoints[r.addr]; ok {
+					return fmt.Errorf("reserved address %s is still in use", addr)
 				}
+				localNet.endpoints[r.addr] = r.poolID
+				localNet.endpointServices[r.addr] = serviceID
+				addresses[i] = r.addr
+				nAttach.Addresses = addresses
+				return nil
 			}
 		}
 
@@ -571,6 +729,9 @@ func (na *NetworkAllocator) allocateNetworkIPs(nAttach *api.NetworkAttachment) e
 			if err == nil {
 				ipStr := ip.String()
 				localNet.endpoints[ipStr] = poolID
+				if serviceID != "" {
+					localNet.endpointServices[ipStr] = serviceID
+				}
 				addresses[i] = ipStr
 				nAttach.Addresses = addresses
 				return nil
@@ -581,6 +742,201 @@ func (na *NetworkAllocator) allocateNetworkIPs(nAttach *api.NetworkAttachment) e
 	return errors.New("could not find an available IP")
 }
 
+// parseAddress parses an address either in CIDR format or as a plain IP.
+func parseAddress(rawAddr string) (net.IP, error) {
+	addr, _, err := net.ParseCIDR(rawAddr)
+	if err != nil {
+		addr = net.ParseIP(rawAddr)
+
+		if addr == nil {
+			return nil, errors.Wrapf(err, "could not parse address string %s", rawAddr)
+		}
+	}
+	return addr, nil
+}
+
+// reserveSlotAddresses reserves the slot addresses of the network
+// attachments of the service, and releases the reservations of the service
+// which its spec no longer has.
+func (na *NetworkAllocator) reserveSlotAddresses(s *api.Service) error {
+	specNetworks := s.Spec.Task.Networks
+	if len(specNetworks) == 0 {
+		specNetworks = s.Spec.Networks
+	}
+
+	for networkID, localNet := range na.networks {
+		for ip, r := range localNet.reserved {
+			if r.serviceID == s.ID && !slotAddressInSpec(specNetworks, networkID, ip) {
+				na.releaseReservation(localNet, ip)
+			}
+		}
+	}
+
+	for _, nAttach := range specNetworks {
+		for _, slotAddress := range nAttach.SlotAddresses {
+			for _, rawAddr := range slotAddress.Addresses {
+				if err := na.reserveAddress(nAttach.Target, rawAddr, s.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// reserveAddress reserves an address of a network for the service.
+func (na *NetworkAllocator) reserveAddress(networkID, rawAddr, serviceID string) error {
+	localNet := na.getNetwork(networkID)
+	if localNet == nil {
+		return fmt.Errorf("could not find network allocator state for network %s", networkID)
+	}
+
+	addr, err := parseAddress(rawAddr)
+	if err != nil {
+		return err
+	}
+
+	if r, ok := localNet.reserved[addr.String()]; ok {
+		if r.serviceID != serviceID {
+			return fmt.Errorf("address %s is reserved by service %s", addr, r.serviceID)
+		}
+		return nil
+	}
+
+	// The address may still be held by a task of the service if its
+	// reservation was released while the task was running. Addresses
+	// held by anything else, such as VIPs or the tasks of other
+	// services, can't be reserved.
+	for endpoint, poolID := range localNet.endpoints {
+		if ip, _, err := net.ParseCIDR(endpoint); err == nil && ip.Equal(addr) {
+			if localNet.endpointServices[endpoint] != serviceID {
+				return fmt.Errorf("address %s is already in use", addr)
+			}
+			localNet.reserved[addr.String()] = &reservation{addr: endpoint, poolID: poolID, serviceID: serviceID}
+			return nil
+		}
+	}
+
+	ipam, _, _, err := na.resolveIPAM(localNet.nw)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve IPAM while reserving")
+	}
+
+	for _, poolID := range localNet.pools {
+		ip, _, err := ipam.RequestAddress(poolID, addr, nil)
+		if err == ipamapi.ErrIPOutOfRange {
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not reserve address %s", addr)
+		}
+
+		localNet.reserved[addr.String()] = &reservation{addr: ip.String(), poolID: poolID, serviceID: serviceID}
+		return nil
+	}
+
+	return fmt.Errorf("address %s is out of the range of network %s", addr, networkID)
+}
+
+// releaseSlotAddresses releases the addresses reserved for the service.
+// Addresses still held by a task are released with the task.
+func (na *NetworkAllocator) releaseSlotAddresses(serviceID string) {
+	for _, localNet := range na.networks {
+		for ip, r := range localNet.reserved {
+			if r.serviceID == serviceID {
+				na.releaseReservation(localNet, ip)
+			}
+		}
+	}
+}
+
+// releaseReservation releases the reservation of ip in the network. If a
+// task still holds the address, it is released with the task.
+func (na *NetworkAllocator) releaseReservation(localNet *network, ip string) {
+	r := localNet.reserved[ip]
+	delete(localNet.reserved, ip)
+	if _, ok := localNet.endpoints[r.addr]; ok {
+		return
+	}
+
+	ipam, _, _, err := na.resolveIPAM(localNet.nw)
+	if err != nil {
+		log.G(context.TODO()).WithError(err).Errorf("Failed to resolve IPAM while releasing reserved address %s", r.addr)
+		return
+	}
+
+	addr, _, err := net.ParseCIDR(r.addr)
+	if err != nil {
+		log.G(context.TODO()).Errorf("Could not parse reserved address %s while releasing", r.addr)
+		return
+	}
+
+	if err := ipam.ReleaseAddress(r.poolID, addr); err != nil {
+		log.G(context.TODO()).WithError(err).Errorf("IPAM failure while releasing reserved address %s", r.addr)
+	}
+}
+
+// slotAddressInSpec returns true if ip is a slot address of the attachment
+// to the network in specNetworks.
+func slotAddressInSpec(specNetworks []*api.NetworkAttachmentConfig, networkID, ip string) bool {
+	for _, nAttach := range specNetworks {
+		if nAttach.Target != networkID {
+			continue
+		}
+		for _, slotAddress := range nAttach.SlotAddresses {
+			for _, rawAddr := range slotAddress.Addresses {
+				if addr, err := parseAddress(rawAddr); err == nil && addr.String() == ip {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// slotAddressesReserved returns true if all the slot addresses of the
+// service are reserved for it, and it holds no other reservation.
+func (na *NetworkAllocator) slotAddressesReserved(s *api.Service) bool {
+	specNetworks := s.Spec.Task.Networks
+	if len(specNetworks) == 0 {
+		specNetworks = s.Spec.Networks
+	}
+
+	for networkID, localNet := range na.networks {
+		for ip, r := range localNet.reserved {
+			if r.serviceID == s.ID && !slotAddressInSpec(specNetworks, networkID, ip) {
+				return false
+			}
+		}
+	}
+
+	for _, nAttach := range specNetworks {
+		if len(nAttach.SlotAddresses) == 0 {
+			continue
+		}
+
+		localNet := na.getNetwork(nAttach.Target)
+		if localNet == nil {
+			return false
+		}
+
+		for _, slotAddress := range nAttach.SlotAddresses {
+			for _, rawAddr := range slotAddress.Addresses {
+				addr, err := parseAddress(rawAddr)
+				if err != nil {
+					return false
+				}
+				if r, ok := localNet.reserved[addr.String()]; !ok || r.serviceID != s.ID {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
 func (na *NetworkAllocator) freeDriverState(n *api.Network) error {
 	d, _, err := na.resolveDriver(n)
 	if err != nil {
@@ -773,7 +1129,11 @@ func (na *NetworkAllocator) allocatePools(n *api.Network) (map[string]string, er
 	}
 
 	for i, ic := range ipamConfigs {
-		poolID, poolIP, _, err := ipam.RequestPool(asName, ic.Subnet, ic.Range, dOptions, false)
+		var (
+			poolID string
+			poolIP *net.IPNet
+		)
+		poolID, poolIP, _, err = ipam.RequestPool(asName, ic.Subnet, ic.Range, dOptions, ic.Family == api.IPAMConfig_IPV6)
 		if err != nil {
 			// Rollback by releasing all the resources allocated so far.
 			releasePools(ipam, ipamConfigs[:i], pools)
@@ -801,6 +1161,19 @@ func (na *NetworkAllocator) allocatePools(n *api.Network) (map[string]string, er
 	return pools, nil
 }
 
+// allocatedSubnets returns the subnets of the networks allocated so far.
+func (na *NetworkAllocator) allocatedSubnets() []*net.IPNet {
+	var allocated []*net.IPNet
+	for _, nw := range na.networks {
+		for subnet := range nw.pools {
+			if _, ipNet, err := net.ParseCIDR(subnet); err == nil {
+				allocated = append(allocated, ipNet)
+			}
+		}
+	}
+	return allocated
+}
+
 func initializeDrivers(reg *drvregistry.DrvRegistry) error {
 	for _, i := range getInitializers() {
 		if err := reg.AddDriver(i.ntype, i.fn, nil); err != nil {
@@ -789,3 +789,267 @@ func TestCorrectlyPassIPAMOptions(t *testing.T) {
 	assert.Equal(t, expectedIpamOptions, ipamDriver.actualIpamOptions)
 	assert.NoError(t, err)
 }
+
+func TestSlotAddresses(t *testing.T) {
+	na := newNetworkAllocator(t)
+	n := &api.Network{
+		ID: "testID",
+		Spec: api.NetworkSpec{
+			Annotations: api.Annotations{
+				Name: "test",
+			},
+			DriverConfig: &api.Driver{},
+			IPAM: &api.IPAMOptions{
+				Driver: &api.Driver{},
+				Configs: []*api.IPAMConfig{
+					{
+						Subnet:  "192.168.10.0/24",
+						Gateway: "192.168.10.1",
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, na.Allocate(n))
+
+	s := &api.Service{
+		ID: "testServiceID",
+		Spec: api.ServiceSpec{
+			Task: api.TaskSpec{
+				Networks: []*api.NetworkAttachmentConfig{
+					{
+						Target: "testID",
+						SlotAddresses: map[uint64]*api.SlotAddress{
+							1: {Addresses: []string{"192.168.10.53"}},
+						},
+					},
+				},
+			},
+			Endpoint: &api.EndpointSpec{
+				Mode: api.ResolutionModeDNSRoundRobin,
+			},
+		},
+	}
+	assert.False(t, na.IsServiceAllocated(s))
+	assert.NoError(t, na.ServiceAllocate(s))
+	assert.True(t, na.IsServiceAllocated(s))
+
+	// Another service can't reserve or use the address.
+	other := s.Copy()
+	other.ID = "otherServiceID"
+	assert.Error(t, na.ServiceAllocate(other))
+	otherTask := &api.Task{
+		ID:        "otherTaskID",
+		ServiceID: "otherServiceID",
+		Networks: []*api.NetworkAttachment{
+			{Network: n, Addresses: []string{"192.168.10.53"}},
+		},
+	}
+	assert.Error(t, na.AllocateTask(otherTask))
+
+	// A dynamically allocated address is never the reserved one.
+	dynamicTask := &api.Task{
+		ID:        "dynamicTaskID",
+		ServiceID: "otherServiceID",
+		Networks: []*api.NetworkAttachment{
+			{Network: n},
+		},
+	}
+	for i := 0; i < 60; i++ {
+		dynamicTask.Networks[0].Addresses = nil
+		assert.NoError(t, na.AllocateTask(dynamicTask))
+		assert.NotEqual(t, "192.168.10.53/24", dynamicTask.Networks[0].Addresses[0])
+	}
+
+	newTask := func(id string) *api.Task {
+		return &api.Task{
+			ID:        id,
+			ServiceID: s.ID,
+			Slot:      1,
+			Networks: []*api.NetworkAttachment{
+				{Network: n, Addresses: []string{"192.168.10.53"}},
+			},
+		}
+	}
+
+	task1 := newTask("taskID1")
+	assert.NoError(t, na.AllocateTask(task1))
+	assert.Equal(t, []string{"192.168.10.53/24"}, task1.Networks[0].Addresses)
+	assert.True(t, na.IsTaskAllocated(task1))
+
+	// The replacement task waits for the address to be released.
+	task2 := newTask("taskID2")
+	assert.Error(t, na.AllocateTask(task2))
+
+	assert.NoError(t, na.DeallocateTask(task1))
+	assert.True(t, na.IsServiceAllocated(s))
+	assert.NoError(t, na.AllocateTask(task2))
+	assert.Equal(t, []string{"192.168.10.53/24"}, task2.Networks[0].Addresses)
+
+	// The address is released once both the service and its last task
+	// are gone.
+	assert.NoError(t, na.ServiceDeallocate(s))
+	assert.NoError(t, na.DeallocateTask(task2))
+	assert.NoError(t, na.ServiceAllocate(other))
+	assert.True(t, na.IsServiceAllocated(other))
+}
+
+func TestSlotAddressesOwnership(t *testing.T) {
+	na := newNetworkAllocator(t)
+	n := &api.Network{
+		ID: "testID",
+		Spec: api.NetworkSpec{
+			Annotations: api.Annotations{
+				Name: "test",
+			},
+			DriverConfig: &api.Driver{},
+			IPAM: &api.IPAMOptions{
+				Driver: &api.Driver{},
+				Configs: []*api.IPAMConfig{
+					{
+						Subnet:  "192.168.20.0/24",
+						Gateway: "192.168.20.1",
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, na.Allocate(n))
+
+	newService := func(id string, mode api.EndpointSpec_ResolutionMode, addrs ...string) *api.Service {
+		s := &api.Service{
+			ID: id,
+			Spec: api.ServiceSpec{
+				Task: api.TaskSpec{
+					Networks: []*api.NetworkAttachmentConfig{
+						{Target: "testID"},
+					},
+				},
+				Endpoint: &api.EndpointSpec{Mode: mode},
+			},
+		}
+		if len(addrs) != 0 {
+			s.Spec.Task.Networks[0].SlotAddresses = map[uint64]*api.SlotAddress{
+				1: {Addresses: addrs},
+			}
+		}
+		return s
+	}
+
+	// The VIP of a service can't be reserved by another service.
+	vipService := newService("vipServiceID", api.ResolutionModeVirtualIP)
+	assert.NoError(t, na.ServiceAllocate(vipService))
+	vip := vipService.Endpoint.VirtualIPs[0].Addr
+	vipAddr, _, err := net.ParseCIDR(vip)
+	assert.NoError(t, err)
+	assert.Error(t, na.ServiceAllocate(newService("serviceID1", api.ResolutionModeDNSRoundRobin, vipAddr.String())))
+
+	// Neither can the address of a task of another service.
+	otherTask := &api.Task{
+		ID:        "otherTaskID",
+		ServiceID: "otherServiceID",
+		Networks: []*api.NetworkAttachment{
+			{Network: n},
+		},
+	}
+	assert.NoError(t, na.AllocateTask(otherTask))
+	taskAddr, _, err := net.ParseCIDR(otherTask.Networks[0].Addresses[0])
+	assert.NoError(t, err)
+	assert.Error(t, na.ServiceAllocate(newService("serviceID2", api.ResolutionModeDNSRoundRobin, taskAddr.String())))
+
+	// The address of a task of the service is taken over by its
+	// reservation, and stays allocated once the task is released.
+	s := newService("serviceID3", api.ResolutionModeDNSRoundRobin)
+	task := &api.Task{
+		ID:        "taskID",
+		ServiceID: s.ID,
+		Networks: []*api.NetworkAttachment{
+			{Network: n},
+		},
+	}
+	assert.NoError(t, na.AllocateTask(task))
+	addr, _, err := net.ParseCIDR(task.Networks[0].Addresses[0])
+	assert.NoError(t, err)
+	s.Spec.Task.Networks[0].SlotAddresses = map[uint64]*api.SlotAddress{
+		1: {Addresses: []string{addr.String(), "192.168.20.200"}},
+	}
+	assert.NoError(t, na.ServiceAllocate(s))
+	assert.NoError(t, na.DeallocateTask(task))
+	assert.Error(t, na.ServiceAllocate(newService("serviceID4", api.ResolutionModeDNSRoundRobin, addr.String())))
+
+	// Deallocating a VIP never releases a reserved address.
+	assert.NoError(t, na.deallocateVIP(&api.Endpoint_VirtualIP{NetworkID: "testID", Addr: addr.String() + "/24"}))
+	assert.Error(t, na.ServiceAllocate(newService("serviceID4", api.ResolutionModeDNSRoundRobin, addr.String())))
+
+	// Removing slot addresses from the service releases them.
+	s.Spec.Task.Networks[0].SlotAddresses = map[uint64]*api.SlotAddress{
+		1: {Addresses: []string{"192.168.20.200"}},
+	}
+	assert.False(t, na.IsServiceAllocated(s))
+	assert.NoError(t, na.ServiceAllocate(s))
+	assert.True(t, na.IsServiceAllocated(s))
+	assert.NoError(t, na.ServiceAllocate(newService("serviceID4", api.ResolutionModeDNSRoundRobin, addr.String())))
+	assert.Error(t, na.ServiceAllocate(newService("serviceID5", api.ResolutionModeDNSRoundRobin, "192.168.20.200")))
+}
+
+func TestUpdateAppendPools(t *testing.T) {
+	na := newNetworkAllocator(t)
+	n := &api.Network{
+		ID: "testID",
+		Spec: api.NetworkSpec{
+			Annotations: api.Annotations{
+				Name: "test",
+			},
+			DriverConfig: &api.Driver{},
+			IPAM: &api.IPAMOptions{
+				Driver: &api.Driver{},
+				Configs: []*api.IPAMConfig{
+					{
+						Subnet: "192.168.30.0/30",
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, na.Allocate(n))
+
+	// Nothing to allocate.
+	updated, err := na.Update(n)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	// The only usable address of the first pool is used up.
+	task1 := &api.Task{
+		ID:       "taskID1",
+		Networks: []*api.NetworkAttachment{{Network: n}},
+	}
+	assert.NoError(t, na.AllocateTask(task1))
+	task2 := &api.Task{
+		ID:       "taskID2",
+		Networks: []*api.NetworkAttachment{{Network: n}},
+	}
+	assert.Error(t, na.AllocateTask(task2))
+
+	n.Spec.IPAM.Configs = append(n.Spec.IPAM.Configs, &api.IPAMConfig{Subnet: "192.168.31.0/24"})
+	updated, err = na.Update(n)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+	assert.Len(t, n.IPAM.Configs, 2)
+	assert.Equal(t, "192.168.31.0/24", n.IPAM.Configs[1].Subnet)
+	assert.Equal(t, "192.168.31.1", n.IPAM.Configs[1].Gateway)
+
+	// The appended pool is used once the others are exhausted.
+	task2.Networks[0].Addresses = nil
+	assert.NoError(t, na.AllocateTask(task2))
+	ip, _, err := net.ParseCIDR(task2.Networks[0].Addresses[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.31.2", ip.String())
+
+	updated, err = na.Update(n)
+	assert.NoError(t, err)
+	assert.False(t, updated)
+
+	assert.NoError(t, na.DeallocateTask(task1))
+	assert.NoError(t, na.DeallocateTask(task2))
+	assert.NoError(t, na.Deallocate(n))
+}
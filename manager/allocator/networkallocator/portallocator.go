@@ -8,12 +8,12 @@ import (
 )
 
 const (
-	// Start of the dynamic port range from which node ports will
-	// be allocated when the user did not specify a port.
+	// Start of the default dynamic port range from which node
+	// ports will be allocated when the user did not specify a port.
 	dynamicPortStart = 30000
 
-	// End of the dynamic port range from which node ports will be
-	// allocated when the user did not specify a port.
+	// End of the default dynamic port range from which node ports
+	// will be allocated when the user did not specify a port.
 	dynamicPortEnd = 32767
 
 	// The start of master port range which will hold all the
@@ -36,6 +36,51 @@ type portSpace struct {
 	protocol         api.PortConfig_Protocol
 	masterPortSpace  *idm.Idm
 	dynamicPortSpace *idm.Idm
+
+	// dynamicRange is the range covered by dynamicPortSpace
+	dynamicRange api.PortRange
+
+	// reserved ports are held in dynamicPortSpace so they are never
+	// allocated dynamically
+	reserved []api.PortRange
+}
+
+// DynamicPortRange returns the range published ports are allocated from
+// dynamically under the given config.
+func DynamicPortRange(config *api.PortAllocationConfig) api.PortRange {
+	if config == nil || config.DynamicRange == nil {
+		return api.PortRange{Start: dynamicPortStart, End: dynamicPortEnd}
+	}
+	return *config.DynamicRange
+}
+
+// ValidatePortAllocationConfig checks that the port ranges of config are
+// valid.
+func ValidatePortAllocationConfig(config *api.PortAllocationConfig) error {
+	ranges := config.Reserved
+	if config.DynamicRange != nil {
+		ranges = append([]api.PortRange{*config.DynamicRange}, ranges...)
+	}
+	for _, r := range ranges {
+		if r.Start < masterPortStart || r.End > masterPortEnd || r.Start > r.End {
+			return fmt.Errorf("invalid port range %d-%d", r.Start, r.End)
+		}
+	}
+	return nil
+}
+
+// IsPortReserved returns true if port falls in one of the reserved ranges.
+func IsPortReserved(reserved []api.PortRange, port uint32) bool {
+	for _, r := range reserved {
+		if inRange(r, port) {
+			return true
+		}
+	}
+	return false
+}
+
+func inRange(r api.PortRange, port uint32) bool {
+	return port >= r.Start && port <= r.End
 }
 
 type allocatedPorts map[api.PortConfig]map[uint32]*api.PortConfig
@@ -105,7 +150,7 @@ func (ps allocatedPorts) delState(p *api.PortConfig) *api.PortConfig {
 
 func newPortAllocator() (*portAllocator, error) {
 	portSpaces := make(map[api.PortConfig_Protocol]*portSpace)
-	for _, protocol := range []api.PortConfig_Protocol{api.ProtocolTCP, api.ProtocolUDP} {
+	for _, protocol := range []api.PortConfig_Protocol{api.ProtocolTCP, api.ProtocolUDP, api.ProtocolSCTP} {
 		ps, err := newPortSpace(protocol)
 		if err != nil {
 			return nil, err
@@ -135,9 +180,107 @@ func newPortSpace(protocol api.PortConfig_Protocol) (*portSpace, error) {
 		protocol:         protocol,
 		masterPortSpace:  master,
 		dynamicPortSpace: dynamic,
+		dynamicRange:     api.PortRange{Start: dynamicPortStart, End: dynamicPortEnd},
 	}, nil
 }
 
+func (pa *portAllocator) updateConfig(config *api.PortAllocationConfig) error {
+	for _, ps := range pa.portSpaces {
+		if err := ps.updateConfig(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateConfig updates the dynamic port space for the ranges of config. It
+// is only rebuilt when the dynamic range changes, otherwise only the ports
+// whose reservation changed are updated. Ports which are already allocated
+// stay allocated, even if they now fall in a reserved range.
+func (ps *portSpace) updateConfig(config *api.PortAllocationConfig) error {
+	if err := ValidatePortAllocationConfig(config); err != nil {
+		return err
+	}
+
+	dynamicRange := DynamicPortRange(config)
+	if dynamicRange.Start != ps.dynamicRange.Start || dynamicRange.End != ps.dynamicRange.End {
+		return ps.rebuildDynamicSpace(dynamicRange, config.Reserved)
+	}
+
+	for port := range ps.reservedPorts(config.Reserved) {
+		if !IsPortReserved(ps.reserved, port) && !ps.isAllocated(port) {
+			if err := ps.dynamicPortSpace.GetSpecificID(uint64(port)); err != nil {
+				return err
+			}
+		}
+	}
+	for port := range ps.reservedPorts(ps.reserved) {
+		if !IsPortReserved(config.Reserved, port) && !ps.isAllocated(port) {
+			ps.dynamicPortSpace.Release(uint64(port))
+		}
+	}
+
+	ps.reserved = config.Reserved
+	return nil
+}
+
+// rebuildDynamicSpace replaces the dynamic port space with one covering
+// dynamicRange, holding the reserved and allocated ports.
+func (ps *portSpace) rebuildDynamicSpace(dynamicRange api.PortRange, reserved []api.PortRange) error {
+	dynamicName := fmt.Sprintf("%s-dynamic-ports", ps.protocol)
+	dynamic, err := idm.New(nil, dynamicName, uint64(dynamicRange.Start), uint64(dynamicRange.End))
+	if err != nil {
+		return err
+	}
+
+	for port := dynamicRange.Start; port <= dynamicRange.End; port++ {
+		if IsPortReserved(reserved, port) || ps.isAllocated(port) {
+			if err := dynamic.GetSpecificID(uint64(port)); err != nil {
+				return err
+			}
+		}
+	}
+
+	ps.dynamicPortSpace = dynamic
+	ps.dynamicRange = dynamicRange
+	ps.reserved = reserved
+	return nil
+}
+
+// reservedPorts returns the ports of the reserved ranges which fall in the
+// dynamic range.
+func (ps *portSpace) reservedPorts(reserved []api.PortRange) map[uint32]struct{} {
+	ports := make(map[uint32]struct{})
+	for _, r := range reserved {
+		start, end := r.Start, r.End
+		if start < ps.dynamicRange.Start {
+			start = ps.dynamicRange.Start
+		}
+		if end > ps.dynamicRange.End {
+			end = ps.dynamicRange.End
+		}
+		for port := start; port <= end; port++ {
+			ports[port] = struct{}{}
+		}
+	}
+	return ports
+}
+
+// isAllocated returns true if port is allocated in the master port space.
+func (ps *portSpace) isAllocated(port uint32) bool {
+	if err := ps.masterPortSpace.GetSpecificID(uint64(port)); err != nil {
+		return true
+	}
+	ps.masterPortSpace.Release(uint64(port))
+	return false
+}
+
+// inDynamicSpace returns true if the allocation state of port is tracked
+// in the dynamic port space. Reserved ports are always held there.
+func (ps *portSpace) inDynamicSpace(port uint32) bool {
+	return inRange(ps.dynamicRange, port) && !IsPortReserved(ps.reserved, port)
+}
+
 // getPortConfigKey returns a map key for doing set operations with
 // ports. The key consists of name, protocol and target port which
 // uniquely identifies a port within a single Endpoint.
@@ -366,7 +509,7 @@ func (ps *portSpace) allocate(p *api.PortConfig) (err error) {
 	if p.PublishedPort != 0 {
 		// If it falls in the dynamic port range check out
 		// from dynamic port space first.
-		if p.PublishedPort >= dynamicPortStart && p.PublishedPort <= dynamicPortEnd {
+		if ps.inDynamicSpace(p.PublishedPort) {
 			if err = ps.dynamicPortSpace.GetSpecificID(uint64(p.PublishedPort)); err != nil {
 				return err
 			}
@@ -402,7 +545,7 @@ func (ps *portSpace) allocate(p *api.PortConfig) (err error) {
 }
 
 func (ps *portSpace) free(p *api.PortConfig) {
-	if p.PublishedPort >= dynamicPortStart && p.PublishedPort <= dynamicPortEnd {
+	if ps.inDynamicSpace(p.PublishedPort) {
 		ps.dynamicPortSpace.Release(uint64(p.PublishedPort))
 	}
 
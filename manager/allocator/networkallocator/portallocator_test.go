@@ -882,3 +882,134 @@ func TestAllocate(t *testing.T) {
 	err = pSpace.allocate(pConfig)
 	assert.Error(t, err)
 }
+
+func TestAllocateSCTP(t *testing.T) {
+	pa, err := newPortAllocator()
+	assert.NoError(t, err)
+
+	// TCP, UDP and SCTP ports are allocated from separate port spaces.
+	for _, protocol := range []api.PortConfig_Protocol{api.ProtocolTCP, api.ProtocolUDP, api.ProtocolSCTP} {
+		err = pa.portSpaces[protocol].allocate(&api.PortConfig{
+			Protocol:      protocol,
+			TargetPort:    3868,
+			PublishedPort: 30000,
+		})
+		assert.NoError(t, err)
+	}
+
+	err = pa.portSpaces[api.ProtocolSCTP].allocate(&api.PortConfig{
+		Protocol:      api.ProtocolSCTP,
+		TargetPort:    3868,
+		PublishedPort: 30000,
+	})
+	assert.Error(t, err)
+
+	pConfig := &api.PortConfig{
+		Protocol:   api.ProtocolSCTP,
+		TargetPort: 3868,
+	}
+	err = pa.portSpaces[api.ProtocolSCTP].allocate(pConfig)
+	assert.NoError(t, err)
+	assert.True(t, pConfig.PublishedPort >= dynamicPortStart && pConfig.PublishedPort <= dynamicPortEnd)
+}
+
+func TestUpdatePortAllocation(t *testing.T) {
+	pSpace, err := newPortSpace(api.ProtocolTCP)
+	assert.NoError(t, err)
+
+	// allocate a port from the default dynamic range, and one specified
+	// explicitly which will fall in the new dynamic range
+	dynamicPort := &api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}
+	assert.NoError(t, pSpace.allocate(dynamicPort))
+	assert.Equal(t, uint32(dynamicPortStart), dynamicPort.PublishedPort)
+
+	staticPort := &api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80, PublishedPort: 40000}
+	assert.NoError(t, pSpace.allocate(staticPort))
+
+	assert.NoError(t, pSpace.updateConfig(&api.PortAllocationConfig{
+		DynamicRange: &api.PortRange{Start: 40000, End: 40003},
+		Reserved:     []api.PortRange{{Start: 40001, End: 40001}},
+	}))
+
+	// existing allocations are kept
+	assert.True(t, pSpace.isAllocated(dynamicPort.PublishedPort))
+	assert.True(t, pSpace.isAllocated(staticPort.PublishedPort))
+
+	// ports are allocated from the new range, skipping allocated and
+	// reserved ports
+	var allocated []uint32
+	for i := 0; i < 2; i++ {
+		p := &api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}
+		assert.NoError(t, pSpace.allocate(p))
+		allocated = append(allocated, p.PublishedPort)
+	}
+	assert.Equal(t, []uint32{40002, 40003}, allocated)
+	assert.Error(t, pSpace.allocate(&api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}))
+
+	// freeing a port which is now outside the dynamic range releases it
+	pSpace.free(dynamicPort)
+	assert.False(t, pSpace.isAllocated(dynamicPort.PublishedPort))
+
+	// freeing a port in the dynamic range makes it available again
+	pSpace.free(staticPort)
+	p := &api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}
+	assert.NoError(t, pSpace.allocate(p))
+	assert.Equal(t, uint32(40000), p.PublishedPort)
+
+	assert.Error(t, pSpace.updateConfig(&api.PortAllocationConfig{
+		DynamicRange: &api.PortRange{Start: 50000, End: 70000},
+	}))
+}
+
+func TestUpdatePortAllocationReserved(t *testing.T) {
+	pSpace, err := newPortSpace(api.ProtocolTCP)
+	assert.NoError(t, err)
+
+	config := &api.PortAllocationConfig{
+		DynamicRange: &api.PortRange{Start: 40000, End: 40002},
+		Reserved:     []api.PortRange{{Start: 40001, End: 40001}},
+	}
+	assert.NoError(t, pSpace.updateConfig(config))
+	dynamicPortSpace := pSpace.dynamicPortSpace
+
+	allocatedPort := &api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}
+	assert.NoError(t, pSpace.allocate(allocatedPort))
+	assert.Equal(t, uint32(40000), allocatedPort.PublishedPort)
+
+	// the same config doesn't rebuild the dynamic port space
+	assert.NoError(t, pSpace.updateConfig(config))
+	assert.True(t, dynamicPortSpace == pSpace.dynamicPortSpace)
+
+	// only the reservations are updated when the dynamic range is
+	// unchanged: 40001 is released and 40000, which is allocated, stays
+	// held
+	assert.NoError(t, pSpace.updateConfig(&api.PortAllocationConfig{
+		DynamicRange: &api.PortRange{Start: 40000, End: 40002},
+		Reserved:     []api.PortRange{{Start: 39000, End: 40000}, {Start: 40000, End: 40000}},
+	}))
+	assert.True(t, dynamicPortSpace == pSpace.dynamicPortSpace)
+	assert.True(t, pSpace.isAllocated(allocatedPort.PublishedPort))
+
+	var allocated []uint32
+	for i := 0; i < 2; i++ {
+		p := &api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}
+		assert.NoError(t, pSpace.allocate(p))
+		allocated = append(allocated, p.PublishedPort)
+	}
+	assert.Equal(t, []uint32{40001, 40002}, allocated)
+
+	// freeing a port which is now reserved keeps it out of the dynamic
+	// port space
+	pSpace.free(allocatedPort)
+	assert.False(t, pSpace.isAllocated(allocatedPort.PublishedPort))
+	assert.Error(t, pSpace.allocate(&api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}))
+
+	// changing the dynamic range rebuilds it
+	assert.NoError(t, pSpace.updateConfig(&api.PortAllocationConfig{
+		DynamicRange: &api.PortRange{Start: 40000, End: 40003},
+	}))
+	assert.False(t, dynamicPortSpace == pSpace.dynamicPortSpace)
+	p := &api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80}
+	assert.NoError(t, pSpace.allocate(p))
+	assert.Equal(t, uint32(40000), p.PublishedPort)
+}
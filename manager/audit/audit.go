@@ -0,0 +1,344 @@
+// Package audit records calls that modify cluster state through the Control
+// API.
+//
+// Every mutating call is stored as an AuditEvent in the raft store, along
+// with the identity of the caller, the object affected and a diff of its
+// spec. Only the most recent events are kept.
+//
+// The Control API writes the event of a successful call with Record, in the
+// same store transaction as the change it describes, so that no change is
+// committed without its event. Failed calls, which don't change the cluster
+// state, are batched in a transaction of their own. As any client can make
+// failing calls, the number of failed calls recorded for each caller is
+// limited and the others are only logged.
+package audit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/identity"
+	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultMaxEvents is the number of audit events kept in the store when
+	// no other limit is configured.
+	DefaultMaxEvents = 1000
+
+	// failedCallsInterval is how long the events of failed calls are held
+	// before they are written to the store together.
+	failedCallsInterval = time.Second
+
+	// maxFailedCalls is the number of events of failed calls written to the
+	// store in each batch.
+	maxFailedCalls = 100
+
+	// maxFailedCallsPerCaller is the number of events of failed calls of a
+	// single caller written to the store in each batch.
+	maxFailedCallsPerCaller = 10
+)
+
+// redacted replaces secret payloads in recorded specs.
+var redacted = []byte("<redacted>")
+
+// Server wraps a ControlServer and records an AuditEvent for every call that
+// creates, updates or removes an object. Read-only calls are passed through
+// unchanged.
+type Server struct {
+	api.ControlServer
+
+	store     *store.MemoryStore
+	maxEvents int
+
+	mu sync.Mutex
+	// failed holds the events of failed calls until they are written
+	failed []*pendingEvent
+	// failedByCaller counts the calls in failed by caller ID
+	failedByCaller map[string]int
+	// flushFailed writes failed to the store once it expires, if set
+	flushFailed *time.Timer
+}
+
+// NewServer returns a ControlServer that records calls made to local in the
+// given store, keeping at most maxEvents events.
+func NewServer(local api.ControlServer, store *store.MemoryStore, maxEvents int) *Server {
+	if maxEvents <= 0 {
+		maxEvents = DefaultMaxEvents
+	}
+	return &Server{
+		ControlServer:  local,
+		store:          store,
+		maxEvents:      maxEvents,
+		failedByCaller: make(map[string]int),
+	}
+}
+
+type eventsKey struct{}
+
+// pendingEvents holds the audit events of a call until they are written in
+// the store transaction making the change they describe.
+type pendingEvents struct {
+	events    []*pendingEvent
+	store     *store.MemoryStore
+	maxEvents int
+
+	mu sync.Mutex
+	// failed holds the events of failed calls until they are written
+	failed []*pendingEvent
+	// failedByCaller counts the calls in failed by caller ID
+	failedByCaller map[string]int
+	// flushFailed writes failed to the store once it expires, if set
+	flushFailed *time.Timer
+}
+
+// pendingEvent is an audit event whose spec diff is computed when it is
+// written with the change it describes.
+type pendingEvent struct {
+	*api.AuditEvent
+	// before looks up the spec of the object before the call, and may be
+	// nil if the object did not exist.
+	before specFunc
+	after  proto.Message
+}
+
+// specFunc returns the spec of the object with the given ID, or nil if it
+// doesn't exist.
+type specFunc func(tx store.ReadTx, id string) proto.Message
+
+// newEvent returns an audit event for a call to method affecting objectID.
+// before looks up the spec of the object before the call and after is its
+// spec after the call. Either may be nil if the object doesn't exist.
+func newEvent(ctx context.Context, method, objectID string, before specFunc, after proto.Message) *pendingEvent {
+	return &pendingEvent{
+		AuditEvent: &api.AuditEvent{
+			ID:       identity.NewID(),
+			Method:   method,
+			ObjectID: objectID,
+			Caller:   caller(ctx),
+		},
+		before: before,
+		after:  after,
+	}
+}
+
+// begin returns a context carrying the audit events of a call, which the
+// Control API writes with Record.
+func (s *Server) begin(ctx context.Context, events ...*pendingEvent) (context.Context, *pendingEvents) {
+	p := &pendingEvents{events: events, store: s.store, maxEvents: s.maxEvents}
+	return context.WithValue(ctx, eventsKey{}, p), p
+}
+
+// end stores the audit events of a call which didn't write them. This is the
+// case of failed calls, which don't change the cluster state, and of calls
+// which don't change the store.
+func (s *Server) end(ctx context.Context, p *pendingEvents, callErr error) {
+	if len(p.events) == 0 {
+		return
+	}
+	// The events were written with the change
+	var written bool
+	s.store.View(func(tx store.ReadTx) {
+		written = store.GetAuditEvent(tx, p.events[0].ID) != nil
+	})
+	if written {
+		return
+	}
+
+	if callErr != nil {
+		s.addFailed(ctx, p.events, callErr)
+		return
+	}
+
+	err := s.store.Update(func(tx store.Tx) error {
+		return p.write(tx)
+	})
+	if err != nil {
+		log.G(ctx).WithError(err).Errorf("failed to record audit event for %s", p.events[0].Method)
+	}
+}
+
+// addFailed queues the audit events of a failed call, unless too many
+// failed calls are already queued, in which case they are only logged.
+func (s *Server) addFailed(ctx context.Context, events []*pendingEvent, callErr error) {
+	for _, event := range events {
+		event.Error = callErr.Error()
+		event.SpecDiff = ""
+	}
+	callerID := events[0].Caller.ID
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.failed)+len(events) > maxFailedCalls || s.failedByCaller[callerID] >= maxFailedCallsPerCaller {
+		log.G(ctx).WithError(callErr).WithFields(logrus.Fields{
+			"method":    events[0].Method,
+			"object.id": events[0].ObjectID,
+			"caller.id": callerID,
+		}).Warn("too many failed calls, audit event not recorded")
+		return
+	}
+
+	s.failed = append(s.failed, events...)
+	s.failedByCaller[callerID]++
+	if s.flushFailed == nil {
+		s.flushFailed = time.AfterFunc(failedCallsInterval, s.writeFailed)
+	}
+}
+
+// writeFailed writes the queued audit events of failed calls in a single
+// transaction.
+func (s *Server) writeFailed() {
+	s.mu.Lock()
+	p := &pendingEvents{events: s.failed, store: s.store, maxEvents: s.maxEvents}
+	s.failed = nil
+	s.failedByCaller = make(map[string]int)
+	s.flushFailed = nil
+	s.mu.Unlock()
+
+	err := s.store.Update(func(tx store.Tx) error {
+		return p.write(tx)
+	})
+	if err != nil {
+		log.L.WithError(err).Errorf("failed to record %d audit events of failed calls", len(p.events))
+	}
+}
+
+// Record writes the audit events of the call made with ctx in tx, so that
+// they are committed together with the change they describe: if they can't
+// be written, the change fails. objectIDs are the IDs of the objects
+// affected by each event, and override the ones known before the call when
+// not empty. Calls which are not audited are ignored.
+func Record(ctx context.Context, tx store.Tx, objectIDs ...string) error {
+	p, ok := ctx.Value(eventsKey{}).(*pendingEvents)
+	if !ok {
+		return nil
+	}
+	for i, id := range objectIDs {
+		if i < len(p.events) && id != "" {
+			p.events[i].ObjectID = id
+		}
+	}
+
+	// tx already contains the change, so the specs before the call are read
+	// from the committed state. No other transaction can be committed while
+	// tx is open, so this is the state tx started from.
+	p.store.View(func(committed store.ReadTx) {
+		for _, event := range p.events {
+			var before proto.Message
+			if event.before != nil {
+				before = event.before(committed, event.ObjectID)
+			}
+			event.SpecDiff = specDiff(before, event.after)
+		}
+	})
+	return p.write(tx)
+}
+
+func (p *pendingEvents) write(tx store.Tx) error {
+	var seq uint64
+	if newest := store.NewestAuditEvent(tx); newest != nil {
+		seq = newest.Sequence
+	}
+	for _, event := range p.events {
+		seq++
+		event.Sequence = seq
+		if err := store.CreateAuditEvent(tx, event.AuditEvent); err != nil {
+			return err
+		}
+	}
+	return prune(tx, seq, p.maxEvents)
+}
+
+// prune removes the oldest audit events so that at most maxEvents remain,
+// newest being the sequence number of the most recent one. As sequence
+// numbers have no gaps, only the events to remove are looked up.
+func prune(tx store.Tx, newest uint64, maxEvents int) error {
+	for {
+		oldest := store.OldestAuditEvent(tx)
+		if oldest == nil || newest-oldest.Sequence < uint64(maxEvents) {
+			return nil
+		}
+		if err := store.DeleteAuditEvent(tx, oldest.ID); err != nil {
+			return err
+		}
+	}
+}
+
+// caller returns the identity of the node or user that made the call.
+func caller(ctx context.Context) api.AuditCaller {
+	nodeInfo, err := ca.RemoteNode(ctx)
+	if err != nil {
+		return api.AuditCaller{}
+	}
+	c := api.AuditCaller{
+		ID:         nodeInfo.NodeID,
+		Roles:      nodeInfo.Roles,
+		RemoteAddr: nodeInfo.RemoteAddr,
+	}
+	if nodeInfo.ForwardedBy != nil {
+		c.ForwardedBy = nodeInfo.ForwardedBy.NodeID
+	}
+	return c
+}
+
+// specDiff returns a unified diff between the text representations of
+// before and after.
+func specDiff(before, after proto.Message) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(specText(before)),
+		B:        difflib.SplitLines(specText(after)),
+		FromFile: "before",
+		ToFile:   "after",
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
+
+func specText(spec proto.Message) string {
+	if spec == nil || isNil(spec) {
+		return ""
+	}
+	return proto.MarshalTextString(spec)
+}
+
+// isNil returns true for typed nil spec pointers.
+func isNil(spec proto.Message) bool {
+	switch s := spec.(type) {
+	case *api.NodeSpec:
+		return s == nil
+	case *api.ServiceSpec:
+		return s == nil
+	case *api.NetworkSpec:
+		return s == nil
+	case *api.ClusterSpec:
+		return s == nil
+	case *api.SecretSpec:
+		return s == nil
+	case *api.RoleSpec:
+		return s == nil
+	}
+	return false
+}
+
+// redactSecret returns a copy of spec with the secret payload removed.
+func redactSecret(spec *api.SecretSpec) *api.SecretSpec {
+	if spec == nil {
+		return nil
+	}
+	spec = spec.Copy()
+	if len(spec.Data) != 0 {
+		spec.Data = redacted
+	}
+	return spec
+}
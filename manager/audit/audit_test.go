@@ -0,0 +1,336 @@
+package audit_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/manager/audit"
+	"github.com/docker/swarmkit/manager/controlapi"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+type mockProposer struct {
+	index uint64
+}
+
+func (mp *mockProposer) ProposeValue(ctx context.Context, storeAction []*api.StoreAction, cb func()) error {
+	if cb != nil {
+		cb()
+	}
+	return nil
+}
+
+func (mp *mockProposer) GetVersion() *api.Version {
+	mp.index += 3
+	return &api.Version{Index: mp.index}
+}
+
+func contextWithCaller(id string) context.Context {
+	return context.WithValue(context.Background(), ca.LocalRequestKey, ca.RemoteNodeInfo{
+		Roles:      []string{ca.ManagerRole},
+		NodeID:     id,
+		RemoteAddr: "10.0.0.1:4242",
+	})
+}
+
+func serviceSpec(name string, replicas uint64) *api.ServiceSpec {
+	return &api.ServiceSpec{
+		Annotations: api.Annotations{Name: name},
+		Task: api.TaskSpec{
+			Runtime: &api.TaskSpec_Container{
+				Container: &api.ContainerSpec{Image: "image"},
+			},
+		},
+		Mode: &api.ServiceSpec_Replicated{
+			Replicated: &api.ReplicatedService{Replicas: replicas},
+		},
+	}
+}
+
+func auditEvents(t *testing.T, s *store.MemoryStore) []*api.AuditEvent {
+	var (
+		events []*api.AuditEvent
+		err    error
+	)
+	s.View(func(tx store.ReadTx) {
+		events, err = store.FindAuditEvents(tx, store.All)
+	})
+	require.NoError(t, err)
+	sort.Sort(bySequence(events))
+	return events
+}
+
+// waitForEvents waits until n audit events are stored, and returns them.
+func waitForEvents(t *testing.T, s *store.MemoryStore, n int) []*api.AuditEvent {
+	var events []*api.AuditEvent
+	for i := 0; i < 50; i++ {
+		events = auditEvents(t, s)
+		if len(events) >= n {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.Len(t, events, n)
+	return events
+}
+
+// bySequence sorts audit events from oldest to most recent.
+type bySequence []*api.AuditEvent
+
+func (b bySequence) Len() int      { return len(b) }
+func (b bySequence) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b bySequence) Less(i, j int) bool {
+	return b[i].Sequence < b[j].Sequence
+}
+
+func TestRecordServiceUpdates(t *testing.T) {
+	s := store.NewMemoryStore(&mockProposer{})
+	require.NotNil(t, s)
+	defer s.Close()
+
+	server := audit.NewServer(controlapi.NewServer(s, nil, nil, nil), s, 0)
+	ctx := contextWithCaller("alice")
+
+	created, err := server.CreateService(ctx, &api.CreateServiceRequest{Spec: serviceSpec("web", 3)})
+	require.NoError(t, err)
+	service := created.Service
+	version := service.Meta.Version
+
+	_, err = server.UpdateService(ctx, &api.UpdateServiceRequest{
+		ServiceID:      service.ID,
+		ServiceVersion: &version,
+		Spec:           serviceSpec("web", 0),
+	})
+	require.NoError(t, err)
+
+	// outdated versions are rejected, and the failure is recorded
+	_, err = server.UpdateService(ctx, &api.UpdateServiceRequest{
+		ServiceID:      service.ID,
+		ServiceVersion: &version,
+		Spec:           serviceSpec("web", 5),
+	})
+	require.Error(t, err)
+
+	events := waitForEvents(t, s, 3)
+
+	assert.Equal(t, "CreateService", events[0].Method)
+	assert.Equal(t, service.ID, events[0].ObjectID)
+	assert.Contains(t, events[0].SpecDiff, "+  replicas: 3")
+	// the event is committed with the change it describes
+	assert.Equal(t, version, events[0].Meta.Version)
+
+	assert.Equal(t, "UpdateService", events[1].Method)
+	assert.Equal(t, service.ID, events[1].ObjectID)
+	assert.Equal(t, "alice", events[1].Caller.ID)
+	assert.Equal(t, []string{ca.ManagerRole}, events[1].Caller.Roles)
+	assert.Equal(t, "10.0.0.1:4242", events[1].Caller.RemoteAddr)
+	assert.Contains(t, events[1].SpecDiff, "-  replicas: 3")
+	assert.Empty(t, events[1].Error)
+
+	assert.Equal(t, "UpdateService", events[2].Method)
+	assert.NotEmpty(t, events[2].Error)
+	assert.Empty(t, events[2].SpecDiff)
+}
+
+// racingServer updates a service in the store just before each call to
+// UpdateService, as a concurrent call would.
+type racingServer struct {
+	api.ControlServer
+	store    *store.MemoryStore
+	replicas uint64
+}
+
+func (r *racingServer) UpdateService(ctx context.Context, request *api.UpdateServiceRequest) (*api.UpdateServiceResponse, error) {
+	err := r.store.Update(func(tx store.Tx) error {
+		service := store.GetService(tx, request.ServiceID)
+		service.Spec.GetReplicated().Replicas = r.replicas
+		if err := store.UpdateService(tx, service); err != nil {
+			return err
+		}
+		request.ServiceVersion = &service.Meta.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.ControlServer.UpdateService(ctx, request)
+}
+
+func TestRecordConcurrentUpdate(t *testing.T) {
+	s := store.NewMemoryStore(&mockProposer{})
+	require.NotNil(t, s)
+	defer s.Close()
+
+	server := audit.NewServer(&racingServer{
+		ControlServer: controlapi.NewServer(s, nil, nil, nil),
+		store:         s,
+		replicas:      7,
+	}, s, 0)
+	ctx := contextWithCaller("alice")
+
+	created, err := server.CreateService(ctx, &api.CreateServiceRequest{Spec: serviceSpec("web", 3)})
+	require.NoError(t, err)
+	_, err = server.UpdateService(ctx, &api.UpdateServiceRequest{
+		ServiceID:      created.Service.ID,
+		ServiceVersion: &created.Service.Meta.Version,
+		Spec:           serviceSpec("web", 0),
+	})
+	require.NoError(t, err)
+
+	// the diff is against the spec the update replaced
+	events := auditEvents(t, s)
+	require.Len(t, events, 2)
+	assert.Equal(t, "UpdateService", events[1].Method)
+	assert.Contains(t, events[1].SpecDiff, "-  replicas: 7")
+	assert.NotContains(t, events[1].SpecDiff, "replicas: 3")
+}
+
+func TestRecordNetworkUpdates(t *testing.T) {
+	s := store.NewMemoryStore(&mockProposer{})
+	require.NotNil(t, s)
+	defer s.Close()
+
+	server := audit.NewServer(controlapi.NewServer(s, nil, nil, nil), s, 0)
+	ctx := contextWithCaller("alice")
+
+	created, err := server.CreateNetwork(ctx, &api.CreateNetworkRequest{
+		Spec: &api.NetworkSpec{Annotations: api.Annotations{Name: "net"}},
+	})
+	require.NoError(t, err)
+	network := created.Network
+
+	spec := network.Spec.Copy()
+	spec.Annotations.Labels = map[string]string{"team": "web"}
+	updated, err := server.UpdateNetwork(ctx, &api.UpdateNetworkRequest{
+		NetworkID:      network.ID,
+		NetworkVersion: &network.Meta.Version,
+		Spec:           spec,
+	})
+	require.NoError(t, err)
+
+	events := auditEvents(t, s)
+	require.Len(t, events, 2)
+	assert.Equal(t, "UpdateNetwork", events[1].Method)
+	assert.Equal(t, network.ID, events[1].ObjectID)
+	assert.Contains(t, events[1].SpecDiff, `+    value: "web"`)
+	assert.Equal(t, updated.Network.Meta.Version, events[1].Meta.Version)
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	s := store.NewMemoryStore(&mockProposer{})
+	require.NotNil(t, s)
+	defer s.Close()
+
+	server := audit.NewServer(controlapi.NewServer(s, nil, nil, nil), s, 0)
+	ctx := contextWithCaller("alice")
+
+	created, err := server.CreateSecret(ctx, &api.CreateSecretRequest{
+		Spec: &api.SecretSpec{
+			Annotations: api.Annotations{Name: "password"},
+			Data:        []byte("hunter2"),
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = server.RemoveSecret(ctx, &api.RemoveSecretRequest{SecretID: created.Secret.ID})
+	require.NoError(t, err)
+
+	events := auditEvents(t, s)
+	require.Len(t, events, 2)
+	for _, e := range events {
+		assert.Equal(t, created.Secret.ID, e.ObjectID)
+		assert.NotContains(t, e.SpecDiff, "hunter2")
+		assert.Contains(t, e.SpecDiff, "<redacted>")
+	}
+}
+
+func TestRecordTransaction(t *testing.T) {
+	s := store.NewMemoryStore(&mockProposer{})
+	require.NotNil(t, s)
+	defer s.Close()
+
+	server := audit.NewServer(controlapi.NewServer(s, nil, nil, nil), s, 0)
+	ctx := contextWithCaller("alice")
+
+	resp, err := server.Transaction(ctx, &api.TransactionRequest{
+		Operations: []*api.TransactionOperation{
+			{Operation: &api.TransactionOperation_CreateSecret{
+				CreateSecret: &api.CreateSecretRequest{Spec: &api.SecretSpec{
+					Annotations: api.Annotations{Name: "password"},
+					Data:        []byte("hunter2"),
+				}},
+			}},
+			{Operation: &api.TransactionOperation_CreateService{
+				CreateService: &api.CreateServiceRequest{Spec: serviceSpec("web", 3)},
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	events := auditEvents(t, s)
+	require.Len(t, events, 2)
+	byObject := make(map[string]*api.AuditEvent)
+	for _, e := range events {
+		assert.Equal(t, "Transaction", e.Method)
+		assert.NotContains(t, e.SpecDiff, "hunter2")
+		byObject[e.ObjectID] = e
+	}
+	service := resp.Results[1].GetCreateService().Service
+	require.Contains(t, byObject, resp.Results[0].GetCreateSecret().Secret.ID)
+	require.Contains(t, byObject, service.ID)
+	assert.Contains(t, byObject[service.ID].SpecDiff, "+  replicas: 3")
+	assert.Equal(t, service.Meta.Version, byObject[service.ID].Meta.Version)
+}
+
+func TestPrune(t *testing.T) {
+	s := store.NewMemoryStore(&mockProposer{})
+	require.NotNil(t, s)
+	defer s.Close()
+
+	server := audit.NewServer(controlapi.NewServer(s, nil, nil, nil), s, 2)
+	ctx := contextWithCaller("alice")
+
+	for _, id := range []string{"a", "b", "c"} {
+		_, err := server.RemoveTask(ctx, &api.RemoveTaskRequest{TaskID: id})
+		assert.Error(t, err)
+	}
+	// the failed calls are written together, then pruned
+	events := waitForEvents(t, s, 2)
+	require.Len(t, events, 2)
+	assert.Equal(t, "b", events[0].ObjectID)
+	assert.Equal(t, uint64(2), events[0].Sequence)
+	assert.Equal(t, "c", events[1].ObjectID)
+	assert.Equal(t, uint64(3), events[1].Sequence)
+}
+
+func TestRecordFailedCalls(t *testing.T) {
+	s := store.NewMemoryStore(&mockProposer{})
+	require.NotNil(t, s)
+	defer s.Close()
+
+	server := audit.NewServer(controlapi.NewServer(s, nil, nil, nil), s, 0)
+
+	// only the first failed calls of each caller are recorded
+	for i := 0; i < 20; i++ {
+		_, err := server.RemoveTask(contextWithCaller("mallory"), &api.RemoveTaskRequest{TaskID: "task"})
+		assert.Error(t, err)
+	}
+	_, err := server.RemoveTask(contextWithCaller("alice"), &api.RemoveTaskRequest{TaskID: "task"})
+	assert.Error(t, err)
+
+	events := waitForEvents(t, s, 11)
+	byCaller := make(map[string]int)
+	for _, e := range events {
+		byCaller[e.Caller.ID]++
+		assert.NotEmpty(t, e.Error)
+		// all in a single transaction
+		assert.Equal(t, events[0].Meta.Version, e.Meta.Version)
+	}
+	assert.Equal(t, map[string]int{"mallory": 10, "alice": 1}, byCaller)
+}
@@ -0,0 +1,231 @@
+package audit
+
+import (
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+func nodeSpec(tx store.ReadTx, id string) proto.Message {
+	if node := store.GetNode(tx, id); node != nil {
+		return &node.Spec
+	}
+	return nil
+}
+
+func serviceSpec(tx store.ReadTx, id string) proto.Message {
+	if service := store.GetService(tx, id); service != nil {
+		return &service.Spec
+	}
+	return nil
+}
+
+func networkSpec(tx store.ReadTx, id string) proto.Message {
+	if network := store.GetNetwork(tx, id); network != nil {
+		return &network.Spec
+	}
+	return nil
+}
+
+func clusterSpec(tx store.ReadTx, id string) proto.Message {
+	if cluster := store.GetCluster(tx, id); cluster != nil {
+		return &cluster.Spec
+	}
+	return nil
+}
+
+func secretSpec(tx store.ReadTx, id string) proto.Message {
+	if secret := store.GetSecret(tx, id); secret != nil {
+		return redactSecret(&secret.Spec)
+	}
+	return nil
+}
+
+func roleSpec(tx store.ReadTx, id string) proto.Message {
+	if role := store.GetRole(tx, id); role != nil {
+		return &role.Spec
+	}
+	return nil
+}
+
+// UpdateNode records calls to ControlServer.UpdateNode.
+func (s *Server) UpdateNode(ctx context.Context, r *api.UpdateNodeRequest) (*api.UpdateNodeResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "UpdateNode", r.NodeID, nodeSpec, r.Spec))
+	resp, err := s.ControlServer.UpdateNode(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// RemoveNode records calls to ControlServer.RemoveNode.
+func (s *Server) RemoveNode(ctx context.Context, r *api.RemoveNodeRequest) (*api.RemoveNodeResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "RemoveNode", r.NodeID, nodeSpec, nil))
+	resp, err := s.ControlServer.RemoveNode(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// RemoveTask records calls to ControlServer.RemoveTask.
+func (s *Server) RemoveTask(ctx context.Context, r *api.RemoveTaskRequest) (*api.RemoveTaskResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "RemoveTask", r.TaskID, nil, nil))
+	resp, err := s.ControlServer.RemoveTask(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// CreateService records calls to ControlServer.CreateService.
+func (s *Server) CreateService(ctx context.Context, r *api.CreateServiceRequest) (*api.CreateServiceResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "CreateService", "", nil, r.Spec))
+	resp, err := s.ControlServer.CreateService(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// UpdateService records calls to ControlServer.UpdateService.
+func (s *Server) UpdateService(ctx context.Context, r *api.UpdateServiceRequest) (*api.UpdateServiceResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "UpdateService", r.ServiceID, serviceSpec, r.Spec))
+	resp, err := s.ControlServer.UpdateService(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// RemoveService records calls to ControlServer.RemoveService.
+func (s *Server) RemoveService(ctx context.Context, r *api.RemoveServiceRequest) (*api.RemoveServiceResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "RemoveService", r.ServiceID, serviceSpec, nil))
+	resp, err := s.ControlServer.RemoveService(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// CreateNetwork records calls to ControlServer.CreateNetwork.
+func (s *Server) CreateNetwork(ctx context.Context, r *api.CreateNetworkRequest) (*api.CreateNetworkResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "CreateNetwork", "", nil, r.Spec))
+	resp, err := s.ControlServer.CreateNetwork(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// UpdateNetwork records calls to ControlServer.UpdateNetwork.
+func (s *Server) UpdateNetwork(ctx context.Context, r *api.UpdateNetworkRequest) (*api.UpdateNetworkResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "UpdateNetwork", r.NetworkID, networkSpec, r.Spec))
+	resp, err := s.ControlServer.UpdateNetwork(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// RemoveNetwork records calls to ControlServer.RemoveNetwork.
+func (s *Server) RemoveNetwork(ctx context.Context, r *api.RemoveNetworkRequest) (*api.RemoveNetworkResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "RemoveNetwork", r.NetworkID, networkSpec, nil))
+	resp, err := s.ControlServer.RemoveNetwork(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// UpdateCluster records calls to ControlServer.UpdateCluster.
+func (s *Server) UpdateCluster(ctx context.Context, r *api.UpdateClusterRequest) (*api.UpdateClusterResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "UpdateCluster", r.ClusterID, clusterSpec, r.Spec))
+	resp, err := s.ControlServer.UpdateCluster(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// CreateSecret records calls to ControlServer.CreateSecret. The secret
+// payload is not recorded.
+func (s *Server) CreateSecret(ctx context.Context, r *api.CreateSecretRequest) (*api.CreateSecretResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "CreateSecret", "", nil, redactSecret(r.Spec)))
+	resp, err := s.ControlServer.CreateSecret(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// UpdateSecret records calls to ControlServer.UpdateSecret. The secret
+// payload is not recorded.
+func (s *Server) UpdateSecret(ctx context.Context, r *api.UpdateSecretRequest) (*api.UpdateSecretResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "UpdateSecret", r.SecretID, secretSpec, redactSecret(r.Spec)))
+	resp, err := s.ControlServer.UpdateSecret(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// RemoveSecret records calls to ControlServer.RemoveSecret.
+func (s *Server) RemoveSecret(ctx context.Context, r *api.RemoveSecretRequest) (*api.RemoveSecretResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "RemoveSecret", r.SecretID, secretSpec, nil))
+	resp, err := s.ControlServer.RemoveSecret(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// CreateRole records calls to ControlServer.CreateRole.
+func (s *Server) CreateRole(ctx context.Context, r *api.CreateRoleRequest) (*api.CreateRoleResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "CreateRole", "", nil, r.Spec))
+	resp, err := s.ControlServer.CreateRole(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// UpdateRole records calls to ControlServer.UpdateRole.
+func (s *Server) UpdateRole(ctx context.Context, r *api.UpdateRoleRequest) (*api.UpdateRoleResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "UpdateRole", r.RoleID, roleSpec, r.Spec))
+	resp, err := s.ControlServer.UpdateRole(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// RemoveRole records calls to ControlServer.RemoveRole.
+func (s *Server) RemoveRole(ctx context.Context, r *api.RemoveRoleRequest) (*api.RemoveRoleResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "RemoveRole", r.RoleID, roleSpec, nil))
+	resp, err := s.ControlServer.RemoveRole(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// IssueUserCertificate records calls to ControlServer.IssueUserCertificate.
+// The object ID is the name of the user the certificate was issued for. As
+// issuing a certificate doesn't change the store, the event is recorded
+// after the call.
+func (s *Server) IssueUserCertificate(ctx context.Context, r *api.IssueUserCertificateRequest) (*api.IssueUserCertificateResponse, error) {
+	ctx, p := s.begin(ctx, newEvent(ctx, "IssueUserCertificate", r.Name, nil, nil))
+	resp, err := s.ControlServer.IssueUserCertificate(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
+
+// Transaction records calls to ControlServer.Transaction, with an event for
+// each of its operations.
+func (s *Server) Transaction(ctx context.Context, r *api.TransactionRequest) (*api.TransactionResponse, error) {
+	events := make([]*pendingEvent, 0, len(r.Operations))
+	for _, op := range r.Operations {
+		var (
+			id     string
+			before specFunc
+			after  proto.Message
+		)
+		switch v := op.Operation.(type) {
+		case *api.TransactionOperation_CreateNetwork:
+			after = v.CreateNetwork.Spec
+		case *api.TransactionOperation_RemoveNetwork:
+			id = v.RemoveNetwork.NetworkID
+			before = networkSpec
+		case *api.TransactionOperation_CreateSecret:
+			after = redactSecret(v.CreateSecret.Spec)
+		case *api.TransactionOperation_RemoveSecret:
+			id = v.RemoveSecret.SecretID
+			before = secretSpec
+		case *api.TransactionOperation_CreateService:
+			after = v.CreateService.Spec
+		case *api.TransactionOperation_UpdateService:
+			id = v.UpdateService.ServiceID
+			before = serviceSpec
+			after = v.UpdateService.Spec
+		case *api.TransactionOperation_RemoveService:
+			id = v.RemoveService.ServiceID
+			before = serviceSpec
+		}
+		events = append(events, newEvent(ctx, "Transaction", id, before, after))
+	}
+
+	ctx, p := s.begin(ctx, events...)
+	resp, err := s.ControlServer.Transaction(ctx, r)
+	s.end(ctx, p, err)
+	return resp, err
+}
@@ -205,3 +205,20 @@ func NodeMatches(constraints []Constraint, n *api.Node) bool {
 
 	return true
 }
+
+// NodeLabelKeys returns the keys of the node labels a node must have to
+// satisfy the given constraints, which can be used to look up the candidate
+// nodes in the label index of the store. Only the keys are returned since
+// label values are compared case insensitively.
+func NodeLabelKeys(constraints []Constraint) []string {
+	var keys []string
+	for _, constraint := range constraints {
+		if constraint.operator != eq {
+			continue
+		}
+		if len(constraint.key) > len(NodeLabelPrefix) && strings.EqualFold(constraint.key[:len(NodeLabelPrefix)], NodeLabelPrefix) {
+			keys = append(keys, constraint.key[len(NodeLabelPrefix):])
+		}
+	}
+	return keys
+}
@@ -115,3 +115,13 @@ func TestMatch(t *testing.T) {
 	assert.False(t, e.Match("fa-$o"))
 	assert.True(t, e.Match("f.-$o"))
 }
+
+func TestNodeLabelKeys(t *testing.T) {
+	exprs, err := Parse([]string{"node.labels.zone==a", "node.labels.disk!=ssd", "Node.Labels.rack==1", "engine.labels.os==linux", "node.role==manager"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"zone", "rack"}, NodeLabelKeys(exprs))
+
+	exprs, err = Parse([]string{"node.labels.disk!=ssd"})
+	assert.NoError(t, err)
+	assert.Empty(t, NodeLabelKeys(exprs))
+}
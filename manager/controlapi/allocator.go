@@ -0,0 +1,42 @@
+package controlapi
+
+import (
+	"github.com/docker/swarmkit/api"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// AllocatorInspector returns a snapshot of the allocator state.
+type AllocatorInspector interface {
+	Inspect(ctx context.Context) (*api.InspectAllocatorResponse, error)
+}
+
+// SetAllocator sets the allocator inspected by InspectAllocator. It is set
+// when this manager becomes the leader, and reset to nil when it stops
+// being the leader.
+func (s *Server) SetAllocator(allocator AllocatorInspector) {
+	s.allocatorMu.Lock()
+	s.allocator = allocator
+	s.allocatorMu.Unlock()
+}
+
+// InspectAllocator returns a snapshot of the in-memory state of the
+// allocator.
+// - Returns `Unavailable` if the allocator is not running.
+// - Returns an error if the inspection fails.
+func (s *Server) InspectAllocator(ctx context.Context, request *api.InspectAllocatorRequest) (*api.InspectAllocatorResponse, error) {
+	s.allocatorMu.Lock()
+	allocator := s.allocator
+	s.allocatorMu.Unlock()
+
+	if allocator == nil {
+		return nil, grpc.Errorf(codes.Unavailable, "allocator is not running")
+	}
+
+	resp, err := allocator.Inspect(ctx)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unavailable, "failed to inspect allocator: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package controlapi
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type fakeAllocator struct {
+	resp *api.InspectAllocatorResponse
+}
+
+func (f *fakeAllocator) Inspect(ctx context.Context) (*api.InspectAllocatorResponse, error) {
+	return f.resp, nil
+}
+
+func TestInspectAllocator(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	_, err := ts.Client.InspectAllocator(context.Background(), &api.InspectAllocatorRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
+
+	ts.Server.SetAllocator(&fakeAllocator{
+		resp: &api.InspectAllocatorResponse{
+			UnallocatedTasks: []*api.InspectAllocatorResponse_Unallocated{
+				{ID: "taskID", Name: "service.1.taskID", NetworkIDs: []string{"networkID"}},
+			},
+		},
+	})
+	r, err := ts.Client.InspectAllocator(context.Background(), &api.InspectAllocatorRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, r.UnallocatedTasks, 1)
+	assert.Equal(t, "taskID", r.UnallocatedTasks[0].ID)
+
+	ts.Server.SetAllocator(nil)
+	_, err = ts.Client.InspectAllocator(context.Background(), &api.InspectAllocatorRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
+}
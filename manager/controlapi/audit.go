@@ -0,0 +1,77 @@
+package controlapi
+
+import (
+	"sort"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+)
+
+// ListAuditEvents returns a `ListAuditEventsResponse` with the recorded
+// `AuditEvent`s matching the filters in `ListAuditEventsRequest`, most recent
+// first. At most `ListAuditEventsRequest.Limit` events are returned if it is
+// set.
+// - Returns an error if listing fails.
+func (s *Server) ListAuditEvents(ctx context.Context, request *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	var (
+		events []*api.AuditEvent
+		err    error
+	)
+
+	s.store.View(func(tx store.ReadTx) {
+		events, err = store.FindAuditEvents(tx, store.All)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Filters != nil {
+		events = filterAuditEvents(events,
+			func(e *api.AuditEvent) bool {
+				return filterContains(e.ObjectID, request.Filters.ObjectIDs)
+			},
+			func(e *api.AuditEvent) bool {
+				return filterContains(e.Method, request.Filters.Methods)
+			},
+			func(e *api.AuditEvent) bool {
+				return filterContains(e.Caller.ID, request.Filters.CallerIDs)
+			},
+		)
+	}
+
+	sort.Sort(auditEventsBySequence(events))
+	if request.Limit > 0 && uint32(len(events)) > request.Limit {
+		events = events[:request.Limit]
+	}
+
+	return &api.ListAuditEventsResponse{Events: events}, nil
+}
+
+func filterAuditEvents(candidates []*api.AuditEvent, filters ...func(*api.AuditEvent) bool) []*api.AuditEvent {
+	result := []*api.AuditEvent{}
+
+	for _, c := range candidates {
+		match := true
+		for _, f := range filters {
+			if !f(c) {
+				match = false
+				break
+			}
+		}
+		if match {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// auditEventsBySequence sorts audit events from most recent to oldest.
+type auditEventsBySequence []*api.AuditEvent
+
+func (b auditEventsBySequence) Len() int      { return len(b) }
+func (b auditEventsBySequence) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b auditEventsBySequence) Less(i, j int) bool {
+	return b[i].Sequence > b[j].Sequence
+}
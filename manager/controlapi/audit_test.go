@@ -0,0 +1,60 @@
+package controlapi
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	for _, e := range []*api.AuditEvent{
+		{ID: "e1", Sequence: 1, Method: "CreateService", ObjectID: "web", Caller: api.AuditCaller{ID: "alice"}},
+		{ID: "e2", Sequence: 2, Method: "UpdateService", ObjectID: "web", Caller: api.AuditCaller{ID: "bob"}},
+		{ID: "e3", Sequence: 3, Method: "RemoveNetwork", ObjectID: "net", Caller: api.AuditCaller{ID: "alice"}},
+		{ID: "e4", Sequence: 4, Method: "UpdateService", ObjectID: "web", Caller: api.AuditCaller{ID: "alice"}},
+	} {
+		err := ts.Store.Update(func(tx store.Tx) error {
+			return store.CreateAuditEvent(tx, e)
+		})
+		require.NoError(t, err)
+	}
+
+	ids := func(events []*api.AuditEvent) []string {
+		var ids []string
+		for _, e := range events {
+			ids = append(ids, e.ID)
+		}
+		return ids
+	}
+
+	// events are returned most recent first
+	resp, err := ts.Client.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e4", "e3", "e2", "e1"}, ids(resp.Events))
+
+	resp, err = ts.Client.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e4", "e3"}, ids(resp.Events))
+
+	resp, err = ts.Client.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{
+		Filters: &api.ListAuditEventsRequest_Filters{ObjectIDs: []string{"web"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e4", "e2", "e1"}, ids(resp.Events))
+
+	resp, err = ts.Client.ListAuditEvents(context.Background(), &api.ListAuditEventsRequest{
+		Filters: &api.ListAuditEventsRequest_Filters{
+			Methods:   []string{"UpdateService"},
+			CallerIDs: []string{"alice"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"e4"}, ids(resp.Events))
+}
@@ -1,11 +1,16 @@
 package controlapi
 
 import (
+	"reflect"
 	"strings"
 	"time"
 
+	"github.com/docker/libnetwork/ipamapi"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/manager/allocator/networkallocator"
+	"github.com/docker/swarmkit/manager/audit"
+	"github.com/docker/swarmkit/manager/constraint"
 	"github.com/docker/swarmkit/manager/encryption"
 	"github.com/docker/swarmkit/manager/state/store"
 	gogotypes "github.com/gogo/protobuf/types"
@@ -46,6 +51,21 @@ func validateClusterSpec(spec *api.ClusterSpec) error {
 		}
 	}
 
+	// Validate that the published port ranges are valid
+	if err := networkallocator.ValidatePortAllocationConfig(&spec.PortAllocation); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Validate that the default address pools are valid
+	if err := networkallocator.ValidateDefaultAddressPools(&spec.DefaultAddressPools); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// Validate that the managers can be scaled automatically
+	if err := validateManagerScaling(&spec.ManagerScaling); err != nil {
+		return err
+	}
+
 	// Validate that heartbeatPeriod time being provided is valid
 	if spec.Dispatcher.HeartbeatPeriod != nil {
 		heartbeatPeriod, err := gogotypes.DurationFromProto(spec.Dispatcher.HeartbeatPeriod)
@@ -60,6 +80,27 @@ func validateClusterSpec(spec *api.ClusterSpec) error {
 	return nil
 }
 
+func validateManagerScaling(cfg *api.ManagerScalingConfig) error {
+	if cfg.DesiredManagers%2 == 0 && cfg.DesiredManagers != 0 {
+		return grpc.Errorf(codes.InvalidArgument, "desired number of managers must be odd")
+	}
+	if cfg.Placement != nil {
+		if _, err := constraint.Parse(cfg.Placement.Constraints); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "invalid manager placement constraints: %v", err)
+		}
+	}
+	if cfg.DownGracePeriod != nil {
+		gracePeriod, err := gogotypes.DurationFromProto(cfg.DownGracePeriod)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "invalid manager down grace period: %v", err)
+		}
+		if gracePeriod < 0 {
+			return grpc.Errorf(codes.InvalidArgument, "manager down grace period cannot be a negative duration")
+		}
+	}
+	return nil
+}
+
 // GetCluster returns a Cluster given a ClusterID.
 // - Returns `InvalidArgument` if ClusterID is not provided.
 // - Returns `NotFound` if the Cluster is not found.
@@ -104,6 +145,12 @@ func (s *Server) UpdateCluster(ctx context.Context, request *api.UpdateClusterRe
 			return grpc.Errorf(codes.NotFound, "cluster %s not found", request.ClusterID)
 
 		}
+		if !reflect.DeepEqual(cluster.Spec.DefaultAddressPools, request.Spec.DefaultAddressPools) {
+			if err := checkNetworksFitAddressPools(tx, &request.Spec.DefaultAddressPools); err != nil {
+				return err
+			}
+		}
+
 		cluster.Meta.Version = *request.ClusterVersion
 		cluster.Spec = *request.Spec.Copy()
 
@@ -141,7 +188,10 @@ func (s *Server) UpdateCluster(ctx context.Context, request *api.UpdateClusterRe
 		}
 		cluster.UnlockKeys = unlockKeys
 
-		return store.UpdateCluster(tx, cluster)
+		if err := store.UpdateCluster(tx, cluster); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
 	if err != nil {
 		return nil, err
@@ -188,6 +238,8 @@ func (s *Server) ListClusters(ctx context.Context, request *api.ListClustersRequ
 			clusters, err = store.FindClusters(tx, buildFilters(store.ByNamePrefix, request.Filters.NamePrefixes))
 		case request.Filters != nil && len(request.Filters.IDPrefixes) > 0:
 			clusters, err = store.FindClusters(tx, buildFilters(store.ByIDPrefix, request.Filters.IDPrefixes))
+		case request.Filters != nil && len(request.Filters.Labels) > 0:
+			clusters, err = store.FindClusters(tx, labelFilter(request.Filters.Labels))
 		default:
 			clusters, err = store.FindClusters(tx, store.All)
 		}
@@ -245,6 +297,39 @@ func redactClusters(clusters []*api.Cluster) []*api.Cluster {
 	return redactedClusters
 }
 
+// checkNetworksFitAddressPools checks that the subnets of networks which were
+// allocated from the default address pools are contained in the given pools.
+func checkNetworksFitAddressPools(tx store.ReadTx, config *api.DefaultAddressPoolsConfig) error {
+	networks, err := store.FindNetworks(tx, store.All)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range networks {
+		if n.IPAM == nil || (n.IPAM.Driver != nil && n.IPAM.Driver.Name != "" && n.IPAM.Driver.Name != ipamapi.DefaultIPAM) {
+			continue
+		}
+		for i, ic := range n.IPAM.Configs {
+			// Subnets specified by the network were not allocated
+			// from the pools
+			if n.Spec.IPAM != nil && i < len(n.Spec.IPAM.Configs) && n.Spec.IPAM.Configs[i].Subnet != "" {
+				continue
+			}
+			if ic.Subnet == "" || ic.Family == api.IPAMConfig_IPV6 {
+				continue
+			}
+			fits, err := networkallocator.SubnetInDefaultAddressPools(config, ic.Subnet)
+			if err != nil {
+				return grpc.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			if !fits {
+				return grpc.Errorf(codes.InvalidArgument, "subnet %s of network %s is outside of the default address pools", ic.Subnet, n.Spec.Annotations.Name)
+			}
+		}
+	}
+	return nil
+}
+
 func expireBlacklistedCerts(cluster *api.Cluster) {
 	nowMinusGrace := time.Now().Add(-expiredCertGrace)
 
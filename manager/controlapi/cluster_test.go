@@ -88,6 +88,62 @@ func TestValidateClusterSpec(t *testing.T) {
 			},
 			c: codes.InvalidArgument,
 		},
+		{
+			spec: &api.ClusterSpec{
+				Annotations: api.Annotations{
+					Name: "name",
+				},
+				ManagerScaling: api.ManagerScalingConfig{
+					DesiredManagers: 4,
+				},
+			},
+			c: codes.InvalidArgument,
+		},
+		{
+			spec: &api.ClusterSpec{
+				Annotations: api.Annotations{
+					Name: "name",
+				},
+				ManagerScaling: api.ManagerScalingConfig{
+					DesiredManagers: 3,
+					Placement:       &api.Placement{Constraints: []string{"node.labels.zone"}},
+				},
+			},
+			c: codes.InvalidArgument,
+		},
+		{
+			spec: &api.ClusterSpec{
+				Annotations: api.Annotations{
+					Name: "name",
+				},
+				PortAllocation: api.PortAllocationConfig{
+					DynamicRange: &api.PortRange{Start: 40000, End: 39000},
+				},
+			},
+			c: codes.InvalidArgument,
+		},
+		{
+			spec: &api.ClusterSpec{
+				Annotations: api.Annotations{
+					Name: "name",
+				},
+				PortAllocation: api.PortAllocationConfig{
+					Reserved: []api.PortRange{{Start: 0, End: 100}},
+				},
+			},
+			c: codes.InvalidArgument,
+		},
+		{
+			spec: &api.ClusterSpec{
+				Annotations: api.Annotations{
+					Name: "name",
+				},
+				DefaultAddressPools: api.DefaultAddressPoolsConfig{
+					Pools: []string{"10.10.0.0/16", "10.10.0.0/24"},
+				},
+			},
+			c: codes.InvalidArgument,
+		},
 	} {
 		err := validateClusterSpec(bad.spec)
 		assert.Error(t, err)
@@ -230,6 +286,44 @@ func TestUpdateCluster(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUpdateClusterDefaultAddressPools(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+	cluster := createCluster(t, ts, "name", "name", api.AcceptancePolicy{}, ts.Server.rootCA)
+
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateNetwork(tx, &api.Network{
+			ID: "networkID",
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{Name: "network"},
+			},
+			IPAM: &api.IPAMOptions{
+				Configs: []*api.IPAMConfig{{Subnet: "10.0.0.0/24"}},
+			},
+		})
+	}))
+
+	// The subnet of the network isn't in the new pools.
+	spec := cluster.Spec.Copy()
+	spec.DefaultAddressPools.Pools = []string{"10.20.0.0/16"}
+	_, err := ts.Client.UpdateCluster(context.Background(), &api.UpdateClusterRequest{
+		ClusterID:      cluster.ID,
+		Spec:           spec,
+		ClusterVersion: &cluster.Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	spec.DefaultAddressPools.Pools = []string{"10.0.0.0/16", "10.20.0.0/16"}
+	r, err := ts.Client.UpdateCluster(context.Background(), &api.UpdateClusterRequest{
+		ClusterID:      cluster.ID,
+		Spec:           spec,
+		ClusterVersion: &cluster.Meta.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, spec.DefaultAddressPools, r.Cluster.Spec.DefaultAddressPools)
+}
+
 func TestUpdateClusterRotateToken(t *testing.T) {
 	ts := newTestServer(t)
 	defer ts.Stop()
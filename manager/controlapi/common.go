@@ -2,6 +2,7 @@ package controlapi
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/docker/docker/pkg/plugingetter"
@@ -47,6 +48,24 @@ func filterContainsPrefix(match string, candidates []string) bool {
 	return false
 }
 
+// labelFilter returns a By selecting the objects which have one of the labels
+// of a label filter. The whole filter must still be matched against them.
+func labelFilter(labels map[string]string) store.By {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	// A label with a value is more selective than one without
+	for _, k := range keys {
+		if labels[k] != "" {
+			return store.ByLabel(k, labels[k])
+		}
+	}
+	return store.ByLabelKey(keys[0])
+}
+
 func filterMatchLabels(match map[string]string, candidates map[string]string) bool {
 	if len(candidates) == 0 {
 		return true
@@ -3,12 +3,16 @@ package controlapi
 import (
 	"fmt"
 	"net"
+	"reflect"
+	"strings"
 
 	"github.com/docker/docker/pkg/plugingetter"
 	"github.com/docker/libnetwork/driverapi"
 	"github.com/docker/libnetwork/ipamapi"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/identity"
+	"github.com/docker/swarmkit/manager/allocator/networkallocator"
+	"github.com/docker/swarmkit/manager/audit"
 	"github.com/docker/swarmkit/manager/state/store"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -61,6 +65,12 @@ func validateIPAM(ipam *api.IPAMOptions, pg plugingetter.PluginGetter) error {
 		return err
 	}
 
+	if ipam.Driver != nil && strings.ToLower(ipam.Driver.Name) == ipamapi.DefaultIPAM {
+		if err := networkallocator.ValidateDefaultPoolOptions(ipam.Driver.Options); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "ipam driver options: %v", err)
+		}
+	}
+
 	for _, ipamConf := range ipam.Configs {
 		if err := validateIPAMConfiguration(ipamConf); err != nil {
 			return err
@@ -96,33 +106,183 @@ func validateNetworkSpec(spec *api.NetworkSpec, pg plugingetter.PluginGetter) er
 func (s *Server) CreateNetwork(ctx context.Context, request *api.CreateNetworkRequest) (*api.CreateNetworkResponse, error) {
 	// if you change this function, you have to change createInternalNetwork in
 	// the tests to match it (except the part where we check the label).
-	if err := validateNetworkSpec(request.Spec, s.pg); err != nil {
+	if err := s.validateCreateNetworkRequest(request); err != nil {
 		return nil, err
 	}
 
+	var n *api.Network
+	err := s.store.Update(func(tx store.Tx) error {
+		var err error
+		n, err = createNetworkInTx(tx, request.Spec)
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx, n.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateNetworkResponse{
+		Network: n,
+	}, nil
+}
+
+func (s *Server) validateCreateNetworkRequest(request *api.CreateNetworkRequest) error {
+	if err := validateNetworkSpec(request.Spec, s.pg); err != nil {
+		return err
+	}
+
 	if _, ok := request.Spec.Annotations.Labels["com.docker.swarm.internal"]; ok {
-		return nil, grpc.Errorf(codes.PermissionDenied, "label com.docker.swarm.internal is for predefined internal networks and cannot be applied by users")
+		return grpc.Errorf(codes.PermissionDenied, "label com.docker.swarm.internal is for predefined internal networks and cannot be applied by users")
 	}
+	return nil
+}
 
+func createNetworkInTx(tx store.Tx, spec *api.NetworkSpec) (*api.Network, error) {
 	// TODO(mrjana): Consider using `Name` as a primary key to handle
 	// duplicate creations. See #65
 	n := &api.Network{
 		ID:   identity.NewID(),
-		Spec: *request.Spec,
+		Spec: *spec,
+	}
+	if err := store.CreateNetwork(tx, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// UpdateNetwork updates a Network referenced by NetworkID with the given
+// NetworkSpec. The annotations and the attachable flag can be changed, and
+// IPAM configs with an explicit subnet can be appended; the allocator then
+// allocates their pools.
+// - Returns `InvalidArgument` if the NetworkSpec is malformed or changes an immutable field.
+// - Returns `NotFound` if the Network is not found.
+// - Returns `PermissionDenied` if the Network is a predefined internal network.
+// - Returns an error if the update fails.
+func (s *Server) UpdateNetwork(ctx context.Context, request *api.UpdateNetworkRequest) (*api.UpdateNetworkResponse, error) {
+	if request.NetworkID == "" || request.NetworkVersion == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", errInvalidArgument)
+	}
+
+	if err := validateNetworkSpec(request.Spec, s.pg); err != nil {
+		return nil, err
 	}
 
+	if _, ok := request.Spec.Annotations.Labels["com.docker.swarm.internal"]; ok {
+		return nil, grpc.Errorf(codes.PermissionDenied, "label com.docker.swarm.internal is for predefined internal networks and cannot be applied by users")
+	}
+
+	var n *api.Network
 	err := s.store.Update(func(tx store.Tx) error {
-		return store.CreateNetwork(tx, n)
+		n = store.GetNetwork(tx, request.NetworkID)
+		if n == nil {
+			return grpc.Errorf(codes.NotFound, "network %s not found", request.NetworkID)
+		}
+
+		if _, ok := n.Spec.Annotations.Labels["com.docker.swarm.internal"]; ok {
+			return grpc.Errorf(codes.PermissionDenied, "%s (%s) is a pre-defined network and cannot be updated", n.Spec.Annotations.Name, n.ID)
+		}
+
+		if err := validateNetworkSpecUpdate(n, request.Spec); err != nil {
+			return err
+		}
+
+		n.Meta.Version = *request.NetworkVersion
+		n.Spec = *request.Spec.Copy()
+		if err := store.UpdateNetwork(tx, n); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &api.CreateNetworkResponse{
+	return &api.UpdateNetworkResponse{
 		Network: n,
 	}, nil
 }
 
+// validateNetworkSpecUpdate checks that spec only changes the mutable
+// fields of the spec of network n.
+func validateNetworkSpecUpdate(n *api.Network, spec *api.NetworkSpec) error {
+	if !reflect.DeepEqual(n.Spec.DriverConfig, spec.DriverConfig) {
+		return grpc.Errorf(codes.InvalidArgument, "network driver configuration cannot be updated")
+	}
+	if n.Spec.Ipv6Enabled != spec.Ipv6Enabled {
+		return grpc.Errorf(codes.InvalidArgument, "IPv6 can't be enabled or disabled on an existing network")
+	}
+	if n.Spec.Internal != spec.Internal {
+		return grpc.Errorf(codes.InvalidArgument, "internal flag of a network cannot be updated")
+	}
+
+	var (
+		oldDriver, newDriver   *api.Driver
+		oldConfigs, newConfigs []*api.IPAMConfig
+	)
+	if n.Spec.IPAM != nil {
+		oldDriver, oldConfigs = n.Spec.IPAM.Driver, n.Spec.IPAM.Configs
+	}
+	if spec.IPAM != nil {
+		newDriver, newConfigs = spec.IPAM.Driver, spec.IPAM.Configs
+	}
+	if !sameDriver(oldDriver, newDriver) {
+		return grpc.Errorf(codes.InvalidArgument, "IPAM driver cannot be updated")
+	}
+
+	if len(newConfigs) < len(oldConfigs) {
+		return grpc.Errorf(codes.InvalidArgument, "IPAM configs cannot be removed")
+	}
+	for i, ic := range oldConfigs {
+		if !reflect.DeepEqual(ic, newConfigs[i]) {
+			return grpc.Errorf(codes.InvalidArgument, "existing IPAM configs cannot be changed, only new configs can be appended")
+		}
+	}
+
+	// The subnets of the appended configs must not overlap the subnets
+	// of the network, including the ones allocated for it.
+	var subnets []*net.IPNet
+	for _, ic := range oldConfigs {
+		if _, subnet, err := net.ParseCIDR(ic.Subnet); err == nil {
+			subnets = append(subnets, subnet)
+		}
+	}
+	if n.IPAM != nil {
+		for _, ic := range n.IPAM.Configs {
+			if _, subnet, err := net.ParseCIDR(ic.Subnet); err == nil {
+				subnets = append(subnets, subnet)
+			}
+		}
+	}
+	for _, ic := range newConfigs[len(oldConfigs):] {
+		_, subnet, err := net.ParseCIDR(ic.Subnet)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "appended IPAM configs must specify a subnet")
+		}
+		for _, other := range subnets {
+			if subnet.Contains(other.IP) || other.Contains(subnet.IP) {
+				return grpc.Errorf(codes.InvalidArgument, "subnet %s overlaps with subnet %s of the network", subnet, other)
+			}
+		}
+		subnets = append(subnets, subnet)
+	}
+
+	return nil
+}
+
+// sameDriver returns true if a and b configure the same driver with the same
+// options. A nil driver is the same as an empty one.
+func sameDriver(a, b *api.Driver) bool {
+	if a == nil {
+		a = &api.Driver{}
+	}
+	if b == nil {
+		b = &api.Driver{}
+	}
+	return a.Name == b.Name && (len(a.Options) == 0 && len(b.Options) == 0 || reflect.DeepEqual(a.Options, b.Options))
+}
+
 // GetNetwork returns a Network given a NetworkID.
 // - Returns `InvalidArgument` if NetworkID is not provided.
 // - Returns `NotFound` if the Network is not found.
@@ -148,46 +308,53 @@ func (s *Server) GetNetwork(ctx context.Context, request *api.GetNetworkRequest)
 // - Returns `NotFound` if the Network is not found.
 // - Returns an error if the deletion fails.
 func (s *Server) RemoveNetwork(ctx context.Context, request *api.RemoveNetworkRequest) (*api.RemoveNetworkResponse, error) {
-	if request.NetworkID == "" {
-		return nil, grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
-	}
-
 	err := s.store.Update(func(tx store.Tx) error {
-		services, err := store.FindServices(tx, store.ByReferencedNetworkID(request.NetworkID))
-		if err != nil {
-			return grpc.Errorf(codes.Internal, "could not find services using network %s: %v", request.NetworkID, err)
+		if err := removeNetworkInTx(tx, request.NetworkID); err != nil {
+			return err
 		}
+		return audit.Record(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.RemoveNetworkResponse{}, nil
+}
 
-		if len(services) != 0 {
-			return grpc.Errorf(codes.FailedPrecondition, "network %s is in use by service %s", request.NetworkID, services[0].ID)
-		}
+func removeNetworkInTx(tx store.Tx, networkID string) error {
+	if networkID == "" {
+		return grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
+	}
 
-		tasks, err := store.FindTasks(tx, store.ByReferencedNetworkID(request.NetworkID))
-		if err != nil {
-			return grpc.Errorf(codes.Internal, "could not find tasks using network %s: %v", request.NetworkID, err)
-		}
+	services, err := store.FindServices(tx, store.ByReferencedNetworkID(networkID))
+	if err != nil {
+		return grpc.Errorf(codes.Internal, "could not find services using network %s: %v", networkID, err)
+	}
 
-		if len(tasks) != 0 {
-			return grpc.Errorf(codes.FailedPrecondition, "network %s is in use by task %s", request.NetworkID, tasks[0].ID)
-		}
+	if len(services) != 0 {
+		return grpc.Errorf(codes.FailedPrecondition, "network %s is in use by service %s", networkID, services[0].ID)
+	}
 
-		nw := store.GetNetwork(tx, request.NetworkID)
-		if _, ok := nw.Spec.Annotations.Labels["com.docker.swarm.internal"]; ok {
-			networkDescription := nw.ID
-			if nw.Spec.Annotations.Name != "" {
-				networkDescription = fmt.Sprintf("%s (%s)", nw.Spec.Annotations.Name, nw.ID)
-			}
-			return grpc.Errorf(codes.PermissionDenied, "%s is a pre-defined network and cannot be removed", networkDescription)
-		}
-		return store.DeleteNetwork(tx, request.NetworkID)
-	})
+	tasks, err := store.FindTasks(tx, store.ByReferencedNetworkID(networkID))
 	if err != nil {
-		if err == store.ErrNotExist {
-			return nil, grpc.Errorf(codes.NotFound, "network %s not found", request.NetworkID)
+		return grpc.Errorf(codes.Internal, "could not find tasks using network %s: %v", networkID, err)
+	}
+
+	if len(tasks) != 0 {
+		return grpc.Errorf(codes.FailedPrecondition, "network %s is in use by task %s", networkID, tasks[0].ID)
+	}
+
+	nw := store.GetNetwork(tx, networkID)
+	if nw == nil {
+		return grpc.Errorf(codes.NotFound, "network %s not found", networkID)
+	}
+	if _, ok := nw.Spec.Annotations.Labels["com.docker.swarm.internal"]; ok {
+		networkDescription := nw.ID
+		if nw.Spec.Annotations.Name != "" {
+			networkDescription = fmt.Sprintf("%s (%s)", nw.Spec.Annotations.Name, nw.ID)
 		}
-		return nil, err
+		return grpc.Errorf(codes.PermissionDenied, "%s is a pre-defined network and cannot be removed", networkDescription)
 	}
-	return &api.RemoveNetworkResponse{}, nil
+	return store.DeleteNetwork(tx, networkID)
 }
 
 func filterNetworks(candidates []*api.Network, filters ...func(*api.Network) bool) []*api.Network {
@@ -224,6 +391,8 @@ func (s *Server) ListNetworks(ctx context.Context, request *api.ListNetworksRequ
 			networks, err = store.FindNetworks(tx, buildFilters(store.ByNamePrefix, request.Filters.NamePrefixes))
 		case request.Filters != nil && len(request.Filters.IDPrefixes) > 0:
 			networks, err = store.FindNetworks(tx, buildFilters(store.ByIDPrefix, request.Filters.IDPrefixes))
+		case request.Filters != nil && len(request.Filters.Labels) > 0:
+			networks, err = store.FindNetworks(tx, labelFilter(request.Filters.Labels))
 		default:
 			networks, err = store.FindNetworks(tx, store.All)
 		}
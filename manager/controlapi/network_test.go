@@ -11,6 +11,7 @@ import (
 
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/identity"
+	"github.com/docker/swarmkit/manager/allocator/networkallocator"
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/stretchr/testify/assert"
 )
@@ -144,6 +145,21 @@ func TestValidateIPAMConfiguration(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestValidateIPAMDefaultPoolOptions(t *testing.T) {
+	ipam := &api.IPAMOptions{
+		Driver: &api.Driver{
+			Name:    "default",
+			Options: map[string]string{networkallocator.DefaultPoolSubnetSizeOption: "26"},
+		},
+	}
+	assert.NoError(t, validateIPAM(ipam, nil))
+
+	ipam.Driver.Options[networkallocator.DefaultPoolSubnetSizeOption] = "30"
+	err := validateIPAM(ipam, nil)
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+}
+
 func TestCreateNetwork(t *testing.T) {
 	ts := newTestServer(t)
 	defer ts.Stop()
@@ -182,6 +198,98 @@ func TestGetNetwork(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUpdateNetwork(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+	spec := createNetworkSpec("testnet5")
+	spec.IPAM = &api.IPAMOptions{
+		Configs: []*api.IPAMConfig{
+			{Subnet: "10.5.0.0/24"},
+		},
+	}
+	nr, err := ts.Client.CreateNetwork(context.Background(), &api.CreateNetworkRequest{
+		Spec: spec,
+	})
+	assert.NoError(t, err)
+	network := nr.Network
+
+	_, err = ts.Client.UpdateNetwork(context.Background(), &api.UpdateNetworkRequest{NetworkID: network.ID, Spec: &network.Spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	_, err = ts.Client.UpdateNetwork(context.Background(), &api.UpdateNetworkRequest{NetworkID: "invalid", Spec: &network.Spec, NetworkVersion: &network.Meta.Version})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	// Immutable fields
+	for _, update := range []func(*api.NetworkSpec){
+		func(spec *api.NetworkSpec) { spec.DriverConfig = &api.Driver{Name: "overlay"} },
+		func(spec *api.NetworkSpec) { spec.Ipv6Enabled = true },
+		func(spec *api.NetworkSpec) { spec.Internal = true },
+		func(spec *api.NetworkSpec) { spec.IPAM.Driver = &api.Driver{Name: "other"} },
+		func(spec *api.NetworkSpec) { spec.IPAM.Configs = nil },
+		func(spec *api.NetworkSpec) { spec.IPAM.Configs[0].Gateway = "10.5.0.254" },
+		func(spec *api.NetworkSpec) {
+			spec.IPAM.Configs = append(spec.IPAM.Configs, &api.IPAMConfig{})
+		},
+		func(spec *api.NetworkSpec) {
+			spec.IPAM.Configs = append(spec.IPAM.Configs, &api.IPAMConfig{Subnet: "10.5.0.128/25"})
+		},
+	} {
+		spec := network.Spec.Copy()
+		update(spec)
+		_, err = ts.Client.UpdateNetwork(context.Background(), &api.UpdateNetworkRequest{
+			NetworkID:      network.ID,
+			Spec:           spec,
+			NetworkVersion: &network.Meta.Version,
+		})
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	}
+
+	spec = network.Spec.Copy()
+	spec.Annotations.Labels = map[string]string{"foo": "bar"}
+	spec.Attachable = true
+	spec.IPAM.Configs = append(spec.IPAM.Configs, &api.IPAMConfig{Subnet: "10.6.0.0/24"})
+	ur, err := ts.Client.UpdateNetwork(context.Background(), &api.UpdateNetworkRequest{
+		NetworkID:      network.ID,
+		Spec:           spec,
+		NetworkVersion: &network.Meta.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, spec, &ur.Network.Spec)
+
+	// Perform an update with the "old" version.
+	_, err = ts.Client.UpdateNetwork(context.Background(), &api.UpdateNetworkRequest{
+		NetworkID:      network.ID,
+		Spec:           spec,
+		NetworkVersion: &network.Meta.Version,
+	})
+	assert.Error(t, err)
+}
+
+func TestUpdateInternalNetwork(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	spec := createNetworkSpec("testnetint")
+	spec.Annotations.Labels = map[string]string{"com.docker.swarm.internal": "true"}
+	nr, err := ts.Server.createInternalNetwork(context.Background(), &api.CreateNetworkRequest{
+		Spec: spec,
+	})
+	assert.NoError(t, err)
+
+	spec = nr.Network.Spec.Copy()
+	spec.Annotations.Labels = nil
+	_, err = ts.Client.UpdateNetwork(context.Background(), &api.UpdateNetworkRequest{
+		NetworkID:      nr.Network.ID,
+		Spec:           spec,
+		NetworkVersion: &nr.Network.Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+}
+
 func TestRemoveNetwork(t *testing.T) {
 	ts := newTestServer(t)
 	defer ts.Stop()
@@ -3,8 +3,11 @@ package controlapi
 import (
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
 
 	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/audit"
+	"github.com/docker/swarmkit/manager/state/raft"
 	"github.com/docker/swarmkit/manager/state/raft/membership"
 	"github.com/docker/swarmkit/manager/state/store"
 	gogotypes "github.com/gogo/protobuf/types"
@@ -89,6 +92,8 @@ func (s *Server) ListNodes(ctx context.Context, request *api.ListNodesRequest) (
 			nodes, err = store.FindNodes(tx, buildFilters(store.ByNamePrefix, request.Filters.NamePrefixes))
 		case request.Filters != nil && len(request.Filters.IDPrefixes) > 0:
 			nodes, err = store.FindNodes(tx, buildFilters(store.ByIDPrefix, request.Filters.IDPrefixes))
+		case request.Filters != nil && len(request.Filters.NodeLabels) > 0:
+			nodes, err = store.FindNodes(tx, labelFilter(request.Filters.NodeLabels))
 		case request.Filters != nil && len(request.Filters.Roles) > 0:
 			filters := make([]store.By, 0, len(request.Filters.Roles))
 			for _, v := range request.Filters.Roles {
@@ -133,6 +138,8 @@ func (s *Server) ListNodes(ctx context.Context, request *api.ListNodesRequest) (
 				return filterContainsPrefix(e.ID, request.Filters.IDPrefixes)
 			},
 			func(e *api.Node) bool {
+				// Engine labels are reported by the nodes and
+				// aren't indexed, unlike the node labels.
 				if len(request.Filters.Labels) == 0 {
 					return true
 				}
@@ -141,6 +148,9 @@ func (s *Server) ListNodes(ctx context.Context, request *api.ListNodesRequest) (
 				}
 				return filterMatchLabels(e.Description.Engine.Labels, request.Filters.Labels)
 			},
+			func(e *api.Node) bool {
+				return filterMatchLabels(e.Spec.Annotations.Labels, request.Filters.NodeLabels)
+			},
 			func(e *api.Node) bool {
 				if len(request.Filters.Roles) == 0 {
 					return true
@@ -193,6 +203,7 @@ func (s *Server) ListNodes(ctx context.Context, request *api.ListNodesRequest) (
 // UpdateNode updates a Node referenced by NodeID with the given NodeSpec.
 // - Returns `NotFound` if the Node is not found.
 // - Returns `InvalidArgument` if the NodeSpec is malformed.
+// - Returns `FailedPrecondition` if a demotion would leave too few reachable managers for a quorum, unless forced.
 // - Returns an error if the update fails.
 func (s *Server) UpdateNode(ctx context.Context, request *api.UpdateNodeRequest) (*api.UpdateNodeResponse, error) {
 	if request.NodeID == "" || request.NodeVersion == nil {
@@ -230,14 +241,17 @@ func (s *Server) UpdateNode(ctx context.Context, request *api.UpdateNodeRequest)
 			}
 
 			// Quorum safeguard
-			if !s.raft.CanRemoveMember(member.RaftID) {
-				return grpc.Errorf(codes.FailedPrecondition, "can't remove member from the raft: this would result in a loss of quorum")
+			if q := s.raft.QuorumStatusWithout(member.RaftID); q.FaultTolerance() < 0 && !request.Force {
+				return grpc.Errorf(codes.FailedPrecondition, "can't remove member from the raft: this would result in a loss of quorum (%s); wait until enough managers are reachable, or use force to demote anyway, which may make the cluster lose its quorum", formatQuorum(q))
 			}
 		}
 
 		node.Meta.Version = *request.NodeVersion
 		node.Spec = *request.Spec.Copy()
-		return store.UpdateNode(tx, node)
+		if err := store.UpdateNode(tx, node); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
 	if err != nil {
 		return nil, err
@@ -248,9 +262,53 @@ func (s *Server) UpdateNode(ctx context.Context, request *api.UpdateNodeRequest)
 	}, nil
 }
 
+// removeRaftMember removes a manager from the raft cluster before its node
+// is forcibly removed.
+func (s *Server) removeRaftMember(ctx context.Context, nodeID string, member *membership.Member) error {
+	if member.RaftID == s.raft.Config.ID {
+		return grpc.Errorf(codes.FailedPrecondition, "node %s is the leader of the raft cluster. It must be demoted to worker before removal, which transfers the leadership", nodeID)
+	}
+	if q := s.raft.QuorumStatusWithout(member.RaftID); q.FaultTolerance() < 0 {
+		return grpc.Errorf(codes.FailedPrecondition, "can't remove member from the raft: this would result in a loss of quorum (%s)", formatQuorum(q))
+	}
+	if err := s.raft.RemoveMember(ctx, member.RaftID); err != nil {
+		if err == raft.ErrCannotRemoveMember {
+			return grpc.Errorf(codes.FailedPrecondition, "can't remove member from the raft: %v", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkNodeRemoval returns an error if the node can't be removed. Managers
+// which are members of the raft cluster can only be removed when forced.
+func (s *Server) checkNodeRemoval(node *api.Node, request *api.RemoveNodeRequest) error {
+	if node == nil {
+		return grpc.Errorf(codes.NotFound, "node %s not found", request.NodeID)
+	}
+	if node.Spec.DesiredRole == api.NodeRoleManager {
+		if s.raft == nil {
+			return grpc.Errorf(codes.FailedPrecondition, "node %s is a manager but cannot access node information from the raft memberlist", request.NodeID)
+		}
+		if member := s.raft.GetMemberByNodeID(request.NodeID); member != nil && !request.Force {
+			return grpc.Errorf(codes.FailedPrecondition, "node %s is a cluster manager and is a member of the raft cluster. It must be demoted to worker before removal", request.NodeID)
+		}
+	}
+	if !request.Force && node.Status.State == api.NodeStatus_READY {
+		return grpc.Errorf(codes.FailedPrecondition, "node %s is not down and can't be removed", request.NodeID)
+	}
+	return nil
+}
+
+// formatQuorum describes the reachability of the managers in error messages.
+func formatQuorum(q raft.QuorumStatus) string {
+	return fmt.Sprintf("%d of %d remaining managers reachable, %d needed", q.Reachable, q.Members, q.Quorum)
+}
+
 // RemoveNode removes a Node referenced by NodeID with the given NodeSpec.
 // - Returns NotFound if the Node is not found.
-// - Returns FailedPrecondition if the Node has manager role (and is part of the memberlist) or is not shut down.
+// - Returns FailedPrecondition if the Node has manager role (and is part of the memberlist) or is not shut down, unless forced.
+// - Returns FailedPrecondition if a forced removal of a manager would result in a loss of quorum.
 // - Returns InvalidArgument if NodeID or NodeVersion is not valid.
 // - Returns an error if the delete fails.
 func (s *Server) RemoveNode(ctx context.Context, request *api.RemoveNodeRequest) (*api.RemoveNodeResponse, error) {
@@ -258,21 +316,31 @@ func (s *Server) RemoveNode(ctx context.Context, request *api.RemoveNodeRequest)
 		return nil, grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
 	}
 
-	err := s.store.Update(func(tx store.Tx) error {
-		node := store.GetNode(tx, request.NodeID)
-		if node == nil {
-			return grpc.Errorf(codes.NotFound, "node %s not found", request.NodeID)
-		}
-		if node.Spec.DesiredRole == api.NodeRoleManager {
-			if s.raft == nil {
-				return grpc.Errorf(codes.FailedPrecondition, "node %s is a manager but cannot access node information from the raft memberlist", request.NodeID)
-			}
-			if member := s.raft.GetMemberByNodeID(request.NodeID); member != nil {
-				return grpc.Errorf(codes.FailedPrecondition, "node %s is a cluster manager and is a member of the raft cluster. It must be demoted to worker before removal", request.NodeID)
+	// Removing a manager from the raft cluster can't be undone, so check
+	// that the node can be removed before doing so.
+	var err error
+	s.store.View(func(tx store.ReadTx) {
+		err = s.checkNodeRemoval(store.GetNode(tx, request.NodeID), request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Force && s.raft != nil {
+		if member := s.raft.GetMemberByNodeID(request.NodeID); member != nil {
+			if err := s.removeRaftMember(ctx, request.NodeID, member); err != nil {
+				return nil, err
 			}
 		}
-		if !request.Force && node.Status.State == api.NodeStatus_READY {
-			return grpc.Errorf(codes.FailedPrecondition, "node %s is not down and can't be removed", request.NodeID)
+	}
+
+	err = s.store.Update(func(tx store.Tx) error {
+		node := store.GetNode(tx, request.NodeID)
+		if err := s.checkNodeRemoval(node, request); err != nil {
+			return err
+		}
+		if node.Spec.DesiredRole == api.NodeRoleManager && s.raft.GetMemberByNodeID(request.NodeID) != nil {
+			return grpc.Errorf(codes.FailedPrecondition, "node %s is a cluster manager and is a member of the raft cluster. It must be demoted to worker before removal", request.NodeID)
 		}
 
 		// lookup the cluster
@@ -313,7 +381,10 @@ func (s *Server) RemoveNode(ctx context.Context, request *api.RemoveNodeRequest)
 			return err
 		}
 
-		return store.DeleteNode(tx, request.NodeID)
+		if err := store.DeleteNode(tx, request.NodeID); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
 	if err != nil {
 		return nil, err
@@ -141,6 +141,35 @@ func TestListNodes(t *testing.T) {
 	)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(r.Nodes))
+
+	// List by node labels, which are distinct from the engine labels.
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		node := store.GetNode(tx, "id2")
+		node.Spec.Annotations.Labels = map[string]string{"zone": "a"}
+		node.Description = &api.NodeDescription{
+			Engine: &api.EngineDescription{Labels: map[string]string{"zone": "b"}},
+		}
+		return store.UpdateNode(tx, node)
+	}))
+	r, err = ts.Client.ListNodes(context.Background(),
+		&api.ListNodesRequest{
+			Filters: &api.ListNodesRequest_Filters{
+				NodeLabels: map[string]string{"zone": "a"},
+			},
+		},
+	)
+	assert.NoError(t, err)
+	require.Len(t, r.Nodes, 1)
+	assert.Equal(t, "id2", r.Nodes[0].ID)
+	r, err = ts.Client.ListNodes(context.Background(),
+		&api.ListNodesRequest{
+			Filters: &api.ListNodesRequest_Filters{
+				NodeLabels: map[string]string{"zone": "b"},
+			},
+		},
+	)
+	assert.NoError(t, err)
+	assert.Empty(t, r.Nodes)
 }
 
 func TestRemoveNodes(t *testing.T) {
@@ -545,6 +574,16 @@ func testUpdateNodeDemote(t *testing.T) {
 	ts.Server.raft = nodes[1].Node
 	ts.Server.store = nodes[1].MemoryStore()
 
+	// A forced removal of a manager without a node object fails before the
+	// manager is removed from the raft cluster
+	_, err := ts.Client.RemoveNode(context.Background(), &api.RemoveNodeRequest{
+		NodeID: nodes[2].SecurityConfig.ClientTLSCreds.NodeID(),
+		Force:  true,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+	assert.NotNil(t, ts.Server.raft.GetMemberByNodeID(nodes[2].SecurityConfig.ClientTLSCreds.NodeID()))
+
 	// Create a node object for each of the managers
 	assert.NoError(t, nodes[1].MemoryStore().Update(func(tx store.Tx) error {
 		assert.NoError(t, store.CreateNode(tx, &api.Node{
@@ -603,6 +642,48 @@ func testUpdateNodeDemote(t *testing.T) {
 	})
 	assert.Error(t, err)
 	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+	assert.Contains(t, grpc.ErrorDesc(err), "loss of quorum (1 of 2 remaining managers reachable, 2 needed)")
+	assert.Contains(t, grpc.ErrorDesc(err), "use force to demote anyway")
+
+	// The cluster can't tolerate any more failures
+	q, err := ts.Client.GetClusterQuorum(context.Background(), &api.GetClusterQuorumRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, &api.GetClusterQuorumResponse{
+		Managers:           3,
+		Reachable:          2,
+		Quorum:             2,
+		FaultTolerance:     0,
+		UnreachableNodeIDs: []string{nodes[3].SecurityConfig.ClientTLSCreds.NodeID()},
+	}, q)
+
+	// Forcing the removal of Node 2 doesn't bypass the quorum safeguard
+	_, err = ts.Client.RemoveNode(context.Background(), &api.RemoveNodeRequest{
+		NodeID: nodes[2].SecurityConfig.ClientTLSCreds.NodeID(),
+		Force:  true,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+	assert.Contains(t, grpc.ErrorDesc(err), "loss of quorum")
+	assert.NotNil(t, ts.Server.raft.GetMemberByNodeID(nodes[2].SecurityConfig.ClientTLSCreds.NodeID()))
+
+	// The demotion skips the quorum safeguard when forced, then is
+	// reverted
+	u, err := ts.Client.UpdateNode(context.Background(), &api.UpdateNodeRequest{
+		NodeID:      nodes[2].SecurityConfig.ClientTLSCreds.NodeID(),
+		Spec:        spec,
+		NodeVersion: version,
+		Force:       true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, api.NodeRoleWorker, u.Node.Spec.DesiredRole)
+	spec = u.Node.Spec.Copy()
+	spec.DesiredRole = api.NodeRoleManager
+	_, err = ts.Client.UpdateNode(context.Background(), &api.UpdateNodeRequest{
+		NodeID:      nodes[2].SecurityConfig.ClientTLSCreds.NodeID(),
+		Spec:        spec,
+		NodeVersion: &u.Node.Meta.Version,
+	})
+	assert.NoError(t, err)
 
 	// Restart Node 3
 	nodes[3] = raftutils.RestartNode(t, clockSource, nodes[3], false)
@@ -0,0 +1,108 @@
+package controlapi
+
+import (
+	"sort"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/allocator/networkallocator"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+)
+
+// ListPublishedPorts returns a `ListPublishedPortsResponse` with the ports
+// currently published by services, grouped by the port range of the
+// cluster's `PortAllocationConfig` they fall in. Ports in a reserved range
+// are listed under that range, even if it overlaps the dynamic range.
+// - Returns an error if listing fails.
+func (s *Server) ListPublishedPorts(ctx context.Context, request *api.ListPublishedPortsRequest) (*api.ListPublishedPortsResponse, error) {
+	var (
+		services []*api.Service
+		clusters []*api.Cluster
+		err      error
+	)
+
+	s.store.View(func(tx store.ReadTx) {
+		services, err = store.FindServices(tx, store.All)
+		if err != nil {
+			return
+		}
+		clusters, err = store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var config *api.PortAllocationConfig
+	if len(clusters) == 1 {
+		config = &clusters[0].Spec.PortAllocation
+	}
+
+	dynamicRange := networkallocator.DynamicPortRange(config)
+	dynamic := &api.ListPublishedPortsResponse_RangeUsage{
+		Kind:  api.PortRangeDynamic,
+		Range: &dynamicRange,
+	}
+	var reserved []*api.ListPublishedPortsResponse_RangeUsage
+	if config != nil {
+		for i := range config.Reserved {
+			reserved = append(reserved, &api.ListPublishedPortsResponse_RangeUsage{
+				Kind:  api.PortRangeReserved,
+				Range: &config.Reserved[i],
+			})
+		}
+	}
+	static := &api.ListPublishedPortsResponse_RangeUsage{Kind: api.PortRangeStatic}
+
+	rangeUsage := func(port uint32) *api.ListPublishedPortsResponse_RangeUsage {
+		for _, usage := range reserved {
+			if port >= usage.Range.Start && port <= usage.Range.End {
+				return usage
+			}
+		}
+		if port >= dynamicRange.Start && port <= dynamicRange.End {
+			return dynamic
+		}
+		return static
+	}
+
+	for _, service := range services {
+		if service.Endpoint == nil {
+			continue
+		}
+		for _, pc := range service.Endpoint.Ports {
+			if pc.PublishedPort == 0 {
+				continue
+			}
+			usage := rangeUsage(pc.PublishedPort)
+			usage.Ports = append(usage.Ports, &api.ListPublishedPortsResponse_PublishedPort{
+				Port:        pc.PublishedPort,
+				Protocol:    pc.Protocol,
+				PublishMode: pc.PublishMode,
+				ServiceID:   service.ID,
+				TargetPort:  pc.TargetPort,
+			})
+		}
+	}
+
+	ranges := append([]*api.ListPublishedPortsResponse_RangeUsage{dynamic}, reserved...)
+	ranges = append(ranges, static)
+	for _, usage := range ranges {
+		sort.Sort(publishedPortsByPort(usage.Ports))
+	}
+
+	return &api.ListPublishedPortsResponse{Ranges: ranges}, nil
+}
+
+type publishedPortsByPort []*api.ListPublishedPortsResponse_PublishedPort
+
+func (p publishedPortsByPort) Len() int      { return len(p) }
+func (p publishedPortsByPort) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p publishedPortsByPort) Less(i, j int) bool {
+	if p[i].Port != p[j].Port {
+		return p[i].Port < p[j].Port
+	}
+	if p[i].Protocol != p[j].Protocol {
+		return p[i].Protocol < p[j].Protocol
+	}
+	return p[i].ServiceID < p[j].ServiceID
+}
@@ -0,0 +1,129 @@
+package controlapi
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func createServiceWithPorts(t *testing.T, ts *testServer, id string, ports ...*api.PortConfig) {
+	require.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateService(tx, &api.Service{
+			ID:       id,
+			Spec:     api.ServiceSpec{Annotations: api.Annotations{Name: id}},
+			Endpoint: &api.Endpoint{Ports: ports},
+		})
+	}))
+}
+
+func TestListPublishedPorts(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	cluster := createClusterObj("id", store.DefaultClusterName, api.AcceptancePolicy{}, ts.Server.rootCA)
+	cluster.Spec.PortAllocation = api.PortAllocationConfig{
+		DynamicRange: &api.PortRange{Start: 40000, End: 40999},
+		Reserved:     []api.PortRange{{Start: 40500, End: 40599}},
+	}
+	require.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateCluster(tx, cluster)
+	}))
+
+	createServiceWithPorts(t, ts, "web",
+		&api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 80, PublishedPort: 40001},
+		&api.PortConfig{Protocol: api.ProtocolTCP, TargetPort: 443, PublishedPort: 443},
+	)
+	createServiceWithPorts(t, ts, "dns",
+		&api.PortConfig{Protocol: api.ProtocolUDP, TargetPort: 53, PublishedPort: 40000},
+		&api.PortConfig{Protocol: api.ProtocolUDP, TargetPort: 53, PublishedPort: 40500, PublishMode: api.PublishModeHost},
+	)
+
+	resp, err := ts.Client.ListPublishedPorts(context.Background(), &api.ListPublishedPortsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Ranges, 3)
+
+	ports := func(usage *api.ListPublishedPortsResponse_RangeUsage) []uint32 {
+		var ports []uint32
+		for _, p := range usage.Ports {
+			ports = append(ports, p.Port)
+		}
+		return ports
+	}
+
+	assert.Equal(t, api.PortRangeDynamic, resp.Ranges[0].Kind)
+	assert.Equal(t, api.PortRange{Start: 40000, End: 40999}, *resp.Ranges[0].Range)
+	assert.Equal(t, []uint32{40000, 40001}, ports(resp.Ranges[0]))
+	assert.Equal(t, "dns", resp.Ranges[0].Ports[0].ServiceID)
+
+	assert.Equal(t, api.PortRangeReserved, resp.Ranges[1].Kind)
+	assert.Equal(t, []uint32{40500}, ports(resp.Ranges[1]))
+	assert.Equal(t, api.PublishModeHost, resp.Ranges[1].Ports[0].PublishMode)
+
+	assert.Equal(t, api.PortRangeStatic, resp.Ranges[2].Kind)
+	assert.Nil(t, resp.Ranges[2].Range)
+	assert.Equal(t, []uint32{443}, ports(resp.Ranges[2]))
+}
+
+func TestReservedPorts(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	cluster := createClusterObj("id", store.DefaultClusterName, api.AcceptancePolicy{}, ts.Server.rootCA)
+	cluster.Spec.PortAllocation.Reserved = []api.PortRange{{Start: 8000, End: 8080}}
+	require.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateCluster(tx, cluster)
+	}))
+
+	// publishing a reserved port fails
+	spec := createSpec("reserved", "image", 1)
+	spec.Endpoint = &api.EndpointSpec{Ports: []*api.PortConfig{{TargetPort: 80, PublishedPort: 8000}}}
+	_, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	spec.Endpoint.Ports[0].PublishedPort = 8081
+	r, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	require.NoError(t, err)
+	service := r.Service
+
+	// ports published before they are reserved can still be published
+	service.Endpoint = &api.Endpoint{Ports: []*api.PortConfig{{TargetPort: 80, PublishedPort: 8081}}}
+	require.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, "id")
+		cluster.Spec.PortAllocation.Reserved = []api.PortRange{{Start: 8000, End: 8090}}
+		if err := store.UpdateCluster(tx, cluster); err != nil {
+			return err
+		}
+		return store.UpdateService(tx, service)
+	}))
+	service = getServiceByID(t, ts, service.ID)
+
+	spec.Endpoint.Ports = append(spec.Endpoint.Ports, &api.PortConfig{TargetPort: 81, PublishedPort: 9000})
+	r2, err := ts.Client.UpdateService(context.Background(), &api.UpdateServiceRequest{
+		ServiceID:      service.ID,
+		Spec:           spec,
+		ServiceVersion: &service.Meta.Version,
+	})
+	require.NoError(t, err)
+
+	spec.Endpoint.Ports = append(spec.Endpoint.Ports, &api.PortConfig{TargetPort: 82, PublishedPort: 8082})
+	_, err = ts.Client.UpdateService(context.Background(), &api.UpdateServiceRequest{
+		ServiceID:      service.ID,
+		Spec:           spec,
+		ServiceVersion: &r2.Service.Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+}
+
+func getServiceByID(t *testing.T, ts *testServer, id string) *api.Service {
+	r, err := ts.Client.GetService(context.Background(), &api.GetServiceRequest{ServiceID: id})
+	require.NoError(t, err)
+	return r.Service
+}
@@ -0,0 +1,34 @@
+package controlapi
+
+import (
+	"github.com/docker/swarmkit/api"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// GetClusterQuorum returns a `GetClusterQuorumResponse` with the number of
+// managers the leader can currently reach, and how many more of them can fail
+// before the cluster loses its quorum.
+// - Returns `FailedPrecondition` if the raft cluster is not available.
+func (s *Server) GetClusterQuorum(ctx context.Context, request *api.GetClusterQuorumRequest) (*api.GetClusterQuorumResponse, error) {
+	if s.raft == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "cannot access the raft memberlist")
+	}
+
+	q := s.raft.QuorumStatus()
+	members := s.raft.GetMemberlist()
+
+	resp := &api.GetClusterQuorumResponse{
+		Managers:       uint32(q.Members),
+		Reachable:      uint32(q.Reachable),
+		Quorum:         uint32(q.Quorum),
+		FaultTolerance: int32(q.FaultTolerance()),
+	}
+	for _, id := range q.Unreachable {
+		if member, ok := members[id]; ok {
+			resp.UnreachableNodeIDs = append(resp.UnreachableNodeIDs, member.NodeID)
+		}
+	}
+	return resp, nil
+}
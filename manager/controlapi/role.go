@@ -0,0 +1,299 @@
+package controlapi
+
+import (
+	"github.com/Sirupsen/logrus"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/identity"
+	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/audit"
+	"github.com/docker/swarmkit/manager/rbac"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func validateRoleSpec(spec *api.RoleSpec) error {
+	if spec == nil {
+		return grpc.Errorf(codes.InvalidArgument, "%v", errInvalidArgument)
+	}
+	if err := validateAnnotations(spec.Annotations); err != nil {
+		return err
+	}
+
+	for _, rule := range spec.Rules {
+		if len(rule.Methods) == 0 {
+			return grpc.Errorf(codes.InvalidArgument, "role rules must list at least one method")
+		}
+		for _, method := range rule.Methods {
+			if !rbac.ValidMethodPattern(method) {
+				return grpc.Errorf(codes.InvalidArgument, "unknown Control API method %q", method)
+			}
+		}
+	}
+
+	for _, subject := range spec.Subjects {
+		if subject == "" {
+			return grpc.Errorf(codes.InvalidArgument, "role subjects must not be empty")
+		}
+	}
+	return nil
+}
+
+// GetRole returns a `GetRoleResponse` with a `Role` with the same
+// id as `GetRoleRequest.RoleID`
+// - Returns `NotFound` if the Role with the given id is not found.
+// - Returns `InvalidArgument` if the `GetRoleRequest.RoleID` is empty.
+// - Returns an error if getting fails.
+func (s *Server) GetRole(ctx context.Context, request *api.GetRoleRequest) (*api.GetRoleResponse, error) {
+	if request.RoleID == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "role ID must be provided")
+	}
+
+	var role *api.Role
+	s.store.View(func(tx store.ReadTx) {
+		role = store.GetRole(tx, request.RoleID)
+	})
+
+	if role == nil {
+		return nil, grpc.Errorf(codes.NotFound, "role %s not found", request.RoleID)
+	}
+
+	return &api.GetRoleResponse{Role: role}, nil
+}
+
+// UpdateRole updates the rules and subjects of the Role referenced by
+// `UpdateRoleRequest.RoleID`.
+// - Returns `NotFound` if the Role with the given id is not found.
+// - Returns `InvalidArgument` if the request is malformed or tries to
+//   rename the role.
+// - Returns an error if updating fails.
+func (s *Server) UpdateRole(ctx context.Context, request *api.UpdateRoleRequest) (*api.UpdateRoleResponse, error) {
+	if request.RoleID == "" || request.RoleVersion == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", errInvalidArgument)
+	}
+	if err := validateRoleSpec(request.Spec); err != nil {
+		return nil, err
+	}
+
+	var role *api.Role
+	err := s.store.Update(func(tx store.Tx) error {
+		role = store.GetRole(tx, request.RoleID)
+		if role == nil {
+			return grpc.Errorf(codes.NotFound, "role %s not found", request.RoleID)
+		}
+
+		if role.Spec.Annotations.Name != request.Spec.Annotations.Name {
+			return grpc.Errorf(codes.InvalidArgument, "renaming roles is not supported")
+		}
+
+		role.Meta.Version = *request.RoleVersion
+		role.Spec = *request.Spec.Copy()
+
+		if err := store.UpdateRole(tx, role); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.G(ctx).WithFields(logrus.Fields{
+		"role.ID":   request.RoleID,
+		"role.Name": request.Spec.Annotations.Name,
+		"method":    "UpdateRole",
+	}).Debugf("role updated")
+
+	return &api.UpdateRoleResponse{
+		Role: role,
+	}, nil
+}
+
+// ListRoles returns a `ListRolesResponse` with a list of all `Role`s, or
+// all roles matching the filters in `ListRolesRequest`.
+// - Returns an error if listing fails.
+func (s *Server) ListRoles(ctx context.Context, request *api.ListRolesRequest) (*api.ListRolesResponse, error) {
+	var (
+		roles []*api.Role
+		err   error
+	)
+
+	s.store.View(func(tx store.ReadTx) {
+		switch {
+		case request.Filters != nil && len(request.Filters.Names) > 0:
+			roles, err = store.FindRoles(tx, buildFilters(store.ByName, request.Filters.Names))
+		case request.Filters != nil && len(request.Filters.NamePrefixes) > 0:
+			roles, err = store.FindRoles(tx, buildFilters(store.ByNamePrefix, request.Filters.NamePrefixes))
+		case request.Filters != nil && len(request.Filters.IDPrefixes) > 0:
+			roles, err = store.FindRoles(tx, buildFilters(store.ByIDPrefix, request.Filters.IDPrefixes))
+		case request.Filters != nil && len(request.Filters.Subjects) > 0:
+			roles, err = store.FindRoles(tx, buildFilters(store.BySubject, request.Filters.Subjects))
+		case request.Filters != nil && len(request.Filters.Labels) > 0:
+			roles, err = store.FindRoles(tx, labelFilter(request.Filters.Labels))
+		default:
+			roles, err = store.FindRoles(tx, store.All)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Filters != nil {
+		roles = filterRoles(roles,
+			func(e *api.Role) bool {
+				return filterContains(e.Spec.Annotations.Name, request.Filters.Names)
+			},
+			func(e *api.Role) bool {
+				return filterContainsPrefix(e.Spec.Annotations.Name, request.Filters.NamePrefixes)
+			},
+			func(e *api.Role) bool {
+				return filterContainsPrefix(e.ID, request.Filters.IDPrefixes)
+			},
+			func(e *api.Role) bool {
+				return filterMatchLabels(e.Spec.Annotations.Labels, request.Filters.Labels)
+			},
+			func(e *api.Role) bool {
+				if len(request.Filters.Subjects) == 0 {
+					return true
+				}
+				for _, subject := range e.Spec.Subjects {
+					if filterContains(subject, request.Filters.Subjects) {
+						return true
+					}
+				}
+				return false
+			},
+		)
+	}
+
+	return &api.ListRolesResponse{Roles: roles}, nil
+}
+
+func filterRoles(candidates []*api.Role, filters ...func(*api.Role) bool) []*api.Role {
+	result := []*api.Role{}
+
+	for _, c := range candidates {
+		match := true
+		for _, f := range filters {
+			if !f(c) {
+				match = false
+				break
+			}
+		}
+		if match {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// CreateRole creates and returns a `CreateRoleResponse` with a `Role`
+// based on the provided `CreateRoleRequest.Spec`.
+// - Returns `InvalidArgument` if the `CreateRoleRequest.Spec` is malformed.
+// - Returns `AlreadyExists` if a role with the same name exists.
+// - Returns an error if the creation fails.
+func (s *Server) CreateRole(ctx context.Context, request *api.CreateRoleRequest) (*api.CreateRoleResponse, error) {
+	if err := validateRoleSpec(request.Spec); err != nil {
+		return nil, err
+	}
+
+	role := &api.Role{
+		ID:   identity.NewID(),
+		Spec: *request.Spec,
+	}
+	err := s.store.Update(func(tx store.Tx) error {
+		if err := store.CreateRole(tx, role); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx, role.ID)
+	})
+
+	switch err {
+	case store.ErrNameConflict:
+		return nil, grpc.Errorf(codes.AlreadyExists, "role %s already exists", request.Spec.Annotations.Name)
+	case nil:
+		log.G(ctx).WithFields(logrus.Fields{
+			"role.Name": request.Spec.Annotations.Name,
+			"method":    "CreateRole",
+		}).Debugf("role created")
+
+		return &api.CreateRoleResponse{Role: role}, nil
+	default:
+		return nil, err
+	}
+}
+
+// RemoveRole removes the role referenced by `RemoveRoleRequest.RoleID`.
+// - Returns `InvalidArgument` if `RemoveRoleRequest.RoleID` is empty.
+// - Returns `NotFound` if the role is not found.
+// - Returns an error if the deletion fails.
+func (s *Server) RemoveRole(ctx context.Context, request *api.RemoveRoleRequest) (*api.RemoveRoleResponse, error) {
+	if request.RoleID == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "role ID must be provided")
+	}
+
+	err := s.store.Update(func(tx store.Tx) error {
+		if err := store.DeleteRole(tx, request.RoleID); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
+	})
+	switch err {
+	case store.ErrNotExist:
+		return nil, grpc.Errorf(codes.NotFound, "role %s not found", request.RoleID)
+	case nil:
+		log.G(ctx).WithFields(logrus.Fields{
+			"role.ID": request.RoleID,
+			"method":  "RemoveRole",
+		}).Debugf("role removed")
+
+		return &api.RemoveRoleResponse{}, nil
+	default:
+		return nil, err
+	}
+}
+
+// IssueUserCertificate signs the certificate signing request in
+// `IssueUserCertificateRequest.CSR` with the cluster's root CA, issuing a
+// client certificate with the swarm-user role for the given user.
+// - Returns `InvalidArgument` if the name or the CSR is malformed.
+// - Returns `FailedPrecondition` if this manager can't sign certificates.
+// - Returns an error if signing fails.
+func (s *Server) IssueUserCertificate(ctx context.Context, request *api.IssueUserCertificateRequest) (*api.IssueUserCertificateResponse, error) {
+	if !isValidName.MatchString(request.Name) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "invalid user name %q", request.Name)
+	}
+	if len(request.CSR) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "a certificate signing request must be provided")
+	}
+	if s.rootCA == nil || !s.rootCA.CanSign() {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "this manager can't sign certificates")
+	}
+
+	var (
+		clusters []*api.Cluster
+		err      error
+	)
+	s.store.View(func(tx store.ReadTx) {
+		clusters, err = store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) != 1 {
+		return nil, grpc.Errorf(codes.Internal, "cluster object not found")
+	}
+
+	cert, err := s.rootCA.IssueUserCertificate(request.CSR, request.Name, clusters[0].ID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "could not sign the certificate: %v", err)
+	}
+
+	log.G(ctx).WithField("user", request.Name).Info("user certificate issued")
+	return &api.IssueUserCertificateResponse{
+		Certificate:       cert,
+		RootCACertificate: s.rootCA.Cert,
+	}, nil
+}
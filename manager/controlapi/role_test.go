@@ -0,0 +1,232 @@
+package controlapi
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func createRoleSpec(name string, methods []string, subjects ...string) *api.RoleSpec {
+	return &api.RoleSpec{
+		Annotations: api.Annotations{Name: name},
+		Rules:       []api.RoleRule{{Methods: methods}},
+		Subjects:    subjects,
+	}
+}
+
+func TestValidateRoleSpec(t *testing.T) {
+	for _, badSpec := range []*api.RoleSpec{
+		nil,
+		createRoleSpec("", []string{"ListServices"}),
+		createRoleSpec("name", nil),
+		createRoleSpec("name", []string{"NoSuchMethod"}),
+		createRoleSpec("name", []string{"NoSuch*"}),
+		createRoleSpec("name", []string{"ListServices"}, ""),
+	} {
+		err := validateRoleSpec(badSpec)
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+	}
+
+	for _, goodSpec := range []*api.RoleSpec{
+		createRoleSpec("name", []string{"ListServices"}),
+		createRoleSpec("name", []string{"List*", "GetService"}, "alice"),
+		createRoleSpec("name", []string{"*"}, "alice", "bob"),
+	} {
+		assert.NoError(t, validateRoleSpec(goodSpec))
+	}
+}
+
+func TestCreateRole(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	_, err := ts.Client.CreateRole(context.Background(), &api.CreateRoleRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	spec := createRoleSpec("viewer", []string{"List*"}, "alice")
+	resp, err := ts.Client.CreateRole(context.Background(), &api.CreateRoleRequest{Spec: spec})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Role)
+	assert.Equal(t, *spec, resp.Role.Spec)
+
+	var stored *api.Role
+	ts.Store.View(func(tx store.ReadTx) {
+		stored = store.GetRole(tx, resp.Role.ID)
+	})
+	assert.NotNil(t, stored)
+
+	_, err = ts.Client.CreateRole(context.Background(), &api.CreateRoleRequest{Spec: spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.AlreadyExists, grpc.Code(err), grpc.ErrorDesc(err))
+}
+
+func TestGetRole(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	_, err := ts.Client.GetRole(context.Background(), &api.GetRoleRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	_, err = ts.Client.GetRole(context.Background(), &api.GetRoleRequest{RoleID: "invalid"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, grpc.Code(err), grpc.ErrorDesc(err))
+
+	created, err := ts.Client.CreateRole(context.Background(), &api.CreateRoleRequest{Spec: createRoleSpec("viewer", []string{"List*"})})
+	require.NoError(t, err)
+
+	resp, err := ts.Client.GetRole(context.Background(), &api.GetRoleRequest{RoleID: created.Role.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, created.Role, resp.Role)
+}
+
+func TestUpdateRole(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	created, err := ts.Client.CreateRole(context.Background(), &api.CreateRoleRequest{Spec: createRoleSpec("viewer", []string{"List*"})})
+	require.NoError(t, err)
+	role := created.Role
+
+	_, err = ts.Client.UpdateRole(context.Background(), &api.UpdateRoleRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	_, err = ts.Client.UpdateRole(context.Background(), &api.UpdateRoleRequest{
+		RoleID:      "invalid",
+		Spec:        &role.Spec,
+		RoleVersion: &role.Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, grpc.Code(err), grpc.ErrorDesc(err))
+
+	// renaming is not allowed
+	renamed := role.Spec.Copy()
+	renamed.Annotations.Name = "other"
+	_, err = ts.Client.UpdateRole(context.Background(), &api.UpdateRoleRequest{
+		RoleID:      role.ID,
+		Spec:        renamed,
+		RoleVersion: &role.Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	// binding a user and adding a rule succeeds
+	spec := role.Spec.Copy()
+	spec.Subjects = []string{"alice"}
+	spec.Rules = append(spec.Rules, api.RoleRule{
+		Methods: []string{"UpdateService"},
+		Labels:  map[string]string{"team": "payments"},
+	})
+	resp, err := ts.Client.UpdateRole(context.Background(), &api.UpdateRoleRequest{
+		RoleID:      role.ID,
+		Spec:        spec,
+		RoleVersion: &role.Meta.Version,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, *spec, resp.Role.Spec)
+
+	// an outdated version is rejected
+	_, err = ts.Client.UpdateRole(context.Background(), &api.UpdateRoleRequest{
+		RoleID:      role.ID,
+		Spec:        spec,
+		RoleVersion: &role.Meta.Version,
+	})
+	assert.Error(t, err)
+}
+
+func TestRemoveRole(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	_, err := ts.Client.RemoveRole(context.Background(), &api.RemoveRoleRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	_, err = ts.Client.RemoveRole(context.Background(), &api.RemoveRoleRequest{RoleID: "invalid"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, grpc.Code(err), grpc.ErrorDesc(err))
+
+	created, err := ts.Client.CreateRole(context.Background(), &api.CreateRoleRequest{Spec: createRoleSpec("viewer", []string{"List*"})})
+	require.NoError(t, err)
+
+	_, err = ts.Client.RemoveRole(context.Background(), &api.RemoveRoleRequest{RoleID: created.Role.ID})
+	assert.NoError(t, err)
+
+	_, err = ts.Client.GetRole(context.Background(), &api.GetRoleRequest{RoleID: created.Role.ID})
+	assert.Equal(t, codes.NotFound, grpc.Code(err), grpc.ErrorDesc(err))
+}
+
+func TestListRoles(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	for _, spec := range []*api.RoleSpec{
+		createRoleSpec("viewer", []string{"List*"}, "alice", "bob"),
+		createRoleSpec("payments", []string{"UpdateService"}, "alice"),
+		createRoleSpec("admin", []string{"*"}, "carol"),
+	} {
+		_, err := ts.Client.CreateRole(context.Background(), &api.CreateRoleRequest{Spec: spec})
+		require.NoError(t, err)
+	}
+
+	resp, err := ts.Client.ListRoles(context.Background(), &api.ListRolesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Roles, 3)
+
+	resp, err = ts.Client.ListRoles(context.Background(), &api.ListRolesRequest{
+		Filters: &api.ListRolesRequest_Filters{Names: []string{"admin"}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Roles, 1)
+
+	resp, err = ts.Client.ListRoles(context.Background(), &api.ListRolesRequest{
+		Filters: &api.ListRolesRequest_Filters{Subjects: []string{"alice"}},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Roles, 2)
+
+	resp, err = ts.Client.ListRoles(context.Background(), &api.ListRolesRequest{
+		Filters: &api.ListRolesRequest_Filters{Subjects: []string{"alice"}, NamePrefixes: []string{"pay"}},
+	})
+	assert.NoError(t, err)
+	require.Len(t, resp.Roles, 1)
+	assert.Equal(t, "payments", resp.Roles[0].Spec.Annotations.Name)
+}
+
+func TestIssueUserCertificate(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	cluster := createCluster(t, ts, "id", store.DefaultClusterName, api.AcceptancePolicy{}, ts.Server.rootCA)
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	_, err = ts.Client.IssueUserCertificate(context.Background(), &api.IssueUserCertificateRequest{Name: "not valid", CSR: csr})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	_, err = ts.Client.IssueUserCertificate(context.Background(), &api.IssueUserCertificateRequest{Name: "alice"})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	resp, err := ts.Client.IssueUserCertificate(context.Background(), &api.IssueUserCertificateRequest{Name: "alice", CSR: csr})
+	require.NoError(t, err)
+	assert.Equal(t, ts.Server.rootCA.Cert, resp.RootCACertificate)
+
+	block, _ := pem.Decode(resp.Certificate)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", cert.Subject.CommonName)
+	assert.Equal(t, []string{ca.UserRole}, cert.Subject.OrganizationalUnit)
+	assert.Equal(t, []string{cluster.ID}, cert.Subject.Organization)
+}
@@ -2,13 +2,17 @@ package controlapi
 
 import (
 	"crypto/subtle"
+	"reflect"
 	"regexp"
 	"strings"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/docker/docker/pkg/plugingetter"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/identity"
 	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/audit"
+	"github.com/docker/swarmkit/manager/drivers"
 	"github.com/docker/swarmkit/manager/state/store"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -25,8 +29,10 @@ var validSecretNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+(?:[a-zA-Z0-9-_.]*[
 // assumes spec is not nil
 func secretFromSecretSpec(spec *api.SecretSpec) *api.Secret {
 	return &api.Secret{
-		ID:   identity.NewID(),
-		Spec: *spec,
+		ID:            identity.NewID(),
+		Spec:          *spec,
+		Version:       1,
+		LatestVersion: 1,
 	}
 }
 
@@ -54,42 +60,72 @@ func (s *Server) GetSecret(ctx context.Context, request *api.GetSecretRequest) (
 }
 
 // UpdateSecret updates a Secret referenced by SecretID with the given SecretSpec.
+// If the SecretSpec contains data that differs from the latest version of the
+// secret, the data is stored as a new version of the secret.
 // - Returns `NotFound` if the Secret is not found.
-// - Returns `InvalidArgument` if the SecretSpec is malformed or anything other than Labels is changed
+// - Returns `InvalidArgument` if the SecretSpec is malformed or anything other than Labels and Data is changed
 // - Returns an error if the update fails.
 func (s *Server) UpdateSecret(ctx context.Context, request *api.UpdateSecretRequest) (*api.UpdateSecretResponse, error) {
 	if request.SecretID == "" || request.SecretVersion == nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
 	}
 
-	var secret *api.Secret
+	var (
+		secret  *api.Secret
+		rotated bool
+	)
 	err := s.store.Update(func(tx store.Tx) error {
 		secret = store.GetSecret(tx, request.SecretID)
 		if secret == nil {
 			return grpc.Errorf(codes.NotFound, "secret %s not found", request.SecretID)
 		}
+		if secret.VersionOf != "" {
+			return grpc.Errorf(codes.InvalidArgument, "secret %s is a version of secret %s and can't be updated", request.SecretID, secret.VersionOf)
+		}
 
-		// Check if the Name is different than the current name, or the secret is non-nil and different
-		// than the current secret
-		if secret.Spec.Annotations.Name != request.Spec.Annotations.Name ||
-			(request.Spec.Data != nil && subtle.ConstantTimeCompare(request.Spec.Data, secret.Spec.Data) == 0) {
-			return grpc.Errorf(codes.InvalidArgument, "only updates to Labels are allowed")
+		// Check if the Name or Driver is different than the current one
+		if request.Spec == nil || secret.Spec.Annotations.Name != request.Spec.Annotations.Name ||
+			!reflect.DeepEqual(secret.Spec.Driver, request.Spec.Driver) {
+			return grpc.Errorf(codes.InvalidArgument, "only updates to Labels and Data are allowed")
+		}
+
+		// If new data is provided and differs from the latest version,
+		// rotate the secret. The data of secrets provided by a driver is
+		// managed by the driver.
+		if request.Spec.Data != nil && secret.Spec.Driver != nil {
+			return grpc.Errorf(codes.InvalidArgument, "secret data must be empty when a driver is specified")
+		}
+		if request.Spec.Data != nil {
+			latest := store.GetSecretVersion(tx, secret.ID, secret.LatestVersion)
+			if latest == nil || subtle.ConstantTimeCompare(request.Spec.Data, latest.Spec.Data) == 0 {
+				if err := validateSecretData(request.Spec.Data); err != nil {
+					return err
+				}
+				rotated = true
+			}
 		}
 
-		// We only allow updating Labels
 		secret.Meta.Version = *request.SecretVersion
 		secret.Spec.Annotations.Labels = request.Spec.Annotations.Labels
-
-		return store.UpdateSecret(tx, secret)
+		if rotated {
+			if err := rotateSecret(tx, secret, request.Spec.Data); err != nil {
+				return err
+			}
+		} else if err := store.UpdateSecret(tx, secret); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	log.G(ctx).WithFields(logrus.Fields{
-		"secret.ID":   request.SecretID,
-		"secret.Name": request.Spec.Annotations.Name,
-		"method":      "UpdateSecret",
+		"secret.ID":      request.SecretID,
+		"secret.Name":    request.Spec.Annotations.Name,
+		"secret.Version": secret.LatestVersion,
+		"rotated":        rotated,
+		"method":         "UpdateSecret",
 	}).Debugf("secret updated")
 
 	// WARN: we should never return the actual secret data here. We need to redact the private fields first.
@@ -99,6 +135,91 @@ func (s *Server) UpdateSecret(ctx context.Context, request *api.UpdateSecretRequ
 	}, nil
 }
 
+// maxSecretVersions is the number of past versions of a secret kept in the
+// store, in addition to versions pinned by services.
+const maxSecretVersions = 10
+
+// rotateSecret stores data as a new version of secret, updates secret to
+// point to it, and removes versions that are no longer needed.
+func rotateSecret(tx store.Tx, secret *api.Secret, data []byte) error {
+	latest := secret.LatestVersion
+	if latest == 0 {
+		latest = 1
+	}
+	secret.LatestVersion = latest + 1
+	if err := store.UpdateSecret(tx, secret); err != nil {
+		return err
+	}
+
+	version := &api.Secret{
+		ID: identity.NewID(),
+		Spec: api.SecretSpec{
+			Annotations: *secret.Spec.Annotations.Copy(),
+			Data:        data,
+		},
+		Version:   secret.LatestVersion,
+		VersionOf: secret.ID,
+	}
+	if err := store.CreateSecret(tx, version); err != nil {
+		return err
+	}
+
+	return pruneSecretVersions(tx, secret)
+}
+
+// pruneSecretVersions removes the oldest versions of secret, keeping the
+// most recent maxSecretVersions, any version pinned by a service and any
+// version still referenced by a task, so that existing tasks keep access to
+// the data they were created with. Those versions are pruned by a later
+// rotation once their tasks are gone.
+func pruneSecretVersions(tx store.Tx, secret *api.Secret) error {
+	versions, err := store.FindSecrets(tx, store.ByVersionOf(secret.ID))
+	if err != nil {
+		return err
+	}
+	if len(versions) <= maxSecretVersions {
+		return nil
+	}
+
+	services, err := store.FindServices(tx, store.ByReferencedSecretID(secret.ID))
+	if err != nil {
+		return err
+	}
+	pinned := make(map[uint64]struct{})
+	for _, service := range services {
+		container := service.Spec.Task.GetContainer()
+		if container == nil {
+			continue
+		}
+		for _, secretRef := range container.Secrets {
+			if secretRef.SecretID == secret.ID && secretRef.SecretVersion != 0 {
+				pinned[secretRef.SecretVersion] = struct{}{}
+			}
+		}
+	}
+
+	for _, version := range versions {
+		if version.Version+maxSecretVersions > secret.LatestVersion {
+			continue
+		}
+		if _, ok := pinned[version.Version]; ok {
+			continue
+		}
+		// Tasks refer to the object holding the version they use
+		tasks, err := store.FindTasks(tx, store.ByReferencedSecretID(version.ID))
+		if err != nil {
+			return err
+		}
+		if len(tasks) > 0 {
+			continue
+		}
+		if err := store.DeleteSecret(tx, version.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ListSecrets returns a `ListSecretResponse` with a list all non-internal `Secret`s being
 // managed, or all secrets matching any name in `ListSecretsRequest.Names`, any
 // name prefix in `ListSecretsRequest.NamePrefixes`, any id in
@@ -131,6 +252,9 @@ func (s *Server) ListSecrets(ctx context.Context, request *api.ListSecretsReques
 	switch len(byFilters) {
 	case 0:
 		by = store.All
+		if len(labels) > 0 {
+			by = labelFilter(labels)
+		}
 	case 1:
 		by = byFilters[0]
 	default:
@@ -145,8 +269,9 @@ func (s *Server) ListSecrets(ctx context.Context, request *api.ListSecretsReques
 	}
 
 	// strip secret data from the secret, filter by label, and filter out all internal secrets
+	// and secret versions
 	for _, secret := range secrets {
-		if secret.Internal || !filterMatchLabels(secret.Spec.Annotations.Labels, labels) {
+		if secret.Internal || secret.VersionOf != "" || !filterMatchLabels(secret.Spec.Annotations.Labels, labels) {
 			continue
 		}
 		secret.Spec.Data = nil // clean the actual secret data so it's never returned
@@ -159,29 +284,50 @@ func (s *Server) ListSecrets(ctx context.Context, request *api.ListSecretsReques
 // CreateSecret creates and returns a `CreateSecretResponse` with a `Secret` based
 // on the provided `CreateSecretRequest.SecretSpec`.
 // - Returns `InvalidArgument` if the `CreateSecretRequest.SecretSpec` is malformed,
-//   or if the secret data is too long or contains invalid characters.
+//   if the secret data is too long or contains invalid characters, or if the
+//   secret driver is not installed.
 // - Returns an error if the creation fails.
 func (s *Server) CreateSecret(ctx context.Context, request *api.CreateSecretRequest) (*api.CreateSecretResponse, error) {
-	if err := validateSecretSpec(request.Spec); err != nil {
+	if err := s.validateCreateSecretRequest(request); err != nil {
 		return nil, err
 	}
 
-	secret := secretFromSecretSpec(request.Spec) // the store will handle name conflicts
+	var secret *api.Secret
 	err := s.store.Update(func(tx store.Tx) error {
-		return store.CreateSecret(tx, secret)
+		var err error
+		secret, err = createSecretInTx(tx, request.Spec)
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx, secret.ID)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	switch err {
+	secret.Spec.Data = nil // clean the actual secret data so it's never returned
+	log.G(ctx).WithFields(logrus.Fields{
+		"secret.Name": request.Spec.Annotations.Name,
+		"method":      "CreateSecret",
+	}).Debugf("secret created")
+
+	return &api.CreateSecretResponse{Secret: secret}, nil
+}
+
+func (s *Server) validateCreateSecretRequest(request *api.CreateSecretRequest) error {
+	if err := validateSecretSpec(request.Spec); err != nil {
+		return err
+	}
+	return s.validateSecretDriver(request.Spec.Driver)
+}
+
+func createSecretInTx(tx store.Tx, spec *api.SecretSpec) (*api.Secret, error) {
+	secret := secretFromSecretSpec(spec) // the store will handle name conflicts
+	switch err := store.CreateSecret(tx, secret); err {
 	case store.ErrNameConflict:
-		return nil, grpc.Errorf(codes.AlreadyExists, "secret %s already exists", request.Spec.Annotations.Name)
+		return nil, grpc.Errorf(codes.AlreadyExists, "secret %s already exists", spec.Annotations.Name)
 	case nil:
-		secret.Spec.Data = nil // clean the actual secret data so it's never returned
-		log.G(ctx).WithFields(logrus.Fields{
-			"secret.Name": request.Spec.Annotations.Name,
-			"method":      "CreateSecret",
-		}).Debugf("secret created")
-
-		return &api.CreateSecretResponse{Secret: secret}, nil
+		return secret, nil
 	default:
 		return nil, err
 	}
@@ -193,54 +339,76 @@ func (s *Server) CreateSecret(ctx context.Context, request *api.CreateSecretRequ
 // - Returns `SecretInUse` if the secret is currently in use
 // - Returns an error if the deletion fails.
 func (s *Server) RemoveSecret(ctx context.Context, request *api.RemoveSecretRequest) (*api.RemoveSecretResponse, error) {
-	if request.SecretID == "" {
-		return nil, grpc.Errorf(codes.InvalidArgument, "secret ID must be provided")
-	}
-
 	err := s.store.Update(func(tx store.Tx) error {
-		// Check if the secret exists
-		secret := store.GetSecret(tx, request.SecretID)
-		if secret == nil {
-			return grpc.Errorf(codes.NotFound, "could not find secret %s", request.SecretID)
+		if err := removeSecretInTx(tx, request.SecretID); err != nil {
+			return err
 		}
+		return audit.Record(ctx, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// Check if any services currently reference this secret, return error if so
-		services, err := store.FindServices(tx, store.ByReferencedSecretID(request.SecretID))
-		if err != nil {
-			return grpc.Errorf(codes.Internal, "could not find services using secret %s: %v", request.SecretID, err)
-		}
+	log.G(ctx).WithFields(logrus.Fields{
+		"secret.ID": request.SecretID,
+		"method":    "RemoveSecret",
+	}).Debugf("secret removed")
 
-		if len(services) != 0 {
-			serviceNames := make([]string, 0, len(services))
-			for _, service := range services {
-				serviceNames = append(serviceNames, service.Spec.Annotations.Name)
-			}
+	return &api.RemoveSecretResponse{}, nil
+}
 
-			secretName := secret.Spec.Annotations.Name
-			serviceNameStr := strings.Join(serviceNames, ", ")
-			serviceStr := "services"
-			if len(serviceNames) == 1 {
-				serviceStr = "service"
-			}
+func removeSecretInTx(tx store.Tx, secretID string) error {
+	if secretID == "" {
+		return grpc.Errorf(codes.InvalidArgument, "secret ID must be provided")
+	}
 
-			return grpc.Errorf(codes.InvalidArgument, "secret '%s' is in use by the following %s: %v", secretName, serviceStr, serviceNameStr)
+	// Check if the secret exists
+	secret := store.GetSecret(tx, secretID)
+	if secret == nil {
+		return grpc.Errorf(codes.NotFound, "could not find secret %s", secretID)
+	}
+	if secret.VersionOf != "" {
+		return grpc.Errorf(codes.InvalidArgument, "secret %s is a version of secret %s and can't be removed on its own", secretID, secret.VersionOf)
+	}
+
+	// Check if any services currently reference this secret, return error if so
+	services, err := store.FindServices(tx, store.ByReferencedSecretID(secretID))
+	if err != nil {
+		return grpc.Errorf(codes.Internal, "could not find services using secret %s: %v", secretID, err)
+	}
+
+	if len(services) != 0 {
+		serviceNames := make([]string, 0, len(services))
+		for _, service := range services {
+			serviceNames = append(serviceNames, service.Spec.Annotations.Name)
 		}
 
-		return store.DeleteSecret(tx, request.SecretID)
-	})
-	switch err {
-	case store.ErrNotExist:
-		return nil, grpc.Errorf(codes.NotFound, "secret %s not found", request.SecretID)
-	case nil:
-		log.G(ctx).WithFields(logrus.Fields{
-			"secret.ID": request.SecretID,
-			"method":    "RemoveSecret",
-		}).Debugf("secret removed")
+		secretName := secret.Spec.Annotations.Name
+		serviceNameStr := strings.Join(serviceNames, ", ")
+		serviceStr := "services"
+		if len(serviceNames) == 1 {
+			serviceStr = "service"
+		}
 
-		return &api.RemoveSecretResponse{}, nil
-	default:
-		return nil, err
+		return grpc.Errorf(codes.InvalidArgument, "secret '%s' is in use by the following %s: %v", secretName, serviceStr, serviceNameStr)
 	}
+
+	// Remove every version of the secret along with it
+	versions, err := store.FindSecrets(tx, store.ByVersionOf(secretID))
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		if err := store.DeleteSecret(tx, version.ID); err != nil {
+			return err
+		}
+	}
+
+	err = store.DeleteSecret(tx, secretID)
+	if err == store.ErrNotExist {
+		return grpc.Errorf(codes.NotFound, "secret %s not found", secretID)
+	}
+	return err
 }
 
 func validateSecretSpec(spec *api.SecretSpec) error {
@@ -251,7 +419,34 @@ func validateSecretSpec(spec *api.SecretSpec) error {
 		return err
 	}
 
-	if len(spec.Data) >= MaxSecretSize || len(spec.Data) < 1 {
+	if spec.Driver != nil {
+		if len(spec.Data) != 0 {
+			return grpc.Errorf(codes.InvalidArgument, "secret data must be empty when a driver is specified")
+		}
+		return nil
+	}
+	return validateSecretData(spec.Data)
+}
+
+// validateSecretDriver checks that the secrets driver, if any, is installed.
+func (s *Server) validateSecretDriver(driver *api.Driver) error {
+	if driver == nil {
+		return nil
+	}
+	if driver.Name == "" {
+		return grpc.Errorf(codes.InvalidArgument, "driver name: if driver is specified name is required")
+	}
+	if s.pg == nil {
+		return grpc.Errorf(codes.InvalidArgument, "secret driver %s not supported", driver.Name)
+	}
+	if _, err := s.pg.Get(driver.Name, drivers.SecretsProviderCapability, plugingetter.Lookup); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "error during lookup of secret driver %s", driver.Name)
+	}
+	return nil
+}
+
+func validateSecretData(data []byte) error {
+	if len(data) >= MaxSecretSize || len(data) < 1 {
 		return grpc.Errorf(codes.InvalidArgument, "secret data must be larger than 0 and less than %d bytes", MaxSecretSize)
 	}
 	return nil
@@ -126,6 +126,19 @@ func TestCreateSecret(t *testing.T) {
 	_, err = ts.Client.CreateSecret(context.Background(), &validSpecRequest)
 	assert.Error(t, err)
 	assert.Equal(t, codes.AlreadyExists, grpc.Code(err), grpc.ErrorDesc(err))
+
+	// ---- creating a secret with both a driver and data fails ----
+	driverSpec := createSecretSpec("driver", data, nil)
+	driverSpec.Driver = &api.Driver{Name: "vault"}
+	_, err = ts.Client.CreateSecret(context.Background(), &api.CreateSecretRequest{Spec: driverSpec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	// ---- creating a secret with a driver that isn't installed fails ----
+	driverSpec.Data = nil
+	_, err = ts.Client.CreateSecret(context.Background(), &api.CreateSecretRequest{Spec: driverSpec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
 }
 
 func TestGetSecret(t *testing.T) {
@@ -181,19 +194,10 @@ func TestUpdateSecret(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, codes.NotFound, grpc.Code(err), grpc.ErrorDesc(err))
 
-	// updating an existing secret's data returns an error
-	secret.Spec.Data = []byte{1}
-	resp, err := ts.Client.UpdateSecret(context.Background(), &api.UpdateSecretRequest{
-		SecretID:      secret.ID,
-		Spec:          &secret.Spec,
-		SecretVersion: &secret.Meta.Version,
-	})
-	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
-
 	// updating an existing secret's Name returns an error
 	secret.Spec.Data = nil
 	secret.Spec.Annotations.Name = "AnotherName"
-	resp, err = ts.Client.UpdateSecret(context.Background(), &api.UpdateSecretRequest{
+	resp, err := ts.Client.UpdateSecret(context.Background(), &api.UpdateSecretRequest{
 		SecretID:      secret.ID,
 		Spec:          &secret.Spec,
 		SecretVersion: &secret.Meta.Version,
@@ -243,6 +247,137 @@ func TestUpdateSecret(t *testing.T) {
 	assert.Equal(t, resp.Secret.Spec.Annotations.Labels, newLabels)
 }
 
+func TestRotateSecret(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	created, err := ts.Client.CreateSecret(context.Background(), &api.CreateSecretRequest{
+		Spec: createSecretSpec("name", []byte("data"), nil),
+	})
+	assert.NoError(t, err)
+	secret := created.Secret
+	assert.Equal(t, uint64(1), secret.Version)
+	assert.Equal(t, uint64(1), secret.LatestVersion)
+
+	// updating a secret's data stores it as a new version
+	spec := secret.Spec.Copy()
+	spec.Data = []byte("new data")
+	resp, err := ts.Client.UpdateSecret(context.Background(), &api.UpdateSecretRequest{
+		SecretID:      secret.ID,
+		Spec:          spec,
+		SecretVersion: &secret.Meta.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), resp.Secret.LatestVersion)
+	assert.Nil(t, resp.Secret.Spec.Data)
+
+	var version *api.Secret
+	ts.Store.View(func(tx store.ReadTx) {
+		version = store.GetSecretVersion(tx, secret.ID, 2)
+	})
+	assert.NotNil(t, version)
+	assert.Equal(t, secret.ID, version.VersionOf)
+	assert.Equal(t, []byte("new data"), version.Spec.Data)
+
+	// version objects are not listed, and cannot be updated or removed on
+	// their own
+	list, err := ts.Client.ListSecrets(context.Background(), &api.ListSecretsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Secrets, 1)
+	assert.Equal(t, secret.ID, list.Secrets[0].ID)
+
+	_, err = ts.Client.UpdateSecret(context.Background(), &api.UpdateSecretRequest{
+		SecretID:      version.ID,
+		Spec:          &version.Spec,
+		SecretVersion: &version.Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	_, err = ts.Client.RemoveSecret(context.Background(), &api.RemoveSecretRequest{SecretID: version.ID})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err), grpc.ErrorDesc(err))
+
+	// updating with the same data does not create a new version
+	resp, err = ts.Client.UpdateSecret(context.Background(), &api.UpdateSecretRequest{
+		SecretID:      secret.ID,
+		Spec:          spec,
+		SecretVersion: &resp.Secret.Meta.Version,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), resp.Secret.LatestVersion)
+
+	// removing the secret removes all of its versions
+	_, err = ts.Client.RemoveSecret(context.Background(), &api.RemoveSecretRequest{SecretID: secret.ID})
+	assert.NoError(t, err)
+	ts.Store.View(func(tx store.ReadTx) {
+		assert.Nil(t, store.GetSecret(tx, version.ID))
+	})
+}
+
+func TestPruneSecretVersions(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	created, err := ts.Client.CreateSecret(context.Background(), &api.CreateSecretRequest{
+		Spec: createSecretSpec("name", []byte("data"), nil),
+	})
+	assert.NoError(t, err)
+	secret := created.Secret
+
+	rotate := func(data string) {
+		spec := secret.Spec.Copy()
+		spec.Data = []byte(data)
+		resp, err := ts.Client.UpdateSecret(context.Background(), &api.UpdateSecretRequest{
+			SecretID:      secret.ID,
+			Spec:          spec,
+			SecretVersion: &secret.Meta.Version,
+		})
+		assert.NoError(t, err)
+		secret = resp.Secret
+	}
+
+	// a task uses version 2, and nothing uses version 3
+	rotate("data 2")
+	rotate("data 3")
+	var v2, v3 *api.Secret
+	ts.Store.View(func(tx store.ReadTx) {
+		v2 = store.GetSecretVersion(tx, secret.ID, 2)
+		v3 = store.GetSecretVersion(tx, secret.ID, 3)
+	})
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateTask(tx, &api.Task{
+			ID: "task",
+			Spec: api.TaskSpec{
+				Runtime: &api.TaskSpec_Container{
+					Container: &api.ContainerSpec{
+						Secrets: []*api.SecretReference{{SecretID: v2.ID, SecretName: "name", SecretVersion: 2}},
+					},
+				},
+			},
+		})
+	}))
+
+	for i := 0; i < maxSecretVersions; i++ {
+		rotate(fmt.Sprintf("data %d", i+4))
+	}
+
+	// versions still used by tasks are kept
+	ts.Store.View(func(tx store.ReadTx) {
+		assert.NotNil(t, store.GetSecret(tx, v2.ID))
+		assert.Nil(t, store.GetSecret(tx, v3.ID))
+	})
+
+	// and pruned once their tasks are gone
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.DeleteTask(tx, "task")
+	}))
+	rotate("data")
+	ts.Store.View(func(tx store.ReadTx) {
+		assert.Nil(t, store.GetSecret(tx, v2.ID))
+	})
+}
+
 func TestRemoveUnusedSecret(t *testing.T) {
 	ts := newTestServer(t)
 	defer ts.Stop()
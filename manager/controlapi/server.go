@@ -2,6 +2,7 @@ package controlapi
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/docker/docker/pkg/plugingetter"
 	"github.com/docker/swarmkit/ca"
@@ -20,6 +21,11 @@ type Server struct {
 	raft   *raft.Node
 	rootCA *ca.RootCA
 	pg     plugingetter.PluginGetter
+
+	// allocator is the allocator running on this manager while it is
+	// the leader.
+	allocatorMu sync.Mutex
+	allocator   AllocatorInspector
 }
 
 // NewServer creates a Cluster API server.
@@ -2,6 +2,8 @@ package controlapi
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"path/filepath"
 	"reflect"
 	"strconv"
@@ -11,6 +13,8 @@ import (
 	"github.com/docker/distribution/reference"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/identity"
+	"github.com/docker/swarmkit/manager/allocator/networkallocator"
+	"github.com/docker/swarmkit/manager/audit"
 	"github.com/docker/swarmkit/manager/constraint"
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/docker/swarmkit/protobuf/ptypes"
@@ -175,6 +179,51 @@ func validatePluginSpec(plugin *api.PluginSpec) error {
 	return nil
 }
 
+func validateRouterSpec(router *api.RouterSpec) error {
+	if router.ListenPort == 0 || router.ListenPort > 65535 {
+		return grpc.Errorf(codes.InvalidArgument, "RouterSpec: listen port must be between 1 and 65535")
+	}
+	if router.Image == "" {
+		return grpc.Errorf(codes.InvalidArgument, "RouterSpec: image reference must be provided")
+	}
+
+	return nil
+}
+
+// validateRouterService checks that the tasks of a router service are
+// attached to networks, which are the only ones they can route to, and that
+// the listen port isn't also published by the service.
+func validateRouterService(spec *api.ServiceSpec) error {
+	port := routerListenPort(spec)
+	if port == 0 {
+		return nil
+	}
+
+	if len(spec.Task.Networks) == 0 && len(spec.Networks) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "RouterSpec: router services must be attached to the networks of the services they route to")
+	}
+
+	if spec.Endpoint != nil {
+		for _, pc := range spec.Endpoint.Ports {
+			if pc.PublishedPort == port && pc.Protocol == api.ProtocolTCP {
+				return grpc.Errorf(codes.InvalidArgument, "RouterSpec: listen port %d is also published by the service", port)
+			}
+		}
+	}
+
+	return nil
+}
+
+// routerListenPort returns the port the tasks of a router service listen on,
+// or 0 if spec isn't a router service.
+func routerListenPort(spec *api.ServiceSpec) uint32 {
+	router := spec.Task.GetRouter()
+	if router == nil {
+		return 0
+	}
+	return router.ListenPort
+}
+
 func validateTaskSpec(taskSpec api.TaskSpec) error {
 	if err := validateResourceRequirements(taskSpec.Resources); err != nil {
 		return err
@@ -206,6 +255,10 @@ func validateTaskSpec(taskSpec api.TaskSpec) error {
 		if err := validatePluginSpec(taskSpec.GetPlugin()); err != nil {
 			return err
 		}
+	case *api.TaskSpec_Router:
+		if err := validateRouterSpec(taskSpec.GetRouter()); err != nil {
+			return err
+		}
 	default:
 		return grpc.Errorf(codes.Unimplemented, "RuntimeSpec: unimplemented runtime in service spec")
 	}
@@ -275,6 +328,11 @@ func validateSecretRefsSpec(spec api.TaskSpec) error {
 			return grpc.Errorf(codes.InvalidArgument, "malformed secret reference, no target provided")
 		}
 
+		// A reference either pins a version or tracks the latest one
+		if secretRef.TrackLatest && secretRef.SecretVersion != 0 {
+			return grpc.Errorf(codes.InvalidArgument, "secret reference '%s' can't both pin a version and track the latest version", secretRef.SecretName)
+		}
+
 		// If this is a file target, we will ensure filename uniqueness
 		if secretRef.GetFile() != nil {
 			fileName := secretRef.GetFile().Name
@@ -295,12 +353,9 @@ func validateSecretRefsSpec(spec api.TaskSpec) error {
 	return nil
 }
 
-func (s *Server) validateNetworks(networks []*api.NetworkAttachmentConfig) error {
+func (s *Server) validateNetworks(tx store.ReadTx, networks []*api.NetworkAttachmentConfig) error {
 	for _, na := range networks {
-		var network *api.Network
-		s.store.View(func(tx store.ReadTx) {
-			network = store.GetNetwork(tx, na.Target)
-		})
+		network := store.GetNetwork(tx, na.Target)
 		if network == nil {
 			continue
 		}
@@ -309,6 +364,9 @@ func (s *Server) validateNetworks(networks []*api.NetworkAttachmentConfig) error
 				"Service cannot be explicitly attached to %q network which is a swarm internal network",
 				network.Spec.Annotations.Name)
 		}
+		if err := checkSlotAddressesInNetwork(network, na); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -347,43 +405,253 @@ func validateServiceSpec(spec *api.ServiceSpec) error {
 	if err := validateMode(spec); err != nil {
 		return err
 	}
+	if err := validateSlotAddresses(spec); err != nil {
+		return err
+	}
+	if err := validateRoutes(spec); err != nil {
+		return err
+	}
+	if err := validateRouterService(spec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// routeKey identifies the requests matched by a route for one of its
+// hostnames.
+type routeKey struct {
+	hostname   string
+	pathPrefix string
+}
+
+// routeKeys returns the keys of the requests matched by route.
+func routeKeys(route *api.Route) []routeKey {
+	pathPrefix := route.PathPrefix
+	if pathPrefix == "" {
+		pathPrefix = "/"
+	}
+	if len(route.Hostnames) == 0 {
+		return []routeKey{{pathPrefix: pathPrefix}}
+	}
+
+	keys := make([]routeKey, 0, len(route.Hostnames))
+	for _, hostname := range route.Hostnames {
+		keys = append(keys, routeKey{hostname: strings.ToLower(hostname), pathPrefix: pathPrefix})
+	}
+	return keys
+}
+
+// validateRoutes checks that the HTTP routes of the service are well formed,
+// don't overlap, and proxy to a network the service is attached to.
+func validateRoutes(spec *api.ServiceSpec) error {
+	if spec.Endpoint == nil || len(spec.Endpoint.Routes) == 0 {
+		return nil
+	}
+
+	networks := spec.Task.Networks
+	if len(networks) == 0 {
+		networks = spec.Networks
+	}
+	if len(networks) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "EndpointSpec: routes require the service to be attached to a network")
+	}
+
+	seen := make(map[routeKey]struct{})
+
+	for _, route := range spec.Endpoint.Routes {
+		if route.TargetPort == 0 || route.TargetPort > 65535 {
+			return grpc.Errorf(codes.InvalidArgument, "EndpointSpec: route target port must be between 1 and 65535")
+		}
+		if route.PathPrefix != "" && !strings.HasPrefix(route.PathPrefix, "/") {
+			return grpc.Errorf(codes.InvalidArgument, "EndpointSpec: route path prefix %q must start with /", route.PathPrefix)
+		}
+		if route.Network != "" {
+			found := false
+			for _, na := range networks {
+				if na.Target == route.Network {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return grpc.Errorf(codes.InvalidArgument, "EndpointSpec: route network %s is not attached to the service", route.Network)
+			}
+		}
+
+		for _, hostname := range route.Hostnames {
+			if hostname == "" || strings.ContainsAny(hostname, "/: ") {
+				return grpc.Errorf(codes.InvalidArgument, "EndpointSpec: invalid route hostname %q", hostname)
+			}
+		}
+
+		for _, key := range routeKeys(route) {
+			if _, ok := seen[key]; ok {
+				return grpc.Errorf(codes.InvalidArgument, "EndpointSpec: duplicate routes provided")
+			}
+			seen[key] = struct{}{}
+		}
+	}
 
 	return nil
 }
 
+// validateSlotAddresses checks that the slot addresses of the network
+// attachments of a replicated service are well formed and not shared
+// between slots.
+func validateSlotAddresses(spec *api.ServiceSpec) error {
+	var networks []*api.NetworkAttachmentConfig
+	networks = append(networks, spec.Networks...)
+	networks = append(networks, spec.Task.Networks...)
+
+	for _, na := range networks {
+		if len(na.SlotAddresses) == 0 {
+			continue
+		}
+		if spec.GetReplicated() == nil {
+			return grpc.Errorf(codes.InvalidArgument, "slot addresses are only supported for replicated services")
+		}
+
+		seen := make(map[string]uint64)
+		for slot, slotAddress := range na.SlotAddresses {
+			if slot == 0 {
+				return grpc.Errorf(codes.InvalidArgument, "invalid slot 0 in slot addresses of network %s", na.Target)
+			}
+			if slotAddress == nil || len(slotAddress.Addresses) == 0 {
+				return grpc.Errorf(codes.InvalidArgument, "no addresses for slot %d of network %s", slot, na.Target)
+			}
+			if slotAddress.MacAddress != "" {
+				if _, err := net.ParseMAC(slotAddress.MacAddress); err != nil {
+					return grpc.Errorf(codes.InvalidArgument, "invalid MAC address %s for slot %d: %v", slotAddress.MacAddress, slot, err)
+				}
+			}
+			for _, addr := range slotAddress.Addresses {
+				ip := parseSlotAddress(addr)
+				if ip == nil {
+					return grpc.Errorf(codes.InvalidArgument, "invalid address %s for slot %d", addr, slot)
+				}
+				if other, ok := seen[ip.String()]; ok {
+					return grpc.Errorf(codes.InvalidArgument, "address %s is used by slots %d and %d", ip, other, slot)
+				}
+				seen[ip.String()] = slot
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseSlotAddress parses a slot address given either in CIDR format or as
+// a plain IP. It returns nil if the address is invalid.
+func parseSlotAddress(addr string) net.IP {
+	if ip, _, err := net.ParseCIDR(addr); err == nil {
+		return ip
+	}
+	return net.ParseIP(addr)
+}
+
+// checkSlotAddressesInNetwork checks that the slot addresses of a network
+// attachment belong to the subnets of the network and aren't its gateway.
+func checkSlotAddressesInNetwork(network *api.Network, na *api.NetworkAttachmentConfig) error {
+	if len(na.SlotAddresses) == 0 {
+		return nil
+	}
+	if network.IPAM == nil || len(network.IPAM.Configs) == 0 {
+		return grpc.Errorf(codes.FailedPrecondition, "network %s has no allocated subnets", network.Spec.Annotations.Name)
+	}
+
+	for slot, slotAddress := range na.SlotAddresses {
+		for _, addr := range slotAddress.Addresses {
+			ip := parseSlotAddress(addr)
+			inSubnet := false
+			for _, ic := range network.IPAM.Configs {
+				_, subnet, err := net.ParseCIDR(ic.Subnet)
+				if err != nil || !subnet.Contains(ip) {
+					continue
+				}
+				if gateway := net.ParseIP(ic.Gateway); gateway != nil && gateway.Equal(ip) {
+					return grpc.Errorf(codes.InvalidArgument, "address %s for slot %d is the gateway of network %s", ip, slot, network.Spec.Annotations.Name)
+				}
+				inSubnet = true
+			}
+			if !inSubnet {
+				return grpc.Errorf(codes.InvalidArgument, "address %s for slot %d is not in a subnet of network %s", ip, slot, network.Spec.Annotations.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkReservedPorts checks that the passed in spec doesn't publish ports
+// the cluster reserves. Ports already published by `service`, the service
+// being updated if any, are accepted so existing allocations are left alone.
+func (s *Server) checkReservedPorts(tx store.ReadTx, spec *api.ServiceSpec, service *api.Service) error {
+	if spec.Endpoint == nil {
+		return nil
+	}
+
+	clusters, err := store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+	if err != nil {
+		return err
+	}
+	if len(clusters) != 1 {
+		return nil
+	}
+	reserved := clusters[0].Spec.PortAllocation.Reserved
+
+	published := make(map[uint32]struct{})
+	if service != nil && service.Endpoint != nil {
+		for _, pc := range service.Endpoint.Ports {
+			published[pc.PublishedPort] = struct{}{}
+		}
+	}
+
+	for _, pc := range spec.Endpoint.Ports {
+		if pc.PublishedPort == 0 {
+			continue
+		}
+		if _, ok := published[pc.PublishedPort]; ok {
+			continue
+		}
+		if networkallocator.IsPortReserved(reserved, pc.PublishedPort) {
+			return grpc.Errorf(codes.InvalidArgument, "port '%d' is reserved by the cluster and can't be published", pc.PublishedPort)
+		}
+	}
+	return nil
+}
+
 // checkPortConflicts does a best effort to find if the passed in spec has port
 // conflicts with existing services.
 // `serviceID string` is the service ID of the spec in service update. If
 // `serviceID` is not "", then conflicts check will be skipped against this
 // service (the service being updated).
-func (s *Server) checkPortConflicts(spec *api.ServiceSpec, serviceID string) error {
-	if spec.Endpoint == nil {
-		return nil
-	}
-
+func (s *Server) checkPortConflicts(tx store.ReadTx, spec *api.ServiceSpec, serviceID string) error {
 	pcToString := func(pc *api.PortConfig) string {
 		port := strconv.FormatUint(uint64(pc.PublishedPort), 10)
 		return port + "/" + pc.Protocol.String()
 	}
+	// Router tasks listen on the host, like published ports.
+	listenPortConfig := func(spec *api.ServiceSpec) *api.PortConfig {
+		return &api.PortConfig{PublishedPort: routerListenPort(spec), Protocol: api.ProtocolTCP}
+	}
 
 	reqPorts := make(map[string]bool)
-	for _, pc := range spec.Endpoint.Ports {
-		if pc.PublishedPort > 0 {
-			reqPorts[pcToString(pc)] = true
+	if spec.Endpoint != nil {
+		for _, pc := range spec.Endpoint.Ports {
+			if pc.PublishedPort > 0 {
+				reqPorts[pcToString(pc)] = true
+			}
 		}
 	}
+	if routerListenPort(spec) != 0 {
+		reqPorts[pcToString(listenPortConfig(spec))] = true
+	}
 	if len(reqPorts) == 0 {
 		return nil
 	}
 
-	var (
-		services []*api.Service
-		err      error
-	)
-
-	s.store.View(func(tx store.ReadTx) {
-		services, err = store.FindServices(tx, store.All)
-	})
+	services, err := store.FindServices(tx, store.All)
 	if err != nil {
 		return err
 	}
@@ -407,6 +675,44 @@ func (s *Server) checkPortConflicts(spec *api.ServiceSpec, serviceID string) err
 				}
 			}
 		}
+		if port := routerListenPort(&service.Spec); port != 0 && reqPorts[pcToString(listenPortConfig(&service.Spec))] {
+			return grpc.Errorf(codes.InvalidArgument, "port '%d' is already in use by router service '%s' (%s)", port, service.Spec.Annotations.Name, service.ID)
+		}
+	}
+	return nil
+}
+
+// checkRouteConflicts checks that the passed in spec doesn't route requests
+// already routed to another service, which would make the routing table
+// ambiguous. `serviceID` is the ID of the service being updated, if any.
+func (s *Server) checkRouteConflicts(tx store.ReadTx, spec *api.ServiceSpec, serviceID string) error {
+	if spec.Endpoint == nil || len(spec.Endpoint.Routes) == 0 {
+		return nil
+	}
+
+	reqRoutes := make(map[routeKey]struct{})
+	for _, route := range spec.Endpoint.Routes {
+		for _, key := range routeKeys(route) {
+			reqRoutes[key] = struct{}{}
+		}
+	}
+
+	services, err := store.FindServices(tx, store.All)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if service.ID == serviceID || service.Spec.Endpoint == nil {
+			continue
+		}
+		for _, route := range service.Spec.Endpoint.Routes {
+			for _, key := range routeKeys(route) {
+				if _, ok := reqRoutes[key]; ok {
+					return grpc.Errorf(codes.InvalidArgument, "route %s%s is already in use by service '%s' (%s)", key.hostname, key.pathPrefix, service.Spec.Annotations.Name, service.ID)
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -421,9 +727,15 @@ func (s *Server) checkSecretExistence(tx store.Tx, spec *api.ServiceSpec) error
 	var failedSecrets []string
 	for _, secretRef := range container.Secrets {
 		secret := store.GetSecret(tx, secretRef.SecretID)
-		// Check to see if the secret exists and secretRef.SecretName matches the actual secretName
-		if secret == nil || secret.Spec.Annotations.Name != secretRef.SecretName {
+		// Check to see if the secret exists and secretRef.SecretName matches the actual secretName.
+		// Services must reference the secret itself rather than one of its versions.
+		if secret == nil || secret.VersionOf != "" || secret.Spec.Annotations.Name != secretRef.SecretName {
 			failedSecrets = append(failedSecrets, secretRef.SecretName)
+			continue
+		}
+		// Check to see if the pinned version exists
+		if secretRef.SecretVersion != 0 && store.GetSecretVersion(tx, secret.ID, secretRef.SecretVersion) == nil {
+			failedSecrets = append(failedSecrets, fmt.Sprintf("%s (version %d)", secretRef.SecretName, secretRef.SecretVersion))
 		}
 	}
 
@@ -440,6 +752,31 @@ func (s *Server) checkSecretExistence(tx store.Tx, spec *api.ServiceSpec) error
 	return nil
 }
 
+// pinSecretVersions pins the secret references of spec which neither pin a
+// version nor track the latest one to the latest version of their secret, so
+// that all the tasks of the service use the same data until the service is
+// updated, even if the secret is rotated in the meantime.
+func pinSecretVersions(tx store.ReadTx, spec *api.ServiceSpec) {
+	container := spec.Task.GetContainer()
+	if container == nil {
+		return
+	}
+
+	for _, secretRef := range container.Secrets {
+		if secretRef.SecretVersion != 0 || secretRef.TrackLatest {
+			continue
+		}
+		secret := store.GetSecret(tx, secretRef.SecretID)
+		if secret == nil {
+			continue
+		}
+		secretRef.SecretVersion = secret.LatestVersion
+		if secretRef.SecretVersion == 0 {
+			secretRef.SecretVersion = 1
+		}
+	}
+}
+
 // CreateService creates and returns a Service based on the provided ServiceSpec.
 // - Returns `InvalidArgument` if the ServiceSpec is malformed.
 // - Returns `Unimplemented` if the ServiceSpec references unimplemented features.
@@ -450,11 +787,50 @@ func (s *Server) CreateService(ctx context.Context, request *api.CreateServiceRe
 		return nil, err
 	}
 
-	if err := s.validateNetworks(request.Spec.Networks); err != nil {
+	var service *api.Service
+	err := s.store.Update(func(tx store.Tx) error {
+		var err error
+		service, err = s.createServiceInTx(tx, request.Spec)
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx, service.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateServiceResponse{
+		Service: service,
+	}, nil
+}
+
+// createServiceInTx checks a validated ServiceSpec against the objects in the
+// store, and creates the service.
+func (s *Server) createServiceInTx(tx store.Tx, spec *api.ServiceSpec) (*api.Service, error) {
+	if err := s.validateNetworks(tx, spec.Networks); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateNetworks(tx, spec.Task.Networks); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPortConflicts(tx, spec, ""); err != nil {
 		return nil, err
 	}
 
-	if err := s.checkPortConflicts(request.Spec, ""); err != nil {
+	if err := s.checkRouteConflicts(tx, spec, ""); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkReservedPorts(tx, spec, nil); err != nil {
+		return nil, err
+	}
+
+	// Check to see if all the secrets being added exist as objects
+	// in our datastore
+	if err := s.checkSecretExistence(tx, spec); err != nil {
 		return nil, err
 	}
 
@@ -462,26 +838,13 @@ func (s *Server) CreateService(ctx context.Context, request *api.CreateServiceRe
 	// duplicate creations. See #65
 	service := &api.Service{
 		ID:   identity.NewID(),
-		Spec: *request.Spec,
+		Spec: *spec.Copy(),
 	}
-
-	err := s.store.Update(func(tx store.Tx) error {
-		// Check to see if all the secrets being added exist as objects
-		// in our datastore
-		err := s.checkSecretExistence(tx, request.Spec)
-		if err != nil {
-			return err
-		}
-
-		return store.CreateService(tx, service)
-	})
-	if err != nil {
+	pinSecretVersions(tx, &service.Spec)
+	if err := store.CreateService(tx, service); err != nil {
 		return nil, err
 	}
-
-	return &api.CreateServiceResponse{
-		Service: service,
-	}, nil
+	return service, nil
 }
 
 // GetService returns a Service given a ServiceID.
@@ -511,98 +874,117 @@ func (s *Server) GetService(ctx context.Context, request *api.GetServiceRequest)
 // - Returns `Unimplemented` if the ServiceSpec references unimplemented features.
 // - Returns an error if the update fails.
 func (s *Server) UpdateService(ctx context.Context, request *api.UpdateServiceRequest) (*api.UpdateServiceResponse, error) {
-	if request.ServiceID == "" || request.ServiceVersion == nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
-	}
-	if err := validateServiceSpec(request.Spec); err != nil {
+	if err := validateUpdateServiceRequest(request); err != nil {
 		return nil, err
 	}
 
 	var service *api.Service
-	s.store.View(func(tx store.ReadTx) {
-		service = store.GetService(tx, request.ServiceID)
+	err := s.store.Update(func(tx store.Tx) error {
+		var err error
+		service, err = s.updateServiceInTx(tx, request)
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateServiceResponse{
+		Service: service,
+	}, nil
+}
+
+func validateUpdateServiceRequest(request *api.UpdateServiceRequest) error {
+	if request.ServiceID == "" || request.ServiceVersion == nil {
+		return grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
+	}
+	return validateServiceSpec(request.Spec)
+}
+
+// updateServiceInTx checks a validated UpdateServiceRequest against the objects
+// in the store, and updates the service.
+func (s *Server) updateServiceInTx(tx store.Tx, request *api.UpdateServiceRequest) (*api.Service, error) {
+	service := store.GetService(tx, request.ServiceID)
 	if service == nil {
 		return nil, grpc.Errorf(codes.NotFound, "service %s not found", request.ServiceID)
 	}
 
-	if request.Spec.Endpoint != nil && !reflect.DeepEqual(request.Spec.Endpoint, service.Spec.Endpoint) {
-		if err := s.checkPortConflicts(request.Spec, request.ServiceID); err != nil {
+	if request.Spec.Endpoint != nil && !reflect.DeepEqual(request.Spec.Endpoint, service.Spec.Endpoint) ||
+		routerListenPort(request.Spec) != routerListenPort(&service.Spec) {
+		if err := s.checkPortConflicts(tx, request.Spec, request.ServiceID); err != nil {
 			return nil, err
 		}
-	}
-
-	err := s.store.Update(func(tx store.Tx) error {
-		service = store.GetService(tx, request.ServiceID)
-		if service == nil {
-			return grpc.Errorf(codes.NotFound, "service %s not found", request.ServiceID)
+		if err := s.checkReservedPorts(tx, request.Spec, service); err != nil {
+			return nil, err
 		}
-		// temporary disable network update
-		requestSpecNetworks := request.Spec.Task.Networks
-		if len(requestSpecNetworks) == 0 {
-			requestSpecNetworks = request.Spec.Networks
+		if err := s.checkRouteConflicts(tx, request.Spec, request.ServiceID); err != nil {
+			return nil, err
 		}
+	}
 
-		specNetworks := service.Spec.Task.Networks
-		if len(specNetworks) == 0 {
-			specNetworks = service.Spec.Networks
-		}
+	// temporary disable network update
+	requestSpecNetworks := request.Spec.Task.Networks
+	if len(requestSpecNetworks) == 0 {
+		requestSpecNetworks = request.Spec.Networks
+	}
 
-		if !reflect.DeepEqual(requestSpecNetworks, specNetworks) {
-			return grpc.Errorf(codes.Unimplemented, errNetworkUpdateNotSupported.Error())
-		}
+	specNetworks := service.Spec.Task.Networks
+	if len(specNetworks) == 0 {
+		specNetworks = service.Spec.Networks
+	}
 
-		// Check to see if all the secrets being added exist as objects
-		// in our datastore
-		err := s.checkSecretExistence(tx, request.Spec)
-		if err != nil {
-			return err
-		}
+	if !reflect.DeepEqual(requestSpecNetworks, specNetworks) {
+		return nil, grpc.Errorf(codes.Unimplemented, errNetworkUpdateNotSupported.Error())
+	}
 
-		// orchestrator is designed to be stateless, so it should not deal
-		// with service mode change (comparing current config with previous config).
-		// proper way to change service mode is to delete and re-add.
-		if reflect.TypeOf(service.Spec.Mode) != reflect.TypeOf(request.Spec.Mode) {
-			return grpc.Errorf(codes.Unimplemented, errModeChangeNotAllowed.Error())
-		}
+	// Check to see if all the secrets being added exist as objects
+	// in our datastore
+	if err := s.checkSecretExistence(tx, request.Spec); err != nil {
+		return nil, err
+	}
 
-		if service.Spec.Annotations.Name != request.Spec.Annotations.Name {
-			return grpc.Errorf(codes.Unimplemented, errRenameNotSupported.Error())
-		}
+	// orchestrator is designed to be stateless, so it should not deal
+	// with service mode change (comparing current config with previous config).
+	// proper way to change service mode is to delete and re-add.
+	if reflect.TypeOf(service.Spec.Mode) != reflect.TypeOf(request.Spec.Mode) {
+		return nil, grpc.Errorf(codes.Unimplemented, errModeChangeNotAllowed.Error())
+	}
 
-		service.Meta.Version = *request.ServiceVersion
+	if service.Spec.Annotations.Name != request.Spec.Annotations.Name {
+		return nil, grpc.Errorf(codes.Unimplemented, errRenameNotSupported.Error())
+	}
 
-		if request.Rollback == api.UpdateServiceRequest_PREVIOUS {
-			if service.PreviousSpec == nil {
-				return grpc.Errorf(codes.FailedPrecondition, "service %s does not have a previous spec", request.ServiceID)
-			}
+	service.Meta.Version = *request.ServiceVersion
 
-			curSpec := service.Spec.Copy()
-			service.Spec = *service.PreviousSpec.Copy()
-			service.PreviousSpec = curSpec
+	if request.Rollback == api.UpdateServiceRequest_PREVIOUS {
+		if service.PreviousSpec == nil {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "service %s does not have a previous spec", request.ServiceID)
+		}
 
-			service.UpdateStatus = &api.UpdateStatus{
-				State:     api.UpdateStatus_ROLLBACK_STARTED,
-				Message:   "manually requested rollback",
-				StartedAt: ptypes.MustTimestampProto(time.Now()),
-			}
-		} else {
-			service.PreviousSpec = service.Spec.Copy()
-			service.Spec = *request.Spec.Copy()
+		curSpec := service.Spec.Copy()
+		service.Spec = *service.PreviousSpec.Copy()
+		service.PreviousSpec = curSpec
 
-			// Reset update status
-			service.UpdateStatus = nil
+		service.UpdateStatus = &api.UpdateStatus{
+			State:     api.UpdateStatus_ROLLBACK_STARTED,
+			Message:   "manually requested rollback",
+			StartedAt: ptypes.MustTimestampProto(time.Now()),
 		}
+	} else {
+		service.PreviousSpec = service.Spec.Copy()
+		service.Spec = *request.Spec.Copy()
+		pinSecretVersions(tx, &service.Spec)
 
-		return store.UpdateService(tx, service)
-	})
-	if err != nil {
-		return nil, err
+		// Reset update status
+		service.UpdateStatus = nil
 	}
 
-	return &api.UpdateServiceResponse{
-		Service: service,
-	}, nil
+	if err := store.UpdateService(tx, service); err != nil {
+		return nil, err
+	}
+	return service, nil
 }
 
 // RemoveService removes a Service referenced by ServiceID.
@@ -610,22 +992,30 @@ func (s *Server) UpdateService(ctx context.Context, request *api.UpdateServiceRe
 // - Returns `NotFound` if the Service is not found.
 // - Returns an error if the deletion fails.
 func (s *Server) RemoveService(ctx context.Context, request *api.RemoveServiceRequest) (*api.RemoveServiceResponse, error) {
-	if request.ServiceID == "" {
-		return nil, grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
-	}
-
 	err := s.store.Update(func(tx store.Tx) error {
-		return store.DeleteService(tx, request.ServiceID)
+		if err := removeServiceInTx(tx, request.ServiceID); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
 	if err != nil {
-		if err == store.ErrNotExist {
-			return nil, grpc.Errorf(codes.NotFound, "service %s not found", request.ServiceID)
-		}
 		return nil, err
 	}
 	return &api.RemoveServiceResponse{}, nil
 }
 
+func removeServiceInTx(tx store.Tx, serviceID string) error {
+	if serviceID == "" {
+		return grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
+	}
+
+	err := store.DeleteService(tx, serviceID)
+	if err == store.ErrNotExist {
+		return grpc.Errorf(codes.NotFound, "service %s not found", serviceID)
+	}
+	return err
+}
+
 func filterServices(candidates []*api.Service, filters ...func(*api.Service) bool) []*api.Service {
 	result := []*api.Service{}
 
@@ -660,6 +1050,8 @@ func (s *Server) ListServices(ctx context.Context, request *api.ListServicesRequ
 			services, err = store.FindServices(tx, buildFilters(store.ByNamePrefix, request.Filters.NamePrefixes))
 		case request.Filters != nil && len(request.Filters.IDPrefixes) > 0:
 			services, err = store.FindServices(tx, buildFilters(store.ByIDPrefix, request.Filters.IDPrefixes))
+		case request.Filters != nil && len(request.Filters.Labels) > 0:
+			services, err = store.FindServices(tx, labelFilter(request.Filters.Labels))
 		default:
 			services, err = store.FindServices(tx, store.All)
 		}
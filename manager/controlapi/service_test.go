@@ -444,8 +444,12 @@ func TestSecretValidation(t *testing.T) {
 	}
 
 	serviceSpec = createServiceSpecWithSecrets("service4", secretRef2, secretRef5)
-	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: serviceSpec})
+	resp, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: serviceSpec})
 	assert.NoError(t, err)
+	// references which don't track the latest version are pinned to it
+	for _, secretRef := range resp.Service.Spec.Task.GetContainer().Secrets {
+		assert.Equal(t, uint64(1), secretRef.SecretVersion)
+	}
 
 	// test secret References with invalid filenames
 	invalidFileNames := []string{"../secretfile.txt", "../../secretfile.txt", "file../.txt", "subdir/file.txt"}
@@ -467,6 +471,23 @@ func TestSecretValidation(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
+	// test pinning a secret version that doesn't exist fails
+	secretRef6 := createSecret(t, ts, "secret6", "secret6.txt")
+	secretRef6.SecretVersion = 2
+	serviceSpec = createServiceSpecWithSecrets("service6", secretRef6)
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: serviceSpec})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// test pinning a version while tracking the latest one fails
+	secretRef6.SecretVersion = 1
+	secretRef6.TrackLatest = true
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: serviceSpec})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	secretRef6.TrackLatest = false
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: serviceSpec})
+	assert.NoError(t, err)
+
 	// test secret target conflicts on update
 	serviceSpec1 := createServiceSpecWithSecrets("service5", secretRef2, secretRef3)
 	// Copy this service, but delete the secrets for creation
@@ -690,6 +711,225 @@ func TestServiceUpdateRejectNetworkChange(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateServiceSlotAddresses(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateNetwork(tx, &api.Network{
+			ID: "testNetworkID",
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{Name: "test"},
+			},
+			IPAM: &api.IPAMOptions{
+				Configs: []*api.IPAMConfig{{Subnet: "10.0.0.0/24", Gateway: "10.0.0.1"}},
+			},
+		})
+	}))
+
+	for _, slotAddresses := range []map[uint64]*api.SlotAddress{
+		{0: {Addresses: []string{"10.0.0.2"}}},
+		{1: {}},
+		{1: {Addresses: []string{"invalid"}}},
+		{1: {Addresses: []string{"10.0.0.2"}, MacAddress: "invalid"}},
+		{1: {Addresses: []string{"10.0.0.2"}}, 2: {Addresses: []string{"10.0.0.2/24"}}},
+		{1: {Addresses: []string{"10.0.1.2"}}},
+		{1: {Addresses: []string{"10.0.0.1"}}},
+	} {
+		spec := createSpec("name", "image", 2)
+		spec.Task.Networks = []*api.NetworkAttachmentConfig{
+			{Target: "testNetworkID", SlotAddresses: slotAddresses},
+		}
+		_, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err), "%v", slotAddresses)
+	}
+
+	// Slot addresses are not supported for global services.
+	spec := createSpec("name", "image", 0)
+	spec.Mode = &api.ServiceSpec_Global{Global: &api.GlobalService{}}
+	spec.Task.Networks = []*api.NetworkAttachmentConfig{
+		{Target: "testNetworkID", SlotAddresses: map[uint64]*api.SlotAddress{1: {Addresses: []string{"10.0.0.2"}}}},
+	}
+	_, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	spec = createSpec("name", "image", 2)
+	spec.Task.Networks = []*api.NetworkAttachmentConfig{
+		{
+			Target: "testNetworkID",
+			SlotAddresses: map[uint64]*api.SlotAddress{
+				1: {Addresses: []string{"10.0.0.2"}, MacAddress: "02:42:0a:00:00:02"},
+				2: {Addresses: []string{"10.0.0.3/24"}},
+			},
+		},
+	}
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.NoError(t, err)
+}
+
+func TestValidateRoutes(t *testing.T) {
+	spec := createSpec("name", "image", 1)
+	spec.Endpoint = &api.EndpointSpec{
+		Routes: []*api.Route{{Hostnames: []string{"example.com"}, TargetPort: 80}},
+	}
+	err := validateRoutes(spec)
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	spec.Task.Networks = []*api.NetworkAttachmentConfig{{Target: "net1"}}
+	for _, routes := range [][]*api.Route{
+		{{TargetPort: 0}},
+		{{TargetPort: 65536}},
+		{{PathPrefix: "api", TargetPort: 80}},
+		{{Network: "net2", TargetPort: 80}},
+		{{Hostnames: []string{"example.com:80"}, TargetPort: 80}},
+		{{Hostnames: []string{"example.com"}, TargetPort: 80}, {Hostnames: []string{"EXAMPLE.COM"}, PathPrefix: "/", TargetPort: 8080}},
+		{{TargetPort: 80}, {TargetPort: 8080}},
+	} {
+		spec.Endpoint.Routes = routes
+		err := validateRoutes(spec)
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	}
+
+	spec.Endpoint.Routes = []*api.Route{
+		{Hostnames: []string{"example.com", "www.example.com"}, TargetPort: 80},
+		{Hostnames: []string{"example.com"}, PathPrefix: "/api", TargetPort: 8080, Network: "net1"},
+		{TargetPort: 80},
+	}
+	assert.NoError(t, validateRoutes(spec))
+}
+
+func TestCreateRouterService(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateNetwork(tx, &api.Network{
+			ID: "testNetworkID",
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{Name: "test"},
+			},
+		})
+	}))
+
+	for _, router := range []*api.RouterSpec{
+		{Image: "router"},
+		{ListenPort: 65536, Image: "router"},
+		{ListenPort: 8080},
+	} {
+		spec := createSpec("router", "", 1)
+		spec.Task.Runtime = &api.TaskSpec_Router{Router: router}
+		spec.Task.Networks = []*api.NetworkAttachmentConfig{{Target: "testNetworkID"}}
+		_, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err), "%v", router)
+	}
+
+	// Router tasks must be attached to the networks they route to.
+	spec := createSpec("router", "", 1)
+	spec.Task.Runtime = &api.TaskSpec_Router{Router: &api.RouterSpec{ListenPort: 8080, Image: "router"}}
+	_, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// The listen port can't also be published by the service.
+	spec.Task.Networks = []*api.NetworkAttachmentConfig{{Target: "testNetworkID"}}
+	spec.Endpoint = &api.EndpointSpec{Ports: []*api.PortConfig{
+		{PublishedPort: 8080, TargetPort: 80, Protocol: api.ProtocolTCP},
+	}}
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	spec.Endpoint = nil
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.NoError(t, err)
+
+	// Other services can't publish the listen port, nor other routers
+	// listen on it.
+	published := createSpec("published", "image", 1)
+	published.Endpoint = &api.EndpointSpec{Ports: []*api.PortConfig{
+		{PublishedPort: 8080, TargetPort: 80, Protocol: api.ProtocolTCP},
+	}}
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: published})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	spec.Annotations.Name = "router2"
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// A router can't listen on a port published by another service.
+	published.Endpoint.Ports[0].PublishedPort = 9090
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: published})
+	assert.NoError(t, err)
+	spec.Task.GetRouter().ListenPort = 9090
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{Spec: spec})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+}
+
+func TestServiceConflictingRoutes(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateNetwork(tx, &api.Network{
+			ID: "testNetworkID",
+			Spec: api.NetworkSpec{
+				Annotations: api.Annotations{Name: "test"},
+			},
+		})
+	}))
+
+	routedSpec := func(name string, routes ...*api.Route) *api.ServiceSpec {
+		spec := createSpec(name, "image", 1)
+		spec.Task.Networks = []*api.NetworkAttachmentConfig{{Target: "testNetworkID"}}
+		spec.Endpoint = &api.EndpointSpec{Routes: routes}
+		return spec
+	}
+
+	r, err := ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{
+		Spec: routedSpec("web", &api.Route{Hostnames: []string{"example.com"}, TargetPort: 80}),
+	})
+	assert.NoError(t, err)
+	web := r.Service
+
+	_, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{
+		Spec: routedSpec("web2", &api.Route{Hostnames: []string{"EXAMPLE.com"}, PathPrefix: "/", TargetPort: 80}),
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	r, err = ts.Client.CreateService(context.Background(), &api.CreateServiceRequest{
+		Spec: routedSpec("api", &api.Route{Hostnames: []string{"example.com"}, PathPrefix: "/api", TargetPort: 80}),
+	})
+	assert.NoError(t, err)
+	api1 := r.Service
+
+	// Updating a service doesn't conflict with its own routes, but does
+	// with the ones of other services.
+	api1.Spec.Endpoint.Routes[0].TargetPort = 8080
+	r2, err := ts.Client.UpdateService(context.Background(), &api.UpdateServiceRequest{
+		ServiceID:      api1.ID,
+		Spec:           &api1.Spec,
+		ServiceVersion: &api1.Meta.Version,
+	})
+	assert.NoError(t, err)
+	api1 = r2.Service
+
+	api1.Spec.Endpoint.Routes[0].PathPrefix = "/"
+	_, err = ts.Client.UpdateService(context.Background(), &api.UpdateServiceRequest{
+		ServiceID:      api1.ID,
+		Spec:           &api1.Spec,
+		ServiceVersion: &api1.Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	assert.Contains(t, err.Error(), web.ID)
+}
+
 func TestRemoveService(t *testing.T) {
 	ts := newTestServer(t)
 	defer ts.Stop()
@@ -915,6 +1155,32 @@ func TestListServices(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(r.Services))
 
+	// List with label filters.
+	r, err = ts.Client.ListServices(context.Background(), &api.ListServicesRequest{
+		Filters: &api.ListServicesRequest_Filters{
+			Labels: map[string]string{"common": ""},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(r.Services))
+
+	r, err = ts.Client.ListServices(context.Background(), &api.ListServicesRequest{
+		Filters: &api.ListServicesRequest_Filters{
+			Labels: map[string]string{"common": "", "unique": "name2"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r.Services))
+	assert.Equal(t, "name2", r.Services[0].Spec.Annotations.Name)
+
+	r, err = ts.Client.ListServices(context.Background(), &api.ListServicesRequest{
+		Filters: &api.ListServicesRequest_Filters{
+			Labels: map[string]string{"common": "no", "unique": "name2"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(r.Services))
+
 	// List with filter intersection.
 	r, err = ts.Client.ListServices(context.Background(),
 		&api.ListServicesRequest{
@@ -3,6 +3,7 @@ package controlapi
 import (
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/api/naming"
+	"github.com/docker/swarmkit/manager/audit"
 	"github.com/docker/swarmkit/manager/state/store"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -39,7 +40,10 @@ func (s *Server) RemoveTask(ctx context.Context, request *api.RemoveTaskRequest)
 	}
 
 	err := s.store.Update(func(tx store.Tx) error {
-		return store.DeleteTask(tx, request.TaskID)
+		if err := store.DeleteTask(tx, request.TaskID); err != nil {
+			return err
+		}
+		return audit.Record(ctx, tx)
 	})
 	if err != nil {
 		if err == store.ErrNotExist {
@@ -94,6 +98,8 @@ func (s *Server) ListTasks(ctx context.Context, request *api.ListTasksRequest) (
 				filters = append(filters, store.ByDesiredState(v))
 			}
 			tasks, err = store.FindTasks(tx, store.Or(filters...))
+		case request.Filters != nil && len(request.Filters.Labels) > 0:
+			tasks, err = store.FindTasks(tx, labelFilter(request.Filters.Labels))
 		default:
 			tasks, err = store.FindTasks(tx, store.All)
 		}
@@ -0,0 +1,320 @@
+package controlapi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/audit"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Transaction checks the preconditions in `TransactionRequest` and applies
+// its operations in a single store transaction: either all of them are
+// applied, or none is.
+// - Returns `InvalidArgument` if a precondition or an operation is malformed.
+// - Returns `FailedPrecondition` if a precondition doesn't hold.
+// - Returns the error of the first failing operation otherwise.
+func (s *Server) Transaction(ctx context.Context, request *api.TransactionRequest) (*api.TransactionResponse, error) {
+	for i, p := range request.Preconditions {
+		if err := validatePrecondition(p); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "precondition %d: %v", i, err)
+		}
+	}
+
+	// The checks which don't depend on the store are done before locking it
+	for i, op := range request.Operations {
+		if err := s.validateOperation(op); err != nil {
+			return nil, operationError(i, err)
+		}
+	}
+
+	results := make([]*api.TransactionResult, len(request.Operations))
+	err := s.store.Update(func(tx store.Tx) error {
+		for i, p := range request.Preconditions {
+			if err := checkPrecondition(tx, p); err != nil {
+				return grpc.Errorf(codes.FailedPrecondition, "precondition %d: %v", i, err)
+			}
+		}
+
+		objectIDs := make([]string, len(request.Operations))
+		for i, op := range request.Operations {
+			result, err := s.applyOperation(tx, op)
+			if err != nil {
+				return operationError(i, err)
+			}
+			results[i] = result
+			objectIDs[i] = createdObjectID(result)
+		}
+		return audit.Record(ctx, tx, objectIDs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if r := result.GetCreateSecret(); r != nil {
+			r.Secret.Spec.Data = nil // clean the actual secret data so it's never returned
+		}
+	}
+	return &api.TransactionResponse{Results: results}, nil
+}
+
+// createdObjectID returns the ID of the object created by an operation, if
+// any.
+func createdObjectID(result *api.TransactionResult) string {
+	switch r := result.Result.(type) {
+	case *api.TransactionResult_CreateNetwork:
+		return r.CreateNetwork.Network.ID
+	case *api.TransactionResult_CreateSecret:
+		return r.CreateSecret.Secret.ID
+	case *api.TransactionResult_CreateService:
+		return r.CreateService.Service.ID
+	}
+	return ""
+}
+
+// operationError prefixes the error of an operation with its index, keeping
+// its code.
+func operationError(i int, err error) error {
+	return grpc.Errorf(grpc.Code(err), "operation %d: %s", i, grpc.ErrorDesc(err))
+}
+
+func validatePrecondition(p *api.TransactionPrecondition) error {
+	if (p.ID == "") == (p.Name == "") {
+		return errors.New("exactly one of ID and name must be set")
+	}
+	if p.Absent && p.Version != nil {
+		return errors.New("a version can't be required for an absent object")
+	}
+	if _, ok := api.TransactionPrecondition_Kind_name[int32(p.Kind)]; !ok {
+		return fmt.Errorf("unknown object kind %d", p.Kind)
+	}
+	return nil
+}
+
+// checkPrecondition checks that a validated precondition holds.
+func checkPrecondition(tx store.ReadTx, p *api.TransactionPrecondition) error {
+	ref := p.ID
+	if ref == "" {
+		ref = p.Name
+	}
+	kind := strings.ToLower(p.Kind.String())
+
+	meta, err := preconditionObject(tx, p)
+	if err != nil {
+		return err
+	}
+	switch {
+	case p.Absent && meta != nil:
+		return fmt.Errorf("%s %s exists", kind, ref)
+	case p.Absent:
+		return nil
+	case meta == nil:
+		return fmt.Errorf("%s %s does not exist", kind, ref)
+	case p.Version != nil && meta.Version != *p.Version:
+		return fmt.Errorf("%s %s has version %d, not %d", kind, ref, meta.Version.Index, p.Version.Index)
+	}
+	return nil
+}
+
+// preconditionObject returns the metadata of the object a precondition
+// refers to, or nil if it doesn't exist.
+func preconditionObject(tx store.ReadTx, p *api.TransactionPrecondition) (*api.Meta, error) {
+	if p.ID != "" {
+		switch p.Kind {
+		case api.TransactionPrecondition_NODE:
+			if n := store.GetNode(tx, p.ID); n != nil {
+				return &n.Meta, nil
+			}
+		case api.TransactionPrecondition_SERVICE:
+			if s := store.GetService(tx, p.ID); s != nil {
+				return &s.Meta, nil
+			}
+		case api.TransactionPrecondition_NETWORK:
+			if n := store.GetNetwork(tx, p.ID); n != nil {
+				return &n.Meta, nil
+			}
+		case api.TransactionPrecondition_CLUSTER:
+			if c := store.GetCluster(tx, p.ID); c != nil {
+				return &c.Meta, nil
+			}
+		case api.TransactionPrecondition_SECRET:
+			if s := store.GetSecret(tx, p.ID); s != nil {
+				return &s.Meta, nil
+			}
+		}
+		return nil, nil
+	}
+
+	by := store.ByName(p.Name)
+	switch p.Kind {
+	case api.TransactionPrecondition_NODE:
+		nodes, err := store.FindNodes(tx, by)
+		if err != nil || len(nodes) == 0 {
+			return nil, err
+		}
+		return &nodes[0].Meta, nil
+	case api.TransactionPrecondition_SERVICE:
+		services, err := store.FindServices(tx, by)
+		if err != nil || len(services) == 0 {
+			return nil, err
+		}
+		return &services[0].Meta, nil
+	case api.TransactionPrecondition_NETWORK:
+		networks, err := store.FindNetworks(tx, by)
+		if err != nil || len(networks) == 0 {
+			return nil, err
+		}
+		return &networks[0].Meta, nil
+	case api.TransactionPrecondition_CLUSTER:
+		clusters, err := store.FindClusters(tx, by)
+		if err != nil || len(clusters) == 0 {
+			return nil, err
+		}
+		return &clusters[0].Meta, nil
+	case api.TransactionPrecondition_SECRET:
+		secrets, err := store.FindSecrets(tx, by)
+		if err != nil || len(secrets) == 0 {
+			return nil, err
+		}
+		return &secrets[0].Meta, nil
+	}
+	return nil, nil
+}
+
+// validateOperation does the checks of an operation which don't depend on the
+// store, as the corresponding RPC does. Service specs are only validated once
+// their references are resolved, in applyOperation.
+func (s *Server) validateOperation(op *api.TransactionOperation) error {
+	switch v := op.Operation.(type) {
+	case *api.TransactionOperation_CreateNetwork:
+		return s.validateCreateNetworkRequest(v.CreateNetwork)
+	case *api.TransactionOperation_CreateSecret:
+		return s.validateCreateSecretRequest(v.CreateSecret)
+	case *api.TransactionOperation_CreateService, *api.TransactionOperation_UpdateService,
+		*api.TransactionOperation_RemoveNetwork, *api.TransactionOperation_RemoveSecret, *api.TransactionOperation_RemoveService:
+		return nil
+	}
+	return grpc.Errorf(codes.InvalidArgument, "unknown operation")
+}
+
+// applyOperation applies a validated operation in tx.
+func (s *Server) applyOperation(tx store.Tx, op *api.TransactionOperation) (*api.TransactionResult, error) {
+	switch v := op.Operation.(type) {
+	case *api.TransactionOperation_CreateNetwork:
+		n, err := createNetworkInTx(tx, v.CreateNetwork.Spec)
+		if err != nil {
+			return nil, err
+		}
+		return &api.TransactionResult{Result: &api.TransactionResult_CreateNetwork{
+			CreateNetwork: &api.CreateNetworkResponse{Network: n},
+		}}, nil
+	case *api.TransactionOperation_RemoveNetwork:
+		if err := removeNetworkInTx(tx, v.RemoveNetwork.NetworkID); err != nil {
+			return nil, err
+		}
+		return &api.TransactionResult{Result: &api.TransactionResult_RemoveNetwork{
+			RemoveNetwork: &api.RemoveNetworkResponse{},
+		}}, nil
+	case *api.TransactionOperation_CreateSecret:
+		secret, err := createSecretInTx(tx, v.CreateSecret.Spec)
+		if err != nil {
+			return nil, err
+		}
+		return &api.TransactionResult{Result: &api.TransactionResult_CreateSecret{
+			CreateSecret: &api.CreateSecretResponse{Secret: secret},
+		}}, nil
+	case *api.TransactionOperation_RemoveSecret:
+		if err := removeSecretInTx(tx, v.RemoveSecret.SecretID); err != nil {
+			return nil, err
+		}
+		return &api.TransactionResult{Result: &api.TransactionResult_RemoveSecret{
+			RemoveSecret: &api.RemoveSecretResponse{},
+		}}, nil
+	case *api.TransactionOperation_CreateService:
+		spec := v.CreateService.Spec.Copy()
+		if err := resolveTransactionReferences(tx, spec); err != nil {
+			return nil, err
+		}
+		if err := validateServiceSpec(spec); err != nil {
+			return nil, err
+		}
+		service, err := s.createServiceInTx(tx, spec)
+		if err != nil {
+			return nil, err
+		}
+		return &api.TransactionResult{Result: &api.TransactionResult_CreateService{
+			CreateService: &api.CreateServiceResponse{Service: service},
+		}}, nil
+	case *api.TransactionOperation_UpdateService:
+		request := v.UpdateService.Copy()
+		if err := resolveTransactionReferences(tx, request.Spec); err != nil {
+			return nil, err
+		}
+		if err := validateUpdateServiceRequest(request); err != nil {
+			return nil, err
+		}
+		service, err := s.updateServiceInTx(tx, request)
+		if err != nil {
+			return nil, err
+		}
+		return &api.TransactionResult{Result: &api.TransactionResult_UpdateService{
+			UpdateService: &api.UpdateServiceResponse{Service: service},
+		}}, nil
+	case *api.TransactionOperation_RemoveService:
+		if err := removeServiceInTx(tx, v.RemoveService.ServiceID); err != nil {
+			return nil, err
+		}
+		return &api.TransactionResult{Result: &api.TransactionResult_RemoveService{
+			RemoveService: &api.RemoveServiceResponse{},
+		}}, nil
+	}
+	return nil, grpc.Errorf(codes.InvalidArgument, "unknown operation")
+}
+
+// resolveTransactionReferences replaces the names of networks and secrets in
+// a service spec with their IDs, so that a transaction can refer to the ones
+// it creates.
+func resolveTransactionReferences(tx store.ReadTx, spec *api.ServiceSpec) error {
+	if spec == nil {
+		return nil
+	}
+
+	for _, networks := range [][]*api.NetworkAttachmentConfig{spec.Networks, spec.Task.Networks} {
+		for _, na := range networks {
+			if store.GetNetwork(tx, na.Target) != nil {
+				continue
+			}
+			found, err := store.FindNetworks(tx, store.ByName(na.Target))
+			if err != nil {
+				return err
+			}
+			if len(found) == 1 {
+				na.Target = found[0].ID
+			}
+		}
+	}
+
+	container := spec.Task.GetContainer()
+	if container == nil {
+		return nil
+	}
+	for _, secretRef := range container.Secrets {
+		if secretRef.SecretID != "" {
+			continue
+		}
+		found, err := store.FindSecrets(tx, store.ByName(secretRef.SecretName))
+		if err != nil {
+			return err
+		}
+		if len(found) == 1 {
+			secretRef.SecretID = found[0].ID
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package controlapi
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func createStackOperations(name string) []*api.TransactionOperation {
+	spec := createServiceInNetworkSpec(name, "image", name+"-net", 1)
+	spec.Task.GetContainer().Secrets = []*api.SecretReference{{
+		SecretName: name + "-secret",
+		Target: &api.SecretReference_File{
+			File: &api.SecretReference_FileTarget{Name: "secret"},
+		},
+	}}
+
+	return []*api.TransactionOperation{
+		{Operation: &api.TransactionOperation_CreateNetwork{
+			CreateNetwork: &api.CreateNetworkRequest{Spec: createNetworkSpec(name + "-net")},
+		}},
+		{Operation: &api.TransactionOperation_CreateSecret{
+			CreateSecret: &api.CreateSecretRequest{Spec: createSecretSpec(name+"-secret", []byte("data"), nil)},
+		}},
+		{Operation: &api.TransactionOperation_CreateService{
+			CreateService: &api.CreateServiceRequest{Spec: spec},
+		}},
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	// The service refers to the network and secret created before it by name
+	resp, err := ts.Client.Transaction(context.Background(), &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{{
+			Kind:   api.TransactionPrecondition_SERVICE,
+			Name:   "app",
+			Absent: true,
+		}},
+		Operations: createStackOperations("app"),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	network := resp.Results[0].GetCreateNetwork().Network
+	secret := resp.Results[1].GetCreateSecret().Secret
+	service := resp.Results[2].GetCreateService().Service
+	assert.Nil(t, secret.Spec.Data)
+	assert.Equal(t, network.ID, service.Spec.Networks[0].Target)
+	assert.Equal(t, secret.ID, service.Spec.Task.GetContainer().Secrets[0].SecretID)
+
+	// The same transaction fails as a whole now that the service exists
+	_, err = ts.Client.Transaction(context.Background(), &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{{
+			Kind:   api.TransactionPrecondition_SERVICE,
+			Name:   "app",
+			Absent: true,
+		}},
+		Operations: createStackOperations("app"),
+	})
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+
+	// If an operation fails, none is applied
+	operations := createStackOperations("other")
+	operations[2].GetCreateService().Spec.Task.GetContainer().Secrets[0].SecretName = "missing"
+	_, err = ts.Client.Transaction(context.Background(), &api.TransactionRequest{Operations: operations})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	assert.Contains(t, grpc.ErrorDesc(err), "operation 2: ")
+	ts.Store.View(func(tx store.ReadTx) {
+		networks, err := store.FindNetworks(tx, store.ByName("other-net"))
+		assert.NoError(t, err)
+		assert.Empty(t, networks)
+		secrets, err := store.FindSecrets(tx, store.ByName("other-secret"))
+		assert.NoError(t, err)
+		assert.Empty(t, secrets)
+	})
+
+	// Updates can be conditioned on the version of another object
+	update := &api.TransactionOperation{Operation: &api.TransactionOperation_UpdateService{
+		UpdateService: &api.UpdateServiceRequest{
+			ServiceID:      service.ID,
+			ServiceVersion: &service.Meta.Version,
+			Spec:           &service.Spec,
+		},
+	}}
+	staleVersion := network.Meta.Version
+	staleVersion.Index++
+	_, err = ts.Client.Transaction(context.Background(), &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{{
+			Kind:    api.TransactionPrecondition_NETWORK,
+			ID:      network.ID,
+			Version: &staleVersion,
+		}},
+		Operations: []*api.TransactionOperation{update},
+	})
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+
+	resp, err = ts.Client.Transaction(context.Background(), &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{{
+			Kind:    api.TransactionPrecondition_NETWORK,
+			ID:      network.ID,
+			Version: &network.Meta.Version,
+		}},
+		Operations: []*api.TransactionOperation{update},
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, service.Meta.Version, resp.Results[0].GetUpdateService().Service.Meta.Version)
+
+	// Malformed preconditions are rejected
+	_, err = ts.Client.Transaction(context.Background(), &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{{
+			Kind:    api.TransactionPrecondition_NETWORK,
+			ID:      network.ID,
+			Version: &network.Meta.Version,
+			Absent:  true,
+		}},
+	})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	_, err = ts.Client.Transaction(context.Background(), &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{{
+			Kind: api.TransactionPrecondition_NETWORK,
+		}},
+	})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// Removals are atomic too
+	_, err = ts.Client.Transaction(context.Background(), &api.TransactionRequest{
+		Operations: []*api.TransactionOperation{
+			{Operation: &api.TransactionOperation_RemoveService{
+				RemoveService: &api.RemoveServiceRequest{ServiceID: service.ID},
+			}},
+			{Operation: &api.TransactionOperation_RemoveSecret{
+				RemoveSecret: &api.RemoveSecretRequest{SecretID: secret.ID},
+			}},
+			{Operation: &api.TransactionOperation_RemoveNetwork{
+				RemoveNetwork: &api.RemoveNetworkRequest{NetworkID: network.ID},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	ts.Store.View(func(tx store.ReadTx) {
+		assert.Nil(t, store.GetService(tx, service.ID))
+		assert.Nil(t, store.GetSecret(tx, secret.ID))
+		assert.Nil(t, store.GetNetwork(tx, network.ID))
+	})
+}
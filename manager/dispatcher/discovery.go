@@ -0,0 +1,259 @@
+package dispatcher
+
+import (
+	"net"
+	"reflect"
+	"sort"
+
+	"github.com/docker/go-events"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/state"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+)
+
+// discoveryUpdate is published on the discovery queue when the discovery
+// record of a service changes. record is nil if the service was removed.
+type discoveryUpdate struct {
+	serviceID string
+	record    *api.DiscoveryRecord
+}
+
+// compileDiscoveryRecord builds the discovery record of service s from its
+// virtual IPs and the addresses of its running tasks.
+func compileDiscoveryRecord(tx store.ReadTx, s *api.Service) (*api.DiscoveryRecord, error) {
+	record := &api.DiscoveryRecord{
+		ServiceID:   s.ID,
+		ServiceName: s.Spec.Annotations.Name,
+	}
+
+	networks := make(map[string]*api.DiscoveryRecord_Network)
+	network := func(id string) *api.DiscoveryRecord_Network {
+		n, ok := networks[id]
+		if !ok {
+			n = &api.DiscoveryRecord_Network{NetworkID: id}
+			networks[id] = n
+		}
+		return n
+	}
+
+	if s.Endpoint != nil {
+		record.Ports = s.Endpoint.Ports
+		for _, vip := range s.Endpoint.VirtualIPs {
+			if ip := addressIP(vip.Addr); ip != "" {
+				network(vip.NetworkID).VirtualIP = ip
+			}
+		}
+	}
+
+	tasks, err := store.FindTasks(tx, store.ByServiceID(s.ID))
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tasks {
+		if t.Status.State != api.TaskStateRunning || t.DesiredState > api.TaskStateRunning {
+			continue
+		}
+		for _, na := range t.Networks {
+			if na.Network == nil {
+				continue
+			}
+			for _, addr := range na.Addresses {
+				if ip := addressIP(addr); ip != "" {
+					n := network(na.Network.ID)
+					n.TaskAddresses = append(n.TaskAddresses, ip)
+				}
+			}
+		}
+	}
+
+	for _, n := range networks {
+		sort.Strings(n.TaskAddresses)
+		record.Networks = append(record.Networks, n)
+	}
+	sort.Sort(discoveryNetworks(record.Networks))
+
+	return record, nil
+}
+
+// addressIP returns the IP of an address in CIDR format, or an empty string
+// if the address is invalid.
+func addressIP(addr string) string {
+	ip, _, err := net.ParseCIDR(addr)
+	if err != nil {
+		return ""
+	}
+	return ip.String()
+}
+
+type discoveryNetworks []*api.DiscoveryRecord_Network
+
+func (n discoveryNetworks) Len() int { return len(n) }
+
+func (n discoveryNetworks) Swap(i, j int) { n[i], n[j] = n[j], n[i] }
+
+func (n discoveryNetworks) Less(i, j int) bool { return n[i].NetworkID < n[j].NetworkID }
+
+// compileDiscoveryRecords builds the discovery records of all services.
+func compileDiscoveryRecords(tx store.ReadTx) (map[string]*api.DiscoveryRecord, error) {
+	services, err := store.FindServices(tx, store.All)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[string]*api.DiscoveryRecord, len(services))
+	for _, s := range services {
+		record, err := compileDiscoveryRecord(tx, s)
+		if err != nil {
+			return nil, err
+		}
+		records[s.ID] = record
+	}
+	return records, nil
+}
+
+// updateDiscoveryRecords compiles the discovery records of the given
+// services and publishes the ones that changed.
+func (d *Dispatcher) updateDiscoveryRecords(ctx context.Context, serviceIDs map[string]struct{}) {
+	updates := make(map[string]*api.DiscoveryRecord, len(serviceIDs))
+	var err error
+	d.store.View(func(readTx store.ReadTx) {
+		for id := range serviceIDs {
+			s := store.GetService(readTx, id)
+			if s == nil {
+				updates[id] = nil
+				continue
+			}
+			var record *api.DiscoveryRecord
+			record, err = compileDiscoveryRecord(readTx, s)
+			if err != nil {
+				return
+			}
+			updates[id] = record
+		}
+	})
+	if err != nil {
+		log.G(ctx).WithError(err).Error("failed to compile discovery records")
+		return
+	}
+
+	// The queue is published to with the lock held, so that streams
+	// reading the records and starting to watch the queue under the lock
+	// don't miss or duplicate updates.
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for id, record := range updates {
+		old, exists := d.discoveryRecords[id]
+		if record == nil {
+			if !exists {
+				continue
+			}
+			delete(d.discoveryRecords, id)
+		} else {
+			if reflect.DeepEqual(old, record) {
+				continue
+			}
+			d.discoveryRecords[id] = record
+		}
+		d.discoveryQueue.Publish(discoveryUpdate{serviceID: id, record: record})
+	}
+}
+
+// watchDiscoveryRecords returns the current discovery records and a channel
+// receiving the discoveryUpdates that follow.
+func (d *Dispatcher) watchDiscoveryRecords() ([]*api.DiscoveryRecord, chan events.Event, func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	records := make([]*api.DiscoveryRecord, 0, len(d.discoveryRecords))
+	for _, record := range d.discoveryRecords {
+		records = append(records, record)
+	}
+	updates, cancel := d.discoveryQueue.Watch()
+	return records, updates, cancel
+}
+
+// eventServiceID returns the ID of the service affected by a service or task
+// event.
+func eventServiceID(ev events.Event) string {
+	switch v := ev.(type) {
+	case state.EventCreateService:
+		return v.Service.ID
+	case state.EventUpdateService:
+		return v.Service.ID
+	case state.EventDeleteService:
+		return v.Service.ID
+	case state.EventCreateTask:
+		return v.Task.ServiceID
+	case state.EventUpdateTask:
+		return v.Task.ServiceID
+	case state.EventDeleteTask:
+		return v.Task.ServiceID
+	}
+	return ""
+}
+
+// maxDiscoveryMessageSize bounds the encoded size of the discovery records
+// sent in a single assignments message, well below the gRPC message size
+// limit, so that large clusters don't fail to send their first message.
+const maxDiscoveryMessageSize = 1 << 20
+
+// taskNetworks returns the IDs of the networks the tasks which aren't done
+// yet are attached to.
+func taskNetworks(tasks map[string]*api.Task) map[string]struct{} {
+	networks := make(map[string]struct{})
+	for _, t := range tasks {
+		if t.Status.State > api.TaskStateRunning {
+			continue
+		}
+		for _, na := range t.Networks {
+			if na.Network != nil {
+				networks[na.Network.ID] = struct{}{}
+			}
+		}
+	}
+	return networks
+}
+
+// nodeDiscoveryRecord returns the part of record about the given networks,
+// which is all a node whose tasks are attached to those networks can use. It
+// returns nil if the service isn't on any of them.
+func nodeDiscoveryRecord(record *api.DiscoveryRecord, networks map[string]struct{}) *api.DiscoveryRecord {
+	if record == nil {
+		return nil
+	}
+
+	var recordNetworks []*api.DiscoveryRecord_Network
+	for _, n := range record.Networks {
+		if _, ok := networks[n.NetworkID]; ok {
+			recordNetworks = append(recordNetworks, n)
+		}
+	}
+	if len(recordNetworks) == 0 {
+		return nil
+	}
+
+	nodeRecord := *record
+	nodeRecord.Networks = recordNetworks
+	return &nodeRecord
+}
+
+// splitRecordChanges splits discovery record changes among assignments
+// messages. The first message also holds changes, and each message holds
+// at most maxSize bytes of record changes, or a single larger one.
+func splitRecordChanges(changes, records []*api.AssignmentChange, maxSize int) [][]*api.AssignmentChange {
+	messages := [][]*api.AssignmentChange{changes}
+	size := 0
+	for _, record := range records {
+		last := len(messages) - 1
+		if size > 0 && size+record.Size() > maxSize {
+			messages = append(messages, nil)
+			last++
+			size = 0
+		}
+		messages[last] = append(messages[last], record)
+		size += record.Size()
+	}
+	return messages
+}
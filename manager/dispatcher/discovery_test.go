@@ -0,0 +1,80 @@
+package dispatcher
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeDiscoveryRecord(t *testing.T) {
+	record := &api.DiscoveryRecord{
+		ServiceID:   "service1",
+		ServiceName: "web",
+		Networks: []*api.DiscoveryRecord_Network{
+			{NetworkID: "net1", VirtualIP: "10.0.0.2"},
+			{NetworkID: "net2", VirtualIP: "10.0.1.2"},
+		},
+	}
+
+	networks := taskNetworks(map[string]*api.Task{
+		"task1": {
+			Status:   api.TaskStatus{State: api.TaskStateRunning},
+			Networks: []*api.NetworkAttachment{{Network: &api.Network{ID: "net2"}}},
+		},
+		// Tasks which are done don't need records anymore.
+		"task2": {
+			Status:   api.TaskStatus{State: api.TaskStateShutdown},
+			Networks: []*api.NetworkAttachment{{Network: &api.Network{ID: "net3"}}},
+		},
+	})
+	assert.Equal(t, map[string]struct{}{"net2": {}}, networks)
+
+	assert.Equal(t, &api.DiscoveryRecord{
+		ServiceID:   "service1",
+		ServiceName: "web",
+		Networks:    []*api.DiscoveryRecord_Network{{NetworkID: "net2", VirtualIP: "10.0.1.2"}},
+	}, nodeDiscoveryRecord(record, networks))
+	assert.Len(t, record.Networks, 2)
+
+	assert.Nil(t, nodeDiscoveryRecord(record, map[string]struct{}{"net3": {}}))
+	assert.Nil(t, nodeDiscoveryRecord(nil, networks))
+}
+
+func TestSplitRecordChanges(t *testing.T) {
+	taskChange := &api.AssignmentChange{
+		Assignment: &api.Assignment{Item: &api.Assignment_Task{Task: &api.Task{ID: "task1"}}},
+		Action:     api.AssignmentChange_AssignmentActionUpdate,
+	}
+	var records []*api.AssignmentChange
+	for i := 0; i < 10; i++ {
+		records = append(records, &api.AssignmentChange{
+			Assignment: &api.Assignment{Item: &api.Assignment_DiscoveryRecord{
+				DiscoveryRecord: &api.DiscoveryRecord{ServiceID: fmt.Sprintf("service%d", i)},
+			}},
+			Action: api.AssignmentChange_AssignmentActionUpdate,
+		})
+	}
+	size := records[0].Size()
+
+	// Everything fits in a single message.
+	messages := splitRecordChanges([]*api.AssignmentChange{taskChange}, records, 10*size)
+	assert.Len(t, messages, 1)
+	assert.Len(t, messages[0], 11)
+
+	// Records are spread among messages of at most 3 records, the first
+	// one also holding the other changes.
+	messages = splitRecordChanges([]*api.AssignmentChange{taskChange}, records, 3*size)
+	assert.Len(t, messages, 4)
+	assert.Equal(t, append([]*api.AssignmentChange{taskChange}, records[:3]...), messages[0])
+	assert.Equal(t, records[3:6], messages[1])
+	assert.Equal(t, records[9:], messages[3])
+
+	// Records larger than the limit are sent on their own.
+	messages = splitRecordChanges(nil, records[:2], 1)
+	assert.Equal(t, [][]*api.AssignmentChange{records[:1], records[1:2]}, messages)
+
+	messages = splitRecordChanges(nil, nil, size)
+	assert.Equal(t, [][]*api.AssignmentChange{nil}, messages)
+}
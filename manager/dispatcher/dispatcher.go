@@ -3,6 +3,7 @@ package dispatcher
 import (
 	"fmt"
 	"net"
+	"reflect"
 	"strconv"
 	"sync"
 	"time"
@@ -16,7 +17,9 @@ import (
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/api/equality"
 	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/identity"
 	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/drivers"
 	"github.com/docker/swarmkit/manager/state"
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/docker/swarmkit/remotes"
@@ -24,6 +27,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -38,6 +42,10 @@ const (
 	// trigger an actual transaction to commit them to the shared store.
 	maxBatchItems = 10000
 
+	// defaultMaxPendingTaskUpdates is the default number of task status
+	// updates held before new ones are rejected.
+	defaultMaxPendingTaskUpdates = 5 * maxBatchItems
+
 	// maxBatchInterval needs to strike a balance between keeping
 	// latency low, and realizing opportunities to combine many writes
 	// into a single transaction. A fraction of a second feels about
@@ -51,6 +59,10 @@ const (
 	// wait before moving tasks assigned to down nodes to ORPHANED
 	// state.
 	defaultNodeDownPeriod = 24 * time.Hour
+
+	// defaultSecretDriverTimeout is the default time a secret driver has
+	// to return the value of a secret.
+	defaultSecretDriverTimeout = 10 * time.Second
 )
 
 var (
@@ -76,6 +88,18 @@ type Config struct {
 	// new session.
 	RateLimitPeriod       time.Duration
 	GracePeriodMultiplier int
+	// TaskStatusRateLimit is the number of task status updates per second
+	// each node may report. Zero means no limit.
+	TaskStatusRateLimit rate.Limit
+	// TaskStatusBurst is the number of task status updates a node may
+	// report at once. Zero means the rate limit is used as the burst.
+	TaskStatusBurst int
+	// MaxPendingTaskUpdates is the number of task status updates held
+	// before new ones are rejected with ResourceExhausted.
+	MaxPendingTaskUpdates int
+	// SecretDriverTimeout is the time a secret driver has to return the
+	// value of a secret before the secret is left out of the assignments.
+	SecretDriverTimeout time.Duration
 }
 
 // DefaultConfig returns default config for Dispatcher.
@@ -85,6 +109,8 @@ func DefaultConfig() *Config {
 		HeartbeatEpsilon:      defaultHeartBeatEpsilon,
 		RateLimitPeriod:       defaultRateLimitPeriod,
 		GracePeriodMultiplier: defaultGracePeriodMultiplier,
+		MaxPendingTaskUpdates: defaultMaxPendingTaskUpdates,
+		SecretDriverTimeout:   defaultSecretDriverTimeout,
 	}
 }
 
@@ -113,8 +139,13 @@ type Dispatcher struct {
 	lastSeenManagers     []*api.WeightedPeer
 	networkBootstrapKeys []*api.EncryptionKey
 	keyMgrQueue          *watch.Queue
+	routingTable         *api.RoutingTable
+	routingQueue         *watch.Queue
+	discoveryRecords     map[string]*api.DiscoveryRecord // indexed by service ID
+	discoveryQueue       *watch.Queue
 	config               *Config
 	cluster              Cluster
+	dp                   *drivers.DriverProvider
 	ctx                  context.Context
 	cancel               context.CancelFunc
 
@@ -134,12 +165,13 @@ type Dispatcher struct {
 }
 
 // New returns Dispatcher with cluster interface(usually raft.Node).
-func New(cluster Cluster, c *Config) *Dispatcher {
+func New(cluster Cluster, c *Config, dp *drivers.DriverProvider) *Dispatcher {
 	d := &Dispatcher{
 		nodes:                 newNodeStore(c.HeartbeatPeriod, c.HeartbeatEpsilon, c.GracePeriodMultiplier, c.RateLimitPeriod),
 		downNodes:             newNodeStore(defaultNodeDownPeriod, 0, 1, 0),
 		store:                 cluster.MemoryStore(),
 		cluster:               cluster,
+		dp:                    dp,
 		taskUpdates:           make(map[string]*api.TaskStatus),
 		nodeUpdates:           make(map[string]nodeUpdate),
 		processUpdatesTrigger: make(chan struct{}, 1),
@@ -151,6 +183,92 @@ func New(cluster Cluster, c *Config) *Dispatcher {
 	return d
 }
 
+// fetchSecretValue returns the secret to send to the node running t. If the
+// secret is provided by a driver, its value is fetched from the driver and set
+// on a copy of the secret, so that it is never stored. The driver has
+// SecretDriverTimeout to answer.
+func (d *Dispatcher) fetchSecretValue(ctx context.Context, secret *api.Secret, t *api.Task) (*api.Secret, error) {
+	if secret.Spec.Driver == nil {
+		return secret, nil
+	}
+	if d.dp == nil {
+		return nil, fmt.Errorf("secret driver %s is not available", secret.Spec.Driver.Name)
+	}
+	if t == nil {
+		return nil, fmt.Errorf("no task to fetch the value of secret %s for", secret.ID)
+	}
+
+	timeout := d.config.SecretDriverTimeout
+	if timeout == 0 {
+		timeout = defaultSecretDriverTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type result struct {
+		value []byte
+		err   error
+	}
+	// The plugin client can't be cancelled, so the call is left to
+	// finish on its own if the driver doesn't answer in time.
+	results := make(chan result, 1)
+	go func() {
+		driver, err := d.dp.NewSecretDriver(secret.Spec.Driver)
+		if err != nil {
+			results <- result{err: err}
+			return
+		}
+		value, err := driver.Get(&secret.Spec, t)
+		results <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-results:
+		if r.err != nil {
+			return nil, r.err
+		}
+		secret = secret.Copy()
+		secret.Spec.Data = r.value
+		return secret, nil
+	case <-ctx.Done():
+		return nil, errors.Wrapf(ctx.Err(), "secret driver %s", secret.Spec.Driver.Name)
+	}
+}
+
+// fetchSecretValues fetches the values of the secrets provided by drivers
+// concurrently, for the task each secret is sent for in secretTasks. Secrets
+// whose value can't be fetched are left out. This calls out to plugins, so it
+// must not be called while the store is locked.
+func (d *Dispatcher) fetchSecretValues(ctx context.Context, secrets []*api.Secret, secretTasks map[string]*api.Task) []*api.Secret {
+	fetched := make([]*api.Secret, len(secrets))
+	var wg sync.WaitGroup
+	for i, secret := range secrets {
+		if secret.Spec.Driver == nil {
+			fetched[i] = secret
+			continue
+		}
+		wg.Add(1)
+		go func(i int, secret *api.Secret, t *api.Task) {
+			defer wg.Done()
+			withValue, err := d.fetchSecretValue(ctx, secret, t)
+			if err != nil {
+				log.G(ctx).WithError(err).WithField("secret.id", secret.ID).Errorf("error fetching secret value from driver")
+				return
+			}
+			fetched[i] = withValue
+		}(i, secret, secretTasks[secret.ID])
+	}
+	wg.Wait()
+
+	var result []*api.Secret
+	for _, secret := range fetched {
+		if secret != nil {
+			result = append(result, secret)
+		}
+	}
+	return result
+}
+
 func getWeightedPeers(cluster Cluster) []*api.WeightedPeer {
 	members := cluster.GetMemberlist()
 	var mgrs []*api.WeightedPeer
@@ -197,6 +315,7 @@ func (d *Dispatcher) Run(ctx context.Context) error {
 				if clusters[0].NetworkBootstrapKeys != nil {
 					d.networkBootstrapKeys = clusters[0].NetworkBootstrapKeys
 				}
+				d.setTaskStatusLimits(clusters[0].Spec.Dispatcher)
 			}
 			return nil
 		},
@@ -206,9 +325,30 @@ func (d *Dispatcher) Run(ctx context.Context) error {
 		d.mu.Unlock()
 		return err
 	}
+	serviceWatcher, serviceCancel, err := store.ViewAndWatch(
+		d.store,
+		func(readTx store.ReadTx) error {
+			var err error
+			d.routingTable, err = compileRoutingTable(readTx)
+			if err != nil {
+				return err
+			}
+			d.discoveryRecords, err = compileDiscoveryRecords(readTx)
+			return err
+		},
+		serviceEvents...,
+	)
+	if err != nil {
+		cancel()
+		d.mu.Unlock()
+		return err
+	}
+	defer serviceCancel()
 	// set queues here to guarantee that Close will close them
 	d.mgrQueue = watch.NewQueue()
 	d.keyMgrQueue = watch.NewQueue()
+	d.routingQueue = watch.NewQueue()
+	d.discoveryQueue = watch.NewQueue()
 
 	peerWatcher, peerCancel := d.cluster.SubscribePeers()
 	defer peerCancel()
@@ -238,6 +378,12 @@ func (d *Dispatcher) Run(ctx context.Context) error {
 	batchTimer := time.NewTimer(maxBatchInterval)
 	defer batchTimer.Stop()
 
+	var (
+		serviceTimeout     <-chan time.Time
+		updatedServiceIDs  = make(map[string]struct{})
+		routerTasksChanged bool
+	)
+
 	for {
 		select {
 		case ev := <-peerWatcher:
@@ -260,15 +406,43 @@ func (d *Dispatcher) Run(ctx context.Context) error {
 					d.nodes.updatePeriod(d.config.HeartbeatPeriod, d.config.HeartbeatEpsilon, d.config.GracePeriodMultiplier)
 				}
 			}
+			d.setTaskStatusLimits(cluster.Cluster.Spec.Dispatcher)
 			d.networkBootstrapKeys = cluster.Cluster.NetworkBootstrapKeys
 			d.mu.Unlock()
 			d.keyMgrQueue.Publish(cluster.Cluster.NetworkBootstrapKeys)
+		case ev := <-serviceWatcher:
+			if serviceID := eventServiceID(ev); serviceID != "" {
+				updatedServiceIDs[serviceID] = struct{}{}
+			}
+			if isRouterTaskEvent(ev) {
+				routerTasksChanged = true
+			}
+			if serviceTimeout == nil {
+				serviceTimeout = time.After(serviceUpdateInterval)
+			}
+		case <-serviceTimeout:
+			serviceTimeout = nil
+			d.updateRoutingTable(ctx, routerTasksChanged)
+			d.updateDiscoveryRecords(ctx, updatedServiceIDs)
+			updatedServiceIDs = make(map[string]struct{})
+			routerTasksChanged = false
 		case <-ctx.Done():
 			return nil
 		}
 	}
 }
 
+// setTaskStatusLimits applies the task status limits of the cluster-level
+// dispatcher config. It must be called with d.mu held.
+func (d *Dispatcher) setTaskStatusLimits(c api.DispatcherConfig) {
+	d.config.TaskStatusRateLimit = rate.Limit(c.TaskStatusRateLimit)
+	d.config.TaskStatusBurst = int(c.TaskStatusBurst)
+	d.config.MaxPendingTaskUpdates = int(c.MaxPendingTaskUpdates)
+	if d.config.MaxPendingTaskUpdates == 0 {
+		d.config.MaxPendingTaskUpdates = defaultMaxPendingTaskUpdates
+	}
+}
+
 // Stop stops dispatcher and closes all grpc streams.
 func (d *Dispatcher) Stop() error {
 	d.mu.Lock()
@@ -289,6 +463,8 @@ func (d *Dispatcher) Stop() error {
 
 	d.mgrQueue.Close()
 	d.keyMgrQueue.Close()
+	d.routingQueue.Close()
+	d.discoveryQueue.Close()
 
 	d.wg.Wait()
 
@@ -347,6 +523,7 @@ func (d *Dispatcher) markNodesUnknown(ctx context.Context) error {
 				expireFunc := func() {
 					log := log.WithField("node", nodeID)
 					log.Debugf("heartbeat expiration for unknown node")
+					heartbeatMissesCounter.WithLabelValues("unknown").Inc()
 					if err := d.markNodeNotReady(nodeID, api.NodeStatus_DOWN, `heartbeat failure for node in "unknown" state`); err != nil {
 						log.WithError(err).Errorf(`failed deregistering node after heartbeat expiration for node in "unknown" state`)
 					}
@@ -466,6 +643,7 @@ func (d *Dispatcher) register(ctx context.Context, nodeID string, description *a
 
 	expireFunc := func() {
 		log.G(ctx).Debugf("heartbeat expiration")
+		heartbeatMissesCounter.WithLabelValues("ready").Inc()
 		if err := d.markNodeNotReady(nodeID, api.NodeStatus_DOWN, "heartbeat failure"); err != nil {
 			log.G(ctx).WithError(err).Errorf("failed deregistering node after heartbeat expiration")
 		}
@@ -508,7 +686,8 @@ func (d *Dispatcher) UpdateTaskStatus(ctx context.Context, r *api.UpdateTaskStat
 		return nil, err
 	}
 
-	if _, err := d.nodes.GetWithSession(nodeID, r.SessionID); err != nil {
+	rn, err := d.nodes.GetWithSession(nodeID, r.SessionID)
+	if err != nil {
 		return nil, err
 	}
 
@@ -535,7 +714,41 @@ func (d *Dispatcher) UpdateTaskStatus(ctx context.Context, r *api.UpdateTaskStat
 		}
 	}
 
+	d.mu.Lock()
+	limit, burst, maxPending := d.config.TaskStatusRateLimit, d.config.TaskStatusBurst, d.config.MaxPendingTaskUpdates
+	d.mu.Unlock()
+
 	d.taskUpdatesLock.Lock()
+	// Updates of tasks which already have a pending update replace it, so
+	// only the others count against the limits.
+	newUpdates := 0
+	for _, u := range r.Updates {
+		if u.Status == nil {
+			continue
+		}
+		if _, ok := d.taskUpdates[u.TaskID]; !ok {
+			newUpdates++
+		}
+	}
+
+	if newUpdates > 0 {
+		if maxPending > 0 && len(d.taskUpdates)+newUpdates > maxPending {
+			d.taskUpdatesLock.Unlock()
+			rejectedTaskUpdatesCounter.WithLabelValues("queue_full").Inc()
+			// make sure the pending updates are being flushed
+			select {
+			case d.processUpdatesTrigger <- struct{}{}:
+			default:
+			}
+			return nil, grpc.Errorf(codes.ResourceExhausted, "too many pending task status updates")
+		}
+		if !rn.allowTaskStatusUpdates(newUpdates, limit, burst) {
+			d.taskUpdatesLock.Unlock()
+			rejectedTaskUpdatesCounter.WithLabelValues("rate_limit").Inc()
+			return nil, grpc.Errorf(codes.ResourceExhausted, "node %s exceeded the task status update rate limit", nodeID)
+		}
+	}
+
 	// Enqueue task updates
 	for _, u := range r.Updates {
 		if u.Status == nil {
@@ -546,6 +759,7 @@ func (d *Dispatcher) UpdateTaskStatus(ctx context.Context, r *api.UpdateTaskStat
 
 	numUpdates := len(d.taskUpdates)
 	d.taskUpdatesLock.Unlock()
+	pendingTaskUpdatesGauge.Set(float64(numUpdates))
 
 	if numUpdates >= maxBatchItems {
 		select {
@@ -565,6 +779,7 @@ func (d *Dispatcher) processUpdates(ctx context.Context) {
 	if len(d.taskUpdates) != 0 {
 		taskUpdates = d.taskUpdates
 		d.taskUpdates = make(map[string]*api.TaskStatus)
+		pendingTaskUpdatesGauge.Set(0)
 	}
 	d.taskUpdatesLock.Unlock()
 
@@ -819,6 +1034,12 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 	)
 	tasksMap := make(map[string]*api.Task)
 	tasksUsingSecret := make(map[string]map[string]struct{})
+	// The values of secrets provided by drivers are fetched for each task
+	// using them, and sent to the node under an ID combining the secret
+	// and task IDs. driverSecrets holds these secrets by secret ID, and
+	// secretTasks the task each of their copies is sent for.
+	driverSecrets := make(map[string]*api.Secret)
+	secretTasks := make(map[string]*api.Task)
 
 	sendMessage := func(msg api.AssignmentsMessage, assignmentType api.AssignmentsMessage_Type) error {
 		sequence++
@@ -833,6 +1054,15 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 		return nil
 	}
 
+	// returns the copy of a secret provided by a driver to send for t
+	addTaskSecret := func(secret *api.Secret, t *api.Task) *api.Secret {
+		taskSecret := secret.Copy()
+		taskSecret.ID = identity.CombineTwoIDs(secret.ID, t.ID)
+		tasksUsingSecret[taskSecret.ID] = map[string]struct{}{t.ID: {}}
+		secretTasks[taskSecret.ID] = t
+		return taskSecret
+	}
+
 	// returns a slice of new secrets to send down
 	addSecretsForTask := func(readTx store.ReadTx, t *api.Task) []*api.Secret {
 		container := t.Spec.GetContainer()
@@ -852,6 +1082,11 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 				"secret.name": secretRef.SecretName,
 			})
 
+			if secret, ok := driverSecrets[secretID]; ok {
+				newSecrets = append(newSecrets, addTaskSecret(secret, t))
+				continue
+			}
+
 			if len(tasksUsingSecret[secretID]) == 0 {
 				tasksUsingSecret[secretID] = make(map[string]struct{})
 
@@ -865,6 +1100,13 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 					continue
 				}
 
+				if secrets[0].Spec.Driver != nil {
+					delete(tasksUsingSecret, secretID)
+					driverSecrets[secretID] = secrets[0]
+					newSecrets = append(newSecrets, addTaskSecret(secrets[0], t))
+					continue
+				}
+
 				// If the secret was found and there was one result
 				// (there should never be more than one because of the
 				// uniqueness constraint), add this secret to our
@@ -879,6 +1121,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 
 	// TODO(aaronl): Also send node secrets that should be exposed to
 	// this node.
+	var initialSecrets []*api.Secret
 	nodeTasks, cancel, err := store.ViewAndWatch(
 		d.store,
 		func(readTx store.ReadTx) error {
@@ -909,19 +1152,7 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 				initial.Changes = append(initial.Changes, taskChange)
 				// Only send secrets down if these tasks are in < RUNNING
 				if t.Status.State <= api.TaskStateRunning {
-					newSecrets := addSecretsForTask(readTx, t)
-					for _, secret := range newSecrets {
-						secretChange := &api.AssignmentChange{
-							Assignment: &api.Assignment{
-								Item: &api.Assignment_Secret{
-									Secret: secret,
-								},
-							},
-							Action: api.AssignmentChange_AssignmentActionUpdate,
-						}
-
-						initial.Changes = append(initial.Changes, secretChange)
-					}
+					initialSecrets = append(initialSecrets, addSecretsForTask(readTx, t)...)
 				}
 			}
 			return nil
@@ -938,8 +1169,60 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 	}
 	defer cancel()
 
-	if err := sendMessage(initial, api.AssignmentsMessage_COMPLETE); err != nil {
-		return err
+	for _, secret := range d.fetchSecretValues(stream.Context(), initialSecrets, secretTasks) {
+		secretChange := &api.AssignmentChange{
+			Assignment: &api.Assignment{
+				Item: &api.Assignment_Secret{
+					Secret: secret,
+				},
+			},
+			Action: api.AssignmentChange_AssignmentActionUpdate,
+		}
+
+		initial.Changes = append(initial.Changes, secretChange)
+	}
+
+	records, discoveryUpdates, discoveryCancel := d.watchDiscoveryRecords()
+	defer discoveryCancel()
+
+	// The node only receives the discovery records of the networks its
+	// tasks are attached to. allRecords holds the complete records of all
+	// services, and sentRecords the records sent to the node.
+	allRecords := make(map[string]*api.DiscoveryRecord, len(records))
+	sentRecords := make(map[string]*api.DiscoveryRecord)
+	recordNetworks := taskNetworks(tasksMap)
+
+	var recordChanges []*api.AssignmentChange
+	for _, record := range records {
+		allRecords[record.ServiceID] = record
+		nodeRecord := nodeDiscoveryRecord(record, recordNetworks)
+		if nodeRecord == nil {
+			continue
+		}
+		sentRecords[record.ServiceID] = nodeRecord
+
+		recordChange := &api.AssignmentChange{
+			Assignment: &api.Assignment{
+				Item: &api.Assignment_DiscoveryRecord{
+					DiscoveryRecord: nodeRecord,
+				},
+			},
+			Action: api.AssignmentChange_AssignmentActionUpdate,
+		}
+
+		recordChanges = append(recordChanges, recordChange)
+	}
+
+	// The records which don't fit in the complete message follow in
+	// incremental ones.
+	for i, changes := range splitRecordChanges(initial.Changes, recordChanges, maxDiscoveryMessageSize) {
+		assignmentType := api.AssignmentsMessage_COMPLETE
+		if i > 0 {
+			assignmentType = api.AssignmentsMessage_INCREMENTAL
+		}
+		if err := sendMessage(api.AssignmentsMessage{Changes: changes}, assignmentType); err != nil {
+			return err
+		}
 	}
 
 	for {
@@ -958,6 +1241,11 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 			updateSecrets   = make(map[string]*api.Secret)
 			removeTasks     = make(map[string]struct{})
 			removeSecrets   = make(map[string]struct{})
+			// services whose discovery records changed
+			updateRecords = make(map[string]struct{})
+			// secrets whose values are fetched from drivers once the
+			// batch is complete
+			fetchSecrets []*api.Secret
 		)
 
 		oneModification := func() {
@@ -981,10 +1269,14 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 
 			for _, secretRef := range container.Secrets {
 				secretID := secretRef.SecretID
+				if taskSecretID := identity.CombineTwoIDs(secretID, t.ID); tasksUsingSecret[taskSecretID] != nil {
+					secretID = taskSecretID
+				}
 				delete(tasksUsingSecret[secretID], t.ID)
 				if len(tasksUsingSecret[secretID]) == 0 {
 					// No tasks are using the secret anymore
 					delete(tasksUsingSecret, secretID)
+					delete(secretTasks, secretID)
 					removeSecrets[secretID] = struct{}{}
 					modified = true
 				}
@@ -1037,13 +1329,9 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 						// add the secrets it references to the secrets assignment.
 						// Task states > RUNNING are worker reported only, are never created in
 						// a > RUNNING state.
-						var newSecrets []*api.Secret
 						d.store.View(func(readTx store.ReadTx) {
-							newSecrets = addSecretsForTask(readTx, v.Task)
+							fetchSecrets = append(fetchSecrets, addSecretsForTask(readTx, v.Task)...)
 						})
-						for _, secret := range newSecrets {
-							updateSecrets[secret.ID] = secret
-						}
 					}
 					tasksMap[v.Task.ID] = v.Task
 					updateTasks[v.Task.ID] = v.Task
@@ -1075,12 +1363,27 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 						v.Secret.Spec.Annotations.Name, v.Secret.ID)
 
 				case state.EventDeleteSecret:
+					delete(driverSecrets, v.Secret.ID)
 					if _, exists := tasksUsingSecret[v.Secret.ID]; !exists {
 						continue
 					}
 					log.Debugf("Secret %s (ID: %d) was deleted though it was still referenced by one or more tasks",
 						v.Secret.Spec.Annotations.Name, v.Secret.ID)
 				}
+			case event, ok := <-discoveryUpdates:
+				if !ok {
+					// the queue is only closed when the dispatcher stops
+					return dctx.Err()
+				}
+				u := event.(discoveryUpdate)
+				if u.record == nil {
+					delete(allRecords, u.serviceID)
+				} else {
+					allRecords[u.serviceID] = u.record
+				}
+				updateRecords[u.serviceID] = struct{}{}
+
+				oneModification()
 			case <-batchingTimeout:
 				break batchingLoop
 			case <-stream.Context().Done():
@@ -1094,6 +1397,10 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 			batchingTimer.Stop()
 		}
 
+		for _, secret := range d.fetchSecretValues(stream.Context(), fetchSecrets, secretTasks) {
+			updateSecrets[secret.ID] = secret
+		}
+
 		if modificationCnt > 0 {
 			for id, task := range updateTasks {
 				if _, ok := removeTasks[id]; !ok {
@@ -1160,8 +1467,65 @@ func (d *Dispatcher) Assignments(r *api.AssignmentsRequest, stream api.Dispatche
 				update.Changes = append(update.Changes, secretChange)
 			}
 
-			if err := sendMessage(update, api.AssignmentsMessage_INCREMENTAL); err != nil {
-				return err
+			// The records the node needs change with the networks of
+			// its tasks.
+			if networks := taskNetworks(tasksMap); !reflect.DeepEqual(networks, recordNetworks) {
+				recordNetworks = networks
+				for id := range allRecords {
+					updateRecords[id] = struct{}{}
+				}
+				for id := range sentRecords {
+					updateRecords[id] = struct{}{}
+				}
+			}
+
+			var recordChanges []*api.AssignmentChange
+			for id := range updateRecords {
+				nodeRecord := nodeDiscoveryRecord(allRecords[id], recordNetworks)
+				if nodeRecord == nil {
+					if _, ok := sentRecords[id]; !ok {
+						continue
+					}
+					delete(sentRecords, id)
+
+					recordChange := &api.AssignmentChange{
+						Assignment: &api.Assignment{
+							Item: &api.Assignment_DiscoveryRecord{
+								DiscoveryRecord: &api.DiscoveryRecord{ServiceID: id},
+							},
+						},
+						Action: api.AssignmentChange_AssignmentActionRemove,
+					}
+
+					recordChanges = append(recordChanges, recordChange)
+					continue
+				}
+				if reflect.DeepEqual(nodeRecord, sentRecords[id]) {
+					continue
+				}
+				sentRecords[id] = nodeRecord
+
+				recordChange := &api.AssignmentChange{
+					Assignment: &api.Assignment{
+						Item: &api.Assignment_DiscoveryRecord{
+							DiscoveryRecord: nodeRecord,
+						},
+					},
+					Action: api.AssignmentChange_AssignmentActionUpdate,
+				}
+
+				recordChanges = append(recordChanges, recordChange)
+			}
+
+			for _, changes := range splitRecordChanges(update.Changes, recordChanges, maxDiscoveryMessageSize) {
+				// Discovery record updates of services on other
+				// networks result in no change for the node.
+				if len(changes) == 0 {
+					continue
+				}
+				if err := sendMessage(api.AssignmentsMessage{Changes: changes}, api.AssignmentsMessage_INCREMENTAL); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -1324,6 +1688,9 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 		}
 	}
 
+	sessionsGauge.Inc()
+	defer sessionsGauge.Dec()
+
 	fields := logrus.Fields{
 		"node.id":      nodeID,
 		"node.session": sessionID,
@@ -1353,11 +1720,21 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 		return err
 	}
 
+	// Watch the routing table before reading it, so that no update is
+	// missed.
+	routingUpdates, routingCancel := d.routingQueue.Watch()
+	defer routingCancel()
+	routing, err := d.getNodeRoutingTable(nodeID)
+	if err != nil {
+		return err
+	}
+
 	if err := stream.Send(&api.SessionMessage{
 		SessionID:            sessionID,
 		Node:                 nodeObj,
 		Managers:             d.getManagers(),
 		NetworkBootstrapKeys: d.getNetworkBootstrapKeys(),
+		RoutingTable:         routing,
 	}); err != nil {
 		return err
 	}
@@ -1414,6 +1791,17 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 			disconnect = true
 		case ev := <-keyMgrUpdates:
 			netKeys = ev.([]*api.EncryptionKey)
+		case <-routingUpdates:
+			nodeRouting, err := d.getNodeRoutingTable(nodeID)
+			if err != nil {
+				return err
+			}
+			// Only nodes running router tasks receive the routing
+			// table, and only when their entries change.
+			if reflect.DeepEqual(nodeRouting, routing) {
+				continue
+			}
+			routing = nodeRouting
 		}
 		if mgrs == nil {
 			mgrs = d.getManagers()
@@ -1427,6 +1815,7 @@ func (d *Dispatcher) Session(r *api.SessionRequest, stream api.Dispatcher_Sessio
 			Node:                 nodeObj,
 			Managers:             mgrs,
 			NetworkBootstrapKeys: netKeys,
+			RoutingTable:         routing,
 		}); err != nil {
 			return err
 		}
@@ -2,8 +2,12 @@ package dispatcher
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,13 +17,18 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/docker/docker/pkg/plugingetter"
+	"github.com/docker/docker/pkg/plugins"
 	"github.com/docker/go-events"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/ca"
 	"github.com/docker/swarmkit/ca/testutils"
+	"github.com/docker/swarmkit/identity"
+	"github.com/docker/swarmkit/manager/drivers"
 	raftutils "github.com/docker/swarmkit/manager/state/raft/testutils"
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type grpcDispatcher struct {
@@ -74,6 +83,10 @@ func (t *testCluster) MemoryStore() *store.MemoryStore {
 }
 
 func startDispatcher(c *Config) (*grpcDispatcher, error) {
+	return startDispatcherWithDrivers(c, nil)
+}
+
+func startDispatcherWithDrivers(c *Config, dp *drivers.DriverProvider) (*grpcDispatcher, error) {
 	l, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
 		return nil, err
@@ -97,7 +110,7 @@ func startDispatcher(c *Config) (*grpcDispatcher, error) {
 
 	s := grpc.NewServer(serverOpts...)
 	tc := &testCluster{addr: l.Addr().String(), store: tca.MemoryStore}
-	d := New(tc, c)
+	d := New(tc, c, dp)
 
 	authorize := func(ctx context.Context, roles []string) error {
 		_, err := ca.AuthorizeForwardedRoleAndOrg(ctx, roles, []string{ca.ManagerRole}, tca.Organization, nil)
@@ -449,6 +462,90 @@ func TestAssignmentsInitialNodeTasks(t *testing.T) {
 	}
 }
 
+func TestAssignmentsDiscoveryRecords(t *testing.T) {
+	t.Parallel()
+
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	expectedSessionID, nodeID := getSessionAndNodeID(t, gd.Clients[0])
+
+	service := func(id, network, vip string) *api.Service {
+		return &api.Service{
+			ID:   id,
+			Spec: api.ServiceSpec{Annotations: api.Annotations{Name: id}},
+			Endpoint: &api.Endpoint{
+				VirtualIPs: []*api.Endpoint_VirtualIP{{NetworkID: network, Addr: vip}},
+			},
+		}
+	}
+	task := func(id, serviceID, nodeID, network, address string) *api.Task {
+		return &api.Task{
+			ID:           id,
+			ServiceID:    serviceID,
+			NodeID:       nodeID,
+			DesiredState: api.TaskStateRunning,
+			Status:       api.TaskStatus{State: api.TaskStateRunning},
+			Networks: []*api.NetworkAttachment{{
+				Network:   &api.Network{ID: network},
+				Addresses: []string{address},
+			}},
+		}
+	}
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateService(tx, service("service1", "net1", "10.0.0.2/24")))
+		assert.NoError(t, store.CreateService(tx, service("service2", "net2", "10.0.1.2/24")))
+		assert.NoError(t, store.CreateTask(tx, task("task1", "service1", "othernode", "net1", "10.0.0.3/24")))
+		assert.NoError(t, store.CreateTask(tx, task("task2", "service2", "othernode", "net2", "10.0.1.3/24")))
+		// the node only runs a task on net1
+		assert.NoError(t, store.CreateTask(tx, task("task3", "client", nodeID, "net1", "10.0.0.4/24")))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// wait for the dispatcher to compile the records
+	time.Sleep(serviceUpdateInterval + 200*time.Millisecond)
+
+	stream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{SessionID: expectedSessionID})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, api.AssignmentsMessage_COMPLETE, resp.Type)
+	var records []*api.DiscoveryRecord
+	for _, change := range resp.Changes {
+		if record := change.Assignment.GetDiscoveryRecord(); record != nil {
+			assert.Equal(t, api.AssignmentChange_AssignmentActionUpdate, change.Action)
+			records = append(records, record)
+		}
+	}
+	assert.Equal(t, []*api.DiscoveryRecord{{
+		ServiceID:   "service1",
+		ServiceName: "service1",
+		Networks: []*api.DiscoveryRecord_Network{{
+			NetworkID:     "net1",
+			VirtualIP:     "10.0.0.2",
+			TaskAddresses: []string{"10.0.0.3"},
+		}},
+	}}, records)
+
+	// Changes to services on other networks are not sent.
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.DeleteService(tx, "service2"))
+		return store.DeleteService(tx, "service1")
+	})
+	assert.NoError(t, err)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, api.AssignmentsMessage_INCREMENTAL, resp.Type)
+	assert.Len(t, resp.Changes, 1)
+	assert.Equal(t, api.AssignmentChange_AssignmentActionRemove, resp.Changes[0].Action)
+	assert.Equal(t, "service1", resp.Changes[0].Assignment.GetDiscoveryRecord().ServiceID)
+}
+
 // As tasks are added, assignments will send down tasks > ASSIGNED, and any secrets
 // for said tasks that are <= RUNNING (if the secrets exist)
 func TestAssignmentsAddingTasks(t *testing.T) {
@@ -624,6 +721,234 @@ func TestAssignmentsSecretUpdateAndDeletion(t *testing.T) {
 	}
 }
 
+type testSecretPlugin struct {
+	client *plugins.Client
+}
+
+func (p *testSecretPlugin) Client() *plugins.Client { return p.client }
+func (p *testSecretPlugin) Name() string            { return "secretdriver" }
+func (p *testSecretPlugin) BasePath() string        { return "" }
+func (p *testSecretPlugin) IsV1() bool              { return false }
+
+type testSecretPluginGetter struct {
+	plugin plugingetter.CompatPlugin
+}
+
+func (pg *testSecretPluginGetter) Get(name, capability string, mode int) (plugingetter.CompatPlugin, error) {
+	if name != pg.plugin.Name() || capability != drivers.SecretsProviderCapability {
+		return nil, plugins.ErrNotFound
+	}
+	return pg.plugin, nil
+}
+
+func (pg *testSecretPluginGetter) GetAllByCap(capability string) ([]plugingetter.CompatPlugin, error) {
+	return nil, nil
+}
+
+func (pg *testSecretPluginGetter) GetAllManagedPluginsByCap(capability string) []plugingetter.CompatPlugin {
+	return nil
+}
+
+func (pg *testSecretPluginGetter) Handle(capability string, callback func(string, *plugins.Client)) {}
+
+// startSecretDriver starts an HTTP stand-in for a secrets provider plugin
+// named "secretdriver". It returns the name of the secret followed by the ID
+// of the task it is requested for, fails for secrets named "fail", and blocks
+// until the returned function stops the driver for secrets named "block".
+func startSecretDriver(t *testing.T) (func(), *drivers.DriverProvider) {
+	blocked := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req drivers.SecretsProviderRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var resp drivers.SecretsProviderResponse
+		switch req.SecretName {
+		case "fail":
+			resp.Err = "secret not found"
+		case "block":
+			<-blocked
+			return
+		default:
+			resp.Value = []byte(req.SecretName + "-" + req.TaskID)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+
+	client, err := plugins.NewClient("tcp://"+strings.TrimPrefix(server.URL, "http://"), nil)
+	require.NoError(t, err)
+	stop := func() {
+		close(blocked)
+		server.Close()
+	}
+	return stop, drivers.New(&testSecretPluginGetter{plugin: &testSecretPlugin{client: client}})
+}
+
+func driverSecret(id, name string) *api.Secret {
+	return &api.Secret{
+		ID: id,
+		Spec: api.SecretSpec{
+			Annotations: api.Annotations{Name: name},
+			Driver:      &api.Driver{Name: "secretdriver"},
+		},
+	}
+}
+
+func TestFetchSecretValue(t *testing.T) {
+	t.Parallel()
+
+	stop, dp := startSecretDriver(t)
+	defer stop()
+
+	config := DefaultConfig()
+	config.SecretDriverTimeout = 500 * time.Millisecond
+	d := &Dispatcher{config: config, dp: dp}
+	task := &api.Task{ID: "task1"}
+
+	// secrets which aren't provided by a driver are returned as they are
+	secret := &api.Secret{ID: "secret", Spec: api.SecretSpec{Data: []byte("data")}}
+	fetched, err := d.fetchSecretValue(context.Background(), secret, task)
+	require.NoError(t, err)
+	assert.Equal(t, secret, fetched)
+
+	// the value is set on a copy of the secret
+	secret = driverSecret("secret", "password")
+	fetched, err = d.fetchSecretValue(context.Background(), secret, task)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("password-task1"), fetched.Spec.Data)
+	assert.Nil(t, secret.Spec.Data)
+
+	// errors of the driver are returned
+	_, err = d.fetchSecretValue(context.Background(), driverSecret("secret", "fail"), task)
+	assert.EqualError(t, err, "secret not found")
+
+	_, err = d.fetchSecretValue(context.Background(), &api.Secret{
+		ID: "secret",
+		Spec: api.SecretSpec{
+			Annotations: api.Annotations{Name: "password"},
+			Driver:      &api.Driver{Name: "unknown"},
+		},
+	}, task)
+	assert.Error(t, err)
+
+	// a driver which doesn't answer in time times out
+	start := time.Now()
+	_, err = d.fetchSecretValue(context.Background(), driverSecret("secret", "block"), task)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+	assert.True(t, time.Since(start) < 5*time.Second)
+
+	// secrets which can't be fetched are left out, the others are kept
+	secrets := []*api.Secret{
+		driverSecret("secret1", "password"),
+		driverSecret("secret2", "fail"),
+		driverSecret("secret3", "block"),
+		{ID: "secret4", Spec: api.SecretSpec{Data: []byte("data")}},
+	}
+	fetchedSecrets := d.fetchSecretValues(context.Background(), secrets, map[string]*api.Task{
+		"secret1": task,
+		"secret2": task,
+		"secret3": task,
+	})
+	require.Len(t, fetchedSecrets, 2)
+	assert.Equal(t, "secret1", fetchedSecrets[0].ID)
+	assert.Equal(t, []byte("password-task1"), fetchedSecrets[0].Spec.Data)
+	assert.Equal(t, "secret4", fetchedSecrets[1].ID)
+}
+
+// Secrets provided by drivers are fetched and sent for each task, under an ID
+// combining the secret and task IDs.
+func TestAssignmentsSecretDriver(t *testing.T) {
+	t.Parallel()
+
+	stop, dp := startSecretDriver(t)
+	defer stop()
+
+	config := DefaultConfig()
+	config.SecretDriverTimeout = 500 * time.Millisecond
+	gd, err := startDispatcherWithDrivers(config, dp)
+	require.NoError(t, err)
+	defer gd.Close()
+
+	expectedSessionID, nodeID := getSessionAndNodeID(t, gd.Clients[0])
+
+	secrets := []*api.Secret{
+		driverSecret("IDsecret1", "password"),
+		driverSecret("IDsecret2", "fail"),
+		driverSecret("IDsecret3", "block"),
+	}
+	tasks := []*api.Task{
+		{
+			ID:     "task1",
+			NodeID: nodeID,
+			Status: api.TaskStatus{State: api.TaskStateAssigned},
+			Spec:   taskSpecFromSecrets(secrets...),
+		},
+		{
+			ID:     "task2",
+			NodeID: nodeID,
+			Status: api.TaskStatus{State: api.TaskStateAssigned},
+			Spec:   taskSpecFromSecrets(secrets[0]),
+		},
+	}
+	err = gd.Store.Update(func(tx store.Tx) error {
+		for _, secret := range secrets {
+			assert.NoError(t, store.CreateSecret(tx, secret))
+		}
+		assert.NoError(t, store.CreateTask(tx, tasks[0]))
+		return nil
+	})
+	require.NoError(t, err)
+
+	stream, err := gd.Clients[0].Assignments(context.Background(), &api.AssignmentsRequest{SessionID: expectedSessionID})
+	require.NoError(t, err)
+	defer stream.CloseSend()
+
+	// only the secret which could be fetched is sent with the task
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	taskChanges, secretChanges := collectTasksAndSecrets(resp.Changes)
+	assert.Len(t, taskChanges, 1)
+	require.Len(t, secretChanges, 1)
+	secret := secretChanges[idAndAction{id: identity.CombineTwoIDs("IDsecret1", "task1"), action: api.AssignmentChange_AssignmentActionUpdate}]
+	require.NotNil(t, secret)
+	assert.Equal(t, []byte("password-task1"), secret.Spec.Data)
+
+	// the store never holds the value
+	gd.Store.View(func(readTx store.ReadTx) {
+		assert.Nil(t, store.GetSecret(readTx, "IDsecret1").Spec.Data)
+	})
+
+	// a new task using the same secret gets its own value
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateTask(tx, tasks[1]))
+		assert.NoError(t, store.UpdateTask(tx, tasks[1]))
+		return nil
+	})
+	require.NoError(t, err)
+
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	taskChanges, secretChanges = collectTasksAndSecrets(resp.Changes)
+	assert.Len(t, taskChanges, 1)
+	require.Len(t, secretChanges, 1)
+	secret = secretChanges[idAndAction{id: identity.CombineTwoIDs("IDsecret1", "task2"), action: api.AssignmentChange_AssignmentActionUpdate}]
+	require.NotNil(t, secret)
+	assert.Equal(t, []byte("password-task2"), secret.Spec.Data)
+
+	// removing a task only removes its own copy of the secret
+	err = gd.Store.Update(func(tx store.Tx) error {
+		return store.DeleteTask(tx, "task2")
+	})
+	require.NoError(t, err)
+
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	taskChanges, secretChanges = collectTasksAndSecrets(resp.Changes)
+	assert.Len(t, taskChanges, 1)
+	assert.Len(t, secretChanges, 1)
+	assert.NotNil(t, secretChanges[idAndAction{id: identity.CombineTwoIDs("IDsecret1", "task2"), action: api.AssignmentChange_AssignmentActionRemove}])
+}
+
 func TestTasksStatusChange(t *testing.T) {
 	t.Parallel()
 
@@ -952,6 +1277,68 @@ func TestTaskUpdateNoCert(t *testing.T) {
 	assert.EqualError(t, err, "rpc error: code = 7 desc = Permission denied: unauthorized peer role: rpc error: code = 7 desc = no client certificates in request")
 }
 
+func TestTaskUpdateRateLimit(t *testing.T) {
+	gd, err := startDispatcher(DefaultConfig())
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	err = gd.Store.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, gd.testCA.Organization)
+		require.NotNil(t, cluster)
+		cluster.Spec.Dispatcher.TaskStatusRateLimit = 1
+		cluster.Spec.Dispatcher.TaskStatusBurst = 2
+		return store.UpdateCluster(tx, cluster)
+	})
+	require.NoError(t, err)
+	require.NoError(t, raftutils.PollFuncWithTimeout(nil, func() error {
+		gd.dispatcherServer.mu.Lock()
+		defer gd.dispatcherServer.mu.Unlock()
+		if gd.dispatcherServer.config.TaskStatusBurst != 2 {
+			return fmt.Errorf("task status limits not applied")
+		}
+		return nil
+	}, 5*time.Second))
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	require.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	nodeID := resp.Node.ID
+
+	var updates []*api.UpdateTaskStatusRequest_TaskStatusUpdate
+	err = gd.Store.Update(func(tx store.Tx) error {
+		for i := 0; i < 3; i++ {
+			task := &api.Task{
+				ID:     fmt.Sprintf("testTask%d", i),
+				NodeID: nodeID,
+			}
+			assert.NoError(t, store.CreateTask(tx, task))
+			updates = append(updates, &api.UpdateTaskStatusRequest_TaskStatusUpdate{
+				TaskID: task.ID,
+				Status: &api.TaskStatus{State: api.TaskStateAssigned},
+			})
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	// the first two updates fit in the burst
+	_, err = gd.Clients[0].UpdateTaskStatus(context.Background(), &api.UpdateTaskStatusRequest{
+		SessionID: resp.SessionID,
+		Updates:   updates[:2],
+	})
+	assert.NoError(t, err)
+
+	// the next one exceeds the rate limit
+	_, err = gd.Clients[0].UpdateTaskStatus(context.Background(), &api.UpdateTaskStatusRequest{
+		SessionID: resp.SessionID,
+		Updates:   updates[2:],
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, grpc.Code(err))
+}
+
 func TestSession(t *testing.T) {
 	cfg := DefaultConfig()
 	gd, err := startDispatcher(cfg)
@@ -967,6 +1354,73 @@ func TestSession(t *testing.T) {
 	assert.Equal(t, 1, len(resp.Managers))
 }
 
+func TestSessionRoutingTable(t *testing.T) {
+	cfg := DefaultConfig()
+	gd, err := startDispatcher(cfg)
+	assert.NoError(t, err)
+	defer gd.Close()
+
+	stream, err := gd.Clients[0].Session(context.Background(), &api.SessionRequest{})
+	assert.NoError(t, err)
+	defer stream.CloseSend()
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	// The node runs no router task.
+	assert.Nil(t, resp.RoutingTable)
+
+	err = gd.Store.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateService(tx, &api.Service{
+			ID: "service1",
+			Spec: api.ServiceSpec{
+				Annotations: api.Annotations{Name: "web"},
+				Task: api.TaskSpec{
+					Networks: []*api.NetworkAttachmentConfig{{Target: "net1"}},
+				},
+				Endpoint: &api.EndpointSpec{
+					Routes: []*api.Route{{Hostnames: []string{"example.com"}, TargetPort: 8080}},
+				},
+			},
+		}))
+		assert.NoError(t, store.CreateTask(tx, &api.Task{
+			ID:           "task1",
+			ServiceID:    "service1",
+			DesiredState: api.TaskStateRunning,
+			Status:       api.TaskStatus{State: api.TaskStateRunning},
+			Networks: []*api.NetworkAttachment{{
+				Network:   &api.Network{ID: "net1"},
+				Addresses: []string{"10.0.0.3/24"},
+			}},
+		}))
+		assert.NoError(t, store.CreateTask(tx, &api.Task{
+			ID:           "router1",
+			ServiceID:    "router",
+			NodeID:       gd.SecurityConfigs[0].ClientTLSCreds.NodeID(),
+			DesiredState: api.TaskStateRunning,
+			Spec: api.TaskSpec{
+				Runtime: &api.TaskSpec_Router{Router: &api.RouterSpec{ListenPort: 80, Image: "router"}},
+			},
+			Networks: []*api.NetworkAttachment{{
+				Network:   &api.Network{ID: "net1"},
+				Addresses: []string{"10.0.0.9/24"},
+			}},
+		}))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, &api.RoutingTable{
+		Entries: []*api.RoutingTable_Entry{{
+			ServiceID:  "service1",
+			Hostnames:  []string{"example.com"},
+			PathPrefix: "/",
+			Backends:   []string{"10.0.0.3:8080"},
+			NetworkID:  "net1",
+		}},
+	}, resp.RoutingTable)
+}
+
 func TestSessionNoCert(t *testing.T) {
 	cfg := DefaultConfig()
 	gd, err := startDispatcher(cfg)
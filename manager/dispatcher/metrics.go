@@ -0,0 +1,40 @@
+package dispatcher
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	pendingTaskUpdatesGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "swarm",
+		Subsystem: "dispatcher",
+		Name:      "pending_task_updates",
+		Help:      "Number of task status updates waiting to be written to the store.",
+	})
+
+	rejectedTaskUpdatesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "swarm",
+		Subsystem: "dispatcher",
+		Name:      "rejected_task_updates_total",
+		Help:      "Number of task status update requests rejected, by reason.",
+	}, []string{"reason"})
+
+	sessionsGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "swarm",
+		Subsystem: "dispatcher",
+		Name:      "sessions",
+		Help:      "Number of open agent sessions.",
+	})
+
+	heartbeatMissesCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "swarm",
+		Subsystem: "dispatcher",
+		Name:      "heartbeat_misses_total",
+		Help:      "Number of nodes marked down after missing their heartbeat, by node state.",
+	}, []string{"state"})
+)
+
+func init() {
+	prometheus.MustRegister(pendingTaskUpdatesGauge)
+	prometheus.MustRegister(rejectedTaskUpdatesCounter)
+	prometheus.MustRegister(sessionsGauge)
+	prometheus.MustRegister(heartbeatMissesCounter)
+}
@@ -1,6 +1,7 @@
 package dispatcher
 
 import (
+	"math"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/identity"
 	"github.com/docker/swarmkit/manager/dispatcher/heartbeat"
+	"golang.org/x/time/rate"
 )
 
 const rateLimitCount = 3
@@ -22,6 +24,35 @@ type registeredNode struct {
 	Node       *api.Node
 	Disconnect chan struct{} // signal to disconnect
 	mu         sync.Mutex
+
+	// statusLimiter limits the rate of the task status updates reported by
+	// the node. It is created on the first update.
+	statusLimiter *rate.Limiter
+}
+
+// allowTaskStatusUpdates reports whether the node may report n new task
+// status updates now, given the current limit and burst. A report of more
+// updates than the burst consumes the whole burst.
+func (rn *registeredNode) allowTaskStatusUpdates(n int, limit rate.Limit, burst int) bool {
+	if limit == 0 {
+		return true
+	}
+	if burst == 0 {
+		burst = int(math.Ceil(float64(limit)))
+	}
+	if n > burst {
+		n = burst
+	}
+
+	rn.mu.Lock()
+	defer rn.mu.Unlock()
+
+	if rn.statusLimiter == nil || rn.statusLimiter.Burst() != burst {
+		rn.statusLimiter = rate.NewLimiter(limit, burst)
+	} else if rn.statusLimiter.Limit() != limit {
+		rn.statusLimiter.SetLimit(limit)
+	}
+	return rn.statusLimiter.AllowN(time.Now(), n)
 }
 
 // checkSessionID determines if the SessionID has changed and returns the
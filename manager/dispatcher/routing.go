@@ -0,0 +1,221 @@
+package dispatcher
+
+import (
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/docker/go-events"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/state"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+)
+
+// serviceUpdateInterval is how long the dispatcher waits after a service or
+// task change before compiling the routing table and discovery records
+// again, so that a burst of changes results in a single update being sent to
+// the agents.
+const serviceUpdateInterval = time.Second
+
+// serviceEvents are the store events which may change the routing table or
+// the discovery records.
+var serviceEvents = []state.Event{
+	state.EventCreateService{},
+	state.EventUpdateService{},
+	state.EventDeleteService{},
+	state.EventCreateTask{},
+	state.EventUpdateTask{},
+	state.EventDeleteTask{},
+}
+
+// compileRoutingTable builds the routing table of the built-in router from
+// the routes of all services and the addresses of their running tasks.
+func compileRoutingTable(tx store.ReadTx) (*api.RoutingTable, error) {
+	services, err := store.FindServices(tx, store.All)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &api.RoutingTable{}
+	for _, s := range services {
+		if s.Spec.Endpoint == nil || len(s.Spec.Endpoint.Routes) == 0 {
+			continue
+		}
+
+		tasks, err := store.FindTasks(tx, store.ByServiceID(s.ID))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, route := range s.Spec.Endpoint.Routes {
+			entry := &api.RoutingTable_Entry{
+				ServiceID:  s.ID,
+				Hostnames:  route.Hostnames,
+				PathPrefix: route.PathPrefix,
+				NetworkID:  routeNetwork(s, route),
+			}
+			if entry.PathPrefix == "" {
+				entry.PathPrefix = "/"
+			}
+
+			for _, t := range tasks {
+				if t.Status.State != api.TaskStateRunning || t.DesiredState > api.TaskStateRunning {
+					continue
+				}
+				for _, na := range t.Networks {
+					if na.Network == nil || na.Network.ID != entry.NetworkID {
+						continue
+					}
+					for _, addr := range na.Addresses {
+						ip, _, err := net.ParseCIDR(addr)
+						if err != nil {
+							continue
+						}
+						entry.Backends = append(entry.Backends, net.JoinHostPort(ip.String(), strconv.Itoa(int(route.TargetPort))))
+					}
+				}
+			}
+			sort.Strings(entry.Backends)
+
+			table.Entries = append(table.Entries, entry)
+		}
+	}
+	sort.Stable(routingEntries(table.Entries))
+
+	return table, nil
+}
+
+// routeNetwork returns the ID of the network whose task addresses the route
+// proxies to.
+func routeNetwork(s *api.Service, route *api.Route) string {
+	if route.Network != "" {
+		return route.Network
+	}
+	networks := s.Spec.Task.Networks
+	if len(networks) == 0 {
+		networks = s.Spec.Networks
+	}
+	if len(networks) == 0 {
+		return ""
+	}
+	return networks[0].Target
+}
+
+// routingEntries sorts routing table entries by decreasing path prefix
+// length, then by service ID.
+type routingEntries []*api.RoutingTable_Entry
+
+func (e routingEntries) Len() int { return len(e) }
+
+func (e routingEntries) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+
+func (e routingEntries) Less(i, j int) bool {
+	if len(e[i].PathPrefix) != len(e[j].PathPrefix) {
+		return len(e[i].PathPrefix) > len(e[j].PathPrefix)
+	}
+	return e[i].ServiceID < e[j].ServiceID
+}
+
+// isRouterTaskEvent returns whether ev is an event about a router task, which
+// may change the routing table of the node the task is assigned to.
+func isRouterTaskEvent(ev events.Event) bool {
+	var t *api.Task
+	switch v := ev.(type) {
+	case state.EventCreateTask:
+		t = v.Task
+	case state.EventUpdateTask:
+		t = v.Task
+	case state.EventDeleteTask:
+		t = v.Task
+	default:
+		return false
+	}
+	return t.Spec.GetRouter() != nil
+}
+
+// nodeRoutingTable returns the entries of the routing table the router tasks
+// assigned to a node can route to, which are the ones on the networks those
+// tasks are attached to. It returns nil if no router task is assigned to the
+// node.
+func nodeRoutingTable(tx store.ReadTx, nodeID string, table *api.RoutingTable) (*api.RoutingTable, error) {
+	tasks, err := store.FindTasks(tx, store.ByNodeID(nodeID))
+	if err != nil {
+		return nil, err
+	}
+
+	var networks map[string]struct{}
+	for _, t := range tasks {
+		if t.Spec.GetRouter() == nil || t.DesiredState > api.TaskStateRunning {
+			continue
+		}
+		if networks == nil {
+			networks = make(map[string]struct{})
+		}
+		for _, na := range t.Networks {
+			if na.Network != nil {
+				networks[na.Network.ID] = struct{}{}
+			}
+		}
+	}
+	if networks == nil {
+		return nil, nil
+	}
+
+	nodeTable := &api.RoutingTable{}
+	if table == nil {
+		return nodeTable, nil
+	}
+	for _, e := range table.Entries {
+		if _, ok := networks[e.NetworkID]; ok {
+			nodeTable.Entries = append(nodeTable.Entries, e)
+		}
+	}
+	return nodeTable, nil
+}
+
+// updateRoutingTable compiles the routing table and notifies the sessions if
+// it changed, or if routerTasksChanged is set, so that they send the routing
+// table of their node again.
+func (d *Dispatcher) updateRoutingTable(ctx context.Context, routerTasksChanged bool) {
+	var (
+		table *api.RoutingTable
+		err   error
+	)
+	d.store.View(func(readTx store.ReadTx) {
+		table, err = compileRoutingTable(readTx)
+	})
+	if err != nil {
+		log.G(ctx).WithError(err).Error("failed to compile routing table")
+		return
+	}
+
+	d.mu.Lock()
+	if reflect.DeepEqual(table, d.routingTable) && !routerTasksChanged {
+		d.mu.Unlock()
+		return
+	}
+	d.routingTable = table
+	d.mu.Unlock()
+	d.routingQueue.Publish(table)
+}
+
+// getNodeRoutingTable returns the routing table to send to a node, or nil if
+// no router task is assigned to it.
+func (d *Dispatcher) getNodeRoutingTable(nodeID string) (*api.RoutingTable, error) {
+	d.mu.Lock()
+	table := d.routingTable
+	d.mu.Unlock()
+
+	var (
+		nodeTable *api.RoutingTable
+		err       error
+	)
+	d.store.View(func(readTx store.ReadTx) {
+		nodeTable, err = nodeRoutingTable(readTx, nodeID, table)
+	})
+	return nodeTable, err
+}
@@ -0,0 +1,137 @@
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileRoutingTable(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	defer s.Close()
+
+	routedService := func(id, network string, routes ...*api.Route) *api.Service {
+		return &api.Service{
+			ID: id,
+			Spec: api.ServiceSpec{
+				Annotations: api.Annotations{Name: id},
+				Task: api.TaskSpec{
+					Networks: []*api.NetworkAttachmentConfig{{Target: network}},
+				},
+				Endpoint: &api.EndpointSpec{Routes: routes},
+			},
+		}
+	}
+	task := func(id, serviceID string, state api.TaskState, addresses ...string) *api.Task {
+		return &api.Task{
+			ID:           id,
+			ServiceID:    serviceID,
+			DesiredState: api.TaskStateRunning,
+			Status:       api.TaskStatus{State: state},
+			Networks: []*api.NetworkAttachment{
+				{Network: &api.Network{ID: "ingress"}, Addresses: []string{"10.255.0.9/16"}},
+				{Network: &api.Network{ID: "net1"}, Addresses: addresses},
+			},
+		}
+	}
+
+	require.NoError(t, s.Update(func(tx store.Tx) error {
+		require.NoError(t, store.CreateService(tx, routedService("api", "net1",
+			&api.Route{Hostnames: []string{"example.com"}, PathPrefix: "/api", TargetPort: 8080})))
+		require.NoError(t, store.CreateService(tx, routedService("web", "net1",
+			&api.Route{Hostnames: []string{"example.com"}, TargetPort: 80})))
+		require.NoError(t, store.CreateService(tx, &api.Service{
+			ID:   "other",
+			Spec: api.ServiceSpec{Annotations: api.Annotations{Name: "other"}},
+		}))
+		require.NoError(t, store.CreateTask(tx, task("api.1", "api", api.TaskStateRunning, "10.0.0.4/24")))
+		require.NoError(t, store.CreateTask(tx, task("web.1", "web", api.TaskStateRunning, "10.0.0.3/24")))
+		require.NoError(t, store.CreateTask(tx, task("web.2", "web", api.TaskStateRunning, "10.0.0.2/24")))
+		// Tasks which are not running are not backends.
+		require.NoError(t, store.CreateTask(tx, task("web.3", "web", api.TaskStatePreparing, "10.0.0.5/24")))
+		return nil
+	}))
+
+	var (
+		table *api.RoutingTable
+		err   error
+	)
+	s.View(func(tx store.ReadTx) {
+		table, err = compileRoutingTable(tx)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &api.RoutingTable{
+		Entries: []*api.RoutingTable_Entry{
+			{
+				ServiceID:  "api",
+				Hostnames:  []string{"example.com"},
+				PathPrefix: "/api",
+				Backends:   []string{"10.0.0.4:8080"},
+				NetworkID:  "net1",
+			},
+			{
+				ServiceID:  "web",
+				Hostnames:  []string{"example.com"},
+				PathPrefix: "/",
+				Backends:   []string{"10.0.0.2:80", "10.0.0.3:80"},
+				NetworkID:  "net1",
+			},
+		},
+	}, table)
+}
+
+func TestNodeRoutingTable(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	defer s.Close()
+
+	routerTask := func(id, nodeID, network string) *api.Task {
+		return &api.Task{
+			ID:           id,
+			NodeID:       nodeID,
+			DesiredState: api.TaskStateRunning,
+			Spec: api.TaskSpec{
+				Runtime: &api.TaskSpec_Router{Router: &api.RouterSpec{ListenPort: 80, Image: "router"}},
+			},
+			Networks: []*api.NetworkAttachment{{Network: &api.Network{ID: network}}},
+		}
+	}
+
+	require.NoError(t, s.Update(func(tx store.Tx) error {
+		require.NoError(t, store.CreateTask(tx, routerTask("router.1", "node1", "net1")))
+		require.NoError(t, store.CreateTask(tx, routerTask("router.2", "node2", "net2")))
+		require.NoError(t, store.CreateTask(tx, &api.Task{
+			ID:           "web.1",
+			NodeID:       "node3",
+			DesiredState: api.TaskStateRunning,
+			Spec: api.TaskSpec{
+				Runtime: &api.TaskSpec_Container{Container: &api.ContainerSpec{Image: "web"}},
+			},
+			Networks: []*api.NetworkAttachment{{Network: &api.Network{ID: "net1"}}},
+		}))
+		return nil
+	}))
+
+	entry1 := &api.RoutingTable_Entry{ServiceID: "web", PathPrefix: "/", NetworkID: "net1"}
+	entry3 := &api.RoutingTable_Entry{ServiceID: "api", PathPrefix: "/", NetworkID: "net3"}
+	table := &api.RoutingTable{Entries: []*api.RoutingTable_Entry{entry1, entry3}}
+
+	s.View(func(tx store.ReadTx) {
+		// Router tasks only receive the entries of their networks.
+		nodeTable, err := nodeRoutingTable(tx, "node1", table)
+		require.NoError(t, err)
+		assert.Equal(t, &api.RoutingTable{Entries: []*api.RoutingTable_Entry{entry1}}, nodeTable)
+
+		nodeTable, err = nodeRoutingTable(tx, "node2", table)
+		require.NoError(t, err)
+		assert.Equal(t, &api.RoutingTable{}, nodeTable)
+
+		// Nodes without router tasks don't receive a routing table.
+		nodeTable, err = nodeRoutingTable(tx, "node3", table)
+		require.NoError(t, err)
+		assert.Nil(t, nodeTable)
+	})
+}
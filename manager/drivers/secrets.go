@@ -0,0 +1,77 @@
+package drivers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/docker/docker/pkg/plugingetter"
+	"github.com/docker/swarmkit/api"
+)
+
+const (
+	// SecretsProviderAPI is the endpoint for fetching secrets from plugins
+	SecretsProviderAPI = "/SecretProvider.GetSecret"
+
+	// SecretsProviderCapability is the secrets provider plugin capability identification
+	SecretsProviderCapability = "secretprovider"
+)
+
+// SecretDriver provides secrets from different stores
+type SecretDriver struct {
+	plugin  plugingetter.CompatPlugin
+	options map[string]string
+}
+
+// NewSecretDriver creates a new driver that provides third party secrets
+func NewSecretDriver(plugin plugingetter.CompatPlugin, options map[string]string) *SecretDriver {
+	return &SecretDriver{plugin: plugin, options: options}
+}
+
+// Get gets a secret from the secret provider. The task is the one the secret
+// is being sent to a node for, so providers can scope their answer to it.
+func (d *SecretDriver) Get(spec *api.SecretSpec, task *api.Task) ([]byte, error) {
+	if spec == nil {
+		return nil, fmt.Errorf("spec is nil")
+	}
+	if task == nil {
+		return nil, fmt.Errorf("task is nil")
+	}
+
+	secretReq := &SecretsProviderRequest{
+		SecretName:    spec.Annotations.Name,
+		SecretLabels:  spec.Annotations.Labels,
+		DriverOptions: d.options,
+		ServiceID:     task.ServiceID,
+		ServiceName:   task.ServiceAnnotations.Name,
+		ServiceLabels: task.ServiceAnnotations.Labels,
+		TaskID:        task.ID,
+		NodeID:        task.NodeID,
+	}
+	secretResp := &SecretsProviderResponse{}
+	if err := d.plugin.Client().Call(SecretsProviderAPI, secretReq, secretResp); err != nil {
+		return nil, err
+	}
+	if secretResp.Err != "" {
+		return nil, errors.New(secretResp.Err)
+	}
+	// Assign the secret value
+	return secretResp.Value, nil
+}
+
+// SecretsProviderRequest is the secrets provider request.
+type SecretsProviderRequest struct {
+	SecretName    string            `json:",omitempty"` // SecretName is the name of the secret to request from the plugin
+	SecretLabels  map[string]string `json:",omitempty"` // SecretLabels capture environment names and other metadata pertaining to the secret
+	DriverOptions map[string]string `json:",omitempty"` // DriverOptions are the options of the secret's driver
+	ServiceID     string            `json:",omitempty"` // ServiceID is the ID of the service the secret is requested for
+	ServiceName   string            `json:",omitempty"` // ServiceName is the name of the service the secret is requested for
+	ServiceLabels map[string]string `json:",omitempty"` // ServiceLabels capture environment names and other metadata pertaining to the service
+	TaskID        string            `json:",omitempty"` // TaskID is the ID of the task the secret is requested for
+	NodeID        string            `json:",omitempty"` // NodeID is the ID of the node the secret is sent to
+}
+
+// SecretsProviderResponse is the secrets provider response.
+type SecretsProviderResponse struct {
+	Value []byte `json:",omitempty"` // Value is the value of the secret
+	Err   string `json:",omitempty"` // Err is the error response of the plugin
+}
@@ -0,0 +1,124 @@
+package drivers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/pkg/plugingetter"
+	"github.com/docker/docker/pkg/plugins"
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPlugin struct {
+	name   string
+	client *plugins.Client
+}
+
+func (p *testPlugin) Client() *plugins.Client { return p.client }
+func (p *testPlugin) Name() string             { return p.name }
+func (p *testPlugin) BasePath() string         { return "" }
+func (p *testPlugin) IsV1() bool               { return false }
+
+type testPluginGetter struct {
+	plugins map[string]plugingetter.CompatPlugin
+}
+
+func (pg *testPluginGetter) Get(name, capability string, mode int) (plugingetter.CompatPlugin, error) {
+	if capability != SecretsProviderCapability {
+		return nil, fmt.Errorf("unexpected capability %s", capability)
+	}
+	p, ok := pg.plugins[name]
+	if !ok {
+		return nil, plugins.ErrNotFound
+	}
+	return p, nil
+}
+
+func (pg *testPluginGetter) GetAllByCap(capability string) ([]plugingetter.CompatPlugin, error) {
+	return nil, nil
+}
+
+func (pg *testPluginGetter) GetAllManagedPluginsByCap(capability string) []plugingetter.CompatPlugin {
+	return nil
+}
+
+func (pg *testPluginGetter) Handle(capability string, callback func(string, *plugins.Client)) {}
+
+// startSecretProvider starts an HTTP stand-in for a secrets provider plugin
+// which returns the values in secrets, by secret name.
+func startSecretProvider(t *testing.T, secrets map[string]string, requests chan<- SecretsProviderRequest) (*httptest.Server, *plugins.Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, SecretsProviderAPI, r.URL.Path)
+
+		var req SecretsProviderRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if requests != nil {
+			requests <- req
+		}
+
+		var resp SecretsProviderResponse
+		if value, ok := secrets[req.SecretName]; ok {
+			resp.Value = []byte(value)
+		} else {
+			resp.Err = "secret not found"
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+
+	client, err := plugins.NewClient("tcp://"+strings.TrimPrefix(server.URL, "http://"), nil)
+	require.NoError(t, err)
+	return server, client
+}
+
+func TestSecretDriver(t *testing.T) {
+	requests := make(chan SecretsProviderRequest, 1)
+	server, client := startSecretProvider(t, map[string]string{"password": "hunter2"}, requests)
+	defer server.Close()
+
+	provider := New(&testPluginGetter{plugins: map[string]plugingetter.CompatPlugin{
+		"vault": &testPlugin{name: "vault", client: client},
+	}})
+
+	_, err := provider.NewSecretDriver(&api.Driver{Name: "missing"})
+	assert.Error(t, err)
+
+	driver, err := provider.NewSecretDriver(&api.Driver{Name: "vault", Options: map[string]string{"path": "prod"}})
+	require.NoError(t, err)
+
+	task := &api.Task{
+		ID:                 "task1",
+		NodeID:             "node1",
+		ServiceID:          "service1",
+		ServiceAnnotations: api.Annotations{Name: "web"},
+	}
+	value, err := driver.Get(&api.SecretSpec{Annotations: api.Annotations{Name: "password"}}, task)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hunter2"), value)
+
+	req := <-requests
+	assert.Equal(t, "password", req.SecretName)
+	assert.Equal(t, map[string]string{"path": "prod"}, req.DriverOptions)
+	assert.Equal(t, "task1", req.TaskID)
+	assert.Equal(t, "node1", req.NodeID)
+	assert.Equal(t, "service1", req.ServiceID)
+	assert.Equal(t, "web", req.ServiceName)
+
+	// errors reported by the plugin are returned
+	_, err = driver.Get(&api.SecretSpec{Annotations: api.Annotations{Name: "unknown"}}, task)
+	assert.EqualError(t, err, "secret not found")
+	<-requests
+}
+
+func TestNewSecretDriverWithoutPlugins(t *testing.T) {
+	_, err := New(nil).NewSecretDriver(&api.Driver{Name: "vault"})
+	assert.Error(t, err)
+
+	_, err = New(&testPluginGetter{}).NewSecretDriver(nil)
+	assert.Error(t, err)
+}
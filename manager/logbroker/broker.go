@@ -138,6 +138,7 @@ func (lb *LogBroker) registerSubscription(subscription *subscription) {
 
 	lb.registeredSubscriptions[subscription.message.ID] = subscription
 	lb.subscriptionQueue.Publish(subscription)
+	subscriptionsGauge.Inc()
 
 	// Mark nodes that won't receive the message as done.
 	for _, node := range subscription.Nodes() {
@@ -152,6 +153,7 @@ func (lb *LogBroker) unregisterSubscription(subscription *subscription) {
 	defer lb.mu.Unlock()
 
 	delete(lb.registeredSubscriptions, subscription.message.ID)
+	subscriptionsGauge.Dec()
 
 	subscription.Close()
 	lb.subscriptionQueue.Publish(subscription)
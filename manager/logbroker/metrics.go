@@ -0,0 +1,14 @@
+package logbroker
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var subscriptionsGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "swarm",
+	Subsystem: "logbroker",
+	Name:      "subscriptions",
+	Help:      "Number of active log subscriptions.",
+})
+
+func init() {
+	prometheus.MustRegister(subscriptionsGauge)
+}
@@ -21,8 +21,10 @@ import (
 	"github.com/docker/swarmkit/connectionbroker"
 	"github.com/docker/swarmkit/log"
 	"github.com/docker/swarmkit/manager/allocator"
+	"github.com/docker/swarmkit/manager/audit"
 	"github.com/docker/swarmkit/manager/controlapi"
 	"github.com/docker/swarmkit/manager/dispatcher"
+	"github.com/docker/swarmkit/manager/drivers"
 	"github.com/docker/swarmkit/manager/health"
 	"github.com/docker/swarmkit/manager/keymanager"
 	"github.com/docker/swarmkit/manager/logbroker"
@@ -30,6 +32,8 @@ import (
 	"github.com/docker/swarmkit/manager/orchestrator/global"
 	"github.com/docker/swarmkit/manager/orchestrator/replicated"
 	"github.com/docker/swarmkit/manager/orchestrator/taskreaper"
+	"github.com/docker/swarmkit/manager/rbac"
+	"github.com/docker/swarmkit/manager/readproxy"
 	"github.com/docker/swarmkit/manager/resourceapi"
 	"github.com/docker/swarmkit/manager/scheduler"
 	"github.com/docker/swarmkit/manager/state"
@@ -40,6 +44,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -127,12 +132,14 @@ type Manager struct {
 	constraintEnforcer     *constraintenforcer.ConstraintEnforcer
 	scheduler              *scheduler.Scheduler
 	allocator              *allocator.Allocator
+	controlAPI             *controlapi.Server
 	keyManager             *keymanager.KeyManager
 	server                 *grpc.Server
 	localserver            *grpc.Server
 	raftNode               *raft.Node
 	dekRotator             *RaftDEKManager
 	roleManager            *roleManager
+	managerScaler          *managerScaler
 
 	cancelFunc context.CancelFunc
 
@@ -210,7 +217,7 @@ func New(config *Config) (*Manager, error) {
 	m := &Manager{
 		config:          *config,
 		caserver:        ca.NewServer(raftNode.MemoryStore(), config.SecurityConfig),
-		dispatcher:      dispatcher.New(raftNode, dispatcher.DefaultConfig()),
+		dispatcher:      dispatcher.New(raftNode, dispatcher.DefaultConfig(), drivers.New(config.PluginGetter)),
 		logbroker:       logbroker.New(raftNode.MemoryStore()),
 		server:          grpc.NewServer(opts...),
 		localserver:     grpc.NewServer(opts...),
@@ -365,6 +372,13 @@ func (m *Manager) Run(parent context.Context) error {
 
 	go m.handleLeadershipEvents(ctx, leadershipCh)
 
+	storeCollector := store.NewObjectsCollector(m.raftNode.MemoryStore())
+	if err := prometheus.Register(storeCollector); err != nil {
+		log.G(ctx).WithError(err).Warn("failed to register store metrics")
+	} else {
+		defer prometheus.Unregister(storeCollector)
+	}
+
 	authorize := func(ctx context.Context, roles []string) error {
 		var (
 			blacklistedCerts map[string]*api.BlacklistedCertificate
@@ -389,11 +403,24 @@ func (m *Manager) Run(parent context.Context) error {
 	}
 
 	baseControlAPI := controlapi.NewServer(m.raftNode.MemoryStore(), m.raftNode, m.config.SecurityConfig.RootCA(), m.config.PluginGetter)
+	m.controlAPI = baseControlAPI
 	baseResourceAPI := resourceapi.New(m.raftNode.MemoryStore())
 	healthServer := health.NewHealthServer()
 	localHealthServer := health.NewHealthServer()
 
-	authenticatedControlAPI := api.NewAuthenticatedWrapperControlServer(baseControlAPI, authorize)
+	// Control API calls from users are further restricted by the roles
+	// bound to them.
+	rbacControlAPI := api.NewRBACWrapperControlServer(baseControlAPI, rbac.NewAuthorizer(m.raftNode.MemoryStore()).Authorize)
+
+	// Mutating Control API calls are recorded in the audit log, including
+	// calls that were denied.
+	auditedControlAPI := audit.NewServer(rbacControlAPI, m.raftNode.MemoryStore(), audit.DefaultMaxEvents)
+
+	// Read-only calls are served from the local store once it has caught
+	// up with the leader.
+	readControlAPI := readproxy.NewServer(auditedControlAPI, auditedControlAPI, m.raftNode, nil)
+
+	authenticatedControlAPI := api.NewAuthenticatedWrapperControlServer(readControlAPI, authorize)
 	authenticatedResourceAPI := api.NewAuthenticatedWrapperResourceAllocatorServer(baseResourceAPI, authorize)
 	authenticatedLogsServerAPI := api.NewAuthenticatedWrapperLogsServer(m.logbroker, authorize)
 	authenticatedLogBrokerAPI := api.NewAuthenticatedWrapperLogBrokerServer(m.logbroker, authorize)
@@ -442,7 +469,9 @@ func (m *Manager) Run(parent context.Context) error {
 
 		return context.WithValue(ctx, ca.LocalRequestKey, nodeInfo), nil
 	}
-	localProxyControlAPI := api.NewRaftProxyControlServer(baseControlAPI, m.raftNode, handleRequestLocally, forwardAsOwnRequest)
+	localAuditedControlAPI := audit.NewServer(baseControlAPI, m.raftNode.MemoryStore(), audit.DefaultMaxEvents)
+	localProxyControlAPI := api.NewRaftProxyControlServer(localAuditedControlAPI, m.raftNode, handleRequestLocally, forwardAsOwnRequest)
+	localReadControlAPI := readproxy.NewServer(localProxyControlAPI, localAuditedControlAPI, m.raftNode, handleRequestLocally)
 	localProxyLogsAPI := api.NewRaftProxyLogsServer(m.logbroker, m.raftNode, handleRequestLocally, forwardAsOwnRequest)
 	localProxyDispatcherAPI := api.NewRaftProxyDispatcherServer(m.dispatcher, m.raftNode, handleRequestLocally, forwardAsOwnRequest)
 	localProxyCAAPI := api.NewRaftProxyCAServer(m.caserver, m.raftNode, handleRequestLocally, forwardAsOwnRequest)
@@ -464,7 +493,7 @@ func (m *Manager) Run(parent context.Context) error {
 	api.RegisterDispatcherServer(m.server, proxyDispatcherAPI)
 	grpc_prometheus.Register(m.server)
 
-	api.RegisterControlServer(m.localserver, localProxyControlAPI)
+	api.RegisterControlServer(m.localserver, localReadControlAPI)
 	api.RegisterLogsServer(m.localserver, localProxyLogsAPI)
 	api.RegisterHealthServer(m.localserver, localHealthServer)
 	api.RegisterDispatcherServer(m.localserver, localProxyDispatcherAPI)
@@ -595,6 +624,9 @@ func (m *Manager) Stop(ctx context.Context, clearData bool) {
 	if m.roleManager != nil {
 		m.roleManager.Stop()
 	}
+	if m.managerScaler != nil {
+		m.managerScaler.Stop()
+	}
 	if m.keyManager != nil {
 		m.keyManager.Stop()
 	}
@@ -896,6 +928,12 @@ func (m *Manager) becomeLeader(ctx context.Context) {
 		return nil
 	})
 
+	// Upgrade the objects written by older managers. Once the new schema
+	// version is recorded, older managers can no longer join.
+	if err := store.Migrate(ctx, s); err != nil {
+		log.G(ctx).WithError(err).Error("store migration failed")
+	}
+
 	// Attempt to rotate the key-encrypting-key of the root CA key-material
 	err := m.rotateRootCAKEK(ctx, clusterID)
 	if err != nil {
@@ -909,6 +947,7 @@ func (m *Manager) becomeLeader(ctx context.Context) {
 	m.scheduler = scheduler.New(s)
 	m.keyManager = keymanager.New(s, keymanager.DefaultConfig())
 	m.roleManager = newRoleManager(s, m.raftNode)
+	m.managerScaler = newManagerScaler(s, nodeID)
 
 	// TODO(stevvooe): Allocate a context that can be used to
 	// shutdown underlying manager processes when leadership is
@@ -956,6 +995,7 @@ func (m *Manager) becomeLeader(ctx context.Context) {
 				log.G(ctx).WithError(err).Error("allocator exited with an error")
 			}
 		}(m.allocator)
+		m.controlAPI.SetAllocator(m.allocator)
 	}
 
 	go func(scheduler *scheduler.Scheduler) {
@@ -987,6 +1027,10 @@ func (m *Manager) becomeLeader(ctx context.Context) {
 	go func(roleManager *roleManager) {
 		roleManager.Run()
 	}(m.roleManager)
+
+	go func(managerScaler *managerScaler) {
+		managerScaler.Run()
+	}(m.managerScaler)
 }
 
 // becomeFollower shuts down the subsystems that are only run by the leader.
@@ -996,6 +1040,7 @@ func (m *Manager) becomeFollower() {
 	m.caserver.Stop()
 
 	if m.allocator != nil {
+		m.controlAPI.SetAllocator(nil)
 		m.allocator.Stop()
 		m.allocator = nil
 	}
@@ -1018,6 +1063,9 @@ func (m *Manager) becomeFollower() {
 	m.roleManager.Stop()
 	m.roleManager = nil
 
+	m.managerScaler.Stop()
+	m.managerScaler = nil
+
 	if m.keyManager != nil {
 		m.keyManager.Stop()
 		m.keyManager = nil
@@ -1058,7 +1106,8 @@ func defaultClusterObject(
 				Manager: ca.GenerateJoinToken(rootCA),
 			},
 		},
-		UnlockKeys: initialUnlockKeys,
+		UnlockKeys:    initialUnlockKeys,
+		SchemaVersion: store.SchemaVersion(),
 	}
 }
 
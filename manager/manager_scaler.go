@@ -0,0 +1,307 @@
+package manager
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/log"
+	"github.com/docker/swarmkit/manager/constraint"
+	"github.com/docker/swarmkit/manager/state"
+	"github.com/docker/swarmkit/manager/state/store"
+	gogotypes "github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+)
+
+const (
+	managerScaleInterval = 5 * time.Second
+
+	// defaultManagerDownGracePeriod is how long a manager must be down
+	// before it is replaced, if the cluster doesn't specify it.
+	defaultManagerDownGracePeriod = time.Minute
+)
+
+// managerScaler maintains the number of managers desired in the cluster's
+// ManagerScalingConfig. It only changes the desired role of nodes, the
+// roleManager carries out the promotions and demotions.
+type managerScaler struct {
+	ctx    context.Context
+	cancel func()
+
+	store *store.MemoryStore
+	// nodeID is the ID of the local node, which is never demoted.
+	nodeID   string
+	doneChan chan struct{}
+
+	// downSince records when managers were first seen down.
+	downSince map[string]time.Time
+	now       func() time.Time
+}
+
+// newManagerScaler creates a new managerScaler.
+func newManagerScaler(store *store.MemoryStore, nodeID string) *managerScaler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &managerScaler{
+		ctx:       ctx,
+		cancel:    cancel,
+		store:     store,
+		nodeID:    nodeID,
+		doneChan:  make(chan struct{}),
+		downSince: make(map[string]time.Time),
+		now:       time.Now,
+	}
+}
+
+// Run is managerScaler's main loop.
+func (ms *managerScaler) Run() {
+	defer close(ms.doneChan)
+
+	watcher, cancelWatch := state.Watch(ms.store.WatchQueue(),
+		state.EventCreateNode{},
+		state.EventUpdateNode{},
+		state.EventDeleteNode{},
+		state.EventUpdateCluster{})
+	defer cancelWatch()
+
+	// Down managers are only replaced after the grace period, which no
+	// event signals.
+	ticker := time.NewTicker(managerScaleInterval)
+	defer ticker.Stop()
+
+	ms.reconcile()
+	for {
+		select {
+		case <-watcher:
+			ms.reconcile()
+		case <-ticker.C:
+			ms.reconcile()
+		case <-ms.ctx.Done():
+			return
+		}
+	}
+}
+
+// Stop stops the managerScaler and waits for the main loop to exit.
+func (ms *managerScaler) Stop() {
+	ms.cancel()
+	<-ms.doneChan
+}
+
+func (ms *managerScaler) reconcile() {
+	var (
+		cluster *api.Cluster
+		nodes   []*api.Node
+		err     error
+	)
+	ms.store.View(func(tx store.ReadTx) {
+		var clusters []*api.Cluster
+		clusters, err = store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+		if err != nil || len(clusters) != 1 {
+			return
+		}
+		cluster = clusters[0]
+		nodes, err = store.FindNodes(tx, store.All)
+	})
+	if err != nil {
+		log.L.WithError(err).Error("manager scaler failed to read the store")
+		return
+	}
+	if cluster == nil {
+		return
+	}
+
+	cfg := cluster.Spec.ManagerScaling
+	if cfg.DesiredManagers == 0 {
+		ms.downSince = make(map[string]time.Time)
+		return
+	}
+
+	gracePeriod := defaultManagerDownGracePeriod
+	if cfg.DownGracePeriod != nil {
+		if d, err := gogotypes.DurationFromProto(cfg.DownGracePeriod); err == nil {
+			gracePeriod = d
+		}
+	}
+
+	var (
+		constraints []constraint.Constraint
+		spread      []string
+	)
+	if cfg.Placement != nil {
+		constraints, err = constraint.Parse(cfg.Placement.Constraints)
+		if err != nil {
+			log.L.WithError(err).Error("invalid manager placement constraints")
+			return
+		}
+		for _, pref := range cfg.Placement.Preferences {
+			if s := pref.GetSpread(); s != nil {
+				spread = append(spread, s.SpreadDescriptor)
+			}
+		}
+	}
+
+	now := ms.now()
+	var (
+		// healthy are the managers which are up, or haven't been down for
+		// the grace period yet.
+		healthy []*api.Node
+		failed  []*api.Node
+		// candidates are the workers which may be promoted.
+		candidates []*api.Node
+	)
+	managers := make(map[string]struct{})
+	for _, n := range nodes {
+		if n.Spec.DesiredRole != api.NodeRoleManager {
+			if n.Role == api.NodeRoleWorker && n.Status.State == api.NodeStatus_READY &&
+				n.Spec.Membership == api.NodeMembershipAccepted && constraint.NodeMatches(constraints, n) {
+				candidates = append(candidates, n)
+			}
+			continue
+		}
+
+		managers[n.ID] = struct{}{}
+		if n.Status.State == api.NodeStatus_READY {
+			delete(ms.downSince, n.ID)
+			healthy = append(healthy, n)
+			continue
+		}
+		since, ok := ms.downSince[n.ID]
+		if !ok {
+			since = now
+			ms.downSince[n.ID] = now
+		}
+		if now.Sub(since) < gracePeriod {
+			healthy = append(healthy, n)
+		} else {
+			failed = append(failed, n)
+		}
+	}
+	for id := range ms.downSince {
+		if _, ok := managers[id]; !ok {
+			delete(ms.downSince, id)
+		}
+	}
+
+	desired := int(cfg.DesiredManagers)
+	var promote, demote []*api.Node
+	if len(healthy) < desired {
+		promote = pickSpread(candidates, healthy, desired-len(healthy), spread, false)
+	}
+
+	// Failed managers are only demoted once they are replaced, and after
+	// them, the managers exceeding the desired count.
+	excess := len(healthy) + len(promote) + len(failed) - desired
+	for _, n := range failed {
+		if excess <= 0 {
+			break
+		}
+		demote = append(demote, n)
+		excess--
+	}
+	if excess > 0 {
+		var removable []*api.Node
+		for _, n := range healthy {
+			if n.ID != ms.nodeID {
+				removable = append(removable, n)
+			}
+		}
+		demote = append(demote, pickSpread(removable, healthy, excess, spread, true)...)
+	}
+
+	for _, n := range promote {
+		ms.setDesiredRole(n, api.NodeRoleManager)
+	}
+	for _, n := range demote {
+		ms.setDesiredRole(n, api.NodeRoleWorker)
+	}
+}
+
+func (ms *managerScaler) setDesiredRole(node *api.Node, role api.NodeRole) {
+	err := ms.store.Update(func(tx store.Tx) error {
+		updatedNode := store.GetNode(tx, node.ID)
+		if updatedNode == nil || updatedNode.Spec.DesiredRole != node.Spec.DesiredRole {
+			return nil
+		}
+		updatedNode.Spec.DesiredRole = role
+		return store.UpdateNode(tx, updatedNode)
+	})
+	if err != nil {
+		log.L.WithError(err).Errorf("failed to change the desired role of node %s", node.ID)
+		return
+	}
+	if role == api.NodeRoleManager {
+		log.L.Infof("manager scaler: promoting node %s", node.ID)
+	} else {
+		log.L.Infof("manager scaler: demoting node %s", node.ID)
+	}
+}
+
+// pickSpread picks count nodes from candidates, spreading managers over the
+// values of the spread descriptors. If demote is false, the nodes are picked
+// where the fewest managers are, otherwise where the most are. Nodes which
+// are down are demoted first.
+func pickSpread(candidates, managers []*api.Node, count int, spread []string, demote bool) []*api.Node {
+	perValue := make(map[string]int)
+	for _, n := range managers {
+		perValue[spreadValue(n, spread)]++
+	}
+
+	candidates = append([]*api.Node(nil), candidates...)
+	sort.Sort(nodesByID(candidates))
+
+	var picked []*api.Node
+	for len(picked) < count && len(candidates) > 0 {
+		best := 0
+		for i := 1; i < len(candidates); i++ {
+			if spreadBefore(candidates[i], candidates[best], perValue, spread, demote) {
+				best = i
+			}
+		}
+		n := candidates[best]
+		candidates = append(candidates[:best], candidates[best+1:]...)
+		picked = append(picked, n)
+		if demote {
+			perValue[spreadValue(n, spread)]--
+		} else {
+			perValue[spreadValue(n, spread)]++
+		}
+	}
+	return picked
+}
+
+func spreadBefore(a, b *api.Node, perValue map[string]int, spread []string, demote bool) bool {
+	if demote {
+		aDown, bDown := a.Status.State != api.NodeStatus_READY, b.Status.State != api.NodeStatus_READY
+		if aDown != bDown {
+			return aDown
+		}
+		return perValue[spreadValue(a, spread)] > perValue[spreadValue(b, spread)]
+	}
+	return perValue[spreadValue(a, spread)] < perValue[spreadValue(b, spread)]
+}
+
+// spreadValue returns the values of the spread descriptors for a node,
+// which managers are spread over.
+func spreadValue(n *api.Node, spread []string) string {
+	values := make([]string, 0, len(spread))
+	for _, descriptor := range spread {
+		var value string
+		switch {
+		case len(descriptor) > len(constraint.NodeLabelPrefix) && strings.EqualFold(descriptor[:len(constraint.NodeLabelPrefix)], constraint.NodeLabelPrefix):
+			value = n.Spec.Annotations.Labels[descriptor[len(constraint.NodeLabelPrefix):]]
+		case len(descriptor) > len(constraint.EngineLabelPrefix) && strings.EqualFold(descriptor[:len(constraint.EngineLabelPrefix)], constraint.EngineLabelPrefix):
+			if n.Description != nil && n.Description.Engine != nil {
+				value = n.Description.Engine.Labels[descriptor[len(constraint.EngineLabelPrefix):]]
+			}
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, "\x00")
+}
+
+type nodesByID []*api.Node
+
+func (n nodesByID) Len() int           { return len(n) }
+func (n nodesByID) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodesByID) Less(i, j int) bool { return n[i].ID < n[j].ID }
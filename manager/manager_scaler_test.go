@@ -0,0 +1,134 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+)
+
+func scalerTestNode(id, zone string, role api.NodeRole, state api.NodeStatus_State) *api.Node {
+	return &api.Node{
+		ID: id,
+		Spec: api.NodeSpec{
+			Annotations: api.Annotations{
+				Name:   id,
+				Labels: map[string]string{"zone": zone},
+			},
+			DesiredRole: role,
+			Membership:  api.NodeMembershipAccepted,
+		},
+		Role:   role,
+		Status: api.NodeStatus{State: state},
+	}
+}
+
+func desiredRoles(t *testing.T, s *store.MemoryStore) map[string]api.NodeRole {
+	roles := make(map[string]api.NodeRole)
+	s.View(func(tx store.ReadTx) {
+		nodes, err := store.FindNodes(tx, store.All)
+		require.NoError(t, err)
+		for _, n := range nodes {
+			roles[n.ID] = n.Spec.DesiredRole
+		}
+	})
+	return roles
+}
+
+func TestManagerScaler(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	defer s.Close()
+
+	pending := scalerTestNode("w4", "c", api.NodeRoleWorker, api.NodeStatus_READY)
+	pending.Spec.Membership = api.NodeMembershipPending
+
+	require.NoError(t, s.Update(func(tx store.Tx) error {
+		require.NoError(t, store.CreateCluster(tx, &api.Cluster{
+			ID: "cluster",
+			Spec: api.ClusterSpec{
+				Annotations: api.Annotations{Name: store.DefaultClusterName},
+				ManagerScaling: api.ManagerScalingConfig{
+					DesiredManagers: 3,
+					Placement: &api.Placement{
+						Constraints: []string{"node.labels.zone!=d"},
+						Preferences: []*api.PlacementPreference{{
+							Preference: &api.PlacementPreference_Spread{
+								Spread: &api.SpreadOver{SpreadDescriptor: "node.labels.zone"},
+							},
+						}},
+					},
+					DownGracePeriod: gogotypes.DurationProto(time.Minute),
+				},
+			},
+		}))
+		for _, n := range []*api.Node{
+			scalerTestNode("m1", "a", api.NodeRoleManager, api.NodeStatus_READY),
+			scalerTestNode("m2", "b", api.NodeRoleManager, api.NodeStatus_READY),
+			scalerTestNode("m3", "c", api.NodeRoleManager, api.NodeStatus_DOWN),
+			scalerTestNode("w1", "a", api.NodeRoleWorker, api.NodeStatus_READY),
+			scalerTestNode("w2", "c", api.NodeRoleWorker, api.NodeStatus_READY),
+			scalerTestNode("w3", "d", api.NodeRoleWorker, api.NodeStatus_READY),
+			pending,
+		} {
+			require.NoError(t, store.CreateNode(tx, n))
+		}
+		return nil
+	}))
+
+	now := time.Now()
+	ms := newManagerScaler(s, "m1")
+	ms.now = func() time.Time { return now }
+
+	// m3 was just seen down, nothing changes during the grace period
+	ms.reconcile()
+	roles := desiredRoles(t, s)
+	require.Equal(t, api.NodeRoleManager, roles["m3"])
+	require.Equal(t, api.NodeRoleWorker, roles["w2"])
+
+	// after the grace period, m3 is replaced by the eligible worker in the
+	// same zone, and demoted
+	now = now.Add(2 * time.Minute)
+	ms.reconcile()
+	roles = desiredRoles(t, s)
+	require.Equal(t, api.NodeRoleWorker, roles["m3"])
+	require.Equal(t, api.NodeRoleManager, roles["w2"])
+	require.Equal(t, api.NodeRoleWorker, roles["w1"])
+	require.Equal(t, api.NodeRoleWorker, roles["w3"])
+	require.Equal(t, api.NodeRoleWorker, roles["w4"])
+
+	// scaling down never demotes the local node
+	require.NoError(t, s.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, "cluster")
+		cluster.Spec.ManagerScaling.DesiredManagers = 1
+		return store.UpdateCluster(tx, cluster)
+	}))
+	ms.reconcile()
+	roles = desiredRoles(t, s)
+	require.Equal(t, api.NodeRoleManager, roles["m1"])
+	require.Equal(t, api.NodeRoleWorker, roles["m2"])
+	require.Equal(t, api.NodeRoleWorker, roles["w2"])
+}
+
+func TestManagerScalerDisabled(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	defer s.Close()
+
+	require.NoError(t, s.Update(func(tx store.Tx) error {
+		require.NoError(t, store.CreateCluster(tx, &api.Cluster{
+			ID: "cluster",
+			Spec: api.ClusterSpec{
+				Annotations: api.Annotations{Name: store.DefaultClusterName},
+			},
+		}))
+		require.NoError(t, store.CreateNode(tx, scalerTestNode("m1", "a", api.NodeRoleManager, api.NodeStatus_READY)))
+		require.NoError(t, store.CreateNode(tx, scalerTestNode("w1", "a", api.NodeRoleWorker, api.NodeStatus_READY)))
+		return nil
+	}))
+
+	ms := newManagerScaler(s, "m1")
+	ms.reconcile()
+	require.Equal(t, api.NodeRoleWorker, desiredRoles(t, s)["w1"])
+}
@@ -1,6 +1,8 @@
 package global
 
 import (
+	"time"
+
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/log"
 	"github.com/docker/swarmkit/manager/constraint"
@@ -144,6 +146,12 @@ func (g *Orchestrator) Run(ctx context.Context) error {
 				}
 				g.updateService(v.Service)
 				g.reconcileServices(ctx, []string{v.Service.ID})
+			case state.EventUpdateSecret:
+				// The secret may have been rotated, so services following
+				// its latest version may need to be updated.
+				if serviceIDs := g.servicesTrackingSecret(ctx, v.Secret.ID); len(serviceIDs) > 0 {
+					g.reconcileServices(ctx, serviceIDs)
+				}
 			case state.EventDeleteService:
 				if !orchestrator.IsGlobalService(v.Service) {
 					continue
@@ -184,6 +192,30 @@ func (g *Orchestrator) Run(ctx context.Context) error {
 	}
 }
 
+// servicesTrackingSecret returns the IDs of the global services that follow
+// the latest version of the given secret.
+func (g *Orchestrator) servicesTrackingSecret(ctx context.Context, secretID string) []string {
+	var (
+		services []*api.Service
+		err      error
+	)
+	g.store.View(func(tx store.ReadTx) {
+		services, err = orchestrator.ServicesTrackingSecret(tx, secretID)
+	})
+	if err != nil {
+		log.G(ctx).WithError(err).Errorf("global orchestrator: failed to find services tracking secret %s", secretID)
+		return nil
+	}
+
+	var serviceIDs []string
+	for _, s := range services {
+		if _, exists := g.globalServices[s.ID]; exists {
+			serviceIDs = append(serviceIDs, s.ID)
+		}
+	}
+	return serviceIDs
+}
+
 // FixTask validates a task with the current cluster settings, and takes
 // action to make it conformant to node state and service constraint
 // it's called at orchestrator initialization
@@ -265,11 +297,21 @@ func (g *Orchestrator) removeTasksFromNode(ctx context.Context, node *api.Node)
 }
 
 func (g *Orchestrator) reconcileServices(ctx context.Context, serviceIDs []string) {
+	defer orchestrator.ObserveReconcileLatency("global", time.Now())
+
 	nodeCompleted := make(map[string]map[string]struct{})
 	nodeTasks := make(map[string]map[string][]*api.Task)
+	serviceNodes := make(map[string]map[string]*api.Node)
 
 	g.store.View(func(tx store.ReadTx) {
 		for _, serviceID := range serviceIDs {
+			nodes, err := g.candidateNodes(tx, g.globalServices[serviceID].constraints)
+			if err != nil {
+				log.G(ctx).WithError(err).Errorf("global orchestrator: reconcileServices failed finding nodes for service %s", serviceID)
+				nodes = g.nodes
+			}
+			serviceNodes[serviceID] = nodes
+
 			tasks, err := store.FindTasks(tx, store.ByServiceID(serviceID))
 			if err != nil {
 				log.G(ctx).WithError(err).Errorf("global orchestrator: reconcileServices failed finding tasks for service %s", serviceID)
@@ -306,7 +348,7 @@ func (g *Orchestrator) reconcileServices(ctx context.Context, serviceIDs []strin
 
 			service := g.globalServices[serviceID]
 
-			for nodeID, node := range g.nodes {
+			for nodeID, node := range serviceNodes[serviceID] {
 				meetsConstraints := constraint.NodeMatches(service.constraints, node)
 				ntasks := nodeTasks[serviceID][nodeID]
 				delete(nodeTasks[serviceID], nodeID)
@@ -336,9 +378,10 @@ func (g *Orchestrator) reconcileServices(ctx context.Context, serviceIDs []strin
 				updates[service.Service] = updateTasks
 			}
 
-			// Remove any tasks assigned to nodes not found in g.nodes.
-			// These must be associated with nodes that are drained, or
-			// nodes that no longer exist.
+			// Remove any tasks assigned to nodes which weren't
+			// candidates. These must be associated with nodes that are
+			// drained, nodes that no longer exist, or nodes without the
+			// labels required by the constraints.
 			for _, ntasks := range nodeTasks[serviceID] {
 				g.removeTasks(ctx, batch, ntasks)
 			}
@@ -356,6 +399,28 @@ func (g *Orchestrator) reconcileServices(ctx context.Context, serviceIDs []strin
 
 }
 
+// candidateNodes returns the nodes which may satisfy the constraints. When
+// the constraints require node labels, only the nodes with these labels are
+// looked up in the store instead of checking every node.
+func (g *Orchestrator) candidateNodes(tx store.ReadTx, constraints []constraint.Constraint) (map[string]*api.Node, error) {
+	keys := constraint.NodeLabelKeys(constraints)
+	if len(keys) == 0 {
+		return g.nodes, nil
+	}
+	nodes, err := store.FindNodes(tx, store.ByLabelKey(keys[0]))
+	if err != nil {
+		return nil, err
+	}
+	candidates := make(map[string]*api.Node, len(nodes))
+	for _, n := range nodes {
+		// g.nodes doesn't contain drained nodes
+		if node, ok := g.nodes[n.ID]; ok {
+			candidates[n.ID] = node
+		}
+	}
+	return candidates, nil
+}
+
 // updateNode updates g.nodes based on the current node value
 func (g *Orchestrator) updateNode(node *api.Node) {
 	if node.Spec.Availability == api.NodeAvailabilityDrain {
@@ -396,6 +461,8 @@ func (g *Orchestrator) reconcileOneNode(ctx context.Context, node *api.Node) {
 
 // reconcileServicesOneNode checks the specified services on one node
 func (g *Orchestrator) reconcileServicesOneNode(ctx context.Context, serviceIDs []string, nodeID string) {
+	defer orchestrator.ObserveReconcileLatency("global", time.Now())
+
 	node, exists := g.nodes[nodeID]
 	if !exists {
 		return
@@ -566,6 +633,7 @@ func (g *Orchestrator) addTask(ctx context.Context, batch *store.Batch, service
 		if store.GetService(tx, service.ID) == nil {
 			return nil
 		}
+		orchestrator.ResolveSecrets(tx, task)
 		return store.CreateTask(tx, task)
 	})
 	if err != nil {
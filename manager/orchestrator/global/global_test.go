@@ -244,6 +244,65 @@ func TestRemoveTask(t *testing.T) {
 	assert.Equal(t, observedTask2.NodeID, "nodeid1")
 }
 
+func TestNodeLabelConstraint(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	labeledNode := node2.Copy()
+	labeledNode.Spec.Annotations.Labels = map[string]string{"zone": "A"}
+	addNode(t, s, node1)
+	addNode(t, s, labeledNode)
+
+	service := service1.Copy()
+	service.Spec.Task.Placement = &api.Placement{Constraints: []string{"node.labels.zone==a"}}
+	addService(t, s, service)
+
+	// a task left on the node without the label
+	addTask(t, s, &api.Task{
+		ID:           "task1",
+		Slot:         0,
+		DesiredState: api.TaskStateRunning,
+		Status: api.TaskStatus{
+			State: api.TaskStateRunning,
+		},
+		Spec:      service.Spec.Task,
+		ServiceID: service.ID,
+		NodeID:    node1.ID,
+	})
+
+	watch, cancel := state.Watch(s.WatchQueue(), state.EventCreateTask{}, state.EventUpdateTask{})
+	defer cancel()
+
+	orchestrator := NewGlobalOrchestrator(s)
+	defer orchestrator.Stop()
+
+	go func() {
+		assert.NoError(t, orchestrator.Run(ctx))
+	}()
+
+	// the tasks are shut down and created in any order
+	var shutdownTask, createdTask *api.Task
+	for shutdownTask == nil || createdTask == nil {
+		select {
+		case event := <-watch:
+			switch v := event.(type) {
+			case state.EventUpdateTask:
+				if v.Task.DesiredState > api.TaskStateRunning {
+					shutdownTask = v.Task
+				}
+			case state.EventCreateTask:
+				createdTask = v.Task
+			}
+		case <-time.After(time.Second):
+			t.Fatal("no task shut down and created")
+		}
+	}
+	assert.Equal(t, "task1", shutdownTask.ID)
+	assert.Equal(t, labeledNode.ID, createdTask.NodeID)
+}
+
 func addService(t *testing.T, s *store.MemoryStore, service *api.Service) {
 	s.Update(func(tx store.Tx) error {
 		assert.NoError(t, store.CreateService(tx, service))
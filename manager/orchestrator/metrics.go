@@ -0,0 +1,24 @@
+package orchestrator
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var reconcileLatencyHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "swarm",
+	Subsystem: "orchestrator",
+	Name:      "reconcile_duration_seconds",
+	Help:      "Time taken to reconcile services with their tasks, by orchestrator.",
+}, []string{"orchestrator"})
+
+func init() {
+	prometheus.MustRegister(reconcileLatencyHistogram)
+}
+
+// ObserveReconcileLatency records the time taken by a reconciliation of the
+// named orchestrator which started at start.
+func ObserveReconcileLatency(orchestrator string, start time.Time) {
+	reconcileLatencyHistogram.WithLabelValues(orchestrator).Observe(time.Since(start).Seconds())
+}
@@ -5,11 +5,13 @@ import (
 	"time"
 
 	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/controlapi"
 	"github.com/docker/swarmkit/manager/orchestrator/testutils"
 	"github.com/docker/swarmkit/manager/state"
 	"github.com/docker/swarmkit/manager/state/store"
 	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 )
 
@@ -706,3 +708,106 @@ func TestOrchestratorRestartWindow(t *testing.T) {
 	assert.Equal(t, observedTask8.DesiredState, api.TaskStateRunning)
 	assert.Equal(t, observedTask8.ServiceAnnotations.Name, "name1")
 }
+
+func TestOrchestratorRestartKeepsSecretVersion(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	server := controlapi.NewServer(s, nil, nil, nil)
+
+	createdSecret, err := server.CreateSecret(ctx, &api.CreateSecretRequest{
+		Spec: &api.SecretSpec{
+			Annotations: api.Annotations{Name: "secret"},
+			Data:        []byte("v1"),
+		},
+	})
+	require.NoError(t, err)
+	secret := createdSecret.Secret
+
+	// The reference neither pins a version nor tracks the latest one
+	_, err = server.CreateService(ctx, &api.CreateServiceRequest{
+		Spec: &api.ServiceSpec{
+			Annotations: api.Annotations{
+				Name: "name1",
+			},
+			Task: api.TaskSpec{
+				Runtime: &api.TaskSpec_Container{
+					Container: &api.ContainerSpec{
+						Image: "image",
+						Secrets: []*api.SecretReference{
+							{
+								SecretID:   secret.ID,
+								SecretName: "secret",
+								Target: &api.SecretReference_File{
+									File: &api.SecretReference_FileTarget{Name: "secret"},
+								},
+							},
+						},
+					},
+				},
+				Restart: &api.RestartPolicy{
+					Condition: api.RestartOnAny,
+					Delay:     gogotypes.DurationProto(0),
+				},
+			},
+			Mode: &api.ServiceSpec_Replicated{
+				Replicated: &api.ReplicatedService{
+					Replicas: 2,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	orchestrator := NewReplicatedOrchestrator(s)
+	defer orchestrator.Stop()
+
+	watch, cancel := state.Watch(s.WatchQueue() /*state.EventCreateTask{}, state.EventUpdateTask{}*/)
+	defer cancel()
+
+	// Start the orchestrator.
+	go func() {
+		assert.NoError(t, orchestrator.Run(ctx))
+	}()
+
+	observedTask1 := testutils.WatchTaskCreate(t, watch)
+	observedTask2 := testutils.WatchTaskCreate(t, watch)
+	for _, task := range []*api.Task{observedTask1, observedTask2} {
+		secretRef := task.Spec.GetContainer().Secrets[0]
+		assert.Equal(t, secret.ID, secretRef.SecretID)
+		assert.Equal(t, uint64(1), secretRef.SecretVersion)
+	}
+
+	// Rotate the secret, then fail the first task. The replacement must use
+	// the same data as the other replica.
+	_, err = server.UpdateSecret(ctx, &api.UpdateSecretRequest{
+		SecretID:      secret.ID,
+		SecretVersion: &secret.Meta.Version,
+		Spec: &api.SecretSpec{
+			Annotations: api.Annotations{Name: "secret"},
+			Data:        []byte("v2"),
+		},
+	})
+	require.NoError(t, err)
+
+	watch2, cancel2 := state.Watch(s.WatchQueue() /*state.EventCreateTask{}, state.EventUpdateTask{}*/)
+	defer cancel2()
+
+	updatedTask1 := observedTask1.Copy()
+	updatedTask1.Status = api.TaskStatus{State: api.TaskStateFailed}
+	err = s.Update(func(tx store.Tx) error {
+		assert.NoError(t, store.UpdateTask(tx, updatedTask1))
+		return nil
+	})
+	assert.NoError(t, err)
+	testutils.Expect(t, watch2, state.EventUpdateTask{})
+	testutils.Expect(t, watch2, state.EventCommit{})
+	testutils.Expect(t, watch2, state.EventUpdateTask{})
+
+	observedTask3 := testutils.WatchTaskCreate(t, watch2)
+	secretRef := observedTask3.Spec.GetContainer().Secrets[0]
+	assert.Equal(t, secret.ID, secretRef.SecretID)
+	assert.Equal(t, uint64(1), secretRef.SecretVersion)
+}
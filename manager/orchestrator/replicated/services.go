@@ -2,6 +2,7 @@ package replicated
 
 import (
 	"sort"
+	"time"
 
 	"github.com/docker/go-events"
 	"github.com/docker/swarmkit/api"
@@ -64,6 +65,25 @@ func (r *Orchestrator) handleServiceEvent(ctx context.Context, event events.Even
 			return
 		}
 		r.reconcileServices[v.Service.ID] = v.Service
+	case state.EventUpdateSecret:
+		// The secret may have been rotated, so services following its
+		// latest version may need to be updated.
+		var (
+			services []*api.Service
+			err      error
+		)
+		r.store.View(func(tx store.ReadTx) {
+			services, err = orchestrator.ServicesTrackingSecret(tx, v.Secret.ID)
+		})
+		if err != nil {
+			log.G(ctx).WithError(err).Errorf("failed to find services tracking secret %s", v.Secret.ID)
+			return
+		}
+		for _, s := range services {
+			if orchestrator.IsReplicatedService(s) {
+				r.reconcileServices[s.ID] = s
+			}
+		}
 	}
 }
 
@@ -88,6 +108,8 @@ func (r *Orchestrator) resolveService(ctx context.Context, task *api.Task) *api.
 }
 
 func (r *Orchestrator) reconcile(ctx context.Context, service *api.Service) {
+	defer orchestrator.ObserveReconcileLatency("replicated", time.Now())
+
 	runningSlots, deadSlots, err := orchestrator.GetRunnableAndDeadSlots(r.store, service.ID)
 	if err != nil {
 		log.G(ctx).WithError(err).Errorf("reconcile failed finding tasks")
@@ -191,7 +213,9 @@ func (r *Orchestrator) addTasks(ctx context.Context, batch *store.Batch, service
 
 		delete(deadSlots, slot)
 		err := batch.Update(func(tx store.Tx) error {
-			return store.CreateTask(tx, orchestrator.NewTask(r.cluster, service, slot, ""))
+			task := orchestrator.NewTask(r.cluster, service, slot, "")
+			orchestrator.ResolveSecrets(tx, task)
+			return store.CreateTask(tx, task)
 		})
 		if err != nil {
 			log.G(ctx).Errorf("Failed to create task: %v", err)
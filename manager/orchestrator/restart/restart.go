@@ -174,6 +174,7 @@ func (r *Supervisor) Restart(ctx context.Context, tx store.Tx, cluster *api.Clus
 		waitStop = false
 	}
 
+	orchestrator.ResolveSecrets(tx, restartTask)
 	if err := store.CreateTask(tx, restartTask); err != nil {
 		log.G(ctx).WithError(err).WithField("task.id", restartTask.ID).Error("task create failed")
 		return err
@@ -0,0 +1,147 @@
+package orchestrator
+
+import (
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/store"
+)
+
+// ResolveSecrets points the secret references of a new task to the objects
+// holding the version of the data the task should use: the pinned version if
+// there is one, the latest version if the reference tracks it, or else the
+// first version, as the Control API pins the other references of services
+// created since secrets have versions. This must be called before the task
+// is created in the store, so the data exposed to a task never changes after
+// it is created.
+func ResolveSecrets(tx store.ReadTx, t *api.Task) {
+	container := t.Spec.GetContainer()
+	if container == nil || len(container.Secrets) == 0 {
+		return
+	}
+
+	// The task spec shares its references with the service spec.
+	t.Spec = *t.Spec.Copy()
+	for _, secretRef := range t.Spec.GetContainer().Secrets {
+		version := secretRef.SecretVersion
+		if version == 0 && secretRef.TrackLatest {
+			secret := store.GetSecret(tx, secretRef.SecretID)
+			if secret == nil {
+				continue
+			}
+			version = latestVersion(secret)
+		} else if version == 0 {
+			version = 1
+		}
+
+		resolved := store.GetSecretVersion(tx, secretRef.SecretID, version)
+		if resolved == nil {
+			continue
+		}
+		secretRef.SecretID = resolved.ID
+		secretRef.SecretVersion = version
+	}
+}
+
+// LatestSecretVersions returns the latest version of each secret the service
+// tracks, by secret ID.
+func LatestSecretVersions(tx store.ReadTx, s *api.Service) map[string]uint64 {
+	versions := make(map[string]uint64)
+	container := s.Spec.Task.GetContainer()
+	if container == nil {
+		return versions
+	}
+	for _, secretRef := range container.Secrets {
+		if !secretRef.TrackLatest {
+			continue
+		}
+		if secret := store.GetSecret(tx, secretRef.SecretID); secret != nil {
+			versions[secret.ID] = latestVersion(secret)
+		}
+	}
+	return versions
+}
+
+// ServicesTrackingSecret returns the services that follow the latest version
+// of the given secret.
+func ServicesTrackingSecret(tx store.ReadTx, secretID string) ([]*api.Service, error) {
+	services, err := store.FindServices(tx, store.ByReferencedSecretID(secretID))
+	if err != nil {
+		return nil, err
+	}
+
+	var tracking []*api.Service
+	for _, s := range services {
+		if tracksSecret(s, secretID) {
+			tracking = append(tracking, s)
+		}
+	}
+	return tracking, nil
+}
+
+func tracksSecret(s *api.Service, secretID string) bool {
+	container := s.Spec.Task.GetContainer()
+	if container == nil {
+		return false
+	}
+	for _, secretRef := range container.Secrets {
+		if secretRef.TrackLatest && secretRef.SecretID == secretID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTaskSecretOutdated returns true if the task uses an older version of a
+// secret the service tracks than the versions given, as returned by
+// LatestSecretVersions. The task must match the service's spec.
+func IsTaskSecretOutdated(s *api.Service, t *api.Task, latest map[string]uint64) bool {
+	serviceContainer := s.Spec.Task.GetContainer()
+	taskContainer := t.Spec.GetContainer()
+	if serviceContainer == nil || taskContainer == nil || len(serviceContainer.Secrets) != len(taskContainer.Secrets) {
+		return false
+	}
+	for i, secretRef := range serviceContainer.Secrets {
+		if !secretRef.TrackLatest {
+			continue
+		}
+		version := taskContainer.Secrets[i].SecretVersion
+		if version == 0 {
+			version = 1
+		}
+		if version < latest[secretRef.SecretID] {
+			return true
+		}
+	}
+	return false
+}
+
+// unresolvedSecrets returns the task spec with its secret references as they
+// appear in the service spec, undoing ResolveSecrets, so the two can be
+// compared.
+func unresolvedSecrets(s *api.Service, spec api.TaskSpec) api.TaskSpec {
+	serviceContainer := s.Spec.Task.GetContainer()
+	taskContainer := spec.GetContainer()
+	if serviceContainer == nil || taskContainer == nil || len(taskContainer.Secrets) == 0 ||
+		len(serviceContainer.Secrets) != len(taskContainer.Secrets) {
+		return spec
+	}
+
+	spec = *spec.Copy()
+	for i, secretRef := range spec.GetContainer().Secrets {
+		serviceRef := serviceContainer.Secrets[i]
+		if secretRef.SecretVersion == 0 {
+			continue
+		}
+		if serviceRef.SecretVersion == 0 || serviceRef.SecretVersion == secretRef.SecretVersion {
+			secretRef.SecretID = serviceRef.SecretID
+			secretRef.SecretVersion = serviceRef.SecretVersion
+		}
+	}
+	return spec
+}
+
+func latestVersion(secret *api.Secret) uint64 {
+	if secret.LatestVersion == 0 {
+		return 1
+	}
+	return secret.LatestVersion
+}
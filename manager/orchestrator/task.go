@@ -61,8 +61,10 @@ func RestartCondition(task *api.Task) api.RestartPolicy_RestartCondition {
 }
 
 // IsTaskDirty determines whether a task matches the given service's spec.
+// Secret references resolved by ResolveSecrets are considered to match the
+// references they were resolved from.
 func IsTaskDirty(s *api.Service, t *api.Task) bool {
-	return !reflect.DeepEqual(s.Spec.Task, t.Spec) ||
+	return !reflect.DeepEqual(s.Spec.Task, unresolvedSecrets(s, t.Spec)) ||
 		(t.Endpoint != nil && !reflect.DeepEqual(s.Spec.Endpoint, t.Endpoint.Spec))
 }
 
@@ -55,7 +55,12 @@ func (u *Supervisor) Update(ctx context.Context, cluster *api.Cluster, service *
 	id := service.ID
 
 	if update, ok := u.updates[id]; ok {
-		if reflect.DeepEqual(service.Spec, update.newService.Spec) {
+		var secretVersions map[string]uint64
+		u.store.View(func(tx store.ReadTx) {
+			secretVersions = orchestrator.LatestSecretVersions(tx, service)
+		})
+		if reflect.DeepEqual(service.Spec, update.newService.Spec) &&
+			reflect.DeepEqual(secretVersions, update.secretVersions) {
 			// There's already an update working towards this goal.
 			return
 		}
@@ -93,6 +98,10 @@ type Updater struct {
 	cluster    *api.Cluster
 	newService *api.Service
 
+	// secretVersions are the latest versions of the secrets tracked by
+	// newService when the update was started.
+	secretVersions map[string]uint64
+
 	updatedTasks   map[string]time.Time // task ID to creation time
 	updatedTasksMu sync.Mutex
 
@@ -103,16 +112,22 @@ type Updater struct {
 }
 
 // NewUpdater creates a new Updater.
-func NewUpdater(store *store.MemoryStore, restartSupervisor *restart.Supervisor, cluster *api.Cluster, newService *api.Service) *Updater {
+func NewUpdater(s *store.MemoryStore, restartSupervisor *restart.Supervisor, cluster *api.Cluster, newService *api.Service) *Updater {
+	var secretVersions map[string]uint64
+	s.View(func(tx store.ReadTx) {
+		secretVersions = orchestrator.LatestSecretVersions(tx, newService)
+	})
+
 	return &Updater{
-		store:        store,
-		watchQueue:   store.WatchQueue(),
-		restarts:     restartSupervisor,
-		cluster:      cluster.Copy(),
-		newService:   newService.Copy(),
-		updatedTasks: make(map[string]time.Time),
-		stopChan:     make(chan struct{}),
-		doneChan:     make(chan struct{}),
+		store:          s,
+		watchQueue:     s.WatchQueue(),
+		restarts:       restartSupervisor,
+		cluster:        cluster.Copy(),
+		newService:     newService.Copy(),
+		secretVersions: secretVersions,
+		updatedTasks:   make(map[string]time.Time),
+		stopChan:       make(chan struct{}),
+		doneChan:       make(chan struct{}),
 	}
 }
 
@@ -390,6 +405,7 @@ func (u *Updater) updateTask(ctx context.Context, slot orchestrator.Slot, update
 				return errors.New("service was deleted")
 			}
 
+			orchestrator.ResolveSecrets(tx, updated)
 			if err := store.CreateTask(tx, updated); err != nil {
 				return err
 			}
@@ -507,7 +523,8 @@ func (u *Updater) removeOldTasks(ctx context.Context, batch *store.Batch, remove
 }
 
 func (u *Updater) isTaskDirty(t *api.Task) bool {
-	return orchestrator.IsTaskDirty(u.newService, t)
+	return orchestrator.IsTaskDirty(u.newService, t) ||
+		orchestrator.IsTaskSecretOutdated(u.newService, t, u.secretVersions)
 }
 
 func (u *Updater) isSlotDirty(slot orchestrator.Slot) bool {
@@ -0,0 +1,554 @@
+// Package rbac implements role-based access control for the Control API.
+//
+// Callers presenting a certificate with the swarm-user role are only allowed
+// to invoke the methods granted to them by the roles they are bound to.
+// Managers are not subject to these checks.
+package rbac
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/manager/state/store"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// controlMethods is the set of method names exposed by the Control API.
+var controlMethods = func() map[string]struct{} {
+	methods := make(map[string]struct{})
+	t := reflect.TypeOf((*api.ControlServer)(nil)).Elem()
+	for i := 0; i < t.NumMethod(); i++ {
+		methods[t.Method(i).Name] = struct{}{}
+	}
+	return methods
+}()
+
+// ValidMethodPattern returns true if pattern names a Control API method, or
+// is a prefix pattern ending in "*" that matches at least one method.
+func ValidMethodPattern(pattern string) bool {
+	if !strings.HasSuffix(pattern, "*") {
+		_, ok := controlMethods[pattern]
+		return ok
+	}
+	for method := range controlMethods {
+		if MethodMatches(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// MethodMatches returns true if the method name is matched by pattern.
+func MethodMatches(pattern, method string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(method, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == method
+}
+
+// labelsMatch returns true if labels contains every key in selector, with
+// the same value unless the selector value is empty.
+func labelsMatch(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		l, ok := labels[k]
+		if !ok {
+			return false
+		}
+		if v != "" && v != l {
+			return false
+		}
+	}
+	return true
+}
+
+// Authorizer checks calls made by users against the roles stored in the
+// cluster.
+type Authorizer struct {
+	store *store.MemoryStore
+}
+
+// NewAuthorizer returns an Authorizer that looks up roles in the given
+// store.
+func NewAuthorizer(store *store.MemoryStore) *Authorizer {
+	return &Authorizer{store: store}
+}
+
+// Authorize returns nil if the caller is allowed to invoke the given Control
+// API method with the given request. Managers are always allowed. Users are
+// allowed if one of the roles bound to them has a rule matching the method
+// and, if the rule is restricted by labels, every object affected by the
+// request. A transaction is allowed if each of its operations is allowed as
+// a call to the method it stands for, and the objects its preconditions
+// refer to can be read.
+func (a *Authorizer) Authorize(ctx context.Context, method string, request interface{}) error {
+	nodeInfo, err := ca.RemoteNode(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range nodeInfo.Roles {
+		if role == ca.ManagerRole {
+			return nil
+		}
+	}
+
+	var allowed, escalates bool
+	deniedMethod := method
+	a.store.View(func(tx store.ReadTx) {
+		var roles []*api.Role
+		roles, err = store.FindRoles(tx, store.BySubject(nodeInfo.NodeID))
+		if err != nil {
+			return
+		}
+
+		calls := []call{{method: method, request: request}}
+		if r, ok := request.(*api.TransactionRequest); ok {
+			calls = transactionCalls(tx, r)
+		}
+		allowed = true
+		for _, c := range calls {
+			if !rolesAllow(tx, roles, c.method, c.request) {
+				allowed = false
+				deniedMethod = c.method
+				break
+			}
+		}
+		if allowed {
+			// Users can't grant permissions they don't have themselves
+			if spec := requestedRoleSpec(request); spec != nil && !rulesGranted(roles, spec.Rules) {
+				allowed = false
+				escalates = true
+			}
+		}
+	})
+	if err != nil {
+		return grpc.Errorf(codes.Internal, "could not look up roles for user %s: %v", nodeInfo.NodeID, err)
+	}
+	if escalates {
+		return grpc.Errorf(codes.PermissionDenied, "Permission denied: user %s can't grant permissions outside of its own roles", nodeInfo.NodeID)
+	}
+	if !allowed {
+		return grpc.Errorf(codes.PermissionDenied, "Permission denied: user %s is not allowed to call %s", nodeInfo.NodeID, deniedMethod)
+	}
+	return nil
+}
+
+// call is a Control API method and the request it is called with.
+type call struct {
+	method  string
+	request interface{}
+}
+
+// transactionCalls returns the calls a transaction is equivalent to: a call
+// to the Get method of each existing object its preconditions refer to, and
+// a call to the method of each of its operations. Preconditions on absent
+// objects don't reveal more than a failed create would.
+func transactionCalls(tx store.ReadTx, r *api.TransactionRequest) []call {
+	calls := make([]call, 0, len(r.Preconditions)+len(r.Operations))
+	for _, p := range r.Preconditions {
+		if c, ok := preconditionCall(tx, p); ok {
+			calls = append(calls, c)
+		}
+	}
+	for _, op := range r.Operations {
+		switch v := op.Operation.(type) {
+		case *api.TransactionOperation_CreateNetwork:
+			calls = append(calls, call{"CreateNetwork", v.CreateNetwork})
+		case *api.TransactionOperation_RemoveNetwork:
+			calls = append(calls, call{"RemoveNetwork", v.RemoveNetwork})
+		case *api.TransactionOperation_CreateSecret:
+			calls = append(calls, call{"CreateSecret", v.CreateSecret})
+		case *api.TransactionOperation_RemoveSecret:
+			calls = append(calls, call{"RemoveSecret", v.RemoveSecret})
+		case *api.TransactionOperation_CreateService:
+			calls = append(calls, call{"CreateService", v.CreateService})
+		case *api.TransactionOperation_UpdateService:
+			calls = append(calls, call{"UpdateService", v.UpdateService})
+		case *api.TransactionOperation_RemoveService:
+			calls = append(calls, call{"RemoveService", v.RemoveService})
+		default:
+			// Unknown operations are only allowed to users who can
+			// call Transaction on anything
+			calls = append(calls, call{"Transaction", r})
+		}
+	}
+	return calls
+}
+
+// preconditionCall returns the Get call reading the object a transaction
+// precondition refers to. The second return value is false if the object
+// doesn't exist.
+func preconditionCall(tx store.ReadTx, p *api.TransactionPrecondition) (call, bool) {
+	switch p.Kind {
+	case api.TransactionPrecondition_NODE:
+		id := p.ID
+		if id == "" {
+			nodes, err := store.FindNodes(tx, store.ByName(p.Name))
+			if err != nil || len(nodes) == 0 {
+				return call{}, false
+			}
+			id = nodes[0].ID
+		} else if store.GetNode(tx, id) == nil {
+			return call{}, false
+		}
+		return call{"GetNode", &api.GetNodeRequest{NodeID: id}}, true
+	case api.TransactionPrecondition_SERVICE:
+		id := p.ID
+		if id == "" {
+			services, err := store.FindServices(tx, store.ByName(p.Name))
+			if err != nil || len(services) == 0 {
+				return call{}, false
+			}
+			id = services[0].ID
+		} else if store.GetService(tx, id) == nil {
+			return call{}, false
+		}
+		return call{"GetService", &api.GetServiceRequest{ServiceID: id}}, true
+	case api.TransactionPrecondition_NETWORK:
+		id := p.ID
+		if id == "" {
+			networks, err := store.FindNetworks(tx, store.ByName(p.Name))
+			if err != nil || len(networks) == 0 {
+				return call{}, false
+			}
+			id = networks[0].ID
+		} else if store.GetNetwork(tx, id) == nil {
+			return call{}, false
+		}
+		return call{"GetNetwork", &api.GetNetworkRequest{NetworkID: id}}, true
+	case api.TransactionPrecondition_CLUSTER:
+		id := p.ID
+		if id == "" {
+			clusters, err := store.FindClusters(tx, store.ByName(p.Name))
+			if err != nil || len(clusters) == 0 {
+				return call{}, false
+			}
+			id = clusters[0].ID
+		} else if store.GetCluster(tx, id) == nil {
+			return call{}, false
+		}
+		return call{"GetCluster", &api.GetClusterRequest{ClusterID: id}}, true
+	case api.TransactionPrecondition_SECRET:
+		id := p.ID
+		if id == "" {
+			secrets, err := store.FindSecrets(tx, store.ByName(p.Name))
+			if err != nil || len(secrets) == 0 {
+				return call{}, false
+			}
+			id = secrets[0].ID
+		} else if store.GetSecret(tx, id) == nil {
+			return call{}, false
+		}
+		return call{"GetSecret", &api.GetSecretRequest{SecretID: id}}, true
+	}
+	return call{}, false
+}
+
+// rolesAllow returns true if one of the roles has a rule allowing the method
+// to be called with the given request.
+func rolesAllow(tx store.ReadTx, roles []*api.Role, method string, request interface{}) bool {
+	var (
+		targets  []map[string]string
+		resolved bool
+		ok       bool
+	)
+	for _, role := range roles {
+		for _, rule := range role.Spec.Rules {
+			if !ruleMatchesMethod(rule, method) {
+				continue
+			}
+			if len(rule.Labels) == 0 {
+				return true
+			}
+			if !resolved {
+				targets, ok = targetLabels(tx, request)
+				resolved = true
+			}
+			if ok && allLabelsMatch(rule.Labels, targets) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requestedRoleSpec returns the role spec created or updated by the request,
+// if any.
+func requestedRoleSpec(request interface{}) *api.RoleSpec {
+	switch r := request.(type) {
+	case *api.CreateRoleRequest:
+		return r.Spec
+	case *api.UpdateRoleRequest:
+		return r.Spec
+	}
+	return nil
+}
+
+// rulesGranted returns true if every method granted by the rules, on the
+// objects selected by their labels, is also granted by one of the roles.
+func rulesGranted(roles []*api.Role, rules []api.RoleRule) bool {
+	for _, rule := range rules {
+		for _, pattern := range rule.Methods {
+			if !patternGranted(roles, pattern, rule.Labels) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func patternGranted(roles []*api.Role, pattern string, labels map[string]string) bool {
+	for _, role := range roles {
+		for _, rule := range role.Spec.Rules {
+			// The objects selected by labels must all be selected by the
+			// rule, which is the case if labels is at least as restrictive.
+			if !labelsMatch(rule.Labels, labels) {
+				continue
+			}
+			for _, p := range rule.Methods {
+				if patternCovers(p, pattern) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// patternCovers returns true if every method matched by pattern is matched
+// by p.
+func patternCovers(p, pattern string) bool {
+	if !strings.HasSuffix(p, "*") {
+		return p == pattern
+	}
+	return strings.HasPrefix(strings.TrimSuffix(pattern, "*"), strings.TrimSuffix(p, "*"))
+}
+
+func ruleMatchesMethod(rule api.RoleRule, method string) bool {
+	for _, pattern := range rule.Methods {
+		if MethodMatches(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func allLabelsMatch(selector map[string]string, targets []map[string]string) bool {
+	for _, labels := range targets {
+		if !labelsMatch(selector, labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// targetLabels returns the labels of every object the request reads or
+// modifies. For updates, this includes both the current labels and the labels
+// in the new spec, so that users can't move objects in or out of their scope.
+// For list requests, the label filter of the request is returned, since it
+// bounds the set of objects returned. The second return value is false if the
+// objects can't be determined.
+func targetLabels(tx store.ReadTx, request interface{}) ([]map[string]string, bool) {
+	switch r := request.(type) {
+	case *api.GetNodeRequest:
+		return nodeLabels(tx, r.NodeID)
+	case *api.UpdateNodeRequest:
+		labels, ok := nodeLabels(tx, r.NodeID)
+		if !ok || r.Spec == nil {
+			return nil, false
+		}
+		return append(labels, r.Spec.Annotations.Labels), true
+	case *api.RemoveNodeRequest:
+		return nodeLabels(tx, r.NodeID)
+	case *api.ListNodesRequest:
+		// Filters.Labels matches engine labels, which aren't managed
+		// through the Control API
+		if r.Filters == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Filters.NodeLabels}, true
+
+	case *api.GetTaskRequest:
+		return taskLabels(tx, r.TaskID)
+	case *api.RemoveTaskRequest:
+		return taskLabels(tx, r.TaskID)
+	case *api.ListTasksRequest:
+		if r.Filters == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Filters.Labels}, true
+
+	case *api.GetServiceRequest:
+		return serviceLabels(tx, r.ServiceID)
+	case *api.CreateServiceRequest:
+		if r.Spec == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Spec.Annotations.Labels}, true
+	case *api.UpdateServiceRequest:
+		labels, ok := serviceLabels(tx, r.ServiceID)
+		if !ok || r.Spec == nil {
+			return nil, false
+		}
+		return append(labels, r.Spec.Annotations.Labels), true
+	case *api.RemoveServiceRequest:
+		return serviceLabels(tx, r.ServiceID)
+	case *api.ListServicesRequest:
+		if r.Filters == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Filters.Labels}, true
+
+	case *api.GetNetworkRequest:
+		return networkLabels(tx, r.NetworkID)
+	case *api.CreateNetworkRequest:
+		if r.Spec == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Spec.Annotations.Labels}, true
+	case *api.UpdateNetworkRequest:
+		labels, ok := networkLabels(tx, r.NetworkID)
+		if !ok || r.Spec == nil {
+			return nil, false
+		}
+		return append(labels, r.Spec.Annotations.Labels), true
+	case *api.RemoveNetworkRequest:
+		return networkLabels(tx, r.NetworkID)
+	case *api.ListNetworksRequest:
+		if r.Filters == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Filters.Labels}, true
+
+	case *api.GetClusterRequest:
+		return clusterLabels(tx, r.ClusterID)
+	case *api.UpdateClusterRequest:
+		labels, ok := clusterLabels(tx, r.ClusterID)
+		if !ok || r.Spec == nil {
+			return nil, false
+		}
+		return append(labels, r.Spec.Annotations.Labels), true
+	case *api.ListClustersRequest:
+		if r.Filters == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Filters.Labels}, true
+
+	case *api.GetSecretRequest:
+		return secretLabels(tx, r.SecretID)
+	case *api.CreateSecretRequest:
+		if r.Spec == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Spec.Annotations.Labels}, true
+	case *api.UpdateSecretRequest:
+		labels, ok := secretLabels(tx, r.SecretID)
+		if !ok || r.Spec == nil {
+			return nil, false
+		}
+		return append(labels, r.Spec.Annotations.Labels), true
+	case *api.RemoveSecretRequest:
+		return secretLabels(tx, r.SecretID)
+	case *api.ListSecretsRequest:
+		if r.Filters == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Filters.Labels}, true
+
+	case *api.GetRoleRequest:
+		return roleLabels(tx, r.RoleID)
+	case *api.CreateRoleRequest:
+		if r.Spec == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Spec.Annotations.Labels}, true
+	case *api.UpdateRoleRequest:
+		labels, ok := roleLabels(tx, r.RoleID)
+		if !ok || r.Spec == nil {
+			return nil, false
+		}
+		return append(labels, r.Spec.Annotations.Labels), true
+	case *api.RemoveRoleRequest:
+		return roleLabels(tx, r.RoleID)
+	case *api.ListRolesRequest:
+		if r.Filters == nil {
+			return nil, false
+		}
+		return []map[string]string{r.Filters.Labels}, true
+
+	// Methods returning information about the whole cluster are scoped by
+	// the labels of the cluster object
+	case *api.ListAuditEventsRequest, *api.ListPublishedPortsRequest,
+		*api.GetClusterQuorumRequest, *api.InspectAllocatorRequest:
+		return defaultClusterLabels(tx)
+	}
+	return nil, false
+}
+
+func nodeLabels(tx store.ReadTx, id string) ([]map[string]string, bool) {
+	node := store.GetNode(tx, id)
+	if node == nil {
+		return nil, false
+	}
+	return []map[string]string{node.Spec.Annotations.Labels}, true
+}
+
+func taskLabels(tx store.ReadTx, id string) ([]map[string]string, bool) {
+	task := store.GetTask(tx, id)
+	if task == nil {
+		return nil, false
+	}
+	return []map[string]string{task.ServiceAnnotations.Labels}, true
+}
+
+func serviceLabels(tx store.ReadTx, id string) ([]map[string]string, bool) {
+	service := store.GetService(tx, id)
+	if service == nil {
+		return nil, false
+	}
+	return []map[string]string{service.Spec.Annotations.Labels}, true
+}
+
+func networkLabels(tx store.ReadTx, id string) ([]map[string]string, bool) {
+	network := store.GetNetwork(tx, id)
+	if network == nil {
+		return nil, false
+	}
+	return []map[string]string{network.Spec.Annotations.Labels}, true
+}
+
+func clusterLabels(tx store.ReadTx, id string) ([]map[string]string, bool) {
+	cluster := store.GetCluster(tx, id)
+	if cluster == nil {
+		return nil, false
+	}
+	return []map[string]string{cluster.Spec.Annotations.Labels}, true
+}
+
+func defaultClusterLabels(tx store.ReadTx) ([]map[string]string, bool) {
+	clusters, err := store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+	if err != nil || len(clusters) != 1 {
+		return nil, false
+	}
+	return []map[string]string{clusters[0].Spec.Annotations.Labels}, true
+}
+
+func secretLabels(tx store.ReadTx, id string) ([]map[string]string, bool) {
+	secret := store.GetSecret(tx, id)
+	if secret == nil {
+		return nil, false
+	}
+	return []map[string]string{secret.Spec.Annotations.Labels}, true
+}
+
+func roleLabels(tx store.ReadTx, id string) ([]map[string]string, bool) {
+	role := store.GetRole(tx, id)
+	if role == nil {
+		return nil, false
+	}
+	return []map[string]string{role.Spec.Annotations.Labels}, true
+}
@@ -0,0 +1,474 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func contextWithCaller(role, id string) context.Context {
+	return context.WithValue(context.Background(), ca.LocalRequestKey, ca.RemoteNodeInfo{
+		Roles:  []string{role},
+		NodeID: id,
+	})
+}
+
+func TestMethodPatterns(t *testing.T) {
+	assert.True(t, MethodMatches("*", "ListServices"))
+	assert.True(t, MethodMatches("List*", "ListServices"))
+	assert.False(t, MethodMatches("List*", "GetService"))
+	assert.True(t, MethodMatches("GetService", "GetService"))
+	assert.False(t, MethodMatches("GetService", "GetServices"))
+
+	assert.True(t, ValidMethodPattern("*"))
+	assert.True(t, ValidMethodPattern("List*"))
+	assert.True(t, ValidMethodPattern("UpdateService"))
+	assert.False(t, ValidMethodPattern("UpdateServices"))
+	assert.False(t, ValidMethodPattern("Frobnicate*"))
+}
+
+func TestAuthorize(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	require.NotNil(t, s)
+	defer s.Close()
+
+	payments := &api.Service{
+		ID: "payments",
+		Spec: api.ServiceSpec{
+			Annotations: api.Annotations{
+				Name:   "payments",
+				Labels: map[string]string{"team": "payments"},
+			},
+		},
+	}
+	frontend := &api.Service{
+		ID: "frontend",
+		Spec: api.ServiceSpec{
+			Annotations: api.Annotations{
+				Name:   "frontend",
+				Labels: map[string]string{"team": "web"},
+			},
+		},
+	}
+	roles := []*api.Role{
+		{
+			ID: "viewer",
+			Spec: api.RoleSpec{
+				Annotations: api.Annotations{Name: "viewer"},
+				Rules:       []api.RoleRule{{Methods: []string{"Get*", "List*"}}},
+				Subjects:    []string{"alice", "bob"},
+			},
+		},
+		{
+			ID: "payments",
+			Spec: api.RoleSpec{
+				Annotations: api.Annotations{Name: "payments"},
+				Rules: []api.RoleRule{{
+					Methods: []string{"UpdateService", "CreateService"},
+					Labels:  map[string]string{"team": "payments"},
+				}},
+				Subjects: []string{"alice"},
+			},
+		},
+	}
+	err := s.Update(func(tx store.Tx) error {
+		for _, service := range []*api.Service{payments, frontend} {
+			if err := store.CreateService(tx, service); err != nil {
+				return err
+			}
+		}
+		for _, role := range roles {
+			if err := store.CreateRole(tx, role); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	a := NewAuthorizer(s)
+
+	assertAllowed := func(ctx context.Context, method string, request interface{}) {
+		assert.NoError(t, a.Authorize(ctx, method, request), method)
+	}
+	assertDenied := func(ctx context.Context, method string, request interface{}) {
+		err := a.Authorize(ctx, method, request)
+		assert.Error(t, err, method)
+		assert.Equal(t, codes.PermissionDenied, grpc.Code(err), grpc.ErrorDesc(err))
+	}
+
+	// managers are not restricted
+	manager := contextWithCaller(ca.ManagerRole, "manager")
+	assertAllowed(manager, "RemoveService", &api.RemoveServiceRequest{ServiceID: "payments"})
+
+	// users with no roles can't do anything
+	carol := contextWithCaller(ca.UserRole, "carol")
+	assertDenied(carol, "ListServices", &api.ListServicesRequest{})
+
+	// unscoped rules apply to every object
+	bob := contextWithCaller(ca.UserRole, "bob")
+	assertAllowed(bob, "ListServices", &api.ListServicesRequest{})
+	assertAllowed(bob, "GetService", &api.GetServiceRequest{ServiceID: "frontend"})
+	assertDenied(bob, "UpdateService", &api.UpdateServiceRequest{
+		ServiceID: "payments",
+		Spec:      &payments.Spec,
+	})
+
+	// scoped rules only apply to objects with matching labels
+	alice := contextWithCaller(ca.UserRole, "alice")
+	assertAllowed(alice, "UpdateService", &api.UpdateServiceRequest{
+		ServiceID: "payments",
+		Spec:      &payments.Spec,
+	})
+	assertDenied(alice, "UpdateService", &api.UpdateServiceRequest{
+		ServiceID: "frontend",
+		Spec:      &frontend.Spec,
+	})
+	assertDenied(alice, "RemoveService", &api.RemoveServiceRequest{ServiceID: "payments"})
+	assertAllowed(alice, "CreateService", &api.CreateServiceRequest{Spec: &payments.Spec})
+	assertDenied(alice, "CreateService", &api.CreateServiceRequest{Spec: &frontend.Spec})
+
+	// objects can't be moved out of scope
+	relabeled := payments.Spec.Copy()
+	relabeled.Annotations.Labels = map[string]string{"team": "web"}
+	assertDenied(alice, "UpdateService", &api.UpdateServiceRequest{
+		ServiceID: "payments",
+		Spec:      relabeled,
+	})
+
+	// updates to missing objects are denied by scoped rules
+	assertDenied(alice, "UpdateService", &api.UpdateServiceRequest{
+		ServiceID: "missing",
+		Spec:      &payments.Spec,
+	})
+}
+
+func TestAuthorizeRoleRules(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	require.NotNil(t, s)
+	defer s.Close()
+
+	admin := &api.Role{
+		ID: "payments-admin",
+		Spec: api.RoleSpec{
+			Annotations: api.Annotations{
+				Name:   "payments-admin",
+				Labels: map[string]string{"team": "payments"},
+			},
+			Rules: []api.RoleRule{
+				{
+					Methods: []string{"CreateRole", "UpdateRole", "GetRole", "UpdateService"},
+					Labels:  map[string]string{"team": "payments"},
+				},
+				{Methods: []string{"List*"}},
+			},
+			Subjects: []string{"alice"},
+		},
+	}
+	require.NoError(t, s.Update(func(tx store.Tx) error {
+		return store.CreateRole(tx, admin)
+	}))
+
+	a := NewAuthorizer(s)
+	alice := contextWithCaller(ca.UserRole, "alice")
+	roleSpec := func(rules ...api.RoleRule) *api.RoleSpec {
+		return &api.RoleSpec{
+			Annotations: api.Annotations{
+				Name:   "new",
+				Labels: map[string]string{"team": "payments"},
+			},
+			Rules:    rules,
+			Subjects: []string{"alice"},
+		}
+	}
+
+	// roles granting a subset of the caller's permissions can be created
+	for _, spec := range []*api.RoleSpec{
+		roleSpec(),
+		roleSpec(api.RoleRule{
+			Methods: []string{"UpdateService", "GetRole"},
+			Labels:  map[string]string{"team": "payments", "tier": ""},
+		}),
+		roleSpec(api.RoleRule{Methods: []string{"ListServices", "ListT*"}}),
+	} {
+		assert.NoError(t, a.Authorize(alice, "CreateRole", &api.CreateRoleRequest{Spec: spec}))
+	}
+
+	// roles granting more methods or objects can't
+	for _, spec := range []*api.RoleSpec{
+		roleSpec(api.RoleRule{Methods: []string{"*"}}),
+		roleSpec(api.RoleRule{Methods: []string{"*"}, Labels: map[string]string{"team": "payments"}}),
+		roleSpec(api.RoleRule{Methods: []string{"UpdateService"}}),
+		roleSpec(api.RoleRule{Methods: []string{"UpdateService"}, Labels: map[string]string{"team": ""}}),
+		roleSpec(api.RoleRule{Methods: []string{"UpdateService"}, Labels: map[string]string{"team": "web"}}),
+		roleSpec(api.RoleRule{Methods: []string{"L*"}}),
+	} {
+		err := a.Authorize(alice, "CreateRole", &api.CreateRoleRequest{Spec: spec})
+		assert.Equal(t, codes.PermissionDenied, grpc.Code(err), "%v", spec.Rules)
+	}
+
+	// the same applies to updates, including of the caller's own roles
+	escalated := admin.Spec.Copy()
+	escalated.Rules = append(escalated.Rules, api.RoleRule{Methods: []string{"RemoveSecret"}})
+	err := a.Authorize(alice, "UpdateRole", &api.UpdateRoleRequest{RoleID: admin.ID, Spec: escalated})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+	assert.NoError(t, a.Authorize(alice, "UpdateRole", &api.UpdateRoleRequest{RoleID: admin.ID, Spec: &admin.Spec}))
+}
+
+func TestAuthorizeClusterScopedMethods(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	require.NotNil(t, s)
+	defer s.Close()
+
+	require.NoError(t, s.Update(func(tx store.Tx) error {
+		if err := store.CreateCluster(tx, &api.Cluster{
+			ID: "cluster",
+			Spec: api.ClusterSpec{
+				Annotations: api.Annotations{
+					Name:   store.DefaultClusterName,
+					Labels: map[string]string{"env": "staging"},
+				},
+			},
+		}); err != nil {
+			return err
+		}
+		for _, role := range []*api.Role{
+			{
+				ID: "staging",
+				Spec: api.RoleSpec{
+					Annotations: api.Annotations{Name: "staging"},
+					Rules: []api.RoleRule{{
+						Methods: []string{"List*", "GetClusterQuorum", "InspectAllocator"},
+						Labels:  map[string]string{"env": "staging"},
+					}},
+					Subjects: []string{"alice"},
+				},
+			},
+			{
+				ID: "production",
+				Spec: api.RoleSpec{
+					Annotations: api.Annotations{Name: "production"},
+					Rules: []api.RoleRule{{
+						Methods: []string{"*"},
+						Labels:  map[string]string{"env": "production"},
+					}},
+					Subjects: []string{"bob"},
+				},
+			},
+		} {
+			if err := store.CreateRole(tx, role); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	a := NewAuthorizer(s)
+	alice := contextWithCaller(ca.UserRole, "alice")
+	bob := contextWithCaller(ca.UserRole, "bob")
+
+	// cluster-wide information is scoped by the labels of the cluster
+	for method, request := range map[string]interface{}{
+		"ListAuditEvents":    &api.ListAuditEventsRequest{},
+		"ListPublishedPorts": &api.ListPublishedPortsRequest{},
+		"GetClusterQuorum":   &api.GetClusterQuorumRequest{},
+		"InspectAllocator":   &api.InspectAllocatorRequest{},
+	} {
+		assert.NoError(t, a.Authorize(alice, method, request), method)
+		assert.Error(t, a.Authorize(bob, method, request), method)
+	}
+
+	// nodes are scoped by the labels of their spec, not the engine labels
+	assert.NoError(t, a.Authorize(alice, "ListNodes", &api.ListNodesRequest{
+		Filters: &api.ListNodesRequest_Filters{NodeLabels: map[string]string{"env": "staging"}},
+	}))
+	assert.Error(t, a.Authorize(alice, "ListNodes", &api.ListNodesRequest{
+		Filters: &api.ListNodesRequest_Filters{Labels: map[string]string{"env": "staging"}},
+	}))
+}
+
+func TestAuthorizeUpdateNetwork(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	require.NotNil(t, s)
+	defer s.Close()
+
+	network := &api.Network{
+		ID: "payments",
+		Spec: api.NetworkSpec{
+			Annotations: api.Annotations{
+				Name:   "payments",
+				Labels: map[string]string{"team": "payments"},
+			},
+		},
+	}
+	err := s.Update(func(tx store.Tx) error {
+		if err := store.CreateNetwork(tx, network); err != nil {
+			return err
+		}
+		return store.CreateRole(tx, &api.Role{
+			ID: "payments",
+			Spec: api.RoleSpec{
+				Annotations: api.Annotations{Name: "payments"},
+				Rules: []api.RoleRule{{
+					Methods: []string{"UpdateNetwork"},
+					Labels:  map[string]string{"team": "payments"},
+				}},
+				Subjects: []string{"alice"},
+			},
+		})
+	})
+	require.NoError(t, err)
+
+	a := NewAuthorizer(s)
+	alice := contextWithCaller(ca.UserRole, "alice")
+
+	assert.NoError(t, a.Authorize(alice, "UpdateNetwork", &api.UpdateNetworkRequest{
+		NetworkID: "payments",
+		Spec:      &network.Spec,
+	}))
+
+	// the network can't be moved out of scope
+	relabeled := network.Spec.Copy()
+	relabeled.Annotations.Labels = map[string]string{"team": "web"}
+	err = a.Authorize(alice, "UpdateNetwork", &api.UpdateNetworkRequest{
+		NetworkID: "payments",
+		Spec:      relabeled,
+	})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+
+	err = a.Authorize(alice, "UpdateNetwork", &api.UpdateNetworkRequest{
+		NetworkID: "missing",
+		Spec:      &network.Spec,
+	})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+}
+
+func TestAuthorizeTransaction(t *testing.T) {
+	s := store.NewMemoryStore(nil)
+	require.NotNil(t, s)
+	defer s.Close()
+
+	labeledService := func(id, team string) *api.Service {
+		return &api.Service{
+			ID: id,
+			Spec: api.ServiceSpec{
+				Annotations: api.Annotations{
+					Name:   id,
+					Labels: map[string]string{"team": team},
+				},
+			},
+		}
+	}
+	payments := labeledService("payments", "payments")
+	frontend := labeledService("frontend", "web")
+	secret := &api.Secret{
+		ID: "secret",
+		Spec: api.SecretSpec{
+			Annotations: api.Annotations{Name: "secret"},
+		},
+	}
+	err := s.Update(func(tx store.Tx) error {
+		for _, service := range []*api.Service{payments, frontend} {
+			if err := store.CreateService(tx, service); err != nil {
+				return err
+			}
+		}
+		if err := store.CreateSecret(tx, secret); err != nil {
+			return err
+		}
+		if err := store.CreateRole(tx, &api.Role{
+			ID: "payments",
+			Spec: api.RoleSpec{
+				Annotations: api.Annotations{Name: "payments"},
+				Rules: []api.RoleRule{
+					{
+						Methods: []string{"GetService", "CreateService", "UpdateService"},
+						Labels:  map[string]string{"team": "payments"},
+					},
+					{Methods: []string{"CreateSecret"}},
+				},
+				Subjects: []string{"alice"},
+			},
+		}); err != nil {
+			return err
+		}
+		return store.CreateRole(tx, &api.Role{
+			ID: "transactions",
+			Spec: api.RoleSpec{
+				Annotations: api.Annotations{Name: "transactions"},
+				Rules:       []api.RoleRule{{Methods: []string{"Transaction"}}},
+				Subjects:    []string{"bob"},
+			},
+		})
+	})
+	require.NoError(t, err)
+
+	a := NewAuthorizer(s)
+	alice := contextWithCaller(ca.UserRole, "alice")
+
+	createSecret := &api.TransactionOperation{Operation: &api.TransactionOperation_CreateSecret{
+		CreateSecret: &api.CreateSecretRequest{Spec: &api.SecretSpec{
+			Annotations: api.Annotations{Name: "new-secret"},
+		}},
+	}}
+	createService := func(team string) *api.TransactionOperation {
+		return &api.TransactionOperation{Operation: &api.TransactionOperation_CreateService{
+			CreateService: &api.CreateServiceRequest{Spec: &labeledService("new", team).Spec},
+		}}
+	}
+	assertDenied := func(ctx context.Context, request *api.TransactionRequest, method string) {
+		err := a.Authorize(ctx, "Transaction", request)
+		assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+		assert.Contains(t, grpc.ErrorDesc(err), "not allowed to call "+method)
+	}
+
+	// each operation is authorized as its own method, on its own objects
+	assert.NoError(t, a.Authorize(alice, "Transaction", &api.TransactionRequest{
+		Operations: []*api.TransactionOperation{createSecret, createService("payments")},
+	}))
+	assertDenied(alice, &api.TransactionRequest{
+		Operations: []*api.TransactionOperation{createSecret, createService("web")},
+	}, "CreateService")
+	assertDenied(alice, &api.TransactionRequest{
+		Operations: []*api.TransactionOperation{{Operation: &api.TransactionOperation_UpdateService{
+			UpdateService: &api.UpdateServiceRequest{
+				ServiceID:      "frontend",
+				ServiceVersion: &frontend.Meta.Version,
+				Spec:           &payments.Spec,
+			},
+		}}},
+	}, "UpdateService")
+	assertDenied(alice, &api.TransactionRequest{
+		Operations: []*api.TransactionOperation{createSecret, {Operation: &api.TransactionOperation_RemoveSecret{
+			RemoveSecret: &api.RemoveSecretRequest{SecretID: "secret"},
+		}}},
+	}, "RemoveSecret")
+
+	// preconditions need the objects they refer to to be readable
+	assert.NoError(t, a.Authorize(alice, "Transaction", &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{
+			{Kind: api.TransactionPrecondition_SERVICE, Name: "payments"},
+			{Kind: api.TransactionPrecondition_SERVICE, Name: "new", Absent: true},
+		},
+		Operations: []*api.TransactionOperation{createService("payments")},
+	}))
+	assertDenied(alice, &api.TransactionRequest{
+		Preconditions: []*api.TransactionPrecondition{
+			{Kind: api.TransactionPrecondition_SERVICE, ID: "frontend"},
+		},
+		Operations: []*api.TransactionOperation{createService("payments")},
+	}, "GetService")
+
+	// being allowed to call Transaction doesn't allow its operations
+	bob := contextWithCaller(ca.UserRole, "bob")
+	assertDenied(bob, &api.TransactionRequest{
+		Operations: []*api.TransactionOperation{createSecret},
+	}, "CreateSecret")
+}
@@ -0,0 +1,225 @@
+// Package readproxy serves the read-only calls of the Control API from the
+// store of the local manager, even when it is a follower, instead of
+// forwarding them to the leader.
+//
+// Reads are linearizable by default: a follower first waits for its store to
+// reflect every change committed before the call, using the raft ReadIndex
+// protocol. Callers that can tolerate stale data may skip this wait with
+// WithStaleReads.
+package readproxy
+
+import (
+	"github.com/docker/swarmkit/api"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// staleReadsKey is the metadata key requesting stale reads.
+const staleReadsKey = "swarm-stale-reads"
+
+// WithStaleReads returns a context for calls that may be served from the
+// store of a follower without waiting for it to catch up with the leader.
+func WithStaleReads(ctx context.Context) context.Context {
+	md, _ := metadata.FromContext(ctx)
+	md = md.Copy()
+	md[staleReadsKey] = []string{"true"}
+	return metadata.NewContext(ctx, md)
+}
+
+func staleReads(ctx context.Context) bool {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	v := md[staleReadsKey]
+	return len(v) > 0 && v[0] == "true"
+}
+
+// Reader waits for the local store to be up to date.
+type Reader interface {
+	IsLeader() bool
+	LinearizableRead(ctx context.Context) error
+}
+
+// Server is a ControlServer serving the read-only calls with local, and
+// passing the others to the embedded ControlServer.
+type Server struct {
+	api.ControlServer
+
+	local   api.ControlServer
+	reader  Reader
+	withCtx func(context.Context) (context.Context, error)
+}
+
+// NewServer returns a ControlServer which serves the read-only calls with
+// local once reader reports the store is up to date, and all other calls
+// with next. withCtx, if not nil, is applied to the context of the calls
+// served with local.
+func NewServer(next, local api.ControlServer, reader Reader, withCtx func(context.Context) (context.Context, error)) *Server {
+	return &Server{
+		ControlServer: next,
+		local:         local,
+		reader:        reader,
+		withCtx:       withCtx,
+	}
+}
+
+// read prepares a read-only call to be served locally. The leader's store is
+// always up to date, so only followers wait.
+func (s *Server) read(ctx context.Context) (context.Context, error) {
+	if !staleReads(ctx) && !s.reader.IsLeader() {
+		if err := s.reader.LinearizableRead(ctx); err != nil {
+			return nil, grpc.Errorf(codes.Unavailable, "failed to catch up with the leader: %v", err)
+		}
+	}
+	if s.withCtx != nil {
+		return s.withCtx(ctx)
+	}
+	return ctx, nil
+}
+
+// GetNode serves the call locally.
+func (s *Server) GetNode(ctx context.Context, r *api.GetNodeRequest) (*api.GetNodeResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.GetNode(ctx, r)
+}
+
+// ListNodes serves the call locally.
+func (s *Server) ListNodes(ctx context.Context, r *api.ListNodesRequest) (*api.ListNodesResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListNodes(ctx, r)
+}
+
+// GetTask serves the call locally.
+func (s *Server) GetTask(ctx context.Context, r *api.GetTaskRequest) (*api.GetTaskResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.GetTask(ctx, r)
+}
+
+// ListTasks serves the call locally.
+func (s *Server) ListTasks(ctx context.Context, r *api.ListTasksRequest) (*api.ListTasksResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListTasks(ctx, r)
+}
+
+// GetService serves the call locally.
+func (s *Server) GetService(ctx context.Context, r *api.GetServiceRequest) (*api.GetServiceResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.GetService(ctx, r)
+}
+
+// ListServices serves the call locally.
+func (s *Server) ListServices(ctx context.Context, r *api.ListServicesRequest) (*api.ListServicesResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListServices(ctx, r)
+}
+
+// GetNetwork serves the call locally.
+func (s *Server) GetNetwork(ctx context.Context, r *api.GetNetworkRequest) (*api.GetNetworkResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.GetNetwork(ctx, r)
+}
+
+// ListNetworks serves the call locally.
+func (s *Server) ListNetworks(ctx context.Context, r *api.ListNetworksRequest) (*api.ListNetworksResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListNetworks(ctx, r)
+}
+
+// GetCluster serves the call locally.
+func (s *Server) GetCluster(ctx context.Context, r *api.GetClusterRequest) (*api.GetClusterResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.GetCluster(ctx, r)
+}
+
+// ListClusters serves the call locally.
+func (s *Server) ListClusters(ctx context.Context, r *api.ListClustersRequest) (*api.ListClustersResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListClusters(ctx, r)
+}
+
+// GetSecret serves the call locally.
+func (s *Server) GetSecret(ctx context.Context, r *api.GetSecretRequest) (*api.GetSecretResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.GetSecret(ctx, r)
+}
+
+// ListSecrets serves the call locally.
+func (s *Server) ListSecrets(ctx context.Context, r *api.ListSecretsRequest) (*api.ListSecretsResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListSecrets(ctx, r)
+}
+
+// GetRole serves the call locally.
+func (s *Server) GetRole(ctx context.Context, r *api.GetRoleRequest) (*api.GetRoleResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.GetRole(ctx, r)
+}
+
+// ListRoles serves the call locally.
+func (s *Server) ListRoles(ctx context.Context, r *api.ListRolesRequest) (*api.ListRolesResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListRoles(ctx, r)
+}
+
+// ListAuditEvents serves the call locally.
+func (s *Server) ListAuditEvents(ctx context.Context, r *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListAuditEvents(ctx, r)
+}
+
+// ListPublishedPorts serves the call locally.
+func (s *Server) ListPublishedPorts(ctx context.Context, r *api.ListPublishedPortsRequest) (*api.ListPublishedPortsResponse, error) {
+	ctx, err := s.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.local.ListPublishedPorts(ctx, r)
+}
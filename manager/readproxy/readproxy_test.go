@@ -0,0 +1,88 @@
+package readproxy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type mockReader struct {
+	leader bool
+	err    error
+	reads  int
+}
+
+func (r *mockReader) IsLeader() bool {
+	return r.leader
+}
+
+func (r *mockReader) LinearizableRead(ctx context.Context) error {
+	r.reads++
+	return r.err
+}
+
+// mockControlServer answers ListNodes and CreateService with a node or
+// service named after the server.
+type mockControlServer struct {
+	api.ControlServer
+	name string
+}
+
+func (s *mockControlServer) ListNodes(ctx context.Context, r *api.ListNodesRequest) (*api.ListNodesResponse, error) {
+	return &api.ListNodesResponse{Nodes: []*api.Node{{ID: s.name}}}, nil
+}
+
+func (s *mockControlServer) CreateService(ctx context.Context, r *api.CreateServiceRequest) (*api.CreateServiceResponse, error) {
+	return &api.CreateServiceResponse{Service: &api.Service{ID: s.name}}, nil
+}
+
+func TestReadProxy(t *testing.T) {
+	next := &mockControlServer{name: "next"}
+	local := &mockControlServer{name: "local"}
+	reader := &mockReader{}
+	s := NewServer(next, local, reader, nil)
+
+	// reads are served locally after catching up
+	resp, err := s.ListNodes(context.Background(), &api.ListNodesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "local", resp.Nodes[0].ID)
+	assert.Equal(t, 1, reader.reads)
+
+	// stale reads don't wait
+	resp, err = s.ListNodes(WithStaleReads(context.Background()), &api.ListNodesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "local", resp.Nodes[0].ID)
+	assert.Equal(t, 1, reader.reads)
+
+	// neither does the leader
+	reader.leader = true
+	_, err = s.ListNodes(context.Background(), &api.ListNodesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, reader.reads)
+
+	// other calls are passed on
+	createResp, err := s.CreateService(context.Background(), &api.CreateServiceRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "next", createResp.Service.ID)
+	assert.Equal(t, 1, reader.reads)
+}
+
+func TestReadProxyCatchUpFailure(t *testing.T) {
+	reader := &mockReader{err: errors.New("no leader")}
+	s := NewServer(&mockControlServer{name: "next"}, &mockControlServer{name: "local"}, reader, nil)
+
+	_, err := s.ListNodes(context.Background(), &api.ListNodesRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
+
+	// stale reads are still served
+	resp, err := s.ListNodes(WithStaleReads(context.Background()), &api.ListNodesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "local", resp.Nodes[0].ID)
+}
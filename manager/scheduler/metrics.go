@@ -0,0 +1,24 @@
+package scheduler
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	tickDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "swarm",
+		Subsystem: "scheduler",
+		Name:      "tick_duration_seconds",
+		Help:      "Time taken by a scheduling pass over the unassigned tasks.",
+	})
+
+	unschedulableTasksGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "swarm",
+		Subsystem: "scheduler",
+		Name:      "unschedulable_tasks",
+		Help:      "Number of tasks left unassigned by the last scheduling pass.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(tickDurationHistogram)
+	prometheus.MustRegister(unschedulableTasksGauge)
+}
@@ -65,6 +65,9 @@ func (ns *nodeSet) tree(serviceID string, preferences []*api.PlacementPreference
 		return root
 	}
 
+	// The candidate nodes aren't looked up in the label index of the store:
+	// the node set holds the resources reserved by decisions which aren't
+	// committed yet, and only follows the store through events.
 	for _, node := range ns.nodes {
 		tree := &root
 		for _, pref := range preferences {
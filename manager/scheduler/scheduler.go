@@ -57,19 +57,23 @@ func New(store *store.MemoryStore) *Scheduler {
 }
 
 func (s *Scheduler) setupTasksList(tx store.ReadTx) error {
-	tasks, err := store.FindTasks(tx, store.All)
+	// Ignore all tasks that have not reached PENDING
+	// state and tasks that no longer consume resources.
+	tasks, err := store.FindTasks(tx, store.Or(
+		store.ByTaskState(api.TaskStatePending),
+		store.ByTaskState(api.TaskStateAssigned),
+		store.ByTaskState(api.TaskStateAccepted),
+		store.ByTaskState(api.TaskStatePreparing),
+		store.ByTaskState(api.TaskStateReady),
+		store.ByTaskState(api.TaskStateStarting),
+		store.ByTaskState(api.TaskStateRunning),
+	))
 	if err != nil {
 		return err
 	}
 
 	tasksByNode := make(map[string]map[string]*api.Task)
 	for _, t := range tasks {
-		// Ignore all tasks that have not reached PENDING
-		// state and tasks that no longer consume resources.
-		if t.Status.State < api.TaskStatePending || t.Status.State > api.TaskStateRunning {
-			continue
-		}
-
 		s.allTasks[t.ID] = t
 		if t.NodeID == "" {
 			s.enqueue(t)
@@ -332,6 +336,12 @@ func (s *Scheduler) processPreassignedTasks(ctx context.Context) {
 
 // tick attempts to schedule the queue.
 func (s *Scheduler) tick(ctx context.Context) {
+	start := time.Now()
+	defer func() {
+		tickDurationHistogram.Observe(time.Since(start).Seconds())
+		unschedulableTasksGauge.Set(float64(s.unassignedTasks.Len()))
+	}()
+
 	tasksByCommonSpec := make(map[string]map[string]*api.Task)
 	schedulingDecisions := make(map[string]schedulingDecision, s.unassignedTasks.Len())
 
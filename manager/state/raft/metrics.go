@@ -0,0 +1,24 @@
+package raft
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	proposalLatencyHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "swarm",
+		Subsystem: "raft",
+		Name:      "proposal_duration_seconds",
+		Help:      "Time taken for a proposed store change to be committed and applied, by result.",
+	}, []string{"result"})
+
+	commitLagGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "swarm",
+		Subsystem: "raft",
+		Name:      "commit_index_lag",
+		Help:      "Number of committed raft log entries not yet applied to the store.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(proposalLatencyHistogram)
+	prometheus.MustRegister(commitLagGauge)
+}
@@ -1,6 +1,7 @@
 package raft
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/rand"
@@ -57,6 +58,9 @@ var (
 	// ErrMemberUnknown is sent in response to a message from an
 	// unrecognized peer.
 	ErrMemberUnknown = errors.New("raft: member unknown")
+	// ErrSchemaVersionTooOld is returned when a manager which doesn't
+	// support the store schema version of the cluster tries to join.
+	ErrSchemaVersionTooOld = errors.New("raft: the store schema version of the cluster is not supported by this manager, it must be upgraded")
 )
 
 // LeadershipState indicates whether the node is a leader or follower.
@@ -110,6 +114,9 @@ type Node struct {
 	appliedIndex    uint64
 	snapshotMeta    raftpb.SnapshotMetadata
 	writtenWALIndex uint64
+	// logSizeSinceSnapshot is the size of the log entries applied since
+	// the last snapshot.
+	logSizeSinceSnapshot uint64
 
 	ticker clock.Ticker
 	doneCh chan struct{}
@@ -142,6 +149,18 @@ type Node struct {
 	rotationQueued      bool
 	clearData           bool
 	waitForAppliedIndex uint64
+
+	// snapshotReceiver reassembles the snapshots streamed by the leader.
+	snapshotReceiver *transport.SnapshotReceiver
+
+	// readWait holds the linearizable reads waiting for their read index.
+	readWait *wait
+	// readAppliedIndex is the applied index as seen by linearizable reads,
+	// and readAppliedCh is closed and replaced when it advances. Both are
+	// protected by readAppliedMu.
+	readAppliedMu    sync.Mutex
+	readAppliedIndex uint64
+	readAppliedCh    chan struct{}
 }
 
 // NodeOptions provides node-level options.
@@ -170,6 +189,12 @@ type NodeOptions struct {
 	// nodes. Leave this as 0 to get the default value.
 	SendTimeout    time.Duration
 	TLSCredentials credentials.TransportCredentials
+	// ReachableStablePeriod is the time a member must have been reachable
+	// since its last failure before it counts towards the quorum when
+	// checking whether a member can be removed, so that a flapping member
+	// doesn't make a removal look safe. Leave this as 0 to get the default
+	// value, an election timeout.
+	ReachableStablePeriod time.Duration
 
 	KeyRotator EncryptionKeyRotator
 }
@@ -190,6 +215,9 @@ func NewNode(opts NodeOptions) *Node {
 	if opts.SendTimeout == 0 {
 		opts.SendTimeout = 2 * time.Second
 	}
+	if opts.ReachableStablePeriod == 0 {
+		opts.ReachableStablePeriod = time.Duration(cfg.ElectionTick) * opts.TickInterval
+	}
 
 	raftStore := raft.NewMemoryStorage()
 
@@ -221,6 +249,9 @@ func NewNode(opts NodeOptions) *Node {
 
 	n.reqIDGen = idutil.NewGenerator(uint16(n.Config.ID), time.Now())
 	n.wait = newWait()
+	n.readWait = newWait()
+	n.snapshotReceiver = transport.NewSnapshotReceiver()
+	n.readAppliedCh = make(chan struct{})
 
 	n.cancelFunc = func(n *Node) func() {
 		var cancelOnce sync.Once
@@ -371,6 +402,7 @@ func (n *Node) JoinAndStart(ctx context.Context) (err error) {
 	n.appliedIndex = snapshot.Metadata.Index
 	n.snapshotMeta = snapshot.Metadata
 	n.writtenWALIndex, _ = n.raftStore.LastIndex() // lastIndex always returns nil as an error
+	n.publishAppliedIndex()
 
 	n.addrLock.Lock()
 	defer n.addrLock.Unlock()
@@ -415,7 +447,8 @@ func (n *Node) JoinAndStart(ctx context.Context) (err error) {
 	joinCtx, joinCancel := context.WithTimeout(ctx, n.reqTimeout())
 	defer joinCancel()
 	resp, err := client.Join(joinCtx, &api.JoinRequest{
-		Addr: n.opts.Addr,
+		Addr:          n.opts.Addr,
+		SchemaVersion: store.SchemaVersion(),
 	})
 	if err != nil {
 		return err
@@ -455,6 +488,7 @@ func DefaultRaftConfig() api.RaftConfig {
 		LogEntriesForSlowFollowers: 500,
 		ElectionTick:               3,
 		HeartbeatTick:              1,
+		SnapshotLogSize:            defaultSnapshotLogSize,
 	}
 }
 
@@ -516,6 +550,9 @@ func (n *Node) Run(ctx context.Context) error {
 	}()
 
 	wasLeader := false
+	// commitIndex is the last commit index reported by raft, used to
+	// track how far the store lags behind it.
+	var commitIndex uint64
 
 	for {
 		select {
@@ -547,6 +584,7 @@ func (n *Node) Run(ctx context.Context) error {
 				n.appliedIndex = rd.Snapshot.Metadata.Index
 				n.snapshotMeta = rd.Snapshot.Metadata
 				n.confState = rd.Snapshot.Metadata.ConfState
+				n.logSizeSinceSnapshot = 0
 			}
 
 			// If we cease to be the leader, we must cancel any
@@ -589,6 +627,25 @@ func (n *Node) Run(ctx context.Context) error {
 				if err := n.processCommitted(ctx, entry); err != nil {
 					log.G(ctx).WithError(err).Error("failed to process committed entries")
 				}
+				n.logSizeSinceSnapshot += uint64(len(entry.Data))
+			}
+			n.publishAppliedIndex()
+
+			// Hand the read indexes to the linearizable reads
+			// waiting for them.
+			for _, rs := range rd.ReadStates {
+				if len(rs.RequestCtx) == 8 {
+					n.readWait.trigger(binary.BigEndian.Uint64(rs.RequestCtx), rs.Index)
+				}
+			}
+
+			if !raft.IsEmptyHardState(rd.HardState) {
+				commitIndex = rd.HardState.Commit
+			}
+			if commitIndex > n.appliedIndex {
+				commitLagGauge.Set(float64(commitIndex - n.appliedIndex))
+			} else {
+				commitLagGauge.Set(0)
 			}
 
 			// in case the previous attempt to update the key failed
@@ -596,7 +653,7 @@ func (n *Node) Run(ctx context.Context) error {
 
 			// Trigger a snapshot every once in awhile
 			if n.snapshotInProgress == nil &&
-				(n.needsSnapshot(ctx) || raftConfig.SnapshotInterval > 0 &&
+				(n.needsSnapshot(ctx) || n.logSizeExceeded(raftConfig) || raftConfig.SnapshotInterval > 0 &&
 					n.appliedIndex-n.snapshotMeta.Index >= raftConfig.SnapshotInterval) {
 				n.doSnapshot(ctx, raftConfig)
 			}
@@ -695,6 +752,21 @@ func (n *Node) restoreFromSnapshot(ctx context.Context, data []byte) error {
 	return nil
 }
 
+// defaultSnapshotLogSize is the size of the log entries which triggers a
+// snapshot if the cluster doesn't set one, as is the case of clusters
+// created before the setting existed.
+const defaultSnapshotLogSize = 64 << 20
+
+// logSizeExceeded reports whether the log entries applied since the last
+// snapshot reached the size which triggers a new snapshot.
+func (n *Node) logSizeExceeded(raftConfig api.RaftConfig) bool {
+	size := raftConfig.SnapshotLogSize
+	if size == 0 {
+		size = defaultSnapshotLogSize
+	}
+	return n.logSizeSinceSnapshot >= size
+}
+
 func (n *Node) needsSnapshot(ctx context.Context) bool {
 	if n.waitForAppliedIndex == 0 && n.keyRotator.NeedsRotation() {
 		keys := n.keyRotator.GetKeys()
@@ -881,6 +953,20 @@ func (n *Node) Join(ctx context.Context, req *api.JoinRequest) (*api.JoinRespons
 		}
 	}
 
+	// Once a migration was recorded, managers which don't know about it
+	// could write objects in the old schema.
+	var schemaVersion uint32
+	n.memoryStore.View(func(tx store.ReadTx) {
+		clusters, err := store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+		if err == nil && len(clusters) == 1 {
+			schemaVersion = clusters[0].SchemaVersion
+		}
+	})
+	if schemaVersion > req.SchemaVersion {
+		log.Debugf("refusing join from manager supporting schema version %d, cluster is at %d", req.SchemaVersion, schemaVersion)
+		return nil, grpc.Errorf(codes.FailedPrecondition, "%s", ErrSchemaVersionTooOld.Error())
+	}
+
 	// Find a unique ID for the joining member.
 	var raftID uint64
 	for {
@@ -1059,34 +1145,67 @@ func (n *Node) Leave(ctx context.Context, req *api.LeaveRequest) (*api.LeaveResp
 	return &api.LeaveResponse{}, nil
 }
 
-// CanRemoveMember checks if a member can be removed from
-// the context of the current node.
-func (n *Node) CanRemoveMember(id uint64) bool {
-	members := n.cluster.Members()
-	nreachable := 0 // reachable managers after removal
-
-	for _, m := range members {
+// QuorumStatus describes how many raft members are reachable compared to
+// the quorum of the cluster.
+type QuorumStatus struct {
+	// Members is the number of members of the raft cluster.
+	Members int
+	// Reachable is the number of members which are currently reachable,
+	// including the local node.
+	Reachable int
+	// Quorum is the number of members needed for the cluster to make
+	// progress.
+	Quorum int
+	// Unreachable lists the raft IDs of the members which can't be reached.
+	Unreachable []uint64
+}
+
+// FaultTolerance returns the number of reachable members which can fail
+// before the cluster loses its quorum. It is negative if the quorum is
+// already lost.
+func (q QuorumStatus) FaultTolerance() int {
+	return q.Reachable - q.Quorum
+}
+
+// QuorumStatus returns the quorum of the raft cluster, computed from the
+// members the local node is currently connected to. Since followers only
+// exchange messages with the leader, it is accurate on the leader only.
+func (n *Node) QuorumStatus() QuorumStatus {
+	return n.QuorumStatusWithout(raft.None)
+}
+
+// QuorumStatusWithout returns the quorum the raft cluster would have after
+// removing the member with the given ID. Members which failed to be reached
+// within ReachableStablePeriod are counted as unreachable.
+func (n *Node) QuorumStatusWithout(id uint64) QuorumStatus {
+	var q QuorumStatus
+	for _, m := range n.cluster.Members() {
 		if m.RaftID == id {
 			continue
 		}
+		q.Members++
 
 		// Local node from where the remove is issued
-		if m.RaftID == n.Config.ID {
-			nreachable++
-			continue
-		}
-
-		if n.transport.Active(m.RaftID) {
-			nreachable++
+		if m.RaftID == n.Config.ID || n.stablyActive(m.RaftID) {
+			q.Reachable++
+		} else {
+			q.Unreachable = append(q.Unreachable, m.RaftID)
 		}
 	}
+	q.Quorum = q.Members/2 + 1
+	return q
+}
 
-	nquorum := (len(members)-1)/2 + 1
-	if nreachable < nquorum {
-		return false
-	}
+// stablyActive returns true if the member is active and didn't fail to be
+// reached within ReachableStablePeriod.
+func (n *Node) stablyActive(id uint64) bool {
+	return n.transport.Active(id) && time.Since(n.transport.LastFailure(id)) >= n.opts.ReachableStablePeriod
+}
 
-	return true
+// CanRemoveMember checks if a member can be removed from
+// the context of the current node.
+func (n *Node) CanRemoveMember(id uint64) bool {
+	return n.QuorumStatusWithout(id).FaultTolerance() >= 0
 }
 
 func (n *Node) removeMember(ctx context.Context, id uint64) error {
@@ -1286,6 +1405,17 @@ func (n *Node) ProcessRaftMessage(ctx context.Context, msg *api.ProcessRaftMessa
 	return &api.ProcessRaftMessageResponse{}, nil
 }
 
+// StreamRaftMessage receives a raft message carrying a snapshot, streamed in
+// chunks, and processes it.
+func (n *Node) StreamRaftMessage(stream api.Raft_StreamRaftMessageServer) error {
+	m, err := n.snapshotReceiver.Receive(stream)
+	if err != nil {
+		return err
+	}
+	_, err = n.ProcessRaftMessage(stream.Context(), &api.ProcessRaftMessageRequest{Message: m})
+	return err
+}
+
 // ResolveAddress returns the address reaching for a given node ID.
 func (n *Node) ResolveAddress(ctx context.Context, msg *api.ResolveAddressRequest) (*api.ResolveAddressResponse, error) {
 	if !n.IsMember() {
@@ -1411,13 +1541,86 @@ func (n *Node) registerNode(node *api.RaftMember) error {
 func (n *Node) ProposeValue(ctx context.Context, storeAction []*api.StoreAction, cb func()) error {
 	ctx, cancel := n.WithContext(ctx)
 	defer cancel()
+	start := time.Now()
 	_, err := n.processInternalRaftRequest(ctx, &api.InternalRaftRequest{Action: storeAction}, cb)
 	if err != nil {
+		proposalLatencyHistogram.WithLabelValues("error").Observe(time.Since(start).Seconds())
 		return err
 	}
+	proposalLatencyHistogram.WithLabelValues("success").Observe(time.Since(start).Seconds())
 	return nil
 }
 
+// LinearizableRead waits until the local store reflects every change
+// committed before the call, using the raft ReadIndex protocol. Reads from
+// the store that follow it are linearizable, even on a follower.
+func (n *Node) LinearizableRead(ctx context.Context) error {
+	ctx, cancel := n.WithContext(ctx)
+	defer cancel()
+
+	if !n.IsMember() {
+		return ErrNoRaftMember
+	}
+	if n.leader() == raft.None {
+		return ErrNoClusterLeader
+	}
+
+	// A read index request is silently dropped if leadership changes while
+	// it is in flight, so don't wait for it longer than an election.
+	ctx, cancelRead := context.WithTimeout(ctx, time.Duration(n.Config.ElectionTick)*n.opts.TickInterval)
+	defer cancelRead()
+
+	id := n.reqIDGen.Next()
+	ch := n.readWait.register(id, nil, nil)
+	defer n.readWait.cancel(id)
+
+	rctx := make([]byte, 8)
+	binary.BigEndian.PutUint64(rctx, id)
+	if err := n.raftNode.ReadIndex(ctx, rctx); err != nil {
+		return err
+	}
+
+	var readIndex uint64
+	select {
+	case x, ok := <-ch:
+		if !ok {
+			return ErrStopped
+		}
+		readIndex = x.(uint64)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for {
+		n.readAppliedMu.Lock()
+		applied, appliedCh := n.readAppliedIndex, n.readAppliedCh
+		n.readAppliedMu.Unlock()
+
+		if applied >= readIndex {
+			return nil
+		}
+		select {
+		case <-appliedCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// publishAppliedIndex makes the applied index visible to the linearizable
+// reads, waking up the ones waiting for it to advance.
+func (n *Node) publishAppliedIndex() {
+	n.readAppliedMu.Lock()
+	defer n.readAppliedMu.Unlock()
+
+	if n.appliedIndex == n.readAppliedIndex {
+		return
+	}
+	n.readAppliedIndex = n.appliedIndex
+	close(n.readAppliedCh)
+	n.readAppliedCh = make(chan struct{})
+}
+
 // GetVersion returns the sequence information for the current raft round.
 func (n *Node) GetVersion() *api.Version {
 	n.stopMu.RLock()
@@ -207,6 +207,29 @@ func TestRaftLogReplication(t *testing.T) {
 	raftutils.CheckValue(t, clockSource, nodes[3], value)
 }
 
+func TestRaftLinearizableRead(t *testing.T) {
+	t.Parallel()
+
+	nodes, _ := raftutils.NewRaftCluster(t, tc)
+	defer raftutils.TeardownCluster(nodes)
+
+	// Propose a value
+	value, err := raftutils.ProposeValue(t, nodes[1], DefaultProposalTime)
+	require.NoError(t, err, "failed to propose value")
+
+	// Once a linearizable read returns, the value must be in the store of
+	// the followers, without polling
+	for _, i := range []uint64{2, 3} {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		require.NoError(t, nodes[i].LinearizableRead(ctx))
+		cancel()
+
+		nodes[i].MemoryStore().View(func(tx store.ReadTx) {
+			assert.Equal(t, value, store.GetNode(tx, value.ID))
+		})
+	}
+}
+
 func TestRaftLogReplicationWithoutLeader(t *testing.T) {
 	t.Parallel()
 	nodes, clockSource := raftutils.NewRaftCluster(t, tc)
@@ -712,6 +735,80 @@ func TestRaftJoinWithIncorrectAddress(t *testing.T) {
 	assert.Len(t, nodes[1].GetMemberlist(), 1)
 }
 
+func TestRaftJoinWithOldSchemaVersion(t *testing.T) {
+	t.Parallel()
+
+	nodes := make(map[uint64]*raftutils.TestNode)
+	var clockSource *fakeclock.FakeClock
+	nodes[1], clockSource = raftutils.NewInitNode(t, tc, nil)
+	defer raftutils.ShutdownNode(nodes[1])
+
+	// Record a schema version newer than the one this manager supports
+	assert.NoError(t, nodes[1].MemoryStore().Update(func(tx store.Tx) error {
+		return store.CreateCluster(tx, &api.Cluster{
+			ID: "cluster",
+			Spec: api.ClusterSpec{
+				Annotations: api.Annotations{Name: store.DefaultClusterName},
+			},
+			SchemaVersion: store.SchemaVersion() + 1,
+		})
+	}))
+
+	n := raftutils.NewNode(t, clockSource, tc, raft.NodeOptions{JoinAddr: nodes[1].Address})
+	defer raftutils.CleanupNonRunningNode(n)
+
+	err := n.JoinAndStart(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+	assert.Equal(t, raft.ErrSchemaVersionTooOld.Error(), grpc.ErrorDesc(err))
+	assert.Len(t, nodes[1].GetMemberlist(), 1)
+}
+
+func TestRaftMigrationReplicated(t *testing.T) {
+	t.Parallel()
+
+	nodes, clockSource := raftutils.NewRaftCluster(t, tc)
+	defer raftutils.TeardownCluster(nodes)
+
+	assert.NoError(t, nodes[1].MemoryStore().Update(func(tx store.Tx) error {
+		assert.NoError(t, store.CreateCluster(tx, &api.Cluster{
+			ID: "cluster",
+			Spec: api.ClusterSpec{
+				Annotations: api.Annotations{Name: store.DefaultClusterName},
+			},
+		}))
+		return store.CreateService(tx, &api.Service{
+			ID: "legacy",
+			Spec: api.ServiceSpec{
+				Annotations: api.Annotations{Name: "legacy"},
+				Networks:    []*api.NetworkAttachmentConfig{{Target: "net1"}},
+			},
+		})
+	}))
+
+	// The leader migrates the store, the followers receive the migrated
+	// objects through the log
+	assert.NoError(t, store.Migrate(context.Background(), nodes[1].MemoryStore()))
+	for _, node := range []*raftutils.TestNode{nodes[2], nodes[3]} {
+		assert.NoError(t, raftutils.PollFunc(clockSource, func() error {
+			var err error
+			node.MemoryStore().View(func(tx store.ReadTx) {
+				cluster := store.GetCluster(tx, "cluster")
+				service := store.GetService(tx, "legacy")
+				switch {
+				case cluster == nil || service == nil:
+					err = errors.New("objects not replicated yet")
+				case cluster.SchemaVersion != store.SchemaVersion():
+					err = fmt.Errorf("expected schema version %d, got %d", store.SchemaVersion(), cluster.SchemaVersion)
+				case len(service.Spec.Networks) != 0 || len(service.Spec.Task.Networks) != 1:
+					err = errors.New("service networks not migrated")
+				}
+			})
+			return err
+		}))
+	}
+}
+
 func TestStress(t *testing.T) {
 	t.Parallel()
 
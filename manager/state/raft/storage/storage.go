@@ -68,15 +68,8 @@ func (e *EncryptedRaftLogger) BootstrapFromDisk(ctx context.Context, oldEncrypti
 	walDir := e.walDir()
 	snapDir := e.snapDir()
 
-	encrypter, decrypter := encryption.Defaults(e.EncryptionKey)
-	if oldEncryptionKeys != nil {
-		decrypters := []encryption.Decrypter{decrypter}
-		for _, key := range oldEncryptionKeys {
-			_, d := encryption.Defaults(key)
-			decrypters = append(decrypters, d)
-		}
-		decrypter = MultiDecrypter(decrypters)
-	}
+	encrypter, _ := encryption.Defaults(e.EncryptionKey)
+	decrypter := e.decrypter(oldEncryptionKeys)
 
 	snapFactory := NewSnapFactory(encrypter, decrypter)
 
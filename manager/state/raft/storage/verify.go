@@ -0,0 +1,244 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/coreos/etcd/pkg/fileutil"
+	"github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/snap"
+	"github.com/coreos/etcd/wal"
+	"github.com/coreos/etcd/wal/walpb"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/encryption"
+	"golang.org/x/net/context"
+)
+
+// VerifyReport is the result of checking the raft data on disk with Verify.
+// The data is healthy if no problems were found.
+type VerifyReport struct {
+	WALDir  string `json:"wal_dir"`
+	SnapDir string `json:"snap_dir"`
+
+	// Snapshots lists every snapshot file found, newest first.
+	Snapshots []SnapshotReport `json:"snapshots"`
+
+	// SnapshotIndex is the index of the snapshot the WAL was read from.
+	SnapshotIndex uint64 `json:"snapshot_index"`
+	FirstIndex    uint64 `json:"first_index"`
+	LastIndex     uint64 `json:"last_index"`
+	CommitIndex   uint64 `json:"commit_index"`
+	Entries       int    `json:"entries"`
+
+	Problems []string `json:"problems,omitempty"`
+}
+
+// SnapshotReport describes a single snapshot file checked by Verify.
+type SnapshotReport struct {
+	Name  string `json:"name"`
+	Index uint64 `json:"index"`
+	Term  uint64 `json:"term"`
+	Error string `json:"error,omitempty"`
+}
+
+// OK returns true if the verification didn't find any problems.
+func (r *VerifyReport) OK() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *VerifyReport) problemf(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+// Verify checks the raft data in the state directory without modifying it,
+// so it can be run against a copy of the raft directory of a manager that
+// is not running. It checks that every snapshot passes its CRC, can be
+// decrypted and decoded, that the WAL records following the newest
+// snapshot pass their CRCs and can be decrypted, that the entry indices are
+// contiguous, and that every entry decodes to a raft request or
+// configuration change.
+//
+// Problems with the data are recorded in the returned report; an error is
+// only returned if the data could not be examined at all.
+func (e *EncryptedRaftLogger) Verify(ctx context.Context, oldEncryptionKeys ...[]byte) (*VerifyReport, error) {
+	dirs, ok := e.existingDirs()
+	if !ok {
+		return nil, ErrNoWAL
+	}
+
+	report := &VerifyReport{
+		WALDir:  filepath.Join(e.StateDir, dirs.wal),
+		SnapDir: filepath.Join(e.StateDir, dirs.snap),
+	}
+
+	// Only the current directories are encrypted, the legacy ones are
+	// read as-is.
+	decrypt := func(data []byte) ([]byte, error) { return data, nil }
+	if dirs == versionedWALSnapDirs[0] {
+		decrypter := e.decrypter(oldEncryptionKeys)
+		decrypt = func(data []byte) ([]byte, error) {
+			return encryption.Decrypt(data, decrypter)
+		}
+	}
+
+	var walsnap walpb.Snapshot
+	if fileutil.Exist(report.SnapDir) {
+		names, err := ListSnapshots(report.SnapDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			sr := verifySnapshot(filepath.Join(report.SnapDir, name), decrypt)
+			sr.Name = name
+			report.Snapshots = append(report.Snapshots, sr)
+			if sr.Error != "" {
+				report.problemf("snapshot %s: %s", name, sr.Error)
+			}
+		}
+	}
+	// The WAL is checked from the newest snapshot even if it is broken,
+	// since older entries may have been garbage collected.
+	if len(report.Snapshots) > 0 {
+		walsnap.Index, walsnap.Term = report.Snapshots[0].Index, report.Snapshots[0].Term
+	}
+	report.SnapshotIndex = walsnap.Index
+
+	st, ents, err := readWAL(report.WALDir, walsnap)
+	if err != nil {
+		report.problemf("failed to read WAL: %v", err)
+		return report, nil
+	}
+
+	report.CommitIndex = st.Commit
+	report.Entries = len(ents)
+	if len(ents) > 0 {
+		report.FirstIndex = ents[0].Index
+		report.LastIndex = ents[len(ents)-1].Index
+		if report.FirstIndex != walsnap.Index+1 {
+			report.problemf("first WAL entry has index %d, expected %d", report.FirstIndex, walsnap.Index+1)
+		}
+	}
+	if !raft.IsEmptyHardState(st) {
+		if st.Commit < walsnap.Index {
+			report.problemf("commit index %d is behind snapshot index %d", st.Commit, walsnap.Index)
+		}
+		if last := walsnap.Index + uint64(len(ents)); st.Commit > last {
+			report.problemf("commit index %d is beyond the last entry %d", st.Commit, last)
+		}
+	}
+
+	for i, ent := range ents {
+		if i > 0 && ent.Index != ents[i-1].Index+1 {
+			report.problemf("gap in WAL entries between index %d and %d", ents[i-1].Index, ent.Index)
+		}
+		if i > 0 && ent.Term < ents[i-1].Term {
+			report.problemf("entry %d has term %d, lower than the previous entry's term %d", ent.Index, ent.Term, ents[i-1].Term)
+		}
+		if err := verifyEntry(ent, decrypt); err != nil {
+			report.problemf("entry %d: %v", ent.Index, err)
+		}
+	}
+
+	return report, nil
+}
+
+// existingDirs returns the most recent version of the wal and snap
+// directories present on disk.
+func (e *EncryptedRaftLogger) existingDirs() (walSnapDirs, bool) {
+	for _, dirs := range versionedWALSnapDirs {
+		if wal.Exist(filepath.Join(e.StateDir, dirs.wal)) {
+			return dirs, true
+		}
+	}
+	return walSnapDirs{}, false
+}
+
+// decrypter returns a decrypter for the current encryption key, which
+// falls back to the given old keys.
+func (e *EncryptedRaftLogger) decrypter(oldEncryptionKeys [][]byte) encryption.Decrypter {
+	_, decrypter := encryption.Defaults(e.EncryptionKey)
+	if oldEncryptionKeys != nil {
+		decrypters := []encryption.Decrypter{decrypter}
+		for _, key := range oldEncryptionKeys {
+			_, d := encryption.Defaults(key)
+			decrypters = append(decrypters, d)
+		}
+		decrypter = MultiDecrypter(decrypters)
+	}
+	return decrypter
+}
+
+// readWAL reads the WAL records following the given snapshot without
+// locking or repairing the files.
+func readWAL(walDir string, walsnap walpb.Snapshot) (st raftpb.HardState, ents []raftpb.Entry, err error) {
+	r, err := wal.OpenForRead(walDir, walsnap)
+	if err != nil {
+		return st, nil, err
+	}
+	defer r.Close()
+
+	// the wal package panics on records it cannot decode, and on entries
+	// that don't follow the snapshot
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("corrupt WAL: %v", p)
+		}
+	}()
+	_, st, ents, err = r.ReadAll()
+	return st, ents, err
+}
+
+func verifySnapshot(path string, decrypt func([]byte) ([]byte, error)) SnapshotReport {
+	// snap.Read checks the CRC without renaming broken files, unlike
+	// the Snapshotter.
+	snapshot, err := snap.Read(path)
+	if err != nil {
+		sr := SnapshotReport{Error: err.Error()}
+		fmt.Sscanf(filepath.Base(path), "%016x-%016x.snap", &sr.Term, &sr.Index)
+		return sr
+	}
+	sr := SnapshotReport{
+		Index: snapshot.Metadata.Index,
+		Term:  snapshot.Metadata.Term,
+	}
+	data, err := decrypt(snapshot.Data)
+	if err != nil {
+		sr.Error = fmt.Sprintf("failed to decrypt: %v", err)
+		return sr
+	}
+	var s api.Snapshot
+	if err := s.Unmarshal(data); err != nil {
+		sr.Error = fmt.Sprintf("failed to decode store objects: %v", err)
+	}
+	return sr
+}
+
+func verifyEntry(ent raftpb.Entry, decrypt func([]byte) ([]byte, error)) error {
+	data, err := decrypt(ent.Data)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt: %v", err)
+	}
+	switch ent.Type {
+	case raftpb.EntryConfChange:
+		var cc raftpb.ConfChange
+		if err := cc.Unmarshal(data); err != nil {
+			return fmt.Errorf("failed to decode configuration change: %v", err)
+		}
+	case raftpb.EntryNormal:
+		// empty entries are appended by new leaders
+		if len(data) == 0 {
+			return nil
+		}
+		var r api.InternalRaftRequest
+		if err := r.Unmarshal(data); err != nil {
+			return fmt.Errorf("failed to decode raft request: %v", err)
+		}
+		for _, action := range r.Action {
+			if action.Target == nil {
+				return fmt.Errorf("store action %s has no object", action.Action)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+// writeVerifiableData writes a snapshot of a store and a few entries that
+// follow it, encrypted with the given key.
+func writeVerifiableData(t *testing.T, stateDir string, key []byte) raftpb.Snapshot {
+	logger := EncryptedRaftLogger{
+		StateDir:      stateDir,
+		EncryptionKey: key,
+	}
+	require.NoError(t, logger.BootstrapNew([]byte("metadata")))
+
+	storeSnapshot := api.Snapshot{
+		Version: api.Snapshot_V0,
+		Store: api.StoreSnapshot{
+			Nodes: []*api.Node{{ID: "node1"}},
+		},
+	}
+	data, err := storeSnapshot.Marshal()
+	require.NoError(t, err)
+	snapshot := raftpb.Snapshot{
+		Data: data,
+		Metadata: raftpb.SnapshotMetadata{
+			ConfState: raftpb.ConfState{Nodes: []uint64{1}},
+			Index:     2,
+			Term:      1,
+		},
+	}
+	require.NoError(t, logger.SaveSnapshot(snapshot))
+
+	request := api.InternalRaftRequest{
+		ID: 1,
+		Action: []*api.StoreAction{{
+			Action: api.StoreActionKindCreate,
+			Target: &api.StoreAction_Node{Node: &api.Node{ID: "node2"}},
+		}},
+	}
+	data, err = request.Marshal()
+	require.NoError(t, err)
+	entries := []raftpb.Entry{
+		{Index: 3, Term: 2},
+		{Index: 4, Term: 2, Data: data},
+	}
+	require.NoError(t, logger.SaveEntries(raftpb.HardState{Term: 2, Commit: 4}, entries))
+	logger.Close(context.Background())
+
+	return snapshot
+}
+
+func TestVerify(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "raft-verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	writeVerifiableData(t, tempdir, []byte("key1"))
+
+	logger := EncryptedRaftLogger{
+		StateDir:      tempdir,
+		EncryptionKey: []byte("key1"),
+	}
+	report, err := logger.Verify(context.Background())
+	require.NoError(t, err)
+	require.True(t, report.OK(), "unexpected problems: %v", report.Problems)
+	require.Len(t, report.Snapshots, 1)
+	require.EqualValues(t, 2, report.SnapshotIndex)
+	require.EqualValues(t, 3, report.FirstIndex)
+	require.EqualValues(t, 4, report.LastIndex)
+	require.EqualValues(t, 4, report.CommitIndex)
+	require.Equal(t, 2, report.Entries)
+
+	// the old keys are tried as well
+	logger.EncryptionKey = []byte("key2")
+	report, err = logger.Verify(context.Background(), []byte("key1"))
+	require.NoError(t, err)
+	require.True(t, report.OK(), "unexpected problems: %v", report.Problems)
+
+	// a wrong key is reported for the snapshot and every entry
+	report, err = logger.Verify(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	require.Len(t, report.Problems, 3)
+
+	// nothing on disk
+	logger.StateDir = filepath.Join(tempdir, "nonexistent")
+	_, err = logger.Verify(context.Background())
+	require.Equal(t, ErrNoWAL, err)
+}
+
+func TestVerifyCorruptSnapshot(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "raft-verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	writeVerifiableData(t, tempdir, []byte("key1"))
+
+	logger := EncryptedRaftLogger{
+		StateDir:      tempdir,
+		EncryptionKey: []byte("key1"),
+	}
+	snapshots, err := ListSnapshots(logger.snapDir())
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	snapPath := filepath.Join(logger.snapDir(), snapshots[0])
+	data, err := ioutil.ReadFile(snapPath)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(snapPath, data, 0600))
+
+	report, err := logger.Verify(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	require.Len(t, report.Snapshots, 1)
+	require.NotEmpty(t, report.Snapshots[0].Error)
+
+	// the broken snapshot must not have been moved aside
+	_, err = os.Stat(snapPath)
+	require.NoError(t, err)
+}
+
+func TestVerifyUndecodableEntry(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "raft-verify")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	writeVerifiableData(t, tempdir, []byte("key1"))
+
+	logger := EncryptedRaftLogger{
+		StateDir:      tempdir,
+		EncryptionKey: []byte("key1"),
+	}
+	_, _, err = logger.BootstrapFromDisk(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, logger.SaveEntries(raftpb.HardState{Term: 2, Commit: 5}, []raftpb.Entry{
+		{Index: 5, Term: 2, Data: []byte("not a raft request")},
+	}))
+	logger.Close(context.Background())
+
+	report, err := logger.Verify(context.Background())
+	require.NoError(t, err)
+	require.Len(t, report.Problems, 1)
+	require.Contains(t, report.Problems[0], "entry 5")
+}
@@ -130,6 +130,33 @@ func TestRaftSnapshot(t *testing.T) {
 	raftutils.CheckValuesOnNodes(t, clockSource, nodes, nodeIDs, values)
 }
 
+func TestRaftSnapshotLogSize(t *testing.T) {
+	t.Parallel()
+
+	// Bring up a 3 node cluster which only takes snapshots based on the
+	// size of the log
+	nodes, clockSource := raftutils.NewRaftCluster(t, tc, &api.RaftConfig{SnapshotLogSize: 1, LogEntriesForSlowFollowers: 0})
+	defer raftutils.TeardownCluster(nodes)
+
+	value, err := raftutils.ProposeValue(t, nodes[1], DefaultProposalTime)
+	assert.NoError(t, err, "failed to propose value")
+	raftutils.CheckValuesOnNodes(t, clockSource, nodes, []string{value.ID}, []*api.Node{value})
+
+	// All nodes should have a snapshot file
+	for _, node := range nodes {
+		assert.NoError(t, raftutils.PollFunc(clockSource, func() error {
+			dirents, err := ioutil.ReadDir(filepath.Join(node.StateDir, "snap-v3-encrypted"))
+			if err != nil {
+				return err
+			}
+			if len(dirents) == 0 {
+				return fmt.Errorf("expected a snapshot, found none")
+			}
+			return nil
+		}))
+	}
+}
+
 func TestRaftSnapshotRestart(t *testing.T) {
 	t.Parallel()
 
@@ -302,6 +302,9 @@ func NewNode(t *testing.T, clockSource *fakeclock.FakeClock, tc *cautils.TestCA,
 		ClockSource:    clockSource,
 		TLSCredentials: securityConfig.ClientTLSCreds,
 		KeyRotator:     keyRotator,
+		// Tests can't wait for members to be reachable for an election
+		// timeout in real time.
+		ReachableStablePeriod: time.Nanosecond,
 	}
 
 	if len(opts) > 1 {
@@ -309,6 +312,9 @@ func NewNode(t *testing.T, clockSource *fakeclock.FakeClock, tc *cautils.TestCA,
 	}
 	if len(opts) == 1 {
 		newNodeOpts.JoinAddr = opts[0].JoinAddr
+		if opts[0].ReachableStablePeriod != 0 {
+			newNodeOpts.ReachableStablePeriod = opts[0].ReachableStablePeriod
+		}
 		if opts[0].Addr != "" {
 			newNodeOpts.Addr = opts[0].Addr
 		}
@@ -416,6 +422,8 @@ func CopyNode(t *testing.T, clockSource *fakeclock.FakeClock, oldNode *TestNode,
 		SendTimeout:     2 * time.Second,
 		TLSCredentials:  securityConfig.ClientTLSCreds,
 		KeyRotator:      kr,
+		// See NewNode
+		ReachableStablePeriod: time.Nanosecond,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
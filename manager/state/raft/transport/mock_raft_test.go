@@ -35,6 +35,8 @@ type mockRaft struct {
 
 	removed map[uint64]bool
 
+	snapshotReceiver *SnapshotReceiver
+
 	processedMessages  chan *raftpb.Message
 	processedSnapshots chan snapshotReport
 
@@ -51,6 +53,7 @@ func newMockRaft() (*mockRaft, error) {
 		lis:                  l,
 		s:                    grpc.NewServer(),
 		removed:              make(map[uint64]bool),
+		snapshotReceiver:     NewSnapshotReceiver(),
 		nodeRemovedSignal:    make(chan struct{}),
 		processedMessages:    make(chan *raftpb.Message, 4096),
 		processedSnapshots:   make(chan snapshotReport, 4096),
@@ -94,6 +97,15 @@ func (r *mockRaft) ProcessRaftMessage(ctx context.Context, req *api.ProcessRaftM
 	return &api.ProcessRaftMessageResponse{}, nil
 }
 
+func (r *mockRaft) StreamRaftMessage(stream api.Raft_StreamRaftMessageServer) error {
+	m, err := r.snapshotReceiver.Receive(stream)
+	if err != nil {
+		return err
+	}
+	_, err = r.ProcessRaftMessage(stream.Context(), &api.ProcessRaftMessageRequest{Message: m})
+	return err
+}
+
 func (r *mockRaft) ResolveAddress(ctx context.Context, req *api.ResolveAddressRequest) (*api.ResolveAddressResponse, error) {
 	addr, err := r.tr.PeerAddr(req.RaftID)
 	if err != nil {
@@ -36,6 +36,8 @@ type peer struct {
 
 	active       bool
 	becameActive time.Time
+	// lastFailure is the last time sending a message to the peer failed
+	lastFailure time.Time
 }
 
 func newPeer(id uint64, addr string, tr *Transport) (*peer, error) {
@@ -65,6 +67,7 @@ func (p *peer) send(m raftpb.Message) (err error) {
 		if err != nil {
 			p.active = false
 			p.becameActive = time.Time{}
+			p.lastFailure = time.Now()
 		}
 		p.mu.Unlock()
 	}()
@@ -141,9 +144,16 @@ func (p *peer) reportSnapshot(failure bool) {
 }
 
 func (p *peer) sendProcessMessage(ctx context.Context, m raftpb.Message) error {
-	ctx, cancel := context.WithTimeout(ctx, p.tr.config.SendTimeout)
-	defer cancel()
-	_, err := api.NewRaftClient(p.conn()).ProcessRaftMessage(ctx, &api.ProcessRaftMessageRequest{Message: &m})
+	var err error
+	if m.Type == raftpb.MsgSnap {
+		err = p.streamSnapshot(ctx, m)
+		if grpc.Code(err) == codes.Unimplemented {
+			// the peer can't receive streamed snapshots
+			err = p.processMessage(ctx, m)
+		}
+	} else {
+		err = p.processMessage(ctx, m)
+	}
 	if grpc.Code(err) == codes.NotFound && grpc.ErrorDesc(err) == membership.ErrMemberRemoved.Error() {
 		p.tr.config.NodeRemoved()
 	}
@@ -161,6 +171,13 @@ func (p *peer) sendProcessMessage(ctx context.Context, m raftpb.Message) error {
 	return nil
 }
 
+func (p *peer) processMessage(ctx context.Context, m raftpb.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, p.tr.config.SendTimeout)
+	defer cancel()
+	_, err := api.NewRaftClient(p.conn()).ProcessRaftMessage(ctx, &api.ProcessRaftMessageRequest{Message: &m})
+	return err
+}
+
 func healthCheckConn(ctx context.Context, cc *grpc.ClientConn) error {
 	resp, err := api.NewHealthClient(cc).Check(ctx, &api.HealthCheckRequest{Service: "Raft"})
 	if err != nil {
@@ -191,9 +208,16 @@ func (p *peer) setInactive() {
 	p.mu.Lock()
 	p.active = false
 	p.becameActive = time.Time{}
+	p.lastFailure = time.Now()
 	p.mu.Unlock()
 }
 
+func (p *peer) lastFailureTime() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lastFailure
+}
+
 func (p *peer) activeTime() time.Time {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -0,0 +1,245 @@
+package transport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/docker/swarmkit/api"
+	"github.com/pkg/errors"
+)
+
+const (
+	// snapshotChunkSize is the size of the chunks snapshots are streamed
+	// in.
+	snapshotChunkSize = 1 << 20
+	// snapshotSendTimeout bounds the time taken to stream a snapshot to a
+	// peer. An interrupted transfer is resumed when raft sends the
+	// snapshot again.
+	snapshotSendTimeout = 5 * time.Minute
+)
+
+// streamSnapshot sends m, which carries a snapshot, to the peer, streaming
+// the snapshot data in chunks as it is compressed.
+func (p *peer) streamSnapshot(ctx context.Context, m raftpb.Message) error {
+	checksum := sha256.Sum256(m.Snapshot.Data)
+
+	ctx, cancel := context.WithTimeout(ctx, snapshotSendTimeout)
+	defer cancel()
+	stream, err := api.NewRaftClient(p.conn()).StreamRaftMessage(ctx)
+	if err != nil {
+		return err
+	}
+
+	header := m
+	header.Snapshot.Data = nil
+	if err := stream.Send(&api.StreamRaftMessageRequest{
+		Message:      &header,
+		SnapshotSize: uint64(len(m.Snapshot.Data)),
+		Checksum:     checksum[:],
+		Compression:  api.StreamRaftMessageRequest_GZIP,
+	}); err != nil {
+		return closeSnapshotStream(stream, err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	compressed := compressSnapshot(m.Snapshot.Data)
+	defer compressed.Close()
+
+	// Compressing the same data gives the same output, so the data the
+	// peer already has is skipped.
+	if _, err := io.CopyN(ioutil.Discard, compressed, int64(resp.Offset)); err != nil {
+		return errors.Wrapf(err, "failed to skip snapshot data up to offset %d", resp.Offset)
+	}
+
+	chunk := make([]byte, snapshotChunkSize)
+	for offset := resp.Offset; ; {
+		n, err := io.ReadFull(compressed, chunk)
+		if n > 0 {
+			if err := stream.Send(&api.StreamRaftMessageRequest{
+				Offset: offset,
+				Chunk:  chunk[:n],
+			}); err != nil {
+				return closeSnapshotStream(stream, err)
+			}
+			offset += uint64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to compress snapshot")
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	// the stream ends once the peer has processed the message
+	if _, err := stream.Recv(); err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// closeSnapshotStream returns the error which ended the stream when sending
+// on it failed with err.
+func closeSnapshotStream(stream api.Raft_StreamRaftMessageClient, err error) error {
+	if err != io.EOF {
+		return err
+	}
+	// the peer closed the stream, the actual error is returned by Recv
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	return errors.New("peer closed the snapshot stream")
+}
+
+// compressSnapshot returns a reader of the compressed data. The data is
+// compressed as it is read, and the reader must be closed.
+func compressSnapshot(data []byte) io.ReadCloser {
+	r, w := io.Pipe()
+	go func() {
+		gw := gzip.NewWriter(w)
+		_, err := gw.Write(data)
+		if err == nil {
+			err = gw.Close()
+		}
+		w.CloseWithError(err)
+	}()
+	return r
+}
+
+// maxCompressedSize bounds the size of size bytes of data once compressed,
+// for data which doesn't compress.
+func maxCompressedSize(size uint64) uint64 {
+	return size + size>>12 + 1024
+}
+
+func decompressSnapshot(data []byte, compression api.StreamRaftMessageRequest_Compression) ([]byte, error) {
+	switch compression {
+	case api.StreamRaftMessageRequest_NONE:
+		return data, nil
+	case api.StreamRaftMessageRequest_GZIP:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	}
+	return nil, errors.Errorf("unknown snapshot compression %s", compression)
+}
+
+// SnapshotReceiver reassembles the snapshots streamed by peers. It keeps the
+// data of an interrupted transfer from each peer, so that the transfer can
+// be resumed when the peer sends the same snapshot again.
+type SnapshotReceiver struct {
+	mu      sync.Mutex
+	partial map[uint64]*partialSnapshot
+}
+
+type partialSnapshot struct {
+	checksum []byte
+	size     uint64
+	data     []byte
+}
+
+// NewSnapshotReceiver returns a SnapshotReceiver with no partial transfers.
+func NewSnapshotReceiver() *SnapshotReceiver {
+	return &SnapshotReceiver{
+		partial: make(map[uint64]*partialSnapshot),
+	}
+}
+
+// take returns the partial transfer of the snapshot from the given peer, or
+// a new one if the peer was sending a different snapshot.
+func (r *SnapshotReceiver) take(from uint64, checksum []byte, size uint64) *partialSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ps := r.partial[from]
+	delete(r.partial, from)
+	if ps == nil || ps.size != size || !bytes.Equal(ps.checksum, checksum) {
+		ps = &partialSnapshot{checksum: checksum, size: size}
+	}
+	return ps
+}
+
+func (r *SnapshotReceiver) keep(from uint64, ps *partialSnapshot) {
+	r.mu.Lock()
+	r.partial[from] = ps
+	r.mu.Unlock()
+}
+
+// Receive reads a raft message from stream, reassembling its snapshot data.
+// The stream must be ended by the caller once the message is processed.
+func (r *SnapshotReceiver) Receive(stream api.Raft_StreamRaftMessageServer) (*raftpb.Message, error) {
+	first, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if first.Message == nil || len(first.Checksum) != sha256.Size {
+		return nil, grpc.Errorf(codes.InvalidArgument, "the first request of the stream must carry the message and its checksum")
+	}
+	from := first.Message.From
+
+	ps := r.take(from, first.Checksum, first.SnapshotSize)
+	complete := false
+	defer func() {
+		if !complete {
+			r.keep(from, ps)
+		}
+	}()
+
+	if err := stream.Send(&api.StreamRaftMessageResponse{Offset: uint64(len(ps.data))}); err != nil {
+		return nil, err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if req.Offset != uint64(len(ps.data)) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "snapshot chunk at offset %d, expected %d", req.Offset, len(ps.data))
+		}
+		if uint64(len(ps.data)+len(req.Chunk)) > maxCompressedSize(ps.size) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "compressed snapshot data exceeds the bound for its size %d", ps.size)
+		}
+		ps.data = append(ps.data, req.Chunk...)
+	}
+
+	// the transfer is over, whether the data is valid or not
+	complete = true
+
+	data, err := decompressSnapshot(ps.data, first.Compression)
+	if err != nil {
+		return nil, grpc.Errorf(codes.DataLoss, "failed to decompress snapshot: %v", err)
+	}
+	checksum := sha256.Sum256(data)
+	if uint64(len(data)) != ps.size || !bytes.Equal(checksum[:], ps.checksum) {
+		return nil, grpc.Errorf(codes.DataLoss, "snapshot checksum mismatch")
+	}
+
+	m := *first.Message
+	m.Snapshot.Data = data
+	return &m, nil
+}
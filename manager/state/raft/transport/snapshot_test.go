@@ -0,0 +1,153 @@
+package transport
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendLargeSnapshot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	c := newCluster()
+	defer func() {
+		cancel()
+		c.Stop()
+	}()
+	require.NoError(t, c.Add(1))
+	require.NoError(t, c.Add(2))
+
+	// larger than the default gRPC message size limit, and incompressible
+	data := make([]byte, 5*snapshotChunkSize+42)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Get(1).tr.Send(raftpb.Message{
+		Type:     raftpb.MsgSnap,
+		From:     1,
+		To:       2,
+		Snapshot: raftpb.Snapshot{Data: data, Metadata: raftpb.SnapshotMetadata{Index: 10, Term: 2}},
+	}))
+
+	select {
+	case msg := <-c.Get(2).processedMessages:
+		assert.Equal(t, raftpb.MsgSnap, msg.Type)
+		assert.Equal(t, uint64(10), msg.Snapshot.Metadata.Index)
+		assert.True(t, bytes.Equal(data, msg.Snapshot.Data), "snapshot data doesn't match")
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+}
+
+// mockSnapshotStream is a server stream replaying requests, and failing once
+// they are all received unless closed is set.
+type mockSnapshotStream struct {
+	grpc.ServerStream
+
+	requests  []*api.StreamRaftMessageRequest
+	closed    bool
+	responses []*api.StreamRaftMessageResponse
+}
+
+func (s *mockSnapshotStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *mockSnapshotStream) Send(resp *api.StreamRaftMessageResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func (s *mockSnapshotStream) Recv() (*api.StreamRaftMessageRequest, error) {
+	if len(s.requests) == 0 {
+		if s.closed {
+			return nil, io.EOF
+		}
+		return nil, errors.New("connection lost")
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func TestSnapshotReceiverResume(t *testing.T) {
+	raw := []byte("some snapshot data, sent in two chunks")
+	data, err := ioutil.ReadAll(compressSnapshot(raw))
+	require.NoError(t, err)
+	// the sender skips the data the receiver has by compressing again
+	again, err := ioutil.ReadAll(compressSnapshot(raw))
+	require.NoError(t, err)
+	require.Equal(t, data, again)
+
+	checksum := sha256.Sum256(raw)
+	header := &api.StreamRaftMessageRequest{
+		Message:      &raftpb.Message{Type: raftpb.MsgSnap, From: 1, To: 2},
+		SnapshotSize: uint64(len(raw)),
+		Checksum:     checksum[:],
+		Compression:  api.StreamRaftMessageRequest_GZIP,
+	}
+	half := uint64(len(data) / 2)
+
+	r := NewSnapshotReceiver()
+
+	// the first transfer is interrupted after the first chunk
+	stream := &mockSnapshotStream{
+		requests: []*api.StreamRaftMessageRequest{
+			header,
+			{Offset: 0, Chunk: data[:half]},
+		},
+	}
+	_, err = r.Receive(stream)
+	assert.Error(t, err)
+	require.Len(t, stream.responses, 1)
+	assert.Equal(t, uint64(0), stream.responses[0].Offset)
+
+	// the second one resumes after it
+	stream = &mockSnapshotStream{
+		requests: []*api.StreamRaftMessageRequest{
+			header,
+			{Offset: half, Chunk: data[half:]},
+		},
+		closed: true,
+	}
+	m, err := r.Receive(stream)
+	require.NoError(t, err)
+	require.Len(t, stream.responses, 1)
+	assert.Equal(t, half, stream.responses[0].Offset)
+	assert.Equal(t, "some snapshot data, sent in two chunks", string(m.Snapshot.Data))
+
+	// a corrupted transfer is rejected, and not resumed
+	corrupted := append([]byte{}, data...)
+	corrupted[0]++
+	stream = &mockSnapshotStream{
+		requests: []*api.StreamRaftMessageRequest{
+			header,
+			{Offset: 0, Chunk: corrupted},
+		},
+		closed: true,
+	}
+	_, err = r.Receive(stream)
+	assert.Equal(t, codes.DataLoss, grpc.Code(err))
+
+	stream = &mockSnapshotStream{
+		requests: []*api.StreamRaftMessageRequest{header},
+		closed:   true,
+	}
+	_, err = r.Receive(stream)
+	assert.Error(t, err)
+	require.Len(t, stream.responses, 1)
+	assert.Equal(t, uint64(0), stream.responses[0].Offset)
+}
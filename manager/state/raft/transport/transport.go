@@ -296,6 +296,18 @@ func (t *Transport) Active(id uint64) bool {
 	return active
 }
 
+// LastFailure returns the last time sending a message to the node failed, or
+// the zero time if it never did.
+func (t *Transport) LastFailure(id uint64) time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p, ok := t.peers[id]
+	if !ok {
+		return time.Time{}
+	}
+	return p.lastFailureTime()
+}
+
 // LongestActive returns the ID of the peer that has been active for the longest
 // length of time.
 func (t *Transport) LongestActive() (uint64, error) {
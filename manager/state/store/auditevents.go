@@ -0,0 +1,251 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state"
+	memdb "github.com/hashicorp/go-memdb"
+)
+
+const (
+	tableAuditEvent = "auditevent"
+
+	indexSequence        = "sequence"
+	indexReverseSequence = "reversesequence"
+)
+
+func init() {
+	register(ObjectStoreConfig{
+		Name: tableAuditEvent,
+		Table: &memdb.TableSchema{
+			Name: tableAuditEvent,
+			Indexes: map[string]*memdb.IndexSchema{
+				indexID: {
+					Name:    indexID,
+					Unique:  true,
+					Indexer: auditEventIndexerByID{},
+				},
+				indexSequence: {
+					Name:    indexSequence,
+					Indexer: auditEventIndexerBySequence{},
+				},
+				indexReverseSequence: {
+					Name:    indexReverseSequence,
+					Indexer: auditEventIndexerBySequence{reverse: true},
+				},
+			},
+		},
+		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
+			var err error
+			snapshot.AuditEvents, err = FindAuditEvents(tx, All)
+			return err
+		},
+		Restore: func(tx Tx, snapshot *api.StoreSnapshot) error {
+			auditEvents, err := FindAuditEvents(tx, All)
+			if err != nil {
+				return err
+			}
+			for _, e := range auditEvents {
+				if err := DeleteAuditEvent(tx, e.ID); err != nil {
+					return err
+				}
+			}
+			for _, e := range snapshot.AuditEvents {
+				if err := CreateAuditEvent(tx, e); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		ApplyStoreAction: func(tx Tx, sa *api.StoreAction) error {
+			switch v := sa.Target.(type) {
+			case *api.StoreAction_AuditEvent:
+				obj := v.AuditEvent
+				switch sa.Action {
+				case api.StoreActionKindCreate:
+					return CreateAuditEvent(tx, obj)
+				case api.StoreActionKindUpdate:
+					return UpdateAuditEvent(tx, obj)
+				case api.StoreActionKindRemove:
+					return DeleteAuditEvent(tx, obj.ID)
+				}
+			}
+			return errUnknownStoreAction
+		},
+		NewStoreAction: func(c state.Event) (api.StoreAction, error) {
+			var sa api.StoreAction
+			switch v := c.(type) {
+			case state.EventCreateAuditEvent:
+				sa.Action = api.StoreActionKindCreate
+				sa.Target = &api.StoreAction_AuditEvent{
+					AuditEvent: v.AuditEvent,
+				}
+			case state.EventUpdateAuditEvent:
+				sa.Action = api.StoreActionKindUpdate
+				sa.Target = &api.StoreAction_AuditEvent{
+					AuditEvent: v.AuditEvent,
+				}
+			case state.EventDeleteAuditEvent:
+				sa.Action = api.StoreActionKindRemove
+				sa.Target = &api.StoreAction_AuditEvent{
+					AuditEvent: v.AuditEvent,
+				}
+			default:
+				return api.StoreAction{}, errUnknownStoreAction
+			}
+			return sa, nil
+		},
+	})
+}
+
+type auditEventEntry struct {
+	*api.AuditEvent
+}
+
+func (e auditEventEntry) ID() string {
+	return e.AuditEvent.ID
+}
+
+func (e auditEventEntry) Meta() api.Meta {
+	return e.AuditEvent.Meta
+}
+
+func (e auditEventEntry) SetMeta(meta api.Meta) {
+	e.AuditEvent.Meta = meta
+}
+
+func (e auditEventEntry) Copy() Object {
+	return auditEventEntry{e.AuditEvent.Copy()}
+}
+
+func (e auditEventEntry) EventCreate() state.Event {
+	return state.EventCreateAuditEvent{AuditEvent: e.AuditEvent}
+}
+
+func (e auditEventEntry) EventUpdate() state.Event {
+	return state.EventUpdateAuditEvent{AuditEvent: e.AuditEvent}
+}
+
+func (e auditEventEntry) EventDelete() state.Event {
+	return state.EventDeleteAuditEvent{AuditEvent: e.AuditEvent}
+}
+
+// CreateAuditEvent adds a new audit event to the store.
+// Returns ErrExist if the ID is already taken.
+func CreateAuditEvent(tx Tx, e *api.AuditEvent) error {
+	return tx.create(tableAuditEvent, auditEventEntry{e})
+}
+
+// UpdateAuditEvent updates an existing audit event in the store.
+// Returns ErrNotExist if the audit event doesn't exist.
+func UpdateAuditEvent(tx Tx, e *api.AuditEvent) error {
+	return tx.update(tableAuditEvent, auditEventEntry{e})
+}
+
+// DeleteAuditEvent removes an audit event from the store.
+// Returns ErrNotExist if the audit event doesn't exist.
+func DeleteAuditEvent(tx Tx, id string) error {
+	return tx.delete(tableAuditEvent, id)
+}
+
+// GetAuditEvent looks up an audit event by ID.
+// Returns nil if the audit event doesn't exist.
+func GetAuditEvent(tx ReadTx, id string) *api.AuditEvent {
+	e := tx.get(tableAuditEvent, id)
+	if e == nil {
+		return nil
+	}
+	return e.(auditEventEntry).AuditEvent
+}
+
+// OldestAuditEvent returns the audit event with the lowest sequence number.
+// Returns nil if there are no audit events.
+func OldestAuditEvent(tx ReadTx) *api.AuditEvent {
+	return firstAuditEvent(tx, indexSequence)
+}
+
+// NewestAuditEvent returns the audit event with the highest sequence number.
+// Returns nil if there are no audit events.
+func NewestAuditEvent(tx ReadTx) *api.AuditEvent {
+	return firstAuditEvent(tx, indexReverseSequence)
+}
+
+func firstAuditEvent(tx ReadTx, index string) *api.AuditEvent {
+	e := tx.lookup(tableAuditEvent, index+prefix, "")
+	if e == nil {
+		return nil
+	}
+	return e.Copy().(auditEventEntry).AuditEvent
+}
+
+// FindAuditEvents selects a set of audit events and returns them.
+func FindAuditEvents(tx ReadTx, by By) ([]*api.AuditEvent, error) {
+	checkType := func(by By) error {
+		switch by.(type) {
+		case byIDPrefix:
+			return nil
+		default:
+			return ErrInvalidFindBy
+		}
+	}
+
+	auditEventList := []*api.AuditEvent{}
+	appendResult := func(o Object) {
+		auditEventList = append(auditEventList, o.(auditEventEntry).AuditEvent)
+	}
+
+	err := tx.find(tableAuditEvent, by, checkType, appendResult)
+	return auditEventList, err
+}
+
+type auditEventIndexerByID struct{}
+
+func (ei auditEventIndexerByID) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ei auditEventIndexerByID) FromObject(obj interface{}) (bool, []byte, error) {
+	e, ok := obj.(auditEventEntry)
+	if !ok {
+		panic("unexpected type passed to FromObject")
+	}
+
+	// Add the null character as a terminator
+	val := e.AuditEvent.ID + "\x00"
+	return true, []byte(val), nil
+}
+
+func (ei auditEventIndexerByID) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
+
+// auditEventIndexerBySequence orders audit events by increasing sequence
+// number, or by decreasing sequence number if reverse is set.
+type auditEventIndexerBySequence struct {
+	reverse bool
+}
+
+func (ei auditEventIndexerBySequence) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ei auditEventIndexerBySequence) FromObject(obj interface{}) (bool, []byte, error) {
+	e, ok := obj.(auditEventEntry)
+	if !ok {
+		panic("unexpected type passed to FromObject")
+	}
+
+	seq := e.AuditEvent.Sequence
+	if ei.reverse {
+		seq = ^seq
+	}
+	// Fixed width, so that the lexical order is the numerical order. Add
+	// the null character as a terminator
+	val := fmt.Sprintf("%016x", seq) + "\x00"
+	return true, []byte(val), nil
+}
+
+func (ei auditEventIndexerBySequence) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
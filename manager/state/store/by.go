@@ -143,3 +143,50 @@ func (b byReferencedSecretID) isBy() {
 func ByReferencedSecretID(secretID string) By {
 	return byReferencedSecretID(secretID)
 }
+
+type bySubject string
+
+func (b bySubject) isBy() {
+}
+
+// BySubject creates an object to pass to Find to search for a role bound to
+// the given user.
+func BySubject(subject string) By {
+	return bySubject(subject)
+}
+
+type byVersionOf string
+
+func (b byVersionOf) isBy() {
+}
+
+// ByVersionOf creates an object to pass to Find to search for the versions
+// of the secret with the given ID.
+func ByVersionOf(secretID string) By {
+	return byVersionOf(secretID)
+}
+
+type byLabel struct {
+	key   string
+	value string
+}
+
+func (b byLabel) isBy() {
+}
+
+// ByLabel creates an object to pass to Find to select objects with the
+// given label set to the given value.
+func ByLabel(key, value string) By {
+	return byLabel{key: key, value: value}
+}
+
+type byLabelKey string
+
+func (b byLabelKey) isBy() {
+}
+
+// ByLabelKey creates an object to pass to Find to select objects which have
+// the given label, whatever its value.
+func ByLabelKey(key string) By {
+	return byLabelKey(key)
+}
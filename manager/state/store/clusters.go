@@ -32,6 +32,11 @@ func init() {
 					Unique:  true,
 					Indexer: clusterIndexerByName{},
 				},
+				indexLabel: {
+					Name:         indexLabel,
+					AllowMissing: true,
+					Indexer:      labelIndexer{},
+				},
 			},
 		},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
@@ -173,7 +178,7 @@ func GetCluster(tx ReadTx, id string) *api.Cluster {
 func FindClusters(tx ReadTx, by By) ([]*api.Cluster, error) {
 	checkType := func(by By) error {
 		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix:
+		case byName, byNamePrefix, byIDPrefix, byLabel, byLabelKey:
 			return nil
 		default:
 			return ErrInvalidFindBy
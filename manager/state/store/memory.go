@@ -31,6 +31,9 @@ const (
 	indexMembership   = "membership"
 	indexNetwork      = "network"
 	indexSecret       = "secret"
+	indexSubject      = "subject"
+	indexVersionOf    = "versionof"
+	indexLabel        = "label"
 
 	prefix = "_prefix"
 
@@ -135,6 +138,48 @@ func prefixFromArgs(args ...interface{}) ([]byte, error) {
 	return val, nil
 }
 
+// labelIndexer indexes objects by their labels. Each label is indexed as its
+// key and value, so that objects can also be selected by key, using a prefix.
+type labelIndexer struct{}
+
+func (li labelIndexer) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (li labelIndexer) FromObject(obj interface{}) (bool, [][]byte, error) {
+	var labels map[string]string
+	switch v := obj.(type) {
+	case clusterEntry:
+		labels = v.Spec.Annotations.Labels
+	case networkEntry:
+		labels = v.Spec.Annotations.Labels
+	case nodeEntry:
+		labels = v.Spec.Annotations.Labels
+	case roleEntry:
+		labels = v.Spec.Annotations.Labels
+	case secretEntry:
+		labels = v.Spec.Annotations.Labels
+	case serviceEntry:
+		labels = v.Spec.Annotations.Labels
+	case taskEntry:
+		// Tasks are listed by the labels of their service
+		labels = v.ServiceAnnotations.Labels
+	default:
+		panic("unexpected type passed to FromObject")
+	}
+
+	var vals [][]byte
+	for k, v := range labels {
+		// Add the null character as a terminator
+		vals = append(vals, []byte(k+"\x00"+v+"\x00"))
+	}
+	return len(vals) != 0, vals, nil
+}
+
+func (li labelIndexer) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
+
 // ReadTx is a read transaction. Note that transaction does not imply
 // any internal batching. It only means that the transaction presents a
 // consistent view of the data that cannot be affected by other
@@ -638,6 +683,30 @@ func (tx readTx) findIterators(table string, by By, checkType func(By) error) ([
 			return nil, err
 		}
 		return []memdb.ResultIterator{it}, nil
+	case bySubject:
+		it, err := tx.memDBTx.Get(table, indexSubject, string(v))
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{it}, nil
+	case byVersionOf:
+		it, err := tx.memDBTx.Get(table, indexVersionOf, string(v))
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{it}, nil
+	case byLabel:
+		it, err := tx.memDBTx.Get(table, indexLabel, v.key+"\x00"+v.value)
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{it}, nil
+	case byLabelKey:
+		it, err := tx.memDBTx.Get(table, indexLabel+prefix, string(v)+"\x00")
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{it}, nil
 	default:
 		return nil, ErrInvalidFindBy
 	}
@@ -686,8 +755,12 @@ func (s *MemoryStore) Save(tx ReadTx) (*pb.StoreSnapshot, error) {
 }
 
 // Restore sets the contents of the store to the serialized data in the
-// argument.
+// argument. Data from an older schema version is restored as is, it is
+// migrated by the leader when it calls Migrate.
 func (s *MemoryStore) Restore(snapshot *pb.StoreSnapshot) error {
+	if snapshotSchemaVersion(snapshot) > SchemaVersion() {
+		return ErrSchemaTooNew
+	}
 	return s.updateLocal(func(tx Tx) error {
 		for _, os := range objectStorers {
 			if err := os.Restore(tx, snapshot); err != nil {
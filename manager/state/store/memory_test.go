@@ -2,6 +2,7 @@ package store
 
 import (
 	"errors"
+	"sort"
 	"strconv"
 	"sync"
 	"testing"
@@ -453,6 +454,37 @@ func TestStoreNetwork(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStoreAuditEventSequence(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+
+	s.View(func(readTx ReadTx) {
+		assert.Nil(t, OldestAuditEvent(readTx))
+		assert.Nil(t, NewestAuditEvent(readTx))
+	})
+
+	err := s.Update(func(tx Tx) error {
+		for _, seq := range []uint64{3, 1, 257, 2} {
+			assert.NoError(t, CreateAuditEvent(tx, &api.AuditEvent{
+				ID:       "e" + strconv.FormatUint(seq, 10),
+				Sequence: seq,
+			}))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = s.Update(func(tx Tx) error {
+		assert.Equal(t, "e1", OldestAuditEvent(tx).ID)
+		assert.Equal(t, "e257", NewestAuditEvent(tx).ID)
+
+		assert.NoError(t, DeleteAuditEvent(tx, "e1"))
+		assert.Equal(t, "e2", OldestAuditEvent(tx).ID)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
 func TestStoreTask(t *testing.T) {
 	s := NewMemoryStore(nil)
 	assert.NotNil(t, s)
@@ -560,6 +592,85 @@ func TestStoreTask(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestStoreByLabel(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	labeledService := func(id string, labels map[string]string) *api.Service {
+		return &api.Service{
+			ID: id,
+			Spec: api.ServiceSpec{
+				Annotations: api.Annotations{
+					Name:   id,
+					Labels: labels,
+				},
+			},
+		}
+	}
+
+	err := s.Update(func(tx Tx) error {
+		assert.NoError(t, CreateService(tx, labeledService("id1", map[string]string{"env": "prod", "team": "a"})))
+		assert.NoError(t, CreateService(tx, labeledService("id2", map[string]string{"env": "dev"})))
+		assert.NoError(t, CreateService(tx, labeledService("id3", map[string]string{"environment": "prod"})))
+		assert.NoError(t, CreateService(tx, labeledService("id4", nil)))
+		assert.NoError(t, CreateTask(tx, &api.Task{
+			ID:                 "task1",
+			ServiceAnnotations: api.Annotations{Labels: map[string]string{"env": "prod"}},
+		}))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	serviceIDs := func(services []*api.Service) []string {
+		var ids []string
+		for _, s := range services {
+			ids = append(ids, s.ID)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	s.View(func(readTx ReadTx) {
+		foundServices, err := FindServices(readTx, ByLabel("env", "prod"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id1"}, serviceIDs(foundServices))
+
+		foundServices, err = FindServices(readTx, ByLabelKey("env"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id1", "id2"}, serviceIDs(foundServices))
+
+		foundServices, err = FindServices(readTx, Or(ByLabel("team", "a"), ByLabelKey("environment")))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id1", "id3"}, serviceIDs(foundServices))
+
+		foundServices, err = FindServices(readTx, ByLabelKey("invalid"))
+		assert.NoError(t, err)
+		assert.Empty(t, foundServices)
+
+		foundTasks, err := FindTasks(readTx, ByLabel("env", "prod"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 1)
+	})
+
+	// The index follows label updates
+	err = s.Update(func(tx Tx) error {
+		service := GetService(tx, "id1")
+		service.Spec.Annotations.Labels = map[string]string{"env": "dev"}
+		return UpdateService(tx, service)
+	})
+	assert.NoError(t, err)
+
+	s.View(func(readTx ReadTx) {
+		foundServices, err := FindServices(readTx, ByLabel("env", "prod"))
+		assert.NoError(t, err)
+		assert.Empty(t, foundServices)
+
+		foundServices, err = FindServices(readTx, ByLabel("env", "dev"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id1", "id2"}, serviceIDs(foundServices))
+	})
+}
+
 func TestStoreSnapshot(t *testing.T) {
 	s1 := NewMemoryStore(nil)
 	assert.NotNil(t, s1)
@@ -0,0 +1,43 @@
+package store
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var objectsDesc = prometheus.NewDesc(
+	"swarm_store_objects",
+	"Number of objects in the store, by table.",
+	[]string{"table"}, nil,
+)
+
+// objectsCollector is a prometheus.Collector reporting the number of objects
+// in each table of a MemoryStore.
+type objectsCollector struct {
+	s *MemoryStore
+}
+
+// NewObjectsCollector returns a prometheus.Collector reporting the number of
+// objects in each table of the store s. It is meant to be registered for as
+// long as s is in use.
+func NewObjectsCollector(s *MemoryStore) prometheus.Collector {
+	return objectsCollector{s: s}
+}
+
+func (c objectsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- objectsDesc
+}
+
+func (c objectsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.s.View(func(tx ReadTx) {
+		memDBTx := tx.(readTx).memDBTx
+		for _, os := range objectStorers {
+			it, err := memDBTx.Get(os.Name, indexID)
+			if err != nil {
+				continue
+			}
+			count := 0
+			for obj := it.Next(); obj != nil; obj = it.Next() {
+				count++
+			}
+			ch <- prometheus.MustNewConstMetric(objectsDesc, prometheus.GaugeValue, float64(count), os.Name)
+		}
+	})
+}
@@ -0,0 +1,35 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectsCollector(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	setupTestStore(t, s)
+
+	ch := make(chan prometheus.Metric, len(objectStorers))
+	NewObjectsCollector(s).Collect(ch)
+	close(ch)
+
+	counts := make(map[string]float64)
+	for metric := range ch {
+		var m dto.Metric
+		require.NoError(t, metric.Write(&m))
+		require.Len(t, m.Label, 1)
+		counts[m.Label[0].GetValue()] = m.Gauge.GetValue()
+	}
+
+	assert.Len(t, counts, len(objectStorers))
+	assert.Equal(t, float64(len(nodeSet)), counts[tableNode])
+	assert.Equal(t, float64(len(serviceSet)), counts[tableService])
+	assert.Equal(t, float64(len(taskSet)), counts[tableTask])
+}
@@ -0,0 +1,156 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/log"
+	"golang.org/x/net/context"
+)
+
+// ErrSchemaTooNew is returned when the data in the store was migrated to a
+// newer schema version than this manager supports.
+var ErrSchemaTooNew = errors.New("store data uses a newer schema version than this manager supports")
+
+// Migration transforms the objects in the store to a new schema version.
+type Migration struct {
+	// Version is the schema version the migration upgrades the store to.
+	Version uint32
+	// Description says what the migration changes.
+	Description string
+	// Migrate transforms the objects in the store. It runs in the same
+	// transaction as the update recording the new version.
+	Migrate func(tx Tx) error
+}
+
+var migrations []Migration
+
+// RegisterMigration registers a migration. Migrations must be registered in
+// order, each one upgrading the schema version by one.
+func RegisterMigration(m Migration) {
+	if m.Version != SchemaVersion()+1 {
+		panic(fmt.Sprintf("migration to schema version %d registered after version %d", m.Version, SchemaVersion()))
+	}
+	migrations = append(migrations, m)
+}
+
+// SchemaVersion returns the newest store schema version supported by this
+// manager.
+func SchemaVersion() uint32 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+func init() {
+	RegisterMigration(Migration{
+		Version:     1,
+		Description: "move the deprecated service networks into the task spec",
+		Migrate:     migrateServiceNetworks,
+	})
+}
+
+// Migrate upgrades the objects in the store to the newest schema version
+// and records it in the cluster object, so that managers which don't
+// support it can't join the cluster. It must be called by the leader: each
+// migration is proposed to the raft cluster with the version it records, so
+// that followers apply the same changes instead of migrating on their own.
+func Migrate(ctx context.Context, s *MemoryStore) error {
+	for _, m := range migrations {
+		var applied bool
+		err := s.Update(func(tx Tx) error {
+			clusters, err := FindClusters(tx, ByName(DefaultClusterName))
+			if err != nil {
+				return err
+			}
+			if len(clusters) != 1 {
+				return fmt.Errorf("cluster object not found")
+			}
+			cluster := clusters[0]
+			if cluster.SchemaVersion > SchemaVersion() {
+				return ErrSchemaTooNew
+			}
+			if cluster.SchemaVersion >= m.Version {
+				return nil
+			}
+			if err := m.Migrate(tx); err != nil {
+				return fmt.Errorf("migration to schema version %d failed: %v", m.Version, err)
+			}
+			cluster.SchemaVersion = m.Version
+			applied = true
+			return UpdateCluster(tx, cluster)
+		})
+		if err != nil {
+			return err
+		}
+		if applied {
+			log.G(ctx).Infof("migrated store to schema version %d: %s", m.Version, m.Description)
+		}
+	}
+	return nil
+}
+
+// snapshotSchemaVersion returns the schema version recorded in the cluster
+// object of a snapshot.
+func snapshotSchemaVersion(snapshot *api.StoreSnapshot) uint32 {
+	for _, c := range snapshot.Clusters {
+		if c.Spec.Annotations.Name == DefaultClusterName {
+			return c.SchemaVersion
+		}
+	}
+	return 0
+}
+
+// migrateServiceNetworks moves the networks from the deprecated
+// ServiceSpec.Networks field into the task spec. The tasks of these services
+// get the same networks in their spec, so that they are not considered
+// dirty and restarted by the orchestrators.
+func migrateServiceNetworks(tx Tx) error {
+	services, err := FindServices(tx, All)
+	if err != nil {
+		return err
+	}
+	for _, s := range services {
+		migrated := moveServiceNetworks(&s.Spec)
+		if s.PreviousSpec != nil && moveServiceNetworks(s.PreviousSpec) {
+			migrated = true
+		}
+		if !migrated {
+			continue
+		}
+		if err := UpdateService(tx, s); err != nil {
+			return err
+		}
+
+		tasks, err := FindTasks(tx, ByServiceID(s.ID))
+		if err != nil {
+			return err
+		}
+		for _, t := range tasks {
+			if len(t.Spec.Networks) != 0 || len(s.Spec.Task.Networks) == 0 {
+				continue
+			}
+			t.Spec.Networks = make([]*api.NetworkAttachmentConfig, 0, len(s.Spec.Task.Networks))
+			for _, na := range s.Spec.Task.Networks {
+				t.Spec.Networks = append(t.Spec.Networks, na.Copy())
+			}
+			if err := UpdateTask(tx, t); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func moveServiceNetworks(spec *api.ServiceSpec) bool {
+	if len(spec.Networks) == 0 {
+		return false
+	}
+	if len(spec.Task.Networks) == 0 {
+		spec.Task.Networks = spec.Networks
+	}
+	spec.Networks = nil
+	return true
+}
@@ -0,0 +1,85 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+func legacyNetworksService() *api.Service {
+	return &api.Service{
+		ID: "legacy",
+		Spec: api.ServiceSpec{
+			Annotations: api.Annotations{Name: "legacy"},
+			Networks:    []*api.NetworkAttachmentConfig{{Target: "net1"}},
+		},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		require.NoError(t, CreateCluster(tx, &api.Cluster{
+			ID:   "cluster",
+			Spec: api.ClusterSpec{Annotations: api.Annotations{Name: DefaultClusterName}},
+		}))
+		require.NoError(t, CreateService(tx, legacyNetworksService()))
+		require.NoError(t, CreateTask(tx, &api.Task{ID: "task1", ServiceID: "legacy"}))
+		return nil
+	}))
+
+	require.NoError(t, Migrate(context.Background(), s))
+	s.View(func(tx ReadTx) {
+		assert.Equal(t, SchemaVersion(), GetCluster(tx, "cluster").SchemaVersion)
+		service := GetService(tx, "legacy")
+		assert.Empty(t, service.Spec.Networks)
+		assert.Equal(t, []*api.NetworkAttachmentConfig{{Target: "net1"}}, service.Spec.Task.Networks)
+		assert.Equal(t, service.Spec.Task.Networks, GetTask(tx, "task1").Spec.Networks)
+	})
+
+	// migrating again doesn't change anything
+	var version api.Version
+	s.View(func(tx ReadTx) {
+		version = GetCluster(tx, "cluster").Meta.Version
+	})
+	require.NoError(t, Migrate(context.Background(), s))
+	s.View(func(tx ReadTx) {
+		assert.Equal(t, version, GetCluster(tx, "cluster").Meta.Version)
+	})
+
+	// data from a newer manager isn't touched
+	require.NoError(t, s.Update(func(tx Tx) error {
+		cluster := GetCluster(tx, "cluster")
+		cluster.SchemaVersion = SchemaVersion() + 1
+		return UpdateCluster(tx, cluster)
+	}))
+	assert.Equal(t, ErrSchemaTooNew, Migrate(context.Background(), s))
+}
+
+func TestRestoreDoesNotMigrate(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	cluster := &api.Cluster{
+		ID:   "cluster",
+		Spec: api.ClusterSpec{Annotations: api.Annotations{Name: DefaultClusterName}},
+	}
+	snapshot := &api.StoreSnapshot{
+		Clusters: []*api.Cluster{cluster},
+		Services: []*api.Service{legacyNetworksService()},
+	}
+	require.NoError(t, s.Restore(snapshot))
+	s.View(func(tx ReadTx) {
+		// only the leader migrates the data
+		assert.Len(t, GetService(tx, "legacy").Spec.Networks, 1)
+		assert.Zero(t, GetCluster(tx, "cluster").SchemaVersion)
+	})
+
+	cluster.SchemaVersion = SchemaVersion() + 1
+	assert.Equal(t, ErrSchemaTooNew, s.Restore(snapshot))
+}
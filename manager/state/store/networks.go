@@ -26,6 +26,11 @@ func init() {
 					Unique:  true,
 					Indexer: networkIndexerByName{},
 				},
+				indexLabel: {
+					Name:         indexLabel,
+					AllowMissing: true,
+					Indexer:      labelIndexer{},
+				},
 			},
 		},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
@@ -167,7 +172,7 @@ func GetNetwork(tx ReadTx, id string) *api.Network {
 func FindNetworks(tx ReadTx, by By) ([]*api.Network, error) {
 	checkType := func(by By) error {
 		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix:
+		case byName, byNamePrefix, byIDPrefix, byLabel, byLabelKey:
 			return nil
 		default:
 			return ErrInvalidFindBy
@@ -36,6 +36,11 @@ func init() {
 					Name:    indexMembership,
 					Indexer: nodeIndexerByMembership{},
 				},
+				indexLabel: {
+					Name:         indexLabel,
+					AllowMissing: true,
+					Indexer:      labelIndexer{},
+				},
 			},
 		},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
@@ -165,7 +170,7 @@ func GetNode(tx ReadTx, id string) *api.Node {
 func FindNodes(tx ReadTx, by By) ([]*api.Node, error) {
 	checkType := func(by By) error {
 		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byRole, byMembership:
+		case byName, byNamePrefix, byIDPrefix, byRole, byMembership, byLabel, byLabelKey:
 			return nil
 		default:
 			return ErrInvalidFindBy
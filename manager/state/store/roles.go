@@ -0,0 +1,257 @@
+package store
+
+import (
+	"strings"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state"
+	memdb "github.com/hashicorp/go-memdb"
+)
+
+const tableRole = "role"
+
+func init() {
+	register(ObjectStoreConfig{
+		Name: tableRole,
+		Table: &memdb.TableSchema{
+			Name: tableRole,
+			Indexes: map[string]*memdb.IndexSchema{
+				indexID: {
+					Name:    indexID,
+					Unique:  true,
+					Indexer: roleIndexerByID{},
+				},
+				indexName: {
+					Name:    indexName,
+					Unique:  true,
+					Indexer: roleIndexerByName{},
+				},
+				indexSubject: {
+					Name:         indexSubject,
+					AllowMissing: true,
+					Indexer:      roleIndexerBySubject{},
+				},
+				indexLabel: {
+					Name:         indexLabel,
+					AllowMissing: true,
+					Indexer:      labelIndexer{},
+				},
+			},
+		},
+		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
+			var err error
+			snapshot.Roles, err = FindRoles(tx, All)
+			return err
+		},
+		Restore: func(tx Tx, snapshot *api.StoreSnapshot) error {
+			roles, err := FindRoles(tx, All)
+			if err != nil {
+				return err
+			}
+			for _, s := range roles {
+				if err := DeleteRole(tx, s.ID); err != nil {
+					return err
+				}
+			}
+			for _, s := range snapshot.Roles {
+				if err := CreateRole(tx, s); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		ApplyStoreAction: func(tx Tx, sa *api.StoreAction) error {
+			switch v := sa.Target.(type) {
+			case *api.StoreAction_Role:
+				obj := v.Role
+				switch sa.Action {
+				case api.StoreActionKindCreate:
+					return CreateRole(tx, obj)
+				case api.StoreActionKindUpdate:
+					return UpdateRole(tx, obj)
+				case api.StoreActionKindRemove:
+					return DeleteRole(tx, obj.ID)
+				}
+			}
+			return errUnknownStoreAction
+		},
+		NewStoreAction: func(c state.Event) (api.StoreAction, error) {
+			var sa api.StoreAction
+			switch v := c.(type) {
+			case state.EventCreateRole:
+				sa.Action = api.StoreActionKindCreate
+				sa.Target = &api.StoreAction_Role{
+					Role: v.Role,
+				}
+			case state.EventUpdateRole:
+				sa.Action = api.StoreActionKindUpdate
+				sa.Target = &api.StoreAction_Role{
+					Role: v.Role,
+				}
+			case state.EventDeleteRole:
+				sa.Action = api.StoreActionKindRemove
+				sa.Target = &api.StoreAction_Role{
+					Role: v.Role,
+				}
+			default:
+				return api.StoreAction{}, errUnknownStoreAction
+			}
+			return sa, nil
+		},
+	})
+}
+
+type roleEntry struct {
+	*api.Role
+}
+
+func (s roleEntry) ID() string {
+	return s.Role.ID
+}
+
+func (s roleEntry) Meta() api.Meta {
+	return s.Role.Meta
+}
+
+func (s roleEntry) SetMeta(meta api.Meta) {
+	s.Role.Meta = meta
+}
+
+func (s roleEntry) Copy() Object {
+	return roleEntry{s.Role.Copy()}
+}
+
+func (s roleEntry) EventCreate() state.Event {
+	return state.EventCreateRole{Role: s.Role}
+}
+
+func (s roleEntry) EventUpdate() state.Event {
+	return state.EventUpdateRole{Role: s.Role}
+}
+
+func (s roleEntry) EventDelete() state.Event {
+	return state.EventDeleteRole{Role: s.Role}
+}
+
+// CreateRole adds a new role to the store.
+// Returns ErrExist if the ID is already taken.
+func CreateRole(tx Tx, s *api.Role) error {
+	// Ensure the name is not already in use.
+	if tx.lookup(tableRole, indexName, strings.ToLower(s.Spec.Annotations.Name)) != nil {
+		return ErrNameConflict
+	}
+
+	return tx.create(tableRole, roleEntry{s})
+}
+
+// UpdateRole updates an existing role in the store.
+// Returns ErrNotExist if the role doesn't exist.
+func UpdateRole(tx Tx, s *api.Role) error {
+	// Ensure the name is either not in use or already used by this same Role.
+	if existing := tx.lookup(tableRole, indexName, strings.ToLower(s.Spec.Annotations.Name)); existing != nil {
+		if existing.ID() != s.ID {
+			return ErrNameConflict
+		}
+	}
+
+	return tx.update(tableRole, roleEntry{s})
+}
+
+// DeleteRole removes a role from the store.
+// Returns ErrNotExist if the role doesn't exist.
+func DeleteRole(tx Tx, id string) error {
+	return tx.delete(tableRole, id)
+}
+
+// GetRole looks up a role by ID.
+// Returns nil if the role doesn't exist.
+func GetRole(tx ReadTx, id string) *api.Role {
+	n := tx.get(tableRole, id)
+	if n == nil {
+		return nil
+	}
+	return n.(roleEntry).Role
+}
+
+// FindRoles selects a set of roles and returns them.
+func FindRoles(tx ReadTx, by By) ([]*api.Role, error) {
+	checkType := func(by By) error {
+		switch by.(type) {
+		case byName, byNamePrefix, byIDPrefix, bySubject, byLabel, byLabelKey:
+			return nil
+		default:
+			return ErrInvalidFindBy
+		}
+	}
+
+	roleList := []*api.Role{}
+	appendResult := func(o Object) {
+		roleList = append(roleList, o.(roleEntry).Role)
+	}
+
+	err := tx.find(tableRole, by, checkType, appendResult)
+	return roleList, err
+}
+
+type roleIndexerByID struct{}
+
+func (ci roleIndexerByID) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ci roleIndexerByID) FromObject(obj interface{}) (bool, []byte, error) {
+	s, ok := obj.(roleEntry)
+	if !ok {
+		panic("unexpected type passed to FromObject")
+	}
+
+	// Add the null character as a terminator
+	val := s.Role.ID + "\x00"
+	return true, []byte(val), nil
+}
+
+func (ci roleIndexerByID) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
+
+type roleIndexerByName struct{}
+
+func (ci roleIndexerByName) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ci roleIndexerByName) FromObject(obj interface{}) (bool, []byte, error) {
+	s, ok := obj.(roleEntry)
+	if !ok {
+		panic("unexpected type passed to FromObject")
+	}
+
+	// Add the null character as a terminator
+	return true, []byte(strings.ToLower(s.Spec.Annotations.Name) + "\x00"), nil
+}
+
+func (ci roleIndexerByName) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
+
+type roleIndexerBySubject struct{}
+
+func (ri roleIndexerBySubject) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ri roleIndexerBySubject) FromObject(obj interface{}) (bool, [][]byte, error) {
+	r, ok := obj.(roleEntry)
+	if !ok {
+		panic("unexpected type passed to FromObject")
+	}
+
+	var subjects [][]byte
+
+	for _, subject := range r.Spec.Subjects {
+		// Add the null character as a terminator
+		subjects = append(subjects, []byte(subject+"\x00"))
+	}
+
+	return len(subjects) != 0, subjects, nil
+}
@@ -22,9 +22,20 @@ func init() {
 					Indexer: secretIndexerByID{},
 				},
 				indexName: {
-					Name:    indexName,
-					Unique:  true,
-					Indexer: secretIndexerByName{},
+					Name:         indexName,
+					Unique:       true,
+					Indexer:      secretIndexerByName{},
+					AllowMissing: true,
+				},
+				indexVersionOf: {
+					Name:         indexVersionOf,
+					Indexer:      secretIndexerByVersionOf{},
+					AllowMissing: true,
+				},
+				indexLabel: {
+					Name:         indexLabel,
+					AllowMissing: true,
+					Indexer:      labelIndexer{},
 				},
 			},
 		},
@@ -126,8 +137,9 @@ func (s secretEntry) EventDelete() state.Event {
 // CreateSecret adds a new secret to the store.
 // Returns ErrExist if the ID is already taken.
 func CreateSecret(tx Tx, s *api.Secret) error {
-	// Ensure the name is not already in use.
-	if tx.lookup(tableSecret, indexName, strings.ToLower(s.Spec.Annotations.Name)) != nil {
+	// Ensure the name is not already in use. Secret versions share the name
+	// of the secret they belong to.
+	if s.VersionOf == "" && tx.lookup(tableSecret, indexName, strings.ToLower(s.Spec.Annotations.Name)) != nil {
 		return ErrNameConflict
 	}
 
@@ -138,9 +150,11 @@ func CreateSecret(tx Tx, s *api.Secret) error {
 // Returns ErrNotExist if the secret doesn't exist.
 func UpdateSecret(tx Tx, s *api.Secret) error {
 	// Ensure the name is either not in use or already used by this same Secret.
-	if existing := tx.lookup(tableSecret, indexName, strings.ToLower(s.Spec.Annotations.Name)); existing != nil {
-		if existing.ID() != s.ID {
-			return ErrNameConflict
+	if s.VersionOf == "" {
+		if existing := tx.lookup(tableSecret, indexName, strings.ToLower(s.Spec.Annotations.Name)); existing != nil {
+			if existing.ID() != s.ID {
+				return ErrNameConflict
+			}
 		}
 	}
 
@@ -163,11 +177,30 @@ func GetSecret(tx ReadTx, id string) *api.Secret {
 	return n.(secretEntry).Secret
 }
 
+// GetSecretVersion looks up the object holding the given version of the
+// data of a secret. Version 1 is held by the secret itself.
+// Returns nil if the secret or version doesn't exist.
+func GetSecretVersion(tx ReadTx, id string, version uint64) *api.Secret {
+	if version <= 1 {
+		return GetSecret(tx, id)
+	}
+	versions, err := FindSecrets(tx, ByVersionOf(id))
+	if err != nil {
+		return nil
+	}
+	for _, s := range versions {
+		if s.Version == version {
+			return s
+		}
+	}
+	return nil
+}
+
 // FindSecrets selects a set of secrets and returns them.
 func FindSecrets(tx ReadTx, by By) ([]*api.Secret, error) {
 	checkType := func(by By) error {
 		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix:
+		case byName, byNamePrefix, byIDPrefix, byVersionOf, byLabel, byLabelKey:
 			return nil
 		default:
 			return ErrInvalidFindBy
@@ -216,6 +249,11 @@ func (ci secretIndexerByName) FromObject(obj interface{}) (bool, []byte, error)
 		panic("unexpected type passed to FromObject")
 	}
 
+	// Secret versions are not indexed by name
+	if s.VersionOf != "" {
+		return false, nil, nil
+	}
+
 	// Add the null character as a terminator
 	return true, []byte(strings.ToLower(s.Spec.Annotations.Name) + "\x00"), nil
 }
@@ -223,3 +261,23 @@ func (ci secretIndexerByName) FromObject(obj interface{}) (bool, []byte, error)
 func (ci secretIndexerByName) PrefixFromArgs(args ...interface{}) ([]byte, error) {
 	return prefixFromArgs(args...)
 }
+
+type secretIndexerByVersionOf struct{}
+
+func (ci secretIndexerByVersionOf) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ci secretIndexerByVersionOf) FromObject(obj interface{}) (bool, []byte, error) {
+	s, ok := obj.(secretEntry)
+	if !ok {
+		panic("unexpected type passed to FromObject")
+	}
+
+	if s.VersionOf == "" {
+		return false, nil, nil
+	}
+
+	// Add the null character as a terminator
+	return true, []byte(s.VersionOf + "\x00"), nil
+}
@@ -532,6 +532,168 @@ func (e EventDeleteSecret) matches(watchEvent events.Event) bool {
 	return true
 }
 
+// RoleCheckFunc is the type of function used to perform filtering checks on
+// api.Role structures.
+type RoleCheckFunc func(v1, v2 *api.Role) bool
+
+// RoleCheckID is a RoleCheckFunc for matching role IDs.
+func RoleCheckID(v1, v2 *api.Role) bool {
+	return v1.ID == v2.ID
+}
+
+// EventCreateRole is the type used to put CreateRole events on the
+// publish/subscribe queue and filter these events in calls to Watch.
+type EventCreateRole struct {
+	Role *api.Role
+	// Checks is a list of functions to call to filter events for a watch
+	// stream. They are applied with AND logic. They are only applicable for
+	// calls to Watch.
+	Checks []RoleCheckFunc
+}
+
+func (e EventCreateRole) matches(watchEvent events.Event) bool {
+	typedEvent, ok := watchEvent.(EventCreateRole)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.Role, typedEvent.Role) {
+			return false
+		}
+	}
+	return true
+}
+
+// EventUpdateRole is the type used to put UpdateRole events on the
+// publish/subscribe queue and filter these events in calls to Watch.
+type EventUpdateRole struct {
+	Role *api.Role
+	// Checks is a list of functions to call to filter events for a watch
+	// stream. They are applied with AND logic. They are only applicable for
+	// calls to Watch.
+	Checks []RoleCheckFunc
+}
+
+func (e EventUpdateRole) matches(watchEvent events.Event) bool {
+	typedEvent, ok := watchEvent.(EventUpdateRole)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.Role, typedEvent.Role) {
+			return false
+		}
+	}
+	return true
+}
+
+// EventDeleteRole is the type used to put DeleteRole events on the
+// publish/subscribe queue and filter these events in calls to Watch.
+type EventDeleteRole struct {
+	Role *api.Role
+	// Checks is a list of functions to call to filter events for a watch
+	// stream. They are applied with AND logic. They are only applicable for
+	// calls to Watch.
+	Checks []RoleCheckFunc
+}
+
+func (e EventDeleteRole) matches(watchEvent events.Event) bool {
+	typedEvent, ok := watchEvent.(EventDeleteRole)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.Role, typedEvent.Role) {
+			return false
+		}
+	}
+	return true
+}
+
+// AuditEventCheckFunc is the type of function used to perform filtering checks on
+// api.AuditEvent structures.
+type AuditEventCheckFunc func(v1, v2 *api.AuditEvent) bool
+
+// AuditEventCheckID is an AuditEventCheckFunc for matching audit event IDs.
+func AuditEventCheckID(v1, v2 *api.AuditEvent) bool {
+	return v1.ID == v2.ID
+}
+
+// EventCreateAuditEvent is the type used to put CreateAuditEvent events on the
+// publish/subscribe queue and filter these events in calls to Watch.
+type EventCreateAuditEvent struct {
+	AuditEvent *api.AuditEvent
+	// Checks is a list of functions to call to filter events for a watch
+	// stream. They are applied with AND logic. They are only applicable for
+	// calls to Watch.
+	Checks []AuditEventCheckFunc
+}
+
+func (e EventCreateAuditEvent) matches(watchEvent events.Event) bool {
+	typedEvent, ok := watchEvent.(EventCreateAuditEvent)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.AuditEvent, typedEvent.AuditEvent) {
+			return false
+		}
+	}
+	return true
+}
+
+// EventUpdateAuditEvent is the type used to put UpdateAuditEvent events on the
+// publish/subscribe queue and filter these events in calls to Watch.
+type EventUpdateAuditEvent struct {
+	AuditEvent *api.AuditEvent
+	// Checks is a list of functions to call to filter events for a watch
+	// stream. They are applied with AND logic. They are only applicable for
+	// calls to Watch.
+	Checks []AuditEventCheckFunc
+}
+
+func (e EventUpdateAuditEvent) matches(watchEvent events.Event) bool {
+	typedEvent, ok := watchEvent.(EventUpdateAuditEvent)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.AuditEvent, typedEvent.AuditEvent) {
+			return false
+		}
+	}
+	return true
+}
+
+// EventDeleteAuditEvent is the type used to put DeleteAuditEvent events on the
+// publish/subscribe queue and filter these events in calls to Watch.
+type EventDeleteAuditEvent struct {
+	AuditEvent *api.AuditEvent
+	// Checks is a list of functions to call to filter events for a watch
+	// stream. They are applied with AND logic. They are only applicable for
+	// calls to Watch.
+	Checks []AuditEventCheckFunc
+}
+
+func (e EventDeleteAuditEvent) matches(watchEvent events.Event) bool {
+	typedEvent, ok := watchEvent.(EventDeleteAuditEvent)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.AuditEvent, typedEvent.AuditEvent) {
+			return false
+		}
+	}
+	return true
+}
+
 // Watch takes a variable number of events to match against. The subscriber
 // will receive events that match any of the arguments passed to Watch.
 //
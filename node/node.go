@@ -82,6 +82,10 @@ type Config struct {
 	// Executor specifies the executor to use for the agent.
 	Executor exec.Executor
 
+	// DiscoverySocket is the path of the local socket the agent serves the
+	// service discovery records on. If empty, the records are not served.
+	DiscoverySocket string
+
 	// ElectionTick defines the amount of ticks needed without
 	// leader to trigger a new election
 	ElectionTick uint32
@@ -424,6 +428,7 @@ waitPeer:
 		DB:               db,
 		NotifyNodeChange: n.notifyNodeChange,
 		Credentials:      creds,
+		DiscoverySocket:  n.config.DiscoverySocket,
 	})
 	if err != nil {
 		return err
@@ -64,10 +64,19 @@ var E_TlsAuthorization = &proto.ExtensionDesc{
 	Tag:           "bytes,73626345,opt,name=tls_authorization,json=tlsAuthorization",
 }
 
+var E_Rbac = &proto.ExtensionDesc{
+	ExtendedType:  (*google_protobuf.ServiceOptions)(nil),
+	ExtensionType: (*bool)(nil),
+	Field:         73626346,
+	Name:          "docker.protobuf.plugin.rbac",
+	Tag:           "varint,73626346,opt,name=rbac",
+}
+
 func init() {
 	proto.RegisterType((*TLSAuthorization)(nil), "docker.protobuf.plugin.TLSAuthorization")
 	proto.RegisterExtension(E_Deepcopy)
 	proto.RegisterExtension(E_TlsAuthorization)
+	proto.RegisterExtension(E_Rbac)
 }
 func (m *TLSAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -402,8 +411,8 @@ var (
 func init() { proto.RegisterFile("plugin.proto", fileDescriptorPlugin) }
 
 var fileDescriptorPlugin = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0xc8, 0x29, 0x4d,
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0xc8, 0x29, 0x4d,
 	0xcf, 0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4b, 0xc9, 0x4f, 0xce, 0x4e, 0x2d,
 	0x82, 0xf0, 0x92, 0x4a, 0xd3, 0xf4, 0x20, 0xb2, 0x52, 0x0a, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9,
 	0xfa, 0x30, 0x71, 0xfd, 0x94, 0xd4, 0xe2, 0xe4, 0xa2, 0xcc, 0x82, 0x92, 0x7c, 0xa8, 0x5a, 0x25,
@@ -416,7 +425,9 @@ var fileDescriptorPlugin = []byte{
 	0x58, 0x4a, 0x8a, 0x4a, 0x53, 0x83, 0xe0, 0x1a, 0xad, 0x2a, 0xb8, 0x04, 0x4b, 0x72, 0x8a, 0xe3,
 	0x13, 0x51, 0xdc, 0x22, 0x87, 0xc5, 0xb4, 0x92, 0x8c, 0xfc, 0x14, 0x98, 0x61, 0x2f, 0x9f, 0xf6,
 	0x2a, 0x2b, 0x30, 0x6a, 0x70, 0x1b, 0x69, 0xe8, 0x61, 0x0f, 0x03, 0x3d, 0x74, 0xef, 0x05, 0x09,
-	0x94, 0xe4, 0x14, 0xa3, 0x88, 0x38, 0x49, 0x9c, 0x78, 0x28, 0xc7, 0x70, 0xe3, 0xa1, 0x1c, 0x43,
-	0xc3, 0x23, 0x39, 0xc6, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
-	0x11, 0x10, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x4c, 0x2c, 0xf3, 0x67, 0x01, 0x00, 0x00,
+	0x94, 0xe4, 0x14, 0xa3, 0x88, 0x58, 0x99, 0x71, 0xb1, 0x14, 0x25, 0x25, 0x26, 0x63, 0x71, 0x7a,
+	0x70, 0x6a, 0x51, 0x59, 0x66, 0x32, 0xdc, 0xe9, 0xaf, 0x20, 0xb6, 0x71, 0x04, 0x81, 0xd5, 0x3b,
+	0x49, 0x9c, 0x78, 0x28, 0xc7, 0x70, 0xe3, 0xa1, 0x1c, 0x43, 0xc3, 0x23, 0x39, 0xc6, 0x13, 0x8f,
+	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x11, 0x30, 0x00, 0xf3, 0x32, 0x93,
+	0x72, 0x9f, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,132 @@
+package rbacwrapper
+
+import (
+	"strings"
+
+	"github.com/docker/swarmkit/protobuf/plugin"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
+)
+
+type rbacWrapperGen struct {
+	gen *generator.Generator
+}
+
+func init() {
+	generator.RegisterPlugin(new(rbacWrapperGen))
+}
+
+func (g *rbacWrapperGen) Init(gen *generator.Generator) {
+	g.gen = gen
+}
+
+func (g *rbacWrapperGen) Name() string {
+	return "rbacwrapper"
+}
+
+func rbacEnabled(s *descriptor.ServiceDescriptorProto) bool {
+	if s.Options == nil {
+		return false
+	}
+	enabled, err := proto.GetExtension(s.Options, plugin.E_Rbac)
+	if err != nil {
+		return false
+	}
+	return *(enabled.(*bool))
+}
+
+func (g *rbacWrapperGen) genRBACStruct(s *descriptor.ServiceDescriptorProto) {
+	g.gen.P("type " + serviceTypeName(s) + " struct {")
+	g.gen.P("	local " + s.GetName() + "Server")
+	g.gen.P("	authorize func(context.Context, string, interface{}) error")
+	g.gen.P("}")
+}
+
+func (g *rbacWrapperGen) genRBACConstructor(s *descriptor.ServiceDescriptorProto) {
+	g.gen.P("func NewRBACWrapper" + s.GetName() + "Server(local " + s.GetName() + "Server, authorize func(context.Context, string, interface{}) error)" + s.GetName() + "Server {")
+	g.gen.P("return &" + serviceTypeName(s) + `{
+		local: local,
+		authorize: authorize,
+	}`)
+	g.gen.P("}")
+}
+
+func getInputTypeName(m *descriptor.MethodDescriptorProto) string {
+	parts := strings.Split(m.GetInputType(), ".")
+	return parts[len(parts)-1]
+}
+
+func getOutputTypeName(m *descriptor.MethodDescriptorProto) string {
+	parts := strings.Split(m.GetOutputType(), ".")
+	return parts[len(parts)-1]
+}
+
+func serviceTypeName(s *descriptor.ServiceDescriptorProto) string {
+	return "rbacWrapper" + s.GetName() + "Server"
+}
+
+func sigPrefix(s *descriptor.ServiceDescriptorProto, m *descriptor.MethodDescriptorProto) string {
+	return "func (p *" + serviceTypeName(s) + ") " + m.GetName() + "("
+}
+
+func (g *rbacWrapperGen) genServerStreamingMethod(s *descriptor.ServiceDescriptorProto, m *descriptor.MethodDescriptorProto) {
+	g.gen.P(sigPrefix(s, m) + "r *" + getInputTypeName(m) + ", stream " + s.GetName() + "_" + m.GetName() + "Server) error {")
+	g.gen.P(`
+	if err := p.authorize(stream.Context(), "` + m.GetName() + `", r); err != nil {
+		return err
+	}
+	return p.local.` + m.GetName() + `(r, stream)`)
+	g.gen.P("}")
+}
+
+func (g *rbacWrapperGen) genClientServerStreamingMethod(s *descriptor.ServiceDescriptorProto, m *descriptor.MethodDescriptorProto) {
+	g.gen.P(sigPrefix(s, m) + "stream " + s.GetName() + "_" + m.GetName() + "Server) error {")
+	g.gen.P(`
+	if err := p.authorize(stream.Context(), "` + m.GetName() + `", nil); err != nil {
+		return err
+	}
+	return p.local.` + m.GetName() + `(stream)`)
+	g.gen.P("}")
+}
+
+func (g *rbacWrapperGen) genSimpleMethod(s *descriptor.ServiceDescriptorProto, m *descriptor.MethodDescriptorProto) {
+	g.gen.P(sigPrefix(s, m) + "ctx context.Context, r *" + getInputTypeName(m) + ") (*" + getOutputTypeName(m) + ", error) {")
+	g.gen.P(`
+	if err := p.authorize(ctx, "` + m.GetName() + `", r); err != nil {
+		return nil, err
+	}
+	return p.local.` + m.GetName() + `(ctx, r)`)
+	g.gen.P("}")
+}
+
+func (g *rbacWrapperGen) genRBACMethod(s *descriptor.ServiceDescriptorProto, m *descriptor.MethodDescriptorProto) {
+	g.gen.P()
+	switch {
+	case m.GetClientStreaming():
+		g.genClientServerStreamingMethod(s, m)
+	case m.GetServerStreaming():
+		g.genServerStreamingMethod(s, m)
+	default:
+		g.genSimpleMethod(s, m)
+	}
+	g.gen.P()
+}
+
+func (g *rbacWrapperGen) Generate(file *generator.FileDescriptor) {
+	for _, s := range file.Service {
+		if !rbacEnabled(s) {
+			continue
+		}
+		g.gen.P()
+		g.genRBACStruct(s)
+		g.genRBACConstructor(s)
+		for _, m := range s.Method {
+			g.genRBACMethod(s, m)
+		}
+		g.gen.P()
+	}
+}
+
+func (g *rbacWrapperGen) GenerateImports(file *generator.FileDescriptor) {
+}
@@ -3,9 +3,12 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
+	"github.com/docker/swarmkit/agent/exec"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/api/naming"
+	"github.com/pkg/errors"
 )
 
 // Context defines the strict set of values that can be injected into a
@@ -18,7 +21,8 @@ type Context struct {
 	}
 
 	Node struct {
-		ID string
+		ID       string
+		Hostname string
 	}
 
 	Task struct {
@@ -70,3 +74,84 @@ func (ctx *Context) Expand(s string) (string, error) {
 
 	return buf.String(), nil
 }
+
+// PayloadContext provides a context for expanding the payload of a secret
+// referenced by a task. In addition to the values of Context, the payload can
+// look up the task's environment and the other secrets the task references.
+type PayloadContext struct {
+	Context
+
+	t       *api.Task
+	secrets exec.SecretGetter
+}
+
+// NewPayloadContextFromTask returns a new payload context for the secrets of
+// task t, running on the node described by node. The secrets getter should be
+// restricted to the secrets referenced by the task.
+func NewPayloadContextFromTask(node *api.NodeDescription, t *api.Task, secrets exec.SecretGetter) PayloadContext {
+	ctx := PayloadContext{
+		Context: NewContextFromTask(t),
+		t:       t,
+		secrets: secrets,
+	}
+	if node != nil {
+		ctx.Node.Hostname = node.Hostname
+	}
+	return ctx
+}
+
+// Secret returns the data of the secret the task references under the given
+// name. Templated secrets are returned without being expanded.
+func (ctx PayloadContext) Secret(secretName string) (string, error) {
+	container := ctx.t.Spec.GetContainer()
+	if container != nil && ctx.secrets != nil {
+		for _, secretRef := range container.Secrets {
+			if secretRef.SecretName != secretName {
+				continue
+			}
+			if secret := ctx.secrets.Get(secretRef.SecretID); secret != nil {
+				return string(secret.Spec.Data), nil
+			}
+		}
+	}
+	return "", errors.Errorf("secret %s not found", secretName)
+}
+
+// Env returns the value of the environment variable name for the task, with
+// templates expanded. It returns an empty string if the variable isn't set.
+func (ctx PayloadContext) Env(name string) (string, error) {
+	container := ctx.t.Spec.GetContainer()
+	if container == nil {
+		return "", nil
+	}
+	env, err := expandEnv(ctx.Context, container.Env)
+	if err != nil {
+		return "", err
+	}
+	for _, value := range env {
+		parts := strings.SplitN(value, "=", 2)
+		if parts[0] == name {
+			if len(parts) > 1 {
+				return parts[1], nil
+			}
+			return "", nil
+		}
+	}
+	return "", nil
+}
+
+// Expand treats the data as a template and populates it with values from
+// the context.
+func (ctx PayloadContext) Expand(data []byte) ([]byte, error) {
+	tmpl, err := newTemplate(string(data))
+	if err != nil {
+		return data, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, ctx); err != nil {
+		return data, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,57 @@
+package template
+
+import (
+	"github.com/docker/swarmkit/agent/exec"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/log"
+)
+
+type templatedSecretGetter struct {
+	secrets exec.SecretGetter
+	ctx     PayloadContext
+	refs    map[string]struct{}
+}
+
+// NewTemplatedSecretGetter returns a secret getter which expands the payload
+// of the secrets task t references with templating enabled. The secrets
+// getter should be restricted to the secrets referenced by the task.
+func NewTemplatedSecretGetter(secrets exec.SecretGetter, t *api.Task, node *api.NodeDescription) exec.SecretGetter {
+	refs := make(map[string]struct{})
+	if container := t.Spec.GetContainer(); container != nil {
+		for _, secretRef := range container.Secrets {
+			if secretRef.Templated {
+				refs[secretRef.SecretID] = struct{}{}
+			}
+		}
+	}
+
+	return &templatedSecretGetter{
+		secrets: secrets,
+		ctx:     NewPayloadContextFromTask(node, t, secrets),
+		refs:    refs,
+	}
+}
+
+// Get returns the secret with the given ID, with its payload expanded if the
+// task references it with templating enabled. If the payload can't be
+// expanded, Get returns nil, as if the secret didn't exist.
+func (g *templatedSecretGetter) Get(secretID string) *api.Secret {
+	secret := g.secrets.Get(secretID)
+	if secret == nil {
+		return nil
+	}
+
+	if _, ok := g.refs[secretID]; !ok {
+		return secret
+	}
+
+	data, err := g.ctx.Expand(secret.Spec.Data)
+	if err != nil {
+		log.L.WithError(err).WithField("secret.id", secretID).Error("failed to expand templated secret")
+		return nil
+	}
+
+	secret = secret.Copy()
+	secret.Spec.Data = data
+	return secret
+}
@@ -0,0 +1,82 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/agent/secrets"
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplatedSecretGetter(t *testing.T) {
+	manager := secrets.NewManager()
+	manager.Add(
+		api.Secret{
+			ID: "templated",
+			Spec: api.SecretSpec{
+				Annotations: api.Annotations{Name: "config"},
+				Data: []byte(`replica-{{.Task.Slot}}.conf on {{.Node.Hostname}} for {{.Service.Name}}
+password={{.Secret "password"}}
+env={{.Env "MODE"}}`),
+			},
+		},
+		api.Secret{
+			ID: "verbatim",
+			Spec: api.SecretSpec{
+				Annotations: api.Annotations{Name: "password"},
+				Data:        []byte("{{.Task.Slot}}"),
+			},
+		},
+		api.Secret{
+			ID: "broken",
+			Spec: api.SecretSpec{
+				Annotations: api.Annotations{Name: "broken"},
+				Data:        []byte(`{{.Secret "unknown"}}`),
+			},
+		},
+		api.Secret{
+			ID: "unreferenced",
+			Spec: api.SecretSpec{
+				Annotations: api.Annotations{Name: "unreferenced"},
+				Data:        []byte("hidden"),
+			},
+		},
+	)
+
+	task := modifyTask(func(t *api.Task) {
+		t.Spec = api.TaskSpec{
+			Runtime: &api.TaskSpec_Container{
+				Container: &api.ContainerSpec{
+					Env: []string{"MODE=task-{{.Task.ID}}"},
+					Secrets: []*api.SecretReference{
+						{SecretID: "templated", SecretName: "config", Templated: true},
+						{SecretID: "verbatim", SecretName: "password"},
+						{SecretID: "broken", SecretName: "broken", Templated: true},
+					},
+				},
+			},
+		}
+	})
+
+	getter := NewTemplatedSecretGetter(secrets.Restrict(manager, task), task, &api.NodeDescription{Hostname: "host1"})
+
+	secret := getter.Get("templated")
+	require.NotNil(t, secret)
+	assert.Equal(t, `replica-10.conf on host1 for serviceName
+password={{.Task.Slot}}
+env=task-taskID`, string(secret.Spec.Data))
+
+	// the stored secret isn't modified
+	assert.Contains(t, string(manager.Get("templated").Spec.Data), "{{.Task.Slot}}")
+
+	// secrets referenced without templating are returned verbatim
+	secret = getter.Get("verbatim")
+	require.NotNil(t, secret)
+	assert.Equal(t, "{{.Task.Slot}}", string(secret.Spec.Data))
+
+	// secrets which fail to expand, or which the task doesn't reference,
+	// are not returned
+	assert.Nil(t, getter.Get("broken"))
+	assert.Nil(t, getter.Get("unreferenced"))
+}